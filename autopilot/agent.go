@@ -395,6 +395,54 @@ func mergeChanState(pendingChans map[NodeID]LocalChannel,
 	return totalChans
 }
 
+// candidateNodes gathers the set of all nodes in the graph that we could open a
+// channel to, together with their known addresses. Our own node, nodes without
+// any known addresses and the nodes to skip are excluded.
+func candidateNodes(g ChannelGraph, self *btcec.PublicKey,
+	nodesToSkip map[NodeID]struct{}) (map[NodeID]struct{},
+	map[NodeID][]net.Addr, error) {
+
+	selfPubBytes := self.SerializeCompressed()
+	nodes := make(map[NodeID]struct{})
+	addresses := make(map[NodeID][]net.Addr)
+	if err := g.ForEachNode(func(node Node) error {
+		nID := NodeID(node.PubKey())
+
+		// If we come across ourselves, them we'll continue in
+		// order to avoid attempting to make a channel with
+		// ourselves.
+		if bytes.Equal(nID[:], selfPubBytes) {
+			log.Tracef("Skipping self node %x", nID[:])
+			return nil
+		}
+
+		// If the node has no known addresses, we cannot connect to it,
+		// so we'll skip it.
+		addrs := node.Addrs()
+		if len(addrs) == 0 {
+			log.Tracef("Skipping node %x since no addresses known",
+				nID[:])
+			return nil
+		}
+		addresses[nID] = addrs
+
+		// Additionally, if this node is in the blacklist, then
+		// we'll skip it.
+		if _, ok := nodesToSkip[nID]; ok {
+			log.Tracef("Skipping blacklisted node %x", nID[:])
+			return nil
+		}
+
+		nodes[nID] = struct{}{}
+		return nil
+	}); err != nil {
+		return nil, nil, fmt.Errorf("unable to get graph nodes: %v",
+			err)
+	}
+
+	return nodes, addresses, nil
+}
+
 // controller implements the closed-loop control system of the Agent. The
 // controller will make a decision w.r.t channel placement within the graph
 // based on: its current internal state of the set of active channels open,
@@ -595,41 +643,11 @@ func (a *Agent) openChans(availableFunds btcutil.Amount, numChans uint32,
 
 	// Gather the set of all nodes in the graph, except those we
 	// want to skip.
-	selfPubBytes := a.cfg.Self.SerializeCompressed()
-	nodes := make(map[NodeID]struct{})
-	addresses := make(map[NodeID][]net.Addr)
-	if err := a.cfg.Graph.ForEachNode(func(node Node) error {
-		nID := NodeID(node.PubKey())
-
-		// If we come across ourselves, them we'll continue in
-		// order to avoid attempting to make a channel with
-		// ourselves.
-		if bytes.Equal(nID[:], selfPubBytes) {
-			log.Tracef("Skipping self node %x", nID[:])
-			return nil
-		}
-
-		// If the node has no known addresses, we cannot connect to it,
-		// so we'll skip it.
-		addrs := node.Addrs()
-		if len(addrs) == 0 {
-			log.Tracef("Skipping node %x since no addresses known",
-				nID[:])
-			return nil
-		}
-		addresses[nID] = addrs
-
-		// Additionally, if this node is in the blacklist, then
-		// we'll skip it.
-		if _, ok := nodesToSkip[nID]; ok {
-			log.Tracef("Skipping blacklisted node %x", nID[:])
-			return nil
-		}
-
-		nodes[nID] = struct{}{}
-		return nil
-	}); err != nil {
-		return fmt.Errorf("unable to get graph nodes: %v", err)
+	nodes, addresses, err := candidateNodes(
+		a.cfg.Graph, a.cfg.Self, nodesToSkip,
+	)
+	if err != nil {
+		return err
 	}
 
 	// Use the heuristic to calculate a score for each node in the
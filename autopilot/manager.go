@@ -2,10 +2,12 @@ package autopilot
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing"
@@ -390,3 +392,138 @@ func (m *Manager) SetNodeScores(name string, scores map[NodeID]float64) error {
 
 	return nil
 }
+
+// ChannelCandidate is a node the autopilot agent considers for opening a new
+// channel to.
+type ChannelCandidate struct {
+	// NodeID is the serialized compressed pubkey of the node.
+	NodeID NodeID
+
+	// Score is the score the agent's heuristic gives to the node.
+	Score float64
+}
+
+// CandidatePreview describes the channels the autopilot agent would open given
+// the current state.
+type CandidatePreview struct {
+	// AvailableFunds is the amount the agent is allowed to allocate to new
+	// channels.
+	AvailableFunds btcutil.Amount
+
+	// NumChans is the number of channels the agent would open. This is
+	// zero if the agent's constraints don't allow for any new channels.
+	NumChans uint32
+
+	// ChanSize is the size of the channels the candidates are scored for.
+	ChanSize btcutil.Amount
+
+	// Candidates are the scored candidates, sorted by descending score.
+	// The agent makes a choice weighted by score among these.
+	Candidates []ChannelCandidate
+}
+
+// ChannelCandidates computes the candidates the autopilot agent would consider
+// for opening new channels given the current state, without opening any. The
+// candidates are also computed if the agent isn't active, in which case the
+// agent's config is used.
+func (m *Manager) ChannelCandidates() (*CandidatePreview, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	var (
+		totalChans  []LocalChannel
+		nodesToSkip map[NodeID]struct{}
+	)
+
+	// If the agent is active, we'll use its view of the channel state,
+	// which includes the channels pending open and the nodes it is
+	// currently connecting to or failed to open a channel to.
+	if m.pilot != nil {
+		m.pilot.chanStateMtx.Lock()
+		m.pilot.pendingMtx.Lock()
+		totalChans = mergeChanState(
+			m.pilot.pendingOpens, m.pilot.chanState,
+		)
+		nodesToSkip = mergeNodeMaps(
+			m.pilot.pendingOpens, m.pilot.pendingConns,
+			m.pilot.chanState.ConnectedNodes(),
+			m.pilot.failedNodes,
+		)
+		m.pilot.pendingMtx.Unlock()
+		m.pilot.chanStateMtx.Unlock()
+	} else {
+		chans, err := m.cfg.ChannelState()
+		if err != nil {
+			return nil, err
+		}
+
+		totalChans = chans
+		nodesToSkip = make(map[NodeID]struct{})
+		for _, c := range chans {
+			nodesToSkip[c.Node] = struct{}{}
+		}
+	}
+
+	balance, err := m.cfg.PilotCfg.WalletBalance()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get wallet balance: %v", err)
+	}
+
+	constraints := m.cfg.PilotCfg.Constraints
+	availableFunds, numChans := constraints.ChannelBudget(
+		totalChans, balance,
+	)
+
+	// The agent only opens channels if its budget allows for at least one
+	// channel of the minimum size. Otherwise we'll still score the
+	// candidates for the maximum channel size.
+	chanSize := constraints.MaxChanSize()
+	switch {
+	case availableFunds < constraints.MinChanSize():
+		numChans = 0
+
+	case availableFunds < chanSize:
+		chanSize = availableFunds
+	}
+
+	nodes, _, err := candidateNodes(
+		m.cfg.PilotCfg.Graph, m.cfg.Self, nodesToSkip,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	scores, err := m.cfg.PilotCfg.Heuristic.NodeScores(
+		m.cfg.PilotCfg.Graph, totalChans, chanSize, nodes,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to calculate node scores: %v",
+			err)
+	}
+
+	candidates := make([]ChannelCandidate, 0, len(scores))
+	for nID, score := range scores {
+		// Nodes with a zero score are never chosen by the agent.
+		if score.Score <= 0 {
+			continue
+		}
+
+		candidates = append(candidates, ChannelCandidate{
+			NodeID: nID,
+			Score:  score.Score,
+		})
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+
+	log.Debugf("Computed %d channel candidates for chan_size=%v",
+		len(candidates), chanSize)
+
+	return &CandidatePreview{
+		AvailableFunds: availableFunds,
+		NumChans:       numChans,
+		ChanSize:       chanSize,
+		Candidates:     candidates,
+	}, nil
+}
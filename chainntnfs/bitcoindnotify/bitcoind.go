@@ -72,6 +72,10 @@ type BitcoindNotifier struct {
 // time.
 var _ chainntnfs.ChainNotifier = (*BitcoindNotifier)(nil)
 
+// Ensure BitcoindNotifier implements the ReorgHistorian interface at compile
+// time.
+var _ chainntnfs.ReorgHistorian = (*BitcoindNotifier)(nil)
+
 // New returns a new BitcoindNotifier instance. This function assumes the
 // bitcoind node detailed in the passed configuration is already running, and
 // willing to accept RPC requests and new zmq clients.
@@ -143,6 +147,18 @@ func (b *BitcoindNotifier) Started() bool {
 	return atomic.LoadInt32(&b.active) != 0
 }
 
+// ReorgHistory returns the chain reorganizations observed since the notifier
+// was started, ordered from the oldest to the most recent one.
+//
+// NOTE: This is part of the chainntnfs.ReorgHistorian interface.
+func (b *BitcoindNotifier) ReorgHistory() []chainntnfs.ReorgEvent {
+	if !b.Started() {
+		return nil
+	}
+
+	return b.txNotifier.ReorgHistory()
+}
+
 func (b *BitcoindNotifier) startNotifier() error {
 	// Connect to bitcoind, and register for notifications on connected,
 	// and disconnected blocks.
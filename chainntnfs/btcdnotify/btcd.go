@@ -93,6 +93,9 @@ type BtcdNotifier struct {
 // Ensure BtcdNotifier implements the ChainNotifier interface at compile time.
 var _ chainntnfs.ChainNotifier = (*BtcdNotifier)(nil)
 
+// Ensure BtcdNotifier implements the ReorgHistorian interface at compile time.
+var _ chainntnfs.ReorgHistorian = (*BtcdNotifier)(nil)
+
 // New returns a new BtcdNotifier instance. This function assumes the btcd node
 // detailed in the passed configuration is already running, and willing to
 // accept new websockets clients.
@@ -154,6 +157,18 @@ func (b *BtcdNotifier) Started() bool {
 	return atomic.LoadInt32(&b.active) != 0
 }
 
+// ReorgHistory returns the chain reorganizations observed since the notifier
+// was started, ordered from the oldest to the most recent one.
+//
+// NOTE: This is part of the chainntnfs.ReorgHistorian interface.
+func (b *BtcdNotifier) ReorgHistory() []chainntnfs.ReorgEvent {
+	if !b.Started() {
+		return nil
+	}
+
+	return b.txNotifier.ReorgHistory()
+}
+
 // Stop shutsdown the BtcdNotifier.
 func (b *BtcdNotifier) Stop() error {
 	// Already shutting down?
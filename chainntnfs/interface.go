@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -325,6 +326,43 @@ type BlockEpochEvent struct {
 	Cancel func()
 }
 
+// ReorgEvent describes a chain reorganization that the notifier observed.
+type ReorgEvent struct {
+	// OldTipHash is the hash of the tip of the main chain before the
+	// reorganization. It is zero if the notifier didn't know the hash of
+	// its tip, which is the case if the reorganization happened before
+	// the first block was connected after the notifier was started.
+	OldTipHash chainhash.Hash
+
+	// OldTipHeight is the height of the tip of the main chain before the
+	// reorganization.
+	OldTipHeight uint32
+
+	// NewTipHash is the hash of the first block that was connected to the
+	// main chain after the reorganization.
+	NewTipHash chainhash.Hash
+
+	// NewTipHeight is the height of the first block that was connected to
+	// the main chain after the reorganization.
+	NewTipHeight uint32
+
+	// Depth is the number of blocks that were disconnected from the main
+	// chain.
+	Depth uint32
+
+	// Timestamp is the time at which the reorganization was observed.
+	Timestamp time.Time
+}
+
+// ReorgHistorian is implemented by chain notifiers that keep a history of the
+// chain reorganizations they observed.
+type ReorgHistorian interface {
+	// ReorgHistory returns the chain reorganizations observed since the
+	// notifier was started, ordered from the oldest to the most recent
+	// one.
+	ReorgHistory() []ReorgEvent
+}
+
 // NotifierDriver represents a "driver" for a particular interface. A driver is
 // identified by a globally unique string identifier along with a 'New()'
 // method which is responsible for initializing a particular ChainNotifier
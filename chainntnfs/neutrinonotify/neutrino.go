@@ -86,6 +86,10 @@ type NeutrinoNotifier struct {
 // Ensure NeutrinoNotifier implements the ChainNotifier interface at compile time.
 var _ chainntnfs.ChainNotifier = (*NeutrinoNotifier)(nil)
 
+// Ensure NeutrinoNotifier implements the ReorgHistorian interface at compile
+// time.
+var _ chainntnfs.ReorgHistorian = (*NeutrinoNotifier)(nil)
+
 // New creates a new instance of the NeutrinoNotifier concrete implementation
 // of the ChainNotifier interface.
 //
@@ -159,6 +163,18 @@ func (n *NeutrinoNotifier) Started() bool {
 	return atomic.LoadInt32(&n.active) != 0
 }
 
+// ReorgHistory returns the chain reorganizations observed since the notifier
+// was started, ordered from the oldest to the most recent one.
+//
+// NOTE: This is part of the chainntnfs.ReorgHistorian interface.
+func (n *NeutrinoNotifier) ReorgHistory() []chainntnfs.ReorgEvent {
+	if !n.Started() {
+		return nil
+	}
+
+	return n.txNotifier.ReorgHistory()
+}
+
 func (n *NeutrinoNotifier) startNotifier() error {
 	// Start our concurrent queues before starting the rescan, to ensure
 	// onFilteredBlockConnected and onRelavantTx callbacks won't be
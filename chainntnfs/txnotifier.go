@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
@@ -25,6 +26,11 @@ const (
 	// MaxNumConfs is the maximum number of confirmations that can be
 	// requested on a transaction.
 	MaxNumConfs = ReorgSafetyLimit
+
+	// MaxReorgHistory is the maximum number of chain reorganizations that
+	// are kept in the reorg history. Once it is exceeded, the oldest ones
+	// are discarded.
+	MaxReorgHistory = 100
 )
 
 var (
@@ -476,6 +482,19 @@ type TxNotifier struct {
 	// blocks are disconnected without being interrupted by a new block.
 	reorgDepth uint32
 
+	// tipHash is the hash of the block at currentHeight. It is only known
+	// once a block has been connected, and unknown again while blocks are
+	// being disconnected.
+	tipHash chainhash.Hash
+
+	// reorgTip is the tip of the chain before the chain reorganization
+	// we're currently being informed of.
+	reorgTip chainhash.Hash
+
+	// reorgs is the history of the chain reorganizations we've been
+	// informed of, ordered from the oldest to the most recent one.
+	reorgs []ReorgEvent
+
 	// confNotifications is an index of confirmation notification requests
 	// by transaction hash/output script.
 	confNotifications map[ConfRequest]*confNtfnSet
@@ -1365,7 +1384,21 @@ func (n *TxNotifier) ConnectTip(blockHash *chainhash.Hash, blockHeight uint32,
 			n.currentHeight, blockHeight)
 	}
 	n.currentHeight++
+
+	// The hash of the block may be unknown, in which case we record an
+	// empty one.
+	var tipHash chainhash.Hash
+	if blockHash != nil {
+		tipHash = *blockHash
+	}
+
+	// If blocks were disconnected before this one, then the chain
+	// reorganization is over and we can record it.
+	if n.reorgDepth > 0 {
+		n.recordReorg(tipHash, blockHeight)
+	}
 	n.reorgDepth = 0
+	n.tipHash = tipHash
 
 	// First, we'll iterate over all the transactions found in this block to
 	// determine if it includes any relevant transactions to the TxNotifier.
@@ -1688,6 +1721,43 @@ func (n *TxNotifier) NotifyHeight(height uint32) error {
 	return nil
 }
 
+// recordReorg adds the chain reorganization that ended with the block of the
+// given hash and height being connected to the reorg history.
+//
+// NOTE: This must be called with the TxNotifier's lock held.
+func (n *TxNotifier) recordReorg(newTip chainhash.Hash, newHeight uint32) {
+	reorg := ReorgEvent{
+		OldTipHash:   n.reorgTip,
+		OldTipHeight: newHeight - 1 + n.reorgDepth,
+		NewTipHash:   newTip,
+		NewTipHeight: newHeight,
+		Depth:        n.reorgDepth,
+		Timestamp:    time.Now(),
+	}
+
+	Log.Infof("Chain reorganization of depth %d from %v (height=%d) to "+
+		"%v (height=%d)", reorg.Depth, reorg.OldTipHash,
+		reorg.OldTipHeight, reorg.NewTipHash, reorg.NewTipHeight)
+
+	n.reorgs = append(n.reorgs, reorg)
+	if len(n.reorgs) > MaxReorgHistory {
+		n.reorgs = n.reorgs[len(n.reorgs)-MaxReorgHistory:]
+	}
+}
+
+// ReorgHistory returns the chain reorganizations the TxNotifier has been
+// informed of since it was created, ordered from the oldest to the most recent
+// one. At most MaxReorgHistory of them are returned.
+func (n *TxNotifier) ReorgHistory() []ReorgEvent {
+	n.Lock()
+	defer n.Unlock()
+
+	reorgs := make([]ReorgEvent, len(n.reorgs))
+	copy(reorgs, n.reorgs)
+
+	return reorgs
+}
+
 // DisconnectTip handles the tip of the current chain being disconnected during
 // a chain reorganization. If any watched requests were included in this block,
 // internal structures are updated to ensure confirmation/spend notifications
@@ -1709,8 +1779,15 @@ func (n *TxNotifier) DisconnectTip(blockHeight uint32) error {
 			"current height=%d, disconnected height=%d",
 			n.currentHeight, blockHeight)
 	}
+	// Remember the tip of the chain before the first block of the chain
+	// reorganization is disconnected.
+	if n.reorgDepth == 0 {
+		n.reorgTip = n.tipHash
+	}
+
 	n.currentHeight--
 	n.reorgDepth++
+	n.tipHash = chainhash.Hash{}
 
 	// With the block disconnected, we'll update the confirm and spend hints
 	// for our notification requests to reflect the new height, except for
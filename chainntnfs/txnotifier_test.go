@@ -2669,3 +2669,87 @@ func assertSpendDetails(t *testing.T, result, expected *chainntnfs.SpendDetail)
 			expected.SpendingHeight, result.SpendingHeight)
 	}
 }
+
+// TestTxNotifierReorgHistory ensures that the TxNotifier records a chain
+// reorganization once the first block after it is connected, and that a
+// stable chain doesn't result in any history.
+func TestTxNotifierReorgHistory(t *testing.T) {
+	t.Parallel()
+
+	const startHeight = 10
+
+	hintCache := newMockHintCache()
+	n := chainntnfs.NewTxNotifier(
+		startHeight, chainntnfs.ReorgSafetyLimit, hintCache, hintCache,
+	)
+
+	connectBlock := func(height uint32, nonce uint32) chainhash.Hash {
+		block := btcutil.NewBlock(&wire.MsgBlock{
+			Header: wire.BlockHeader{Nonce: nonce},
+		})
+		if err := n.ConnectTip(block.Hash(), height, nil); err != nil {
+			t.Fatalf("unable to connect block: %v", err)
+		}
+		if err := n.NotifyHeight(height); err != nil {
+			t.Fatalf("unable to dispatch notifications: %v", err)
+		}
+
+		return *block.Hash()
+	}
+
+	// Extending the chain doesn't result in any reorg.
+	connectBlock(startHeight+1, 1)
+	connectBlock(startHeight+2, 2)
+	oldTip := connectBlock(startHeight+3, 3)
+
+	if reorgs := n.ReorgHistory(); len(reorgs) != 0 {
+		t.Fatalf("expected empty reorg history, got %v", reorgs)
+	}
+
+	// Disconnecting two blocks doesn't result in a reorg yet, as we don't
+	// know where it ends.
+	for i := uint32(0); i < 2; i++ {
+		if err := n.DisconnectTip(startHeight + 3 - i); err != nil {
+			t.Fatalf("unable to disconnect block: %v", err)
+		}
+	}
+
+	if reorgs := n.ReorgHistory(); len(reorgs) != 0 {
+		t.Fatalf("expected empty reorg history, got %v", reorgs)
+	}
+
+	// Once the first block of the new chain is connected, the reorg is
+	// recorded.
+	newTip := connectBlock(startHeight+2, 4)
+	connectBlock(startHeight+3, 5)
+	connectBlock(startHeight+4, 6)
+
+	reorgs := n.ReorgHistory()
+	if len(reorgs) != 1 {
+		t.Fatalf("expected 1 reorg, got %d", len(reorgs))
+	}
+
+	reorg := reorgs[0]
+	if reorg.OldTipHash != oldTip {
+		t.Fatalf("expected old tip %v, got %v", oldTip,
+			reorg.OldTipHash)
+	}
+	if reorg.OldTipHeight != startHeight+3 {
+		t.Fatalf("expected old tip height %d, got %d", startHeight+3,
+			reorg.OldTipHeight)
+	}
+	if reorg.NewTipHash != newTip {
+		t.Fatalf("expected new tip %v, got %v", newTip,
+			reorg.NewTipHash)
+	}
+	if reorg.NewTipHeight != startHeight+2 {
+		t.Fatalf("expected new tip height %d, got %d", startHeight+2,
+			reorg.NewTipHeight)
+	}
+	if reorg.Depth != 2 {
+		t.Fatalf("expected depth 2, got %d", reorg.Depth)
+	}
+	if reorg.Timestamp.IsZero() {
+		t.Fatalf("expected reorg timestamp")
+	}
+}
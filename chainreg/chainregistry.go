@@ -120,6 +120,10 @@ type Config struct {
 	// CoinSelectionStrategy is the strategy that is used for selecting
 	// coins when funding a transaction.
 	CoinSelectionStrategy wallet.CoinSelectionStrategy
+
+	// DefaultAddressType is the type of the addresses the wallet generates
+	// if no specific type is requested, including change addresses.
+	DefaultAddressType lnwallet.AddressType
 }
 
 const (
@@ -294,6 +298,7 @@ func NewChainControl(cfg *Config, blockCache *blockcache.BlockCache) (
 		Wallet:                cfg.Wallet,
 		LoaderOptions:         cfg.LoaderOptions,
 		CoinSelectionStrategy: cfg.CoinSelectionStrategy,
+		DefaultAddressType:    cfg.DefaultAddressType,
 	}
 
 	var err error
@@ -0,0 +1,132 @@
+package lnd
+
+import (
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/queue"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// chanOpenHistorySize is the number of channel opens that are kept in the
+// channel open history.
+const chanOpenHistorySize = 100
+
+// chanOpenOutcome describes the outcome of a channel open.
+type chanOpenOutcome uint8
+
+const (
+	// chanOpenPending indicates that the channel open is still in
+	// progress.
+	chanOpenPending chanOpenOutcome = iota
+
+	// chanOpenSucceeded indicates that the funding transaction of the
+	// channel has confirmed.
+	chanOpenSucceeded
+
+	// chanOpenFailed indicates that the channel open failed.
+	chanOpenFailed
+)
+
+// chanOpenAttempt describes a channel open that we initiated.
+type chanOpenAttempt struct {
+	// peer is the identity pubkey of the peer the channel is opened with.
+	peer route.Vertex
+
+	// localAmt is the local funding amount requested for the channel.
+	localAmt btcutil.Amount
+
+	// startTime is the time the channel open was initiated.
+	startTime time.Time
+
+	// outcome is the current outcome of the channel open.
+	outcome chanOpenOutcome
+
+	// chanPoint is the funding outpoint of the channel, which is only set
+	// once the funding transaction has been published.
+	chanPoint *wire.OutPoint
+
+	// failure is the reason the channel open failed, if it did.
+	failure error
+}
+
+// chanOpenHistory keeps track of the most recent channel opens that we
+// initiated. Older channel opens are dropped from the history once it is
+// full.
+type chanOpenHistory struct {
+	// attempts holds the tracked channel opens as *chanOpenAttempt.
+	attempts *queue.CircularBuffer
+
+	mu sync.Mutex
+}
+
+// newChanOpenHistory creates a channel open history that keeps track of up to
+// size channel opens.
+func newChanOpenHistory(size int) (*chanOpenHistory, error) {
+	attempts, err := queue.NewCircularBuffer(size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chanOpenHistory{
+		attempts: attempts,
+	}, nil
+}
+
+// add records a new pending channel open with the given peer.
+func (h *chanOpenHistory) add(peer route.Vertex,
+	localAmt btcutil.Amount) *chanOpenAttempt {
+
+	attempt := &chanOpenAttempt{
+		peer:      peer,
+		localAmt:  localAmt,
+		startTime: time.Now(),
+		outcome:   chanOpenPending,
+	}
+
+	h.mu.Lock()
+	h.attempts.Add(attempt)
+	h.mu.Unlock()
+
+	return attempt
+}
+
+// setChanPoint records the funding outpoint of a channel open.
+func (h *chanOpenHistory) setChanPoint(attempt *chanOpenAttempt,
+	chanPoint *wire.OutPoint) {
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	attempt.chanPoint = chanPoint
+}
+
+// resolve records the final outcome of a channel open. A nil failure marks
+// the channel open as succeeded.
+func (h *chanOpenHistory) resolve(attempt *chanOpenAttempt, failure error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	attempt.outcome = chanOpenSucceeded
+	if failure != nil {
+		attempt.outcome = chanOpenFailed
+		attempt.failure = failure
+	}
+}
+
+// list returns a copy of the tracked channel opens, ordered from the oldest to
+// the most recent one.
+func (h *chanOpenHistory) list() []chanOpenAttempt {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	items := h.attempts.List()
+	attempts := make([]chanOpenAttempt, 0, len(items))
+	for _, item := range items {
+		attempts = append(attempts, *item.(*chanOpenAttempt))
+	}
+
+	return attempts
+}
@@ -0,0 +1,53 @@
+// +build !rpctest
+
+package lnd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestChanOpenHistory asserts that the channel open history reports pending
+// channel opens until they are resolved, and only keeps the most recent ones.
+func TestChanOpenHistory(t *testing.T) {
+	t.Parallel()
+
+	history, err := newChanOpenHistory(2)
+	require.NoError(t, err)
+
+	peer := route.Vertex{1}
+	first := history.add(peer, 1000)
+	second := history.add(peer, 2000)
+
+	// Both channel opens are reported as pending.
+	attempts := history.list()
+	require.Len(t, attempts, 2)
+	require.Equal(t, chanOpenPending, attempts[0].outcome)
+	require.Equal(t, chanOpenPending, attempts[1].outcome)
+
+	chanPoint := &wire.OutPoint{Index: 1}
+	history.setChanPoint(first, chanPoint)
+	history.resolve(first, nil)
+
+	failure := errors.New("peer rejected")
+	history.resolve(second, failure)
+
+	attempts = history.list()
+	require.Equal(t, chanOpenSucceeded, attempts[0].outcome)
+	require.Equal(t, chanPoint, attempts[0].chanPoint)
+	require.Equal(t, chanOpenFailed, attempts[1].outcome)
+	require.Equal(t, failure, attempts[1].failure)
+
+	// Adding a third channel open drops the oldest one.
+	history.add(peer, 3000)
+
+	attempts = history.list()
+	require.Len(t, attempts, 2)
+	require.EqualValues(t, 2000, attempts[0].localAmt)
+	require.EqualValues(t, 3000, attempts[1].localAmt)
+	require.Equal(t, chanOpenPending, attempts[1].outcome)
+}
@@ -250,12 +250,72 @@ func (p *peerLog) channelUptime(channelPoint wire.OutPoint) (time.Duration,
 	return now.Sub(channel.openedAt), uptime, nil
 }
 
+// channelUptimeHistory looks up a channel and splits the window that ends at
+// the present into buckets of the given size, returning the peer's uptime in
+// each of them. If the channel has been monitored for less than the window,
+// the buckets only cover the period since we started monitoring it. The last
+// bucket is cut short at the present if the window is not a multiple of the
+// bucket size.
+func (p *peerLog) channelUptimeHistory(channelPoint wire.OutPoint, window,
+	bucketSize time.Duration) ([]*UptimeBucket, error) {
+
+	if window <= 0 || bucketSize <= 0 {
+		return nil, fmt.Errorf("window: %v and bucket size: %v "+
+			"must be positive", window, bucketSize)
+	}
+
+	channel, ok := p.channels[channelPoint]
+	if !ok {
+		return nil, ErrChannelNotFound
+	}
+
+	now := p.clock.Now()
+
+	start := now.Add(-window)
+	if channel.openedAt.After(start) {
+		start = channel.openedAt
+	}
+
+	var buckets []*UptimeBucket
+	for bucketStart := start; bucketStart.Before(now); {
+		bucketEnd := bucketStart.Add(bucketSize)
+		if bucketEnd.After(now) {
+			bucketEnd = now
+		}
+
+		uptime, err := p.uptime(bucketStart, bucketEnd)
+		if err != nil {
+			return nil, err
+		}
+
+		buckets = append(buckets, &UptimeBucket{
+			Start:  bucketStart,
+			End:    bucketEnd,
+			Uptime: uptime,
+		})
+
+		bucketStart = bucketEnd
+	}
+
+	return buckets, nil
+}
+
 // getFlapCount returns the peer's flap count and the timestamp that we last
 // recorded a flap.
 func (p *peerLog) getFlapCount() (int, *time.Time) {
 	return p.flapCount, p.lastFlap
 }
 
+// resetFlapCount resets the peer's flap count to zero and returns the count
+// prior to the reset. The timestamp of the last flap is kept, so that the reset
+// count is persisted along with it.
+func (p *peerLog) resetFlapCount() int {
+	count := p.flapCount
+	p.flapCount = 0
+
+	return count
+}
+
 // listEvents returns all of the events that our event log has tracked,
 // including events that are staged for addition to our set of events but have
 // not yet been committed to (because we rate limit and store only the aggregate
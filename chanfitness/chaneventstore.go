@@ -56,9 +56,16 @@ type ChannelEventStore struct {
 	// chanInfoRequests serves requests for information about our channel.
 	chanInfoRequests chan channelInfoRequest
 
+	// uptimeHistoryRequests serves requests for the uptime history of our
+	// channels.
+	uptimeHistoryRequests chan uptimeHistoryRequest
+
 	// peerRequests serves requests for information about a peer.
 	peerRequests chan peerRequest
 
+	// resetFlapRequests serves requests to reset a peer's flap count.
+	resetFlapRequests chan peerRequest
+
 	quit chan struct{}
 
 	wg sync.WaitGroup
@@ -111,6 +118,19 @@ type channelInfoResponse struct {
 	err  error
 }
 
+type uptimeHistoryRequest struct {
+	peer         route.Vertex
+	channelPoint wire.OutPoint
+	window       time.Duration
+	bucketSize   time.Duration
+	responseChan chan uptimeHistoryResponse
+}
+
+type uptimeHistoryResponse struct {
+	buckets []*UptimeBucket
+	err     error
+}
+
 type peerRequest struct {
 	peer         route.Vertex
 	responseChan chan peerResponse
@@ -127,11 +147,13 @@ type peerResponse struct {
 // called.
 func NewChannelEventStore(config *Config) *ChannelEventStore {
 	store := &ChannelEventStore{
-		cfg:              config,
-		peers:            make(map[route.Vertex]peerMonitor),
-		chanInfoRequests: make(chan channelInfoRequest),
-		peerRequests:     make(chan peerRequest),
-		quit:             make(chan struct{}),
+		cfg:                   config,
+		peers:                 make(map[route.Vertex]peerMonitor),
+		chanInfoRequests:      make(chan channelInfoRequest),
+		uptimeHistoryRequests: make(chan uptimeHistoryRequest),
+		peerRequests:          make(chan peerRequest),
+		resetFlapRequests:     make(chan peerRequest),
+		quit:                  make(chan struct{}),
 	}
 
 	return store
@@ -389,6 +411,13 @@ func (c *ChannelEventStore) consume(subscriptions *subscriptions) {
 			resp.info, resp.err = c.getChanInfo(req)
 			req.responseChan <- resp
 
+		// Serve all requests for channel uptime history.
+		case req := <-c.uptimeHistoryRequests:
+			var resp uptimeHistoryResponse
+
+			resp.buckets, resp.err = c.getUptimeHistory(req)
+			req.responseChan <- resp
+
 		// Serve all requests for information about our peer.
 		case req := <-c.peerRequests:
 			var resp peerResponse
@@ -398,6 +427,15 @@ func (c *ChannelEventStore) consume(subscriptions *subscriptions) {
 			)
 			req.responseChan <- resp
 
+		// Serve all requests to reset a peer's flap count.
+		case req := <-c.resetFlapRequests:
+			var resp peerResponse
+
+			resp.flapCount, resp.ts, resp.err = c.resetFlapCount(
+				req.peer,
+			)
+			req.responseChan <- resp
+
 		case <-c.cfg.FlapCountTicker.Ticks():
 			if err := c.recordFlapCount(); err != nil {
 				log.Errorf("could not record flap "+
@@ -474,6 +512,70 @@ func (c *ChannelEventStore) getChanInfo(req channelInfoRequest) (*ChannelInfo,
 	}, nil
 }
 
+// UptimeBucket describes the uptime of a channel's peer over a period of time.
+type UptimeBucket struct {
+	// Start is the start of the period.
+	Start time.Time
+
+	// End is the end of the period.
+	End time.Time
+
+	// Uptime is the total amount of time that the channel peer has been
+	// observed as online during the period.
+	Uptime time.Duration
+}
+
+// GetUptimeHistory splits the window that ends at the present into buckets of
+// the given size and returns the uptime of the channel's peer in each of them.
+// If we have monitored the channel for less than the window, the buckets only
+// cover the period since the channel was opened.
+func (c *ChannelEventStore) GetUptimeHistory(channelPoint wire.OutPoint,
+	peer route.Vertex, window, bucketSize time.Duration) ([]*UptimeBucket,
+	error) {
+
+	request := uptimeHistoryRequest{
+		peer:         peer,
+		channelPoint: channelPoint,
+		window:       window,
+		bucketSize:   bucketSize,
+		responseChan: make(chan uptimeHistoryResponse),
+	}
+
+	// Send a request for the channel's uptime history to the main event
+	// loop, or return early with an error if the store has already
+	// received a shutdown signal.
+	select {
+	case c.uptimeHistoryRequests <- request:
+	case <-c.quit:
+		return nil, errShuttingDown
+	}
+
+	// Return the response we receive on the response channel or exit early
+	// if the store is instructed to exit.
+	select {
+	case resp := <-request.responseChan:
+		return resp.buckets, resp.err
+
+	case <-c.quit:
+		return nil, errShuttingDown
+	}
+}
+
+// getUptimeHistory collects the uptime history of a channel from the monitor
+// of its peer.
+func (c *ChannelEventStore) getUptimeHistory(
+	req uptimeHistoryRequest) ([]*UptimeBucket, error) {
+
+	peerMonitor, ok := c.peers[req.peer]
+	if !ok {
+		return nil, ErrPeerNotFound
+	}
+
+	return peerMonitor.channelUptimeHistory(
+		req.channelPoint, req.window, req.bucketSize,
+	)
+}
+
 // FlapCount returns the flap count we have for a peer and the timestamp of its
 // last flap. If we do not have any flaps recorded for the peer, the last flap
 // timestamp will be nil.
@@ -538,6 +640,73 @@ func (c *ChannelEventStore) flapCount(peer route.Vertex) (int, *time.Time,
 	}
 }
 
+// ResetFlapCount resets the flap count of a peer to zero, which immediately
+// lifts any flap based rate limiting that is applied to it, rather than waiting
+// for its flap count to cool down over time. The flap count prior to the reset
+// and the timestamp of the peer's last flap are returned. The reset count is
+// written to disk along with the other flap counts. ErrPeerNotFound is
+// returned if we have no flap count recorded for the peer.
+func (c *ChannelEventStore) ResetFlapCount(peer route.Vertex) (int,
+	*time.Time, error) {
+
+	request := peerRequest{
+		peer:         peer,
+		responseChan: make(chan peerResponse),
+	}
+
+	// Send the reset request to the main event loop, or return early with
+	// an error if the store has already received a shutdown signal.
+	select {
+	case c.resetFlapRequests <- request:
+	case <-c.quit:
+		return 0, nil, errShuttingDown
+	}
+
+	// Return the response we receive on the response channel or exit early
+	// if the store is instructed to exit.
+	select {
+	case resp := <-request.responseChan:
+		return resp.flapCount, resp.ts, resp.err
+
+	case <-c.quit:
+		return 0, nil, errShuttingDown
+	}
+}
+
+// resetFlapCount resets the flap count of a peer, loading the peer's record
+// from disk if we are not currently tracking it in memory.
+func (c *ChannelEventStore) resetFlapCount(peer route.Vertex) (int,
+	*time.Time, error) {
+
+	// If we are not tracking the peer in memory, we only reset its count if
+	// we have previously recorded one on disk. We load it into memory so
+	// that the reset count is flushed to disk with our other flap counts.
+	if _, ok := c.peers[peer]; !ok {
+		_, err := c.cfg.ReadFlapCount(peer)
+		switch err {
+		case channeldb.ErrNoPeerBucket:
+			return 0, nil, ErrPeerNotFound
+
+		case nil:
+
+		default:
+			return 0, nil, err
+		}
+	}
+
+	peerMonitor, err := c.getPeerMonitor(peer)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	count := peerMonitor.resetFlapCount()
+	_, lastFlap := peerMonitor.getFlapCount()
+
+	log.Infof("Reset flap count of peer %v from %v to 0", peer, count)
+
+	return count, lastFlap, nil
+}
+
 // recordFlapCount will record our flap count for each peer that we are
 // currently tracking, skipping peers that have a 0 flap count.
 func (c *ChannelEventStore) recordFlapCount() error {
@@ -291,6 +291,84 @@ func TestGetChanInfo(t *testing.T) {
 	ctx.stop()
 }
 
+// TestGetUptimeHistory tests querying the store for the uptime history of a
+// channel, covering the case where the channel is younger than the window.
+func TestGetUptimeHistory(t *testing.T) {
+	ctx := newChanEventStoreTestCtx(t)
+	ctx.start()
+
+	openTime := ctx.clock.Now()
+	peer, pk, channel := ctx.newChannel()
+
+	// Open a channel with an online peer and wait for our store to pick
+	// it up.
+	ctx.peerEvent(peer, true)
+	ctx.sendChannelOpenedUpdate(pk, channel)
+
+	require.Eventually(t, func() bool {
+		_, err := ctx.store.GetChanInfo(channel, peer)
+		return err == nil
+	}, timeout, time.Millisecond*20)
+
+	// Our peer is online for an hour, and then offline for an hour.
+	now := openTime.Add(time.Hour)
+	ctx.clock.SetTime(now)
+	ctx.peerEvent(peer, false)
+
+	// Query our channel's info to make sure that the offline event has
+	// been processed before we progress our time.
+	_, err := ctx.store.GetChanInfo(channel, peer)
+	require.NoError(t, err)
+
+	now = now.Add(time.Hour)
+	ctx.clock.SetTime(now)
+
+	// We expect an error if we do not provide a bucket size.
+	_, err = ctx.store.GetUptimeHistory(channel, peer, time.Hour, 0)
+	require.Error(t, err)
+
+	// Our channel is younger than the window, so we only expect buckets
+	// that cover the period since it was opened.
+	buckets, err := ctx.store.GetUptimeHistory(
+		channel, peer, time.Hour*4, time.Hour,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*UptimeBucket{
+		{
+			Start:  openTime,
+			End:    openTime.Add(time.Hour),
+			Uptime: time.Hour,
+		},
+		{
+			Start:  openTime.Add(time.Hour),
+			End:    now,
+			Uptime: 0,
+		},
+	}, buckets)
+
+	// When the window is shorter than our channel's lifetime, we only
+	// expect buckets within the window, with the last bucket cut short at
+	// the present.
+	buckets, err = ctx.store.GetUptimeHistory(
+		channel, peer, time.Minute*90, time.Hour,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*UptimeBucket{
+		{
+			Start:  now.Add(-time.Minute * 90),
+			End:    now.Add(-time.Minute * 30),
+			Uptime: time.Minute * 30,
+		},
+		{
+			Start:  now.Add(-time.Minute * 30),
+			End:    now,
+			Uptime: 0,
+		},
+	}, buckets)
+
+	ctx.stop()
+}
+
 // TestFlapCount tests querying the store for peer flap counts, covering the
 // case where the peer is tracked in memory, and the case where we need to
 // lookup the peer on disk.
@@ -341,3 +419,64 @@ func TestFlapCount(t *testing.T) {
 
 	ctx.stop()
 }
+
+// TestResetFlapCount tests resetting the flap count of peers that are tracked
+// in memory, only recorded on disk or unknown to the store.
+func TestResetFlapCount(t *testing.T) {
+	var (
+		diskPeer      = route.Vertex{9, 9, 9}
+		diskFlapCount = 3
+		memPeer       = route.Vertex{1, 2, 3}
+		unknownPeer   = route.Vertex{4, 5, 6}
+	)
+
+	ctx := newChanEventStoreTestCtx(t)
+	lastFlap := ctx.clock.Now()
+	ctx.flapUpdates[diskPeer] = &channeldb.FlapCount{
+		Count:    uint32(diskFlapCount),
+		LastFlap: lastFlap,
+	}
+
+	ctx.start()
+
+	// Resetting a peer that we have no record of should fail.
+	_, _, err := ctx.store.ResetFlapCount(unknownPeer)
+	require.Equal(t, ErrPeerNotFound, err)
+
+	// Record two flaps for a peer that we track in memory and reset its
+	// count.
+	ctx.peerEvent(memPeer, true)
+	ctx.peerEvent(memPeer, false)
+
+	count, ts, err := ctx.store.ResetFlapCount(memPeer)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	require.Equal(t, lastFlap, *ts)
+
+	count, ts, err = ctx.store.FlapCount(memPeer)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+	require.Equal(t, lastFlap, *ts)
+
+	// Reset the peer that only has its flap count recorded on disk.
+	count, ts, err = ctx.store.ResetFlapCount(diskPeer)
+	require.NoError(t, err)
+	require.Equal(t, diskFlapCount, count)
+	require.Equal(t, lastFlap, *ts)
+
+	// Both reset counts should be flushed to disk on our next tick.
+	ctx.tickFlapCount()
+	ctx.assertFlapCountUpdated()
+	ctx.assertFlapCountUpdates(peerFlapCountMap{
+		memPeer: {
+			Count:    0,
+			LastFlap: lastFlap,
+		},
+		diskPeer: {
+			Count:    0,
+			LastFlap: lastFlap,
+		},
+	})
+
+	ctx.stop()
+}
@@ -27,8 +27,18 @@ type peerMonitor interface {
 	channelUptime(channelPoint wire.OutPoint) (time.Duration,
 		time.Duration, error)
 
+	// channelUptimeHistory looks up a channel and returns the uptime of
+	// the peer in buckets of the given size over the window that ends at
+	// the present, or since the channel was opened if it is younger.
+	channelUptimeHistory(channelPoint wire.OutPoint, window,
+		bucketSize time.Duration) ([]*UptimeBucket, error)
+
 	// getFlapCount returns the peer's flap count and the timestamp that we
 	// last recorded a flap, which may be nil if we have never recorded a
 	// flap for this peer.
 	getFlapCount() (int, *time.Time)
+
+	// resetFlapCount resets the peer's flap count to zero and returns the
+	// count prior to the reset. The timestamp of the last flap is kept.
+	resetFlapCount() int
 }
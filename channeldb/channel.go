@@ -7,10 +7,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -100,6 +102,14 @@ var (
 	// remote peer during a channel sync in case we have lost channel state.
 	dataLossCommitPointKey = []byte("data-loss-commit-point-key")
 
+	// targetLocalRatioKey stores the share of the channel capacity that
+	// the user would like to be on the local side of the channel.
+	targetLocalRatioKey = []byte("target-local-ratio-key")
+
+	// maxHtlcHoldKey stores the maximum duration that an HTLC forwarded
+	// over the channel may wait to be added to it before it is failed back.
+	maxHtlcHoldKey = []byte("max-htlc-hold-key")
+
 	// forceCloseTxKey points to a the unilateral closing tx that we
 	// broadcasted when moving the channel to state CommitBroadcasted.
 	forceCloseTxKey = []byte("closing-tx-key")
@@ -163,6 +173,19 @@ var (
 	// in the database.
 	ErrNoCommitPoint = fmt.Errorf("no commit point found")
 
+	// ErrNoTargetLocalRatio is returned when no target local balance ratio
+	// has been set for a channel.
+	ErrNoTargetLocalRatio = fmt.Errorf("no target local ratio found")
+
+	// ErrInvalidTargetLocalRatio is returned when a target local balance
+	// ratio outside of [0, 1] is set for a channel.
+	ErrInvalidTargetLocalRatio = fmt.Errorf("target local ratio must be " +
+		"between 0 and 1")
+
+	// ErrNoMaxHtlcHold is returned when no max htlc hold duration has been
+	// set for a channel.
+	ErrNoMaxHtlcHold = fmt.Errorf("no max htlc hold found")
+
 	// ErrNoCloseTx is returned when no closing tx is found for a channel
 	// in the state CommitBroadcasted.
 	ErrNoCloseTx = fmt.Errorf("no closing tx found")
@@ -1048,6 +1071,132 @@ func (c *OpenChannel) DataLossCommitPoint() (*btcec.PublicKey, error) {
 	return commitPoint, nil
 }
 
+// SetTargetLocalRatio stores the share of the channel capacity that the user
+// would like to be on the local side of the channel, which must be between 0
+// and 1. The ratio is only kept locally, it is never advertised to the remote
+// peer or the network.
+func (c *OpenChannel) SetTargetLocalRatio(ratio float64) error {
+	// The negated check also rejects NaN.
+	if !(ratio >= 0 && ratio <= 1) {
+		return ErrInvalidTargetLocalRatio
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	return kvdb.Update(c.Db, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		err = WriteElement(&b, math.Float64bits(ratio))
+		if err != nil {
+			return err
+		}
+
+		return chanBucket.Put(targetLocalRatioKey, b.Bytes())
+	}, func() {})
+}
+
+// TargetLocalRatio retrieves the target local balance ratio stored by
+// SetTargetLocalRatio. If no ratio has been set, ErrNoTargetLocalRatio is
+// returned.
+func (c *OpenChannel) TargetLocalRatio() (float64, error) {
+	var ratioBits uint64
+
+	err := kvdb.View(c.Db, func(tx kvdb.RTx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		switch err {
+		case nil:
+		case ErrNoChanDBExists, ErrNoActiveChannels, ErrChannelNotFound:
+			return ErrNoTargetLocalRatio
+		default:
+			return err
+		}
+
+		bs := chanBucket.Get(targetLocalRatioKey)
+		if bs == nil {
+			return ErrNoTargetLocalRatio
+		}
+
+		return ReadElement(bytes.NewReader(bs), &ratioBits)
+	}, func() {
+		ratioBits = 0
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return math.Float64frombits(ratioBits), nil
+}
+
+// SetMaxHtlcHold stores the maximum duration that an HTLC forwarded over the
+// channel may wait to be added to it before it is failed back. A zero duration
+// removes a previously stored one.
+func (c *OpenChannel) SetMaxHtlcHold(hold time.Duration) error {
+	c.Lock()
+	defer c.Unlock()
+
+	return kvdb.Update(c.Db, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		if hold == 0 {
+			return chanBucket.Delete(maxHtlcHoldKey)
+		}
+
+		var b bytes.Buffer
+		if err := WriteElement(&b, uint64(hold)); err != nil {
+			return err
+		}
+
+		return chanBucket.Put(maxHtlcHoldKey, b.Bytes())
+	}, func() {})
+}
+
+// MaxHtlcHold retrieves the max htlc hold duration stored by SetMaxHtlcHold.
+// If no duration has been set, ErrNoMaxHtlcHold is returned.
+func (c *OpenChannel) MaxHtlcHold() (time.Duration, error) {
+	var hold uint64
+
+	err := kvdb.View(c.Db, func(tx kvdb.RTx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		switch err {
+		case nil:
+		case ErrNoChanDBExists, ErrNoActiveChannels, ErrChannelNotFound:
+			return ErrNoMaxHtlcHold
+		default:
+			return err
+		}
+
+		bs := chanBucket.Get(maxHtlcHoldKey)
+		if bs == nil {
+			return ErrNoMaxHtlcHold
+		}
+
+		return ReadElement(bytes.NewReader(bs), &hold)
+	}, func() {
+		hold = 0
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(hold), nil
+}
+
 // MarkBorked marks the event when the channel as reached an irreconcilable
 // state, such as a channel breach or state desynchronization. Borked channels
 // should never be added to the switch.
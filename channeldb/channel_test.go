@@ -2,11 +2,13 @@ package channeldb
 
 import (
 	"bytes"
+	"math"
 	"math/rand"
 	"net"
 	"reflect"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -1622,3 +1624,66 @@ func TestKeyLocatorEncoding(t *testing.T) {
 	// version are equal.
 	require.Equal(t, keyLoc, decodedKeyLoc)
 }
+
+// TestTargetLocalRatio tests that the target local balance ratio of a channel
+// can be set and updated, and that ratios outside of [0, 1] are rejected.
+func TestTargetLocalRatio(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := MakeTestDB()
+	require.NoError(t, err, "unable to make test database")
+	defer cleanUp()
+
+	channel := createTestChannel(t, cdb, openChannelOption())
+
+	// A channel without a target reports that none is set.
+	_, err = channel.TargetLocalRatio()
+	require.Equal(t, ErrNoTargetLocalRatio, err)
+
+	for _, ratio := range []float64{0.3, 0, 1} {
+		require.NoError(t, channel.SetTargetLocalRatio(ratio))
+
+		stored, err := channel.TargetLocalRatio()
+		require.NoError(t, err, "unable to fetch target ratio")
+		require.Equal(t, ratio, stored)
+	}
+
+	// Invalid ratios must be rejected without touching the stored one.
+	for _, ratio := range []float64{-0.1, 1.1, math.NaN()} {
+		err := channel.SetTargetLocalRatio(ratio)
+		require.Equal(t, ErrInvalidTargetLocalRatio, err)
+	}
+
+	stored, err := channel.TargetLocalRatio()
+	require.NoError(t, err, "unable to fetch target ratio")
+	require.Equal(t, 1.0, stored)
+}
+
+// TestMaxHtlcHold tests that the max htlc hold duration of a channel can be
+// set, updated and removed.
+func TestMaxHtlcHold(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := MakeTestDB()
+	require.NoError(t, err, "unable to make test database")
+	defer cleanUp()
+
+	channel := createTestChannel(t, cdb, openChannelOption())
+
+	// A channel without a duration reports that none is set.
+	_, err = channel.MaxHtlcHold()
+	require.Equal(t, ErrNoMaxHtlcHold, err)
+
+	for _, hold := range []time.Duration{time.Minute, 30 * time.Second} {
+		require.NoError(t, channel.SetMaxHtlcHold(hold))
+
+		stored, err := channel.MaxHtlcHold()
+		require.NoError(t, err, "unable to fetch max htlc hold")
+		require.Equal(t, hold, stored)
+	}
+
+	// A zero duration removes the stored one.
+	require.NoError(t, channel.SetMaxHtlcHold(0))
+	_, err = channel.MaxHtlcHold()
+	require.Equal(t, ErrNoMaxHtlcHold, err)
+}
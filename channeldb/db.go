@@ -280,6 +280,7 @@ var topLevelBuckets = [][]byte{
 	openChannelBucket,
 	closedChannelBucket,
 	forwardingLogBucket,
+	policyHistoryBucket,
 	fwdPackagesKey,
 	invoiceBucket,
 	payAddrIndexBucket,
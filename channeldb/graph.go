@@ -121,6 +121,20 @@ var (
 	// edge's participants.
 	zombieBucket = []byte("zombie-index")
 
+	// zombieEdgeDataBucket is a sub-bucket of the main edgeBucket bucket
+	// that holds on to the data of the channels that were deleted from the
+	// graph when they were marked as zombies, so they can be restored
+	// later on. Each entry exists within the bucket as follows:
+	//
+	// maps: chanID -> edgeInfo
+	//       chanID || 0 -> policy of the first node
+	//       chanID || 1 -> policy of the second node
+	//
+	// The values are serialized in the same format as within the edge
+	// index and the edge bucket respectively. A policy is only stored if
+	// it was known at the time the channel was marked as a zombie.
+	zombieEdgeDataBucket = []byte("zombie-edge-data")
+
 	// disabledEdgePolicyBucket is a sub-bucket of the main edgeBucket bucket
 	// responsible for maintaining an index of disabled edge policies. Each
 	// entry exists within the bucket as follows:
@@ -1961,6 +1975,15 @@ func delChannelEdge(edges, edgeIndex, chanIndex, zombieIndex,
 	var edgeKey [33 + 8]byte
 	copy(edgeKey[33:], chanID)
 
+	// If the channel is becoming a zombie, we'll hold on to its data
+	// before deleting it, so it can be restored later on.
+	if isZombie {
+		err := putZombieEdgeData(edges, edgeIndex, edgeInfo, chanID)
+		if err != nil {
+			return err
+		}
+	}
+
 	// With the latter half constructed, copy over the first public key to
 	// delete the edge in this direction, then the second to delete the
 	// edge in the opposite direction.
@@ -3462,7 +3485,11 @@ func (c *ChannelGraph) MarkEdgeLive(chanID uint64) error {
 
 		var k [8]byte
 		byteOrder.PutUint64(k[:], chanID)
-		return zombieIndex.Delete(k[:])
+		if err := zombieIndex.Delete(k[:]); err != nil {
+			return err
+		}
+
+		return delZombieEdgeData(edges, k[:])
 	}, func() {})
 	if err != nil {
 		return err
@@ -3555,6 +3582,212 @@ func (c *ChannelGraph) NumZombies() (uint64, error) {
 	return numZombies, nil
 }
 
+// ZombieEdge is a channel within the zombie index, along with the public keys
+// of the two nodes of the channel.
+type ZombieEdge struct {
+	// ChannelID is the unique channel ID of the channel.
+	ChannelID uint64
+
+	// NodeKey1Bytes is the raw public key of the first node.
+	NodeKey1Bytes [33]byte
+
+	// NodeKey2Bytes is the raw public key of the second node.
+	NodeKey2Bytes [33]byte
+}
+
+// FetchZombieEdges returns all channels within the zombie index, in ascending
+// order of their channel ID.
+func (c *ChannelGraph) FetchZombieEdges() ([]ZombieEdge, error) {
+	var zombies []ZombieEdge
+	err := kvdb.View(c.db, func(tx kvdb.RTx) error {
+		edges := tx.ReadBucket(edgeBucket)
+		if edges == nil {
+			return nil
+		}
+		zombieIndex := edges.NestedReadBucket(zombieBucket)
+		if zombieIndex == nil {
+			return nil
+		}
+
+		return zombieIndex.ForEach(func(k, v []byte) error {
+			if len(k) != 8 || len(v) != 66 {
+				return fmt.Errorf("invalid zombie index "+
+					"entry %x", k)
+			}
+
+			zombie := ZombieEdge{
+				ChannelID: byteOrder.Uint64(k),
+			}
+			copy(zombie.NodeKey1Bytes[:], v[:33])
+			copy(zombie.NodeKey2Bytes[:], v[33:])
+
+			zombies = append(zombies, zombie)
+			return nil
+		})
+	}, func() {
+		zombies = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return zombies, nil
+}
+
+// FetchZombieEdgeData returns the channel info and policies that were deleted
+// from the graph when the channel was marked as a zombie. Either policy will
+// be nil if it wasn't known at the time. If no data was kept for the channel,
+// then ErrEdgeNotFound is returned.
+func (c *ChannelGraph) FetchZombieEdgeData(chanID uint64) (*ChannelEdgeInfo,
+	*ChannelEdgePolicy, *ChannelEdgePolicy, error) {
+
+	var (
+		edgeInfo *ChannelEdgeInfo
+		policy1  *ChannelEdgePolicy
+		policy2  *ChannelEdgePolicy
+	)
+	err := kvdb.View(c.db, func(tx kvdb.RTx) error {
+		edges := tx.ReadBucket(edgeBucket)
+		if edges == nil {
+			return ErrEdgeNotFound
+		}
+		zombieData := edges.NestedReadBucket(zombieEdgeDataBucket)
+		if zombieData == nil {
+			return ErrEdgeNotFound
+		}
+		nodes := tx.ReadBucket(nodeBucket)
+
+		var k [8 + 1]byte
+		byteOrder.PutUint64(k[:8], chanID)
+
+		info, err := fetchChanEdgeInfo(zombieData, k[:8])
+		if err != nil {
+			return err
+		}
+		info.db = c.db
+		edgeInfo = &info
+
+		// The node a policy points to may have been pruned from the
+		// graph in the meantime, in which case we'll use a shell node
+		// in its place, just like the graph does once the channel is
+		// added again.
+		fetchPolicy := func(direction byte) (*ChannelEdgePolicy, error) {
+			k[8] = direction
+			policyBytes := zombieData.Get(k[:])
+			if policyBytes == nil {
+				return nil, nil
+			}
+
+			policy, pub, err := deserializeChanEdgePolicyRaw(
+				bytes.NewReader(policyBytes),
+			)
+			switch {
+			case err == ErrEdgePolicyOptionalFieldNotFound:
+				return nil, nil
+
+			case err != nil:
+				return nil, err
+			}
+
+			node := LightningNode{PubKeyBytes: pub}
+			if nodes != nil {
+				dbNode, err := fetchLightningNode(nodes, pub[:])
+				switch {
+				case err == nil:
+					node = dbNode
+
+				case err != ErrGraphNodeNotFound:
+					return nil, err
+				}
+			}
+			node.db = c.db
+
+			policy.Node = &node
+			policy.db = c.db
+
+			return policy, nil
+		}
+
+		policy1, err = fetchPolicy(0)
+		if err != nil {
+			return err
+		}
+		policy2, err = fetchPolicy(1)
+
+		return err
+	}, func() {
+		edgeInfo = nil
+		policy1 = nil
+		policy2 = nil
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return edgeInfo, policy1, policy2, nil
+}
+
+// putZombieEdgeData stores the info and known policies of a channel that's
+// about to be deleted from the graph as a zombie within the zombie edge data
+// bucket.
+func putZombieEdgeData(edges, edgeIndex kvdb.RwBucket,
+	edgeInfo ChannelEdgeInfo, chanID []byte) error {
+
+	zombieData, err := edges.CreateBucketIfNotExists(zombieEdgeDataBucket)
+	if err != nil {
+		return err
+	}
+
+	var k [8 + 1]byte
+	copy(k[:8], chanID)
+
+	if err := zombieData.Put(k[:8], edgeIndex.Get(chanID)); err != nil {
+		return err
+	}
+
+	var edgeKey [33 + 8]byte
+	copy(edgeKey[33:], chanID)
+	for i, nodeKey := range [][33]byte{
+		edgeInfo.NodeKey1Bytes, edgeInfo.NodeKey2Bytes,
+	} {
+
+		copy(edgeKey[:33], nodeKey[:])
+		policyBytes := edges.Get(edgeKey[:])
+		if policyBytes == nil || bytes.Equal(policyBytes, unknownPolicy) {
+			continue
+		}
+
+		k[8] = byte(i)
+		if err := zombieData.Put(k[:], policyBytes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// delZombieEdgeData removes the data kept for a zombie channel, if any.
+func delZombieEdgeData(edges kvdb.RwBucket, chanID []byte) error {
+	zombieData := edges.NestedReadWriteBucket(zombieEdgeDataBucket)
+	if zombieData == nil {
+		return nil
+	}
+
+	var k [8 + 1]byte
+	copy(k[:8], chanID)
+	if err := zombieData.Delete(k[:8]); err != nil {
+		return err
+	}
+	for _, direction := range []byte{0, 1} {
+		k[8] = direction
+		if err := zombieData.Delete(k[:]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func putLightningNode(nodeBucket kvdb.RwBucket, aliasBucket kvdb.RwBucket, // nolint:dupl
 	updateIndex kvdb.RwBucket, node *LightningNode) error {
 
@@ -4211,63 +4444,76 @@ func serializeChanEdgePolicy(w io.Writer, edge *ChannelEdgePolicy,
 func deserializeChanEdgePolicy(r io.Reader,
 	nodes kvdb.RBucket) (*ChannelEdgePolicy, error) {
 
+	edge, pub, err := deserializeChanEdgePolicyRaw(r)
+	if err != nil && err != ErrEdgePolicyOptionalFieldNotFound {
+		return nil, err
+	}
+
+	node, fetchErr := fetchLightningNode(nodes, pub[:])
+	if fetchErr != nil {
+		return nil, fmt.Errorf("unable to fetch node: %x, %v",
+			pub[:], fetchErr)
+	}
+	edge.Node = &node
+
+	return edge, err
+}
+
+// deserializeChanEdgePolicyRaw reads a channel edge policy without looking up
+// the node it points to, whose public key is returned along with the policy.
+func deserializeChanEdgePolicyRaw(r io.Reader) (*ChannelEdgePolicy, [33]byte,
+	error) {
+
+	var pub [33]byte
 	edge := &ChannelEdgePolicy{}
 
 	var err error
 	edge.SigBytes, err = wire.ReadVarBytes(r, 0, 80, "sig")
 	if err != nil {
-		return nil, err
+		return nil, pub, err
 	}
 
 	if err := binary.Read(r, byteOrder, &edge.ChannelID); err != nil {
-		return nil, err
+		return nil, pub, err
 	}
 
 	var scratch [8]byte
 	if _, err := r.Read(scratch[:]); err != nil {
-		return nil, err
+		return nil, pub, err
 	}
 	unix := int64(byteOrder.Uint64(scratch[:]))
 	edge.LastUpdate = time.Unix(unix, 0)
 
 	if err := binary.Read(r, byteOrder, &edge.MessageFlags); err != nil {
-		return nil, err
+		return nil, pub, err
 	}
 	if err := binary.Read(r, byteOrder, &edge.ChannelFlags); err != nil {
-		return nil, err
+		return nil, pub, err
 	}
 	if err := binary.Read(r, byteOrder, &edge.TimeLockDelta); err != nil {
-		return nil, err
+		return nil, pub, err
 	}
 
 	var n uint64
 	if err := binary.Read(r, byteOrder, &n); err != nil {
-		return nil, err
+		return nil, pub, err
 	}
 	edge.MinHTLC = lnwire.MilliSatoshi(n)
 
 	if err := binary.Read(r, byteOrder, &n); err != nil {
-		return nil, err
+		return nil, pub, err
 	}
 	edge.FeeBaseMSat = lnwire.MilliSatoshi(n)
 
 	if err := binary.Read(r, byteOrder, &n); err != nil {
-		return nil, err
+		return nil, pub, err
 	}
 	edge.FeeProportionalMillionths = lnwire.MilliSatoshi(n)
 
-	var pub [33]byte
 	if _, err := r.Read(pub[:]); err != nil {
-		return nil, err
+		return nil, pub, err
 	}
 
-	node, err := fetchLightningNode(nodes, pub[:])
-	if err != nil {
-		return nil, fmt.Errorf("unable to fetch node: %x, %v",
-			pub[:], err)
-	}
-	edge.Node = &node
-
 	// We'll try and see if there are any opaque bytes left, if not, then
 	// we'll ignore the EOF error and return the edge as is.
 	edge.ExtraOpaqueData, err = wire.ReadVarBytes(
@@ -4277,7 +4523,7 @@ func deserializeChanEdgePolicy(r io.Reader,
 	case err == io.ErrUnexpectedEOF:
 	case err == io.EOF:
 	case err != nil:
-		return nil, err
+		return nil, pub, err
 	}
 
 	// See if optional fields are present.
@@ -4290,7 +4536,7 @@ func deserializeChanEdgePolicy(r io.Reader,
 		// stored before this field was validated. We'll return the
 		// edge along with an error.
 		if len(opq) < 8 {
-			return edge, ErrEdgePolicyOptionalFieldNotFound
+			return edge, pub, ErrEdgePolicyOptionalFieldNotFound
 		}
 
 		maxHtlc := byteOrder.Uint64(opq[:8])
@@ -4300,5 +4546,5 @@ func deserializeChanEdgePolicy(r io.Reader,
 		edge.ExtraOpaqueData = opq[8:]
 	}
 
-	return edge, nil
+	return edge, pub, nil
 }
@@ -3059,6 +3059,61 @@ func TestGraphZombieIndex(t *testing.T) {
 		t.Fatal("expected edge to be marked as zombie")
 	}
 	assertNumZombies(t, graph, 1)
+
+	// The zombie must be listed along with the keys of its nodes.
+	zombies, err := graph.FetchZombieEdges()
+	require.NoError(t, err, "unable to fetch zombies")
+	require.Equal(t, []ZombieEdge{{
+		ChannelID:     edge.ChannelID,
+		NodeKey1Bytes: node1.PubKeyBytes,
+		NodeKey2Bytes: node2.PubKeyBytes,
+	}}, zombies)
+}
+
+// TestZombieEdgeData ensures that the data of a channel deleted as a zombie is
+// kept until the channel is marked as live again.
+func TestZombieEdgeData(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := MakeTestDB()
+	defer cleanUp()
+	require.NoError(t, err, "unable to create test database")
+	graph := db.ChannelGraph()
+
+	node1, err := createTestVertex(db)
+	require.NoError(t, err, "unable to create test vertex")
+	require.NoError(t, graph.AddLightningNode(node1))
+	node2, err := createTestVertex(db)
+	require.NoError(t, err, "unable to create test vertex")
+	require.NoError(t, graph.AddLightningNode(node2))
+
+	// Only the first policy is known when the channel is marked as a
+	// zombie.
+	edgeInfo, edge1, _ := createChannelEdge(db, node1, node2)
+	require.NoError(t, graph.AddChannelEdge(edgeInfo))
+	require.NoError(t, graph.UpdateEdgePolicy(edge1))
+
+	// No data is kept for a live channel.
+	_, _, _, err = graph.FetchZombieEdgeData(edgeInfo.ChannelID)
+	require.Equal(t, ErrEdgeNotFound, err)
+
+	err = graph.DeleteChannelEdges(false, edgeInfo.ChannelID)
+	require.NoError(t, err, "unable to mark edge as zombie")
+
+	// The channel's info and known policy must be returned as they were
+	// before the channel was deleted.
+	dbInfo, dbPolicy1, dbPolicy2, err := graph.FetchZombieEdgeData(
+		edgeInfo.ChannelID,
+	)
+	require.NoError(t, err, "unable to fetch zombie edge data")
+	assertEdgeInfoEqual(t, dbInfo, edgeInfo)
+	require.NoError(t, compareEdgePolicies(dbPolicy1, edge1))
+	require.Nil(t, dbPolicy2)
+
+	// Once the channel is marked as live, its data is no longer kept.
+	require.NoError(t, graph.MarkEdgeLive(edgeInfo.ChannelID))
+	_, _, _, err = graph.FetchZombieEdgeData(edgeInfo.ChannelID)
+	require.Equal(t, ErrEdgeNotFound, err)
 }
 
 // compareNodes is used to compare two LightningNodes while excluding the
@@ -2474,3 +2474,43 @@ func TestAddInvoiceInvalidFeatureDeps(t *testing.T) {
 		lnwire.PaymentAddrOptional,
 	))
 }
+
+// TestInvoiceCancelReason asserts that the reason for canceling an invoice is
+// stored with the invoice and that invoices that aren't canceled report an
+// unknown reason.
+func TestInvoiceCancelReason(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup, err := MakeTestDB()
+	require.NoError(t, err, "unable to make test db")
+	defer cleanup()
+
+	invoice, err := randInvoice(500)
+	require.NoError(t, err)
+
+	hash := invoice.Terms.PaymentPreimage.Hash()
+	_, err = db.AddInvoice(invoice, hash)
+	require.NoError(t, err)
+
+	ref := InvoiceRefByHash(hash)
+	dbInvoice, err := db.LookupInvoice(ref)
+	require.NoError(t, err)
+	require.Equal(t, CancelReasonUnknown, dbInvoice.CancelReason)
+
+	_, err = db.UpdateInvoice(ref,
+		func(invoice *Invoice) (*InvoiceUpdateDesc, error) {
+			return &InvoiceUpdateDesc{
+				State: &InvoiceStateUpdateDesc{
+					NewState:     ContractCanceled,
+					CancelReason: CancelReasonExpired,
+				},
+			}, nil
+		})
+	require.NoError(t, err)
+
+	// The reason should be read back from disk together with the state.
+	dbInvoice, err = db.LookupInvoice(ref)
+	require.NoError(t, err)
+	require.Equal(t, ContractCanceled, dbInvoice.State)
+	require.Equal(t, CancelReasonExpired, dbInvoice.CancelReason)
+}
@@ -215,6 +215,10 @@ const (
 	invStateType    tlv.Type = 12
 	amtPaidType     tlv.Type = 13
 	hodlInvoiceType tlv.Type = 14
+
+	// cancelReasonType is odd, so that older versions that don't know
+	// about the cancel reason can still read invoices that include it.
+	cancelReasonType tlv.Type = 15
 )
 
 // InvoiceRef is a composite identifier for invoices. Invoices can be referenced
@@ -367,6 +371,48 @@ func (c ContractState) IsFinal() bool {
 	return c == ContractSettled || c == ContractCanceled
 }
 
+// CancelReason describes why an invoice was canceled.
+type CancelReason uint8
+
+const (
+	// CancelReasonUnknown is used for invoices that aren't canceled and
+	// for invoices that were canceled before the reason was recorded.
+	CancelReasonUnknown CancelReason = 0
+
+	// CancelReasonExpired means the invoice was canceled because it
+	// expired before it was paid.
+	CancelReasonExpired CancelReason = 1
+
+	// CancelReasonUserCanceled means the invoice was canceled explicitly
+	// by the user.
+	CancelReasonUserCanceled CancelReason = 2
+
+	// CancelReasonAutoCanceled means the invoice was canceled
+	// automatically because its accepted htlcs were about to expire.
+	CancelReasonAutoCanceled CancelReason = 3
+
+	// CancelReasonAMPReconstructionFailed means the invoice was canceled
+	// because the preimages of a complete AMP HTLC set couldn't be
+	// reconstructed from the shares carried by its HTLCs.
+	CancelReasonAMPReconstructionFailed CancelReason = 4
+)
+
+// String returns a human readable identifier for the CancelReason type.
+func (c CancelReason) String() string {
+	switch c {
+	case CancelReasonExpired:
+		return "Expired"
+	case CancelReasonUserCanceled:
+		return "UserCanceled"
+	case CancelReasonAutoCanceled:
+		return "AutoCanceled"
+	case CancelReasonAMPReconstructionFailed:
+		return "AMPReconstructionFailed"
+	}
+
+	return "Unknown"
+}
+
 // ContractTerm is a companion struct to the Invoice struct. This struct houses
 // the necessary conditions required before the invoice can be considered fully
 // settled by the payee.
@@ -469,6 +515,10 @@ type Invoice struct {
 	// HodlInvoice indicates whether the invoice should be held in the
 	// Accepted state or be settled right away.
 	HodlInvoice bool
+
+	// CancelReason describes why the invoice was canceled. It is only set
+	// for invoices in the ContractCanceled state.
+	CancelReason CancelReason
 }
 
 // HTLCSet returns the set of HTLCs belonging to setID and in the provided
@@ -709,6 +759,10 @@ type InvoiceStateUpdateDesc struct {
 	// Preimage must be set to the preimage when NewState is settled.
 	Preimage *lntypes.Preimage
 
+	// CancelReason is recorded as the reason for the cancellation when
+	// NewState is canceled.
+	CancelReason CancelReason
+
 	// HTLCPreimages set the HTLC-level preimages stored for AMP HTLCs.
 	// These are only learned when settling the invoice as a whole. Must be
 	// set when settling an invoice with non-nil SetID.
@@ -1448,6 +1502,8 @@ func serializeInvoice(w io.Writer, i *Invoice) error {
 		hodlInvoice = 1
 	}
 
+	cancelReason := uint8(i.CancelReason)
+
 	tlvStream, err := tlv.NewStream(
 		// Memo and payreq.
 		tlv.MakePrimitiveRecord(memoType, &i.Memo),
@@ -1472,6 +1528,7 @@ func serializeInvoice(w io.Writer, i *Invoice) error {
 		tlv.MakePrimitiveRecord(amtPaidType, &amtPaid),
 
 		tlv.MakePrimitiveRecord(hodlInvoiceType, &hodlInvoice),
+		tlv.MakePrimitiveRecord(cancelReasonType, &cancelReason),
 	)
 	if err != nil {
 		return err
@@ -1616,6 +1673,7 @@ func deserializeInvoice(r io.Reader) (Invoice, error) {
 		amtPaid       uint64
 		state         uint8
 		hodlInvoice   uint8
+		cancelReason  uint8
 
 		creationDateBytes []byte
 		settleDateBytes   []byte
@@ -1647,6 +1705,7 @@ func deserializeInvoice(r io.Reader) (Invoice, error) {
 		tlv.MakePrimitiveRecord(amtPaidType, &amtPaid),
 
 		tlv.MakePrimitiveRecord(hodlInvoiceType, &hodlInvoice),
+		tlv.MakePrimitiveRecord(cancelReasonType, &cancelReason),
 	)
 	if err != nil {
 		return i, err
@@ -1673,6 +1732,7 @@ func deserializeInvoice(r io.Reader) (Invoice, error) {
 	i.Terms.Expiry = time.Duration(expiry)
 	i.AmtPaid = lnwire.MilliSatoshi(amtPaid)
 	i.State = ContractState(state)
+	i.CancelReason = CancelReason(cancelReason)
 
 	if hodlInvoice != 0 {
 		i.HodlInvoice = true
@@ -1826,7 +1886,8 @@ func copyInvoice(src *Invoice) *Invoice {
 		Htlcs: make(
 			map[CircuitKey]*InvoiceHTLC, len(src.Htlcs),
 		),
-		HodlInvoice: src.HodlInvoice,
+		HodlInvoice:  src.HodlInvoice,
+		CancelReason: src.CancelReason,
 	}
 
 	dest.Terms.Features = src.Terms.Features.Clone()
@@ -2058,6 +2119,7 @@ func updateInvoiceState(invoice *Invoice, hash *lntypes.Hash,
 	case ContractOpen:
 		if update.NewState == ContractCanceled {
 			invoice.State = update.NewState
+			invoice.CancelReason = update.CancelReason
 			return nil
 		}
 
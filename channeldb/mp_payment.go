@@ -144,6 +144,16 @@ type HTLCFailInfo struct {
 	// field will be populated when the failure reason is either
 	// HTLCFailMessage or HTLCFailUnknown.
 	FailureSourceIndex uint32
+
+	// RawMessage is the failure message as it was decrypted from the
+	// onion, before it was decoded. This field will only be populated for
+	// failures that were sent by a node along the route.
+	RawMessage []byte
+
+	// EncryptedReason is the onion encrypted failure as it was received
+	// from our peer. This field will only be populated for failures that
+	// were sent by a node along the route.
+	EncryptedReason []byte
 }
 
 // MPPayment is a wrapper around a payment's PaymentCreationInfo and
@@ -283,7 +293,18 @@ func serializeHTLCFailInfo(w io.Writer, f *HTLCFailInfo) error {
 		return err
 	}
 
-	return WriteElements(w, byte(f.Reason), f.FailureSourceIndex)
+	err := WriteElements(w, byte(f.Reason), f.FailureSourceIndex)
+	if err != nil {
+		return err
+	}
+
+	// The raw failure is appended at the end, so that fail infos that
+	// were serialized before it was added can still be read.
+	if err := wire.WriteVarBytes(w, 0, f.RawMessage); err != nil {
+		return err
+	}
+
+	return wire.WriteVarBytes(w, 0, f.EncryptedReason)
 }
 
 // deserializeHTLCFailInfo deserializes the details of a failed htlc including
@@ -319,6 +340,35 @@ func deserializeHTLCFailInfo(r io.Reader) (*HTLCFailInfo, error) {
 	}
 	f.Reason = HTLCFailReason(reason)
 
+	// Fail infos that were serialized before the raw failure was added end
+	// here.
+	rawMessage, err := wire.ReadVarBytes(
+		r, 0, lnwire.MaxSliceLength, "raw failure",
+	)
+	switch {
+	case err == io.EOF:
+		return f, nil
+
+	case err != nil:
+		return nil, err
+	}
+
+	encryptedReason, err := wire.ReadVarBytes(
+		r, 0, lnwire.MaxSliceLength, "encrypted failure",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Leave the fields nil if they weren't set, to match the fail info
+	// that was serialized.
+	if len(rawMessage) > 0 {
+		f.RawMessage = rawMessage
+	}
+	if len(encryptedReason) > 0 {
+		f.EncryptedReason = encryptedReason
+	}
+
 	return f, nil
 }
 
@@ -3,7 +3,9 @@ package channeldb
 import (
 	"bytes"
 	"testing"
+	"time"
 
+	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/stretchr/testify/require"
 )
 
@@ -25,3 +27,40 @@ func TestLazySessionKeyDeserialize(t *testing.T) {
 	sessionKey := attempt.SessionKey()
 	require.Equal(t, priv, sessionKey)
 }
+
+// TestHTLCFailInfoSerialization tests that the raw failure of an htlc fail
+// info survives serialization, and that fail infos that were serialized
+// without it can still be read.
+func TestHTLCFailInfoSerialization(t *testing.T) {
+	t.Parallel()
+
+	failInfo := &HTLCFailInfo{
+		FailTime:           time.Unix(100, 0),
+		Message:            &lnwire.FailTemporaryNodeFailure{},
+		Reason:             HTLCFailMessage,
+		FailureSourceIndex: 2,
+		RawMessage:         []byte{1, 2, 3},
+		EncryptedReason:    []byte{4, 5, 6},
+	}
+
+	var b bytes.Buffer
+	require.NoError(t, serializeHTLCFailInfo(&b, failInfo))
+
+	serialized := b.Bytes()
+	decoded, err := deserializeHTLCFailInfo(bytes.NewReader(serialized))
+	require.NoError(t, err)
+	require.Equal(t, failInfo, decoded)
+
+	// Strip the raw failure, which consists of the two empty var bytes
+	// that are written if it isn't set, to get the legacy serialization.
+	failInfo.RawMessage = nil
+	failInfo.EncryptedReason = nil
+
+	b.Reset()
+	require.NoError(t, serializeHTLCFailInfo(&b, failInfo))
+	legacy := b.Bytes()[:b.Len()-2]
+
+	decoded, err = deserializeHTLCFailInfo(bytes.NewReader(legacy))
+	require.NoError(t, err)
+	require.Equal(t, failInfo, decoded)
+}
@@ -147,6 +147,10 @@ const (
 	// balance to complete the payment.
 	FailureReasonInsufficientBalance FailureReason = 4
 
+	// FailureReasonIntermediaryNoRoute indicates that the intermediary node
+	// specified for the payment has no route to the destination.
+	FailureReasonIntermediaryNoRoute FailureReason = 5
+
 	// TODO(halseth): cancel state.
 
 	// TODO(joostjager): Add failure reasons for:
@@ -171,6 +175,8 @@ func (r FailureReason) String() string {
 		return "incorrect_payment_details"
 	case FailureReasonInsufficientBalance:
 		return "insufficient_balance"
+	case FailureReasonIntermediaryNoRoute:
+		return "intermediary_no_route"
 	}
 
 	return "unknown"
@@ -0,0 +1,278 @@
+package channeldb
+
+import (
+	"bytes"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// policyHistoryBucket is the bucket that stores the history of the
+	// forwarding policies of our own channels. It contains a sub-bucket
+	// for each channel, keyed by its short channel ID. Each key within a
+	// sub-bucket is a timestamp (in nano seconds since the unix epoch),
+	// and the value the policy that was set at that time.
+	policyHistoryBucket = []byte("channel-policy-history")
+)
+
+const (
+	// policyUpdateSize is the size of a serialized policy update. The
+	// breakdown is as follows:
+	//
+	//  * 8 byte base fee || 8 byte fee rate || 2 byte time lock delta ||
+	//    8 byte min htlc || 8 byte max htlc
+	policyUpdateSize = 34
+
+	// DefaultMaxPolicyHistoryEntries is the number of policy updates that
+	// are kept per channel. Once a channel exceeds it, its oldest updates
+	// are pruned.
+	DefaultMaxPolicyHistoryEntries = 1000
+)
+
+// PolicyHistory returns an instance of the PolicyHistory object backed by the
+// target database instance.
+func (d *DB) PolicyHistory() *PolicyHistory {
+	return &PolicyHistory{
+		db:         d,
+		maxEntries: DefaultMaxPolicyHistoryEntries,
+	}
+}
+
+// PolicyHistory is a time series database that records the forwarding policies
+// that we set on our own channels. The number of updates kept per channel is
+// bounded, so that the history of a channel whose policy is updated
+// frequently doesn't grow without limit.
+type PolicyHistory struct {
+	db *DB
+
+	// maxEntries is the maximum number of updates kept per channel.
+	maxEntries int
+}
+
+// PolicyUpdate is an entry in the policy history, which describes the policy
+// that was set on one of our channels at a given time.
+type PolicyUpdate struct {
+	// ChanID is the short channel ID of the channel whose policy was
+	// updated.
+	ChanID lnwire.ShortChannelID
+
+	// Timestamp is the time at which the policy was set.
+	Timestamp time.Time
+
+	// BaseFee is the base fee charged for forwards over the channel.
+	BaseFee lnwire.MilliSatoshi
+
+	// FeeRate is the fee rate charged for forwards over the channel, in
+	// millionths of the forwarded amount.
+	FeeRate lnwire.MilliSatoshi
+
+	// TimeLockDelta is the time lock delta required for forwards over the
+	// channel.
+	TimeLockDelta uint16
+
+	// MinHTLC is the smallest htlc forwarded over the channel.
+	MinHTLC lnwire.MilliSatoshi
+
+	// MaxHTLC is the largest htlc forwarded over the channel.
+	MaxHTLC lnwire.MilliSatoshi
+}
+
+// encodePolicyUpdate writes out the target policy update to the passed
+// io.Writer. Note that the channel ID and timestamp aren't serialized, as they
+// are the keys of the update within the bucket.
+func encodePolicyUpdate(w io.Writer, u *PolicyUpdate) error {
+	return WriteElements(
+		w, u.BaseFee, u.FeeRate, u.TimeLockDelta, u.MinHTLC, u.MaxHTLC,
+	)
+}
+
+// decodePolicyUpdate decodes a serialized policy update into the target
+// PolicyUpdate. The channel ID and timestamp are expected to be set by the
+// caller.
+func decodePolicyUpdate(r io.Reader, u *PolicyUpdate) error {
+	return ReadElements(
+		r, &u.BaseFee, &u.FeeRate, &u.TimeLockDelta, &u.MinHTLC,
+		&u.MaxHTLC,
+	)
+}
+
+// AddPolicyUpdates adds a series of policy updates to the history. Once a
+// channel has more updates than are kept per channel, its oldest updates are
+// removed.
+func (p *PolicyHistory) AddPolicyUpdates(updates []PolicyUpdate) error {
+	return kvdb.Update(p.db, func(tx kvdb.RwTx) error {
+		historyBucket, err := tx.CreateTopLevelBucket(
+			policyHistoryBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, update := range updates {
+			var chanID [8]byte
+			byteOrder.PutUint64(chanID[:], update.ChanID.ToUint64())
+
+			chanBucket, err := historyBucket.CreateBucketIfNotExists(
+				chanID[:],
+			)
+			if err != nil {
+				return err
+			}
+
+			err = storePolicyUpdate(chanBucket, update)
+			if err != nil {
+				return err
+			}
+
+			err = prunePolicyUpdates(chanBucket, p.maxEntries)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func() {})
+}
+
+// storePolicyUpdate stores the policy update in the bucket of its channel. If
+// an update with the same timestamp already exists, the timestamp is
+// incremented in nanosecond intervals until a free slot is found, so that
+// updates set in quick succession don't overwrite each other.
+func storePolicyUpdate(bucket walletdb.ReadWriteBucket,
+	update PolicyUpdate) error {
+
+	var timestamp [8]byte
+	nanos := update.Timestamp.UnixNano()
+	byteOrder.PutUint64(timestamp[:], uint64(nanos))
+	for bucket.Get(timestamp[:]) != nil {
+		nanos++
+		byteOrder.PutUint64(timestamp[:], uint64(nanos))
+	}
+
+	var updateBytes [policyUpdateSize]byte
+	updateBuf := bytes.NewBuffer(updateBytes[0:0:policyUpdateSize])
+	if err := encodePolicyUpdate(updateBuf, &update); err != nil {
+		return err
+	}
+
+	return bucket.Put(timestamp[:], updateBuf.Bytes())
+}
+
+// prunePolicyUpdates removes the oldest updates from the bucket of a channel
+// until at most maxEntries updates remain.
+func prunePolicyUpdates(bucket walletdb.ReadWriteBucket, maxEntries int) error {
+	// The keys are copied, as they are only valid until the bucket is
+	// modified.
+	var timestamps [][]byte
+	err := bucket.ForEach(func(k, _ []byte) error {
+		timestamps = append(timestamps, append([]byte(nil), k...))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(timestamps) <= maxEntries {
+		return nil
+	}
+
+	// The timestamps are iterated in ascending order, so the oldest
+	// updates come first.
+	for _, timestamp := range timestamps[:len(timestamps)-maxEntries] {
+		if err := bucket.Delete(timestamp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PolicyHistoryQuery represents a query to the policy history.
+type PolicyHistoryQuery struct {
+	// ChanID restricts the query to the channel with the given short
+	// channel ID. If it is zero, the updates of all channels are
+	// returned.
+	ChanID lnwire.ShortChannelID
+
+	// StartTime is the start time of the time slice.
+	StartTime time.Time
+
+	// EndTime is the end time of the time slice.
+	EndTime time.Time
+}
+
+// Query returns the policy updates within the time slice of the query. The
+// updates are ordered by channel ID, and by time within each channel.
+func (p *PolicyHistory) Query(q PolicyHistoryQuery) ([]PolicyUpdate, error) {
+	var updates []PolicyUpdate
+
+	err := kvdb.View(p.db, func(tx kvdb.RTx) error {
+		// If the bucket wasn't found, then no policy was recorded yet.
+		historyBucket := tx.ReadBucket(policyHistoryBucket)
+		if historyBucket == nil {
+			return nil
+		}
+
+		var startTime, endTime [8]byte
+		byteOrder.PutUint64(startTime[:], uint64(q.StartTime.UnixNano()))
+		byteOrder.PutUint64(endTime[:], uint64(q.EndTime.UnixNano()))
+
+		queryChannel := func(chanID []byte) error {
+			chanBucket := historyBucket.NestedReadBucket(chanID)
+			if chanBucket == nil {
+				return nil
+			}
+
+			shortChanID := lnwire.NewShortChanIDFromInt(
+				byteOrder.Uint64(chanID),
+			)
+
+			cursor := chanBucket.ReadCursor()
+			k, v := cursor.Seek(startTime[:])
+			for ; k != nil; k, v = cursor.Next() {
+				if bytes.Compare(k, endTime[:]) > 0 {
+					break
+				}
+
+				update := PolicyUpdate{
+					ChanID: shortChanID,
+					Timestamp: time.Unix(
+						0, int64(byteOrder.Uint64(k)),
+					),
+				}
+				err := decodePolicyUpdate(
+					bytes.NewReader(v), &update,
+				)
+				if err != nil {
+					return err
+				}
+
+				updates = append(updates, update)
+			}
+
+			return nil
+		}
+
+		if q.ChanID.ToUint64() != 0 {
+			var chanID [8]byte
+			byteOrder.PutUint64(chanID[:], q.ChanID.ToUint64())
+
+			return queryChannel(chanID[:])
+		}
+
+		return historyBucket.ForEach(func(chanID, _ []byte) error {
+			return queryChannel(chanID)
+		})
+	}, func() {
+		updates = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updates, nil
+}
@@ -0,0 +1,105 @@
+package channeldb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPolicyHistory tests that policy updates are returned per channel in the
+// order they were set, and that the oldest updates of a channel are pruned
+// once it exceeds the maximum number of entries.
+func TestPolicyHistory(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := MakeTestDB()
+	require.NoError(t, err, "unable to make test db")
+	defer cleanUp()
+
+	const maxEntries = 3
+
+	history := &PolicyHistory{
+		db:         db,
+		maxEntries: maxEntries,
+	}
+
+	// Querying an empty history returns no updates.
+	updates, err := history.Query(PolicyHistoryQuery{
+		EndTime: time.Unix(0, 0).Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Empty(t, updates)
+
+	chanID1 := lnwire.NewShortChanIDFromInt(1)
+	chanID2 := lnwire.NewShortChanIDFromInt(2)
+
+	startTime := time.Unix(1000, 0)
+	newUpdate := func(chanID lnwire.ShortChannelID, i int) PolicyUpdate {
+		return PolicyUpdate{
+			ChanID:        chanID,
+			Timestamp:     startTime.Add(time.Duration(i) * time.Minute),
+			BaseFee:       lnwire.MilliSatoshi(1000 + i),
+			FeeRate:       lnwire.MilliSatoshi(i),
+			TimeLockDelta: uint16(40 + i),
+			MinHTLC:       1000,
+			MaxHTLC:       lnwire.MilliSatoshi(100000 + i),
+		}
+	}
+
+	// Add updates to both channels, where the second channel's updates are
+	// added first to assert that updates are ordered by channel.
+	chan2Updates := []PolicyUpdate{newUpdate(chanID2, 0)}
+	require.NoError(t, history.AddPolicyUpdates(chan2Updates))
+
+	var chan1Updates []PolicyUpdate
+	for i := 0; i < maxEntries; i++ {
+		chan1Updates = append(chan1Updates, newUpdate(chanID1, i))
+	}
+	require.NoError(t, history.AddPolicyUpdates(chan1Updates))
+
+	query := PolicyHistoryQuery{
+		StartTime: startTime,
+		EndTime:   startTime.Add(time.Hour),
+	}
+	updates, err = history.Query(query)
+	require.NoError(t, err)
+	require.Equal(t, append(chan1Updates, chan2Updates...), updates)
+
+	// Updates set at the same time must not overwrite each other.
+	sameTime := newUpdate(chanID2, 0)
+	sameTime.BaseFee = 2000
+	require.NoError(t, history.AddPolicyUpdates([]PolicyUpdate{sameTime}))
+
+	query.ChanID = chanID2
+	updates, err = history.Query(query)
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+	require.Equal(t, chan2Updates[0], updates[0])
+	require.Equal(t, sameTime.BaseFee, updates[1].BaseFee)
+
+	// The time slice of the query is applied.
+	query.ChanID = chanID1
+	query.StartTime = startTime.Add(time.Minute)
+	query.EndTime = startTime.Add(time.Minute)
+	updates, err = history.Query(query)
+	require.NoError(t, err)
+	require.Equal(t, chan1Updates[1:2], updates)
+
+	// Exceeding the maximum number of entries prunes the oldest update of
+	// the channel, while the other channel is unaffected.
+	newest := newUpdate(chanID1, maxEntries)
+	require.NoError(t, history.AddPolicyUpdates([]PolicyUpdate{newest}))
+
+	query.StartTime = startTime
+	query.EndTime = startTime.Add(time.Hour)
+	updates, err = history.Query(query)
+	require.NoError(t, err)
+	require.Equal(t, append(chan1Updates[1:], newest), updates)
+
+	query.ChanID = chanID2
+	updates, err = history.Query(query)
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+}
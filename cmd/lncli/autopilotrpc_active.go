@@ -141,6 +141,43 @@ loop:
 	return nil
 }
 
+var queryCandidatesCommand = cli.Command{
+	Name: "candidates",
+	Usage: "Preview the nodes autopilot would open channels to, " +
+		"without opening any.",
+	Description: `
+	Returns the nodes the autopilot agent would consider opening channels
+	to given the current state, sorted by score. The agent opens
+	num_channels channels to a weighted random choice among them. This
+	also works if autopilot is disabled.`,
+	Action: actionDecorator(queryCandidates),
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "max_candidates",
+			Usage: "the maximum number of candidates to return, " +
+				"all are returned if zero",
+		},
+	},
+}
+
+func queryCandidates(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getAutopilotClient(ctx)
+	defer cleanUp()
+
+	req := &autopilotrpc.QueryCandidatesRequest{
+		MaxCandidates: uint32(ctx.Uint64("max_candidates")),
+	}
+
+	resp, err := client.QueryCandidates(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 // autopilotCommands will return the set of commands to enable for autopilotrpc
 // builds.
 func autopilotCommands() []cli.Command {
@@ -155,6 +192,7 @@ func autopilotCommands() []cli.Command {
 				enableCommand,
 				disableCommand,
 				queryScoresCommand,
+				queryCandidatesCommand,
 			},
 		},
 	}
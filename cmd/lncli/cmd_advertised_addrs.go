@@ -0,0 +1,34 @@
+package main
+
+import (
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var getAdvertisedAddrsCommand = cli.Command{
+	Name:  "getadvertisedaddrs",
+	Usage: "Display the addresses advertised in the node announcement.",
+	Description: `
+	Display the addresses of the node's latest node announcement, including
+	Tor onion addresses, next to the addresses of the announcement that has
+	been processed by the gossiper and is served to the network. If the
+	node's addresses changed recently, update_pending indicates that the new
+	addresses aren't gossiped yet. Node announcements are only gossiped for
+	nodes with public channels.`,
+	Action: actionDecorator(getAdvertisedAddrs),
+}
+
+func getAdvertisedAddrs(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.GetAdvertisedAddressesRequest{}
+	resp, err := client.GetAdvertisedAddresses(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
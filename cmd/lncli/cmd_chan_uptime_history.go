@@ -0,0 +1,62 @@
+package main
+
+import (
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var chanUptimeHistoryCommand = cli.Command{
+	Name:     "chanuptimehistory",
+	Category: "Channels",
+	Usage:    "Show the uptime history of the peers of open channels.",
+	Description: `
+	Show the uptime of the peers of all open channels, or of a single
+	channel, over the given window split into buckets of the given size.
+	Each bucket reports the time the peer was online and the percentage of
+	the bucket this corresponds to. Channels that are younger than the
+	window only report their uptime since they were opened.
+
+	Example:
+	    $ lncli chanuptimehistory --window=168h --bucket=24h`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "chan_id",
+			Usage: "the short channel id of the channel to show " +
+				"the uptime history for, if not set all open " +
+				"channels are shown",
+		},
+		cli.DurationFlag{
+			Name:  "window",
+			Usage: "the window to show the uptime history for",
+			Value: 7 * 24 * time.Hour,
+		},
+		cli.DurationFlag{
+			Name:  "bucket",
+			Usage: "the size of each bucket within the window",
+			Value: 24 * time.Hour,
+		},
+	},
+	Action: actionDecorator(chanUptimeHistory),
+}
+
+func chanUptimeHistory(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ChannelUptimeHistoryRequest{
+		ChanId:        ctx.Uint64("chan_id"),
+		WindowSeconds: uint64(ctx.Duration("window").Seconds()),
+		BucketSeconds: uint64(ctx.Duration("bucket").Seconds()),
+	}
+
+	resp, err := client.ChannelUptimeHistory(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
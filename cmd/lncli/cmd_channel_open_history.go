@@ -0,0 +1,52 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var channelOpenHistoryCommand = cli.Command{
+	Name:     "channelopenhistory",
+	Category: "Channels",
+	Usage:    "List the outcome of recent channel opens.",
+	Description: `
+	List the most recent channel opens initiated by this node, together
+	with their outcome. Channel opens that haven't completed yet are
+	reported as pending, failed ones include the failure reason. The
+	history is reset when the node restarts.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "peer",
+			Usage: "(optional) only list the channel opens with " +
+				"the peer of the given pubkey",
+		},
+	},
+	Action: actionDecorator(channelOpenHistory),
+}
+
+func channelOpenHistory(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ChannelOpenHistoryRequest{}
+	if ctx.IsSet("peer") {
+		peer, err := hex.DecodeString(ctx.String("peer"))
+		if err != nil {
+			return fmt.Errorf("unable to decode peer pubkey: %v",
+				err)
+		}
+		req.Peer = peer
+	}
+
+	resp, err := client.ChannelOpenHistory(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
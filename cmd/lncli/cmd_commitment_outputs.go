@@ -0,0 +1,61 @@
+package main
+
+import (
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var getCommitmentOutputsCommand = cli.Command{
+	Name:      "getcommitmentoutputs",
+	Category:  "Channels",
+	Usage:     "Show the outputs of the commitment transaction of a channel.",
+	ArgsUsage: "funding_txid [output_index]",
+	Description: `
+	Show the outputs of our current commitment transaction of the channel
+	identified by the given channel point. Each output is listed with its
+	type (to_local, to_remote, anchor or HTLC), amount and script type.
+	Outputs of a side without balance aren't part of the commitment
+	transaction and are therefore not shown.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "funding_txid",
+			Usage: "the txid of the channel's funding transaction",
+		},
+		cli.IntFlag{
+			Name: "output_index",
+			Usage: "the output index for the funding output of the " +
+				"funding transaction",
+		},
+	},
+	Action: actionDecorator(getCommitmentOutputs),
+}
+
+func getCommitmentOutputs(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		_ = cli.ShowCommandHelp(ctx, "getcommitmentoutputs")
+		return nil
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.GetCommitmentOutputsRequest{
+		ChannelPoint: channelPoint,
+	}
+
+	resp, err := client.GetCommitmentOutputs(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var setCoopCloseFeeBandCommand = cli.Command{
+	Name:     "setcoopclosefeeband",
+	Category: "Channels",
+	Usage:    "Query or set the cooperative close fee acceptance band.",
+	Description: `
+	Update the percentage around the node's last fee proposal within which
+	the fee proposed by the remote peer is accepted during cooperative close
+	fee negotiation. A fee outside of this band is answered with a counter
+	proposal. Valid values are within [10, 100].
+
+	If no band is given, the current band is returned without changing it.
+	The change is not persisted across restarts, use the
+	coop-close-fee-band config option for that.`,
+	ArgsUsage: "[band_percent]",
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "band_percent",
+			Usage: "the percentage around our last fee proposal " +
+				"within which the remote fee is accepted",
+		},
+	},
+	Action: actionDecorator(setCoopCloseFeeBand),
+}
+
+func setCoopCloseFeeBand(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		band uint64
+		err  error
+	)
+	switch {
+	case ctx.IsSet("band_percent"):
+		band = ctx.Uint64("band_percent")
+		if band > math.MaxUint32 {
+			return fmt.Errorf("band_percent %v out of range", band)
+		}
+
+	case ctx.Args().Present():
+		band, err = strconv.ParseUint(ctx.Args().First(), 10, 32)
+		if err != nil {
+			return fmt.Errorf("unable to decode band_percent: %v",
+				err)
+		}
+	}
+
+	req := &lnrpc.SetCoopCloseFeeBandRequest{
+		BandPercent: uint32(band),
+	}
+	resp, err := client.SetCoopCloseFeeBand(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var setDefaultInvoiceExpiryCommand = cli.Command{
+	Name:      "setdefaultinvoiceexpiry",
+	Category:  "Invoices",
+	Usage:     "Set the expiry of new invoices that don't specify one.",
+	ArgsUsage: "expiry",
+	Description: `
+	Update the expiry in seconds of new invoices that don't specify one.
+	Invoices that explicitly set an expiry and existing invoices are not
+	affected.
+
+	The new default only lasts until the next restart, after which the
+	default-invoice-expiry config value is used again.
+	`,
+	Action: actionDecorator(setDefaultInvoiceExpiry),
+}
+
+func setDefaultInvoiceExpiry(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	// Display the command's help message if we do not have the expected
+	// number of arguments/flags.
+	if ctx.NArg() != 1 || ctx.NumFlags() > 0 {
+		return cli.ShowCommandHelp(ctx, "setdefaultinvoiceexpiry")
+	}
+
+	expiry, err := strconv.ParseInt(ctx.Args().First(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("unable to parse expiry: %v", err)
+	}
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.SetDefaultInvoiceExpiryRequest{
+		Expiry: expiry,
+	}
+	resp, err := client.SetDefaultInvoiceExpiry(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
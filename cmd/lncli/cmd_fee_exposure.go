@@ -0,0 +1,45 @@
+package main
+
+import (
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var feeExposureCommand = cli.Command{
+	Name:     "feeexposure",
+	Category: "Channels",
+	Usage:    "Show the on-chain fee exposure of all open channels.",
+	Description: `
+	Show the on-chain fees the node has committed to across its open
+	channels, the wallet reserve kept for fee bumping anchor channels and
+	the worst-case fee exposure at the current fee estimate. The worst-case
+	exposure includes the fees needed to bring the commitment transactions
+	up to the fee estimate for the given confirmation target.`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the number of blocks the fee estimate " +
+				"targets, if not set the target used for " +
+				"commitment fee updates is used",
+		},
+	},
+	Action: actionDecorator(feeExposure),
+}
+
+func feeExposure(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.GetFeeExposureRequest{
+		TargetConf: int32(ctx.Int64("conf_target")),
+	}
+
+	resp, err := client.GetFeeExposure(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
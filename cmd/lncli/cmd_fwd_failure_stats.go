@@ -0,0 +1,62 @@
+package main
+
+import (
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var fwdFailureStatsCommand = cli.Command{
+	Name:     "fwdfailurestats",
+	Category: "Payments",
+	Usage:    "Query the number of rejected forwards by reason.",
+	Description: `
+	Return the number of HTLC forwards that were rejected by this node
+	within a time window, grouped by the reason of the rejection. The
+	counts are kept in memory for up to 24 hours and are lost on restart.
+
+	The --reset flag clears the counts and restarts counting.`,
+	Flags: []cli.Flag{
+		cli.DurationFlag{
+			Name: "window",
+			Usage: "the window before now in which rejected " +
+				"forwards are counted, e.g. 1h, at most 24h. " +
+				"If not set, all retained rejections are counted",
+		},
+		cli.BoolFlag{
+			Name:  "reset",
+			Usage: "clear the counts instead of querying them",
+		},
+	},
+	Action: actionDecorator(fwdFailureStats),
+}
+
+func fwdFailureStats(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.Bool("reset") {
+		resp, err := client.ResetForwardingFailureStats(
+			ctxc, &lnrpc.ResetForwardingFailureStatsRequest{},
+		)
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(resp)
+		return nil
+	}
+
+	req := &lnrpc.ForwardingFailureStatsRequest{
+		WindowSeconds: uint64(ctx.Duration("window") / time.Second),
+	}
+	resp, err := client.ForwardingFailureStats(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
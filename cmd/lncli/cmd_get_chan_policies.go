@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var getChanPoliciesCommand = cli.Command{
+	Name:     "getchanpolicies",
+	Category: "Channels",
+	Usage: "Get the routing policies advertised by our node and the " +
+		"remote peer for one of our channels.",
+	Description: `
+	Prints the routing policy advertised by our own node and the routing
+	policy advertised by the remote peer for the given channel. The remote
+	peer's policy determines the fees and limits that apply to traffic it
+	forwards to us. If a node hasn't announced a policy for its direction
+	of the channel yet, its entry is marked as not announced.`,
+	ArgsUsage: "chan_id",
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name:  "chan_id",
+			Usage: "the 8-byte compact channel ID to query for",
+		},
+	},
+	Action: actionDecorator(getChanPolicies),
+}
+
+func getChanPolicies(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		chanID uint64
+		err    error
+	)
+	switch {
+	case ctx.IsSet("chan_id"):
+		chanID = ctx.Uint64("chan_id")
+
+	case ctx.Args().Present():
+		chanID, err = strconv.ParseUint(ctx.Args().First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("error parsing chan_id: %s", err)
+		}
+
+	default:
+		return fmt.Errorf("chan_id argument missing")
+	}
+
+	req := &lnrpc.ChanInfoRequest{
+		ChanId: chanID,
+	}
+	resp, err := client.GetChanPolicies(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var lookupHtlcCircuitCommand = cli.Command{
+	Name:      "lookuphtlccircuit",
+	Category:  "Payments",
+	Usage:     "Show the circuit of an HTLC held by the switch.",
+	ArgsUsage: "chan_point htlc_index",
+	Description: `
+	Show the circuit of the HTLC with the given index in the channel with
+	the given channel point, which maps the incoming HTLC of a forward to
+	its outgoing HTLC, together with the state of the circuit. A pending or
+	open circuit is waiting on the outgoing side, a closing circuit on the
+	incoming side. Resolved HTLCs are reported as no longer tracked.
+
+	By default, the HTLC is assumed to be offered to us by the channel
+	peer. Use --outgoing to look up an HTLC that we offered.`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: "outgoing",
+			Usage: "look up an HTLC offered by us to the channel " +
+				"peer",
+		},
+	},
+	Action: actionDecorator(lookupHtlcCircuit),
+}
+
+func lookupHtlcCircuit(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.NArg() != 2 {
+		return cli.ShowCommandHelp(ctx, "lookuphtlccircuit")
+	}
+
+	chanPoint, err := parseChanPoint(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+
+	htlcIndex, err := strconv.ParseUint(ctx.Args().Get(1), 10, 64)
+	if err != nil {
+		return fmt.Errorf("unable to decode htlc index: %v", err)
+	}
+
+	req := &lnrpc.LookupHtlcCircuitRequest{
+		ChanPoint: chanPoint,
+		HtlcIndex: htlcIndex,
+		Outgoing:  ctx.Bool("outgoing"),
+	}
+	resp, err := client.LookupHtlcCircuit(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var inboundLiquidityCommand = cli.Command{
+	Name:     "inboundliquidity",
+	Category: "Channels",
+	Usage:    "Show the amount that can be received over open channels.",
+	Description: `
+	Show the total amount the node is able to receive across its open
+	channels, broken down by public and private channels. The inbound
+	liquidity of a channel is the remote balance minus the reserve the
+	remote party is required to keep. Channels whose peer is offline are
+	reported separately and don't count towards the total.`,
+	Action: actionDecorator(inboundLiquidity),
+}
+
+func inboundLiquidity(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.InboundLiquidityRequest{}
+	resp, err := client.InboundLiquidity(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
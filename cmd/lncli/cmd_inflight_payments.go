@@ -0,0 +1,87 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/urfave/cli"
+)
+
+var listInFlightPaymentsCommand = cli.Command{
+	Name:     "listinflightpayments",
+	Category: "Payments",
+	Usage:    "List all payments that are tracked as in flight.",
+	Description: `
+	List all payments that are tracked as in flight. A new payment to the
+	hash of an in flight payment is refused.
+	`,
+	Action: actionDecorator(listInFlightPayments),
+}
+
+func listInFlightPayments(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	req := &routerrpc.ListInFlightPaymentsRequest{}
+	resp, err := client.ListInFlightPayments(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var clearInFlightPaymentCommand = cli.Command{
+	Name:      "clearinflightpayment",
+	Category:  "Payments",
+	Usage:     "Clear an in flight payment without HTLCs in flight.",
+	ArgsUsage: "hash",
+	Description: `
+	Fail an in flight payment that has no HTLCs in flight, allowing a new
+	payment to its hash to be made. This can be used to clear a payment
+	that got stuck in flight, for example after a crash.
+
+	Payments that still have HTLCs in flight can't be cleared, since these
+	HTLCs may still be settled.
+	`,
+	Action: actionDecorator(clearInFlightPayment),
+}
+
+func clearInFlightPayment(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	// Display the command's help message if we do not have the expected
+	// number of arguments/flags.
+	if ctx.NArg() != 1 || ctx.NumFlags() > 0 {
+		return cli.ShowCommandHelp(ctx, "clearinflightpayment")
+	}
+
+	hash, err := hex.DecodeString(ctx.Args().First())
+	if err != nil {
+		return fmt.Errorf("unable to decode hash: %v", err)
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	req := &routerrpc.ClearInFlightPaymentRequest{
+		PaymentHash: hash,
+	}
+	resp, err := client.ClearInFlightPayment(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -66,6 +66,13 @@ var addInvoiceCommand = cli.Command{
 				"private channels in order to assist the " +
 				"payer in reaching you",
 		},
+		cli.UintFlag{
+			Name: "max_hints",
+			Usage: "the maximum number of routing hints to " +
+				"encode in the invoice, preferring channels " +
+				"with the most inbound liquidity; if not " +
+				"set, up to 20 hints are encoded",
+		},
 		cli.BoolFlag{
 			Name: "amp",
 			Usage: "creates an AMP invoice. If true, preimage " +
@@ -125,6 +132,7 @@ func addInvoice(ctx *cli.Context) error {
 		Expiry:          ctx.Int64("expiry"),
 		Private:         ctx.Bool("private"),
 		IsAmp:           ctx.Bool("amp"),
+		MaxHints:        uint32(ctx.Uint("max_hints")),
 	}
 
 	resp, err := client.AddInvoice(ctxc, invoice)
@@ -148,6 +156,12 @@ var lookupInvoiceCommand = cli.Command{
 			Usage: "the 32 byte payment hash of the invoice to query for, the hash " +
 				"should be a hex-encoded string",
 		},
+		cli.StringFlag{
+			Name: "set_id",
+			Usage: "the 32 byte hex-encoded set id of an AMP " +
+				"payment, to only report the HTLCs and state " +
+				"of that set",
+		},
 	},
 	Action: actionDecorator(lookupInvoice),
 }
@@ -167,7 +181,7 @@ func lookupInvoice(ctx *cli.Context) error {
 		rHash, err = hex.DecodeString(ctx.String("rhash"))
 	case ctx.Args().Present():
 		rHash, err = hex.DecodeString(ctx.Args().First())
-	default:
+	case !ctx.IsSet("set_id"):
 		return fmt.Errorf("rhash argument missing")
 	}
 
@@ -179,6 +193,13 @@ func lookupInvoice(ctx *cli.Context) error {
 		RHash: rHash,
 	}
 
+	if ctx.IsSet("set_id") {
+		req.SetId, err = hex.DecodeString(ctx.String("set_id"))
+		if err != nil {
+			return fmt.Errorf("unable to decode set_id: %v", err)
+		}
+	}
+
 	invoice, err := client.LookupInvoice(ctxc, req)
 	if err != nil {
 		return err
@@ -189,6 +210,52 @@ func lookupInvoice(ctx *cli.Context) error {
 	return nil
 }
 
+var lookupInvoicesCommand = cli.Command{
+	Name:      "lookupinvoices",
+	Category:  "Invoices",
+	Usage:     "Lookup several existing invoices by their payment hashes.",
+	ArgsUsage: "rhash [rhash...]",
+	Description: `
+	Look up several invoices at once by their hex-encoded payment hashes.
+	A result is returned for every hash, indicating whether a matching
+	invoice was found.
+	`,
+	Action: actionDecorator(lookupInvoices),
+}
+
+func lookupInvoices(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	if !ctx.Args().Present() {
+		return cli.ShowCommandHelp(ctx, "lookupinvoices")
+	}
+
+	rHashes := make([][]byte, 0, ctx.NArg())
+	for _, arg := range ctx.Args() {
+		rHash, err := hex.DecodeString(arg)
+		if err != nil {
+			return fmt.Errorf("unable to decode rhash %v: %v", arg,
+				err)
+		}
+		rHashes = append(rHashes, rHash)
+	}
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.LookupInvoicesRequest{
+		RHashes: rHashes,
+	}
+	resp, err := client.LookupInvoices(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 var listInvoicesCommand = cli.Command{
 	Name:     "listinvoices",
 	Category: "Invoices",
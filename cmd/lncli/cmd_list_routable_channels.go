@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var listRoutableChannelsCommand = cli.Command{
+	Name:     "listroutablechannels",
+	Category: "Channels",
+	Usage: "List the channels that are able to originate a payment of " +
+		"the given amount.",
+	Description: `
+	List all active channels that currently have enough spendable balance
+	to send a payment of the given amount. The spendable balance of a
+	channel is its local balance minus the channel reserve and the value
+	of any HTLCs that are currently in flight.`,
+	ArgsUsage: "amt",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "amt",
+			Usage: "the amount in satoshis to check the " +
+				"channels for",
+		},
+		cli.Int64Flag{
+			Name: "amt_msat",
+			Usage: "the amount in millisatoshis to check the " +
+				"channels for",
+		},
+	},
+	Action: actionDecorator(listRoutableChannels),
+}
+
+func listRoutableChannels(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListRoutableChannelsRequest{
+		AmtMsat: ctx.Int64("amt_msat"),
+	}
+
+	switch {
+	case ctx.IsSet("amt"):
+		req.AmtSat = ctx.Int64("amt")
+
+	case ctx.Args().Present():
+		amt, err := strconv.ParseInt(ctx.Args().First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode amt: %v", err)
+		}
+		req.AmtSat = amt
+	}
+
+	resp, err := client.ListRoutableChannels(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
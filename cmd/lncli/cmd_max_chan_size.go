@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var getMaxChanSizeCommand = cli.Command{
+	Name:     "getmaxchansize",
+	Category: "Channels",
+	Usage:    "Get the limits on the size of channels.",
+	Description: `
+	Return the largest channel size (in satoshis) that the node opens and
+	accepts from remote peers, whether wumbo channels are enabled and the
+	soft cap on the channel size set at runtime, if any.`,
+	Action: actionDecorator(getMaxChanSize),
+}
+
+func getMaxChanSize(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.GetMaxChanSizeRequest{}
+	resp, err := client.GetMaxChanSize(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var setSoftMaxChanSizeCommand = cli.Command{
+	Name:     "setsoftmaxchansize",
+	Category: "Channels",
+	Usage:    "Set a soft cap on the size of channels.",
+	Description: `
+	Set a soft cap on the size of channels (in satoshis) that the node
+	opens or accepts from remote peers. Channel opens above the cap are
+	rejected. The cap can only be set if wumbo channels are enabled and
+	must not exceed the maximum size of wumbo channels.
+
+	A value of 0 removes the soft cap. The change is not persisted across
+	restarts.`,
+	ArgsUsage: "soft_max_size_sat",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "soft_max_size_sat",
+			Usage: "the soft cap on the channel size in " +
+				"satoshis",
+		},
+	},
+	Action: actionDecorator(setSoftMaxChanSize),
+}
+
+func setSoftMaxChanSize(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		softMax int64
+		err     error
+	)
+	switch {
+	case ctx.IsSet("soft_max_size_sat"):
+		softMax = ctx.Int64("soft_max_size_sat")
+
+	case ctx.Args().Present():
+		softMax, err = strconv.ParseInt(ctx.Args().First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode "+
+				"soft_max_size_sat: %v", err)
+		}
+
+	default:
+		return fmt.Errorf("soft_max_size_sat argument missing")
+	}
+
+	req := &lnrpc.SetSoftMaxChanSizeRequest{
+		SoftMaxChanSizeSat: softMax,
+	}
+	resp, err := client.SetSoftMaxChanSize(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
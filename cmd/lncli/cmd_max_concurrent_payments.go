@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/urfave/cli"
+)
+
+var setMaxConcurrentPaymentsCommand = cli.Command{
+	Name:      "setmaxconcurrentpayments",
+	Category:  "Payments",
+	Usage:     "Set the maximum number of concurrent payments.",
+	ArgsUsage: "max",
+	Description: `
+	Update the maximum number of outgoing payments that are processed
+	concurrently. Once the limit is reached, new payments are rejected
+	until in-flight payments complete. A value of 0 removes the limit.
+
+	The new limit only lasts until the next restart, after which the
+	max-concurrent-payments config value is used again.
+	`,
+	Action: actionDecorator(setMaxConcurrentPayments),
+}
+
+func setMaxConcurrentPayments(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	// Display the command's help message if we do not have the expected
+	// number of arguments/flags.
+	if ctx.NArg() != 1 || ctx.NumFlags() > 0 {
+		return cli.ShowCommandHelp(ctx, "setmaxconcurrentpayments")
+	}
+
+	max, err := strconv.ParseUint(ctx.Args().First(), 10, 32)
+	if err != nil {
+		return fmt.Errorf("unable to parse max: %v", err)
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	req := &routerrpc.SetMaxConcurrentPaymentsRequest{
+		MaxConcurrentPayments: uint32(max),
+	}
+	resp, err := client.SetMaxConcurrentPayments(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var setMaxHtlcHoldCommand = cli.Command{
+	Name:      "setmaxhtlchold",
+	Category:  "Channels",
+	Usage:     "Set the max htlc hold duration of a channel.",
+	ArgsUsage: "funding_txid [output_index]",
+	Description: `
+	Set the maximum number of seconds that an HTLC forwarded over the
+	channel identified by the given channel point may wait to be added to
+	the channel, for example while the peer is offline. Once it passes, the
+	incoming HTLC is failed back with a temporary channel failure. HTLCs
+	that the channel has already accepted are only failed back once the
+	peer has removed them. The duration must pass before the incoming HTLC
+	would have to be resolved on chain, given the time lock delta of the
+	channel. A duration of zero removes the max htlc hold.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "funding_txid",
+			Usage: "the txid of the channel's funding transaction",
+		},
+		cli.IntFlag{
+			Name: "output_index",
+			Usage: "the output index for the funding output of the " +
+				"funding transaction",
+		},
+		cli.Uint64Flag{
+			Name: "hold_sec",
+			Usage: "the maximum number of seconds an htlc may wait " +
+				"to be added before it is failed back",
+		},
+	},
+	Action: actionDecorator(setMaxHtlcHold),
+}
+
+func setMaxHtlcHold(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		_ = cli.ShowCommandHelp(ctx, "setmaxhtlchold")
+		return nil
+	}
+
+	if !ctx.IsSet("hold_sec") {
+		return fmt.Errorf("hold_sec argument missing")
+	}
+
+	holdSec := ctx.Uint64("hold_sec")
+	if holdSec > math.MaxUint32 {
+		return fmt.Errorf("hold_sec must be at most %d",
+			uint64(math.MaxUint32))
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.SetMaxHtlcHoldRequest{
+		ChannelPoint:   channelPoint,
+		MaxHtlcHoldSec: uint32(holdSec),
+	}
+
+	resp, err := client.SetMaxHtlcHold(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var getMaxTotalInFlightCommand = cli.Command{
+	Name:     "getmaxtotalinflight",
+	Category: "Channels",
+	Usage:    "Get the maximum total value of HTLCs in flight.",
+	Description: `
+	Return the maximum total value (in millisatoshis) of HTLCs that may be
+	in flight across all channels, along with the value currently in
+	flight. A maximum of 0 means that there is no maximum.`,
+	Action: actionDecorator(getMaxTotalInFlight),
+}
+
+func getMaxTotalInFlight(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.GetMaxTotalInFlightRequest{}
+	resp, err := client.GetMaxTotalInFlight(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var setMaxTotalInFlightCommand = cli.Command{
+	Name:     "setmaxtotalinflight",
+	Category: "Channels",
+	Usage:    "Set the maximum total value of HTLCs in flight.",
+	Description: `
+	Set the maximum total value (in millisatoshis) of HTLCs that may be in
+	flight across all channels. While the total exceeds the maximum, new
+	outgoing and forwarded HTLCs are rejected. HTLCs that are already in
+	flight are not affected.
+
+	A value of 0 removes the maximum. The change is not persisted across
+	restarts.`,
+	ArgsUsage: "max_inflight_msat",
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "max_inflight_msat",
+			Usage: "the maximum total value of HTLCs in flight " +
+				"in millisatoshis",
+		},
+	},
+	Action: actionDecorator(setMaxTotalInFlight),
+}
+
+func setMaxTotalInFlight(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		maxInFlight uint64
+		err         error
+	)
+	switch {
+	case ctx.IsSet("max_inflight_msat"):
+		maxInFlight = ctx.Uint64("max_inflight_msat")
+
+	case ctx.Args().Present():
+		maxInFlight, err = strconv.ParseUint(ctx.Args().First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode "+
+				"max_inflight_msat: %v", err)
+		}
+
+	default:
+		return fmt.Errorf("max_inflight_msat argument missing")
+	}
+
+	req := &lnrpc.SetMaxTotalInFlightRequest{
+		MaxTotalInflightMsat: maxInFlight,
+	}
+	resp, err := client.SetMaxTotalInFlight(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
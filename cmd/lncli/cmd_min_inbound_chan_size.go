@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var setMinInboundChanSizeCommand = cli.Command{
+	Name:     "setmininboundchansize",
+	Category: "Channels",
+	Usage:    "Set the smallest channel size accepted from remote peers.",
+	Description: `
+	Update the smallest channel size (in satoshis) that the node will accept
+	when a remote peer opens a channel to it. Incoming channels below this
+	size are rejected with an error message sent to the peer. Channels
+	opened by this node are not subject to this limit.
+
+	A value of 0 disables the inbound minimum. The change is not persisted
+	across restarts, use the min-chan-size-inbound config option for that.`,
+	ArgsUsage: "min_size_sat",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "min_size_sat",
+			Usage: "the smallest inbound channel size in " +
+				"satoshis that will be accepted",
+		},
+	},
+	Action: actionDecorator(setMinInboundChanSize),
+}
+
+func setMinInboundChanSize(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		minSize int64
+		err     error
+	)
+	switch {
+	case ctx.IsSet("min_size_sat"):
+		minSize = ctx.Int64("min_size_sat")
+
+	case ctx.Args().Present():
+		minSize, err = strconv.ParseInt(ctx.Args().First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode min_size_sat: %v",
+				err)
+		}
+
+	default:
+		return fmt.Errorf("min_size_sat argument missing")
+	}
+
+	req := &lnrpc.SetMinInboundChanSizeRequest{
+		MinChanSizeInboundSat: minSize,
+	}
+	resp, err := client.SetMinInboundChanSize(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
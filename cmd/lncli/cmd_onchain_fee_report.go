@@ -0,0 +1,49 @@
+package main
+
+import (
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var onChainFeeReportCommand = cli.Command{
+	Name:     "onchainfeereport",
+	Category: "On-chain",
+	Usage:    "Report the on-chain fees paid over a time range.",
+	Description: `
+	Report the total on-chain fees paid by the node for transactions that
+	confirmed within the given time range, broken down into channel opens,
+	channel closes, sweeps and other wallet sends. Transactions are
+	attributed to the range by the time of the block they confirmed in.`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "start_time",
+			Usage: "the start of the time range as a unix timestamp " +
+				"in seconds, inclusive",
+		},
+		cli.Uint64Flag{
+			Name: "end_time",
+			Usage: "the end of the time range as a unix timestamp " +
+				"in seconds, exclusive; if not set, the " +
+				"current time is used",
+		},
+	},
+	Action: actionDecorator(onChainFeeReport),
+}
+
+func onChainFeeReport(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.OnChainFeeReportRequest{
+		StartTime: ctx.Uint64("start_time"),
+		EndTime:   ctx.Uint64("end_time"),
+	}
+	resp, err := client.OnChainFeeReport(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"runtime"
@@ -46,6 +47,12 @@ var (
 			"to route through for this payment",
 	}
 
+	intermediaryFlag = cli.StringFlag{
+		Name: "intermediary",
+		Usage: "(optional) pubkey of an intermediary node that the " +
+			"payment must be routed through",
+	}
+
 	dataFlag = cli.StringFlag{
 		Name: "data",
 		Usage: "attach custom data to the payment. The required " +
@@ -60,6 +67,41 @@ var (
 			"displayed. Only valid in combination with --json.",
 	}
 
+	firstHopCltvDeltaFlag = cli.UintFlag{
+		Name: "first_hop_cltv_delta",
+		Usage: "(optional) the number of blocks added to the time " +
+			"lock of the first hop as a buffer against reorgs",
+	}
+
+	amtRoundingMsatFlag = cli.Uint64Flag{
+		Name: "amt_rounding_msat",
+		Usage: "(optional) round the amount to send up to a multiple " +
+			"of this many milli-satoshis to obscure the exact " +
+			"amount; for invoices that encode an amount, the " +
+			"rounding must not overpay by more than that amount",
+	}
+
+	minRouteProbabilityFlag = cli.Float64Flag{
+		Name: "min_route_probability",
+		Usage: "(optional) the minimum success probability a route " +
+			"must have to be attempted, within [0, 1]",
+	}
+
+	maxRouteHopsFlag = cli.UintFlag{
+		Name: "max_route_hops",
+		Usage: "(optional) the maximum number of hops a route may " +
+			"have, 1 only permits direct channels",
+	}
+
+	capacityHintsFlag = cli.StringFlag{
+		Name: "outgoing_chan_capacity_hints",
+		Usage: "(optional) the balances in milli-satoshis known " +
+			"to be available on the outgoing channels, " +
+			"formatted as <chan_id>=<amt_msat>,.. For " +
+			"example: --outgoing_chan_capacity_hints " +
+			"770495967390531585=50000",
+	}
+
 	maxPartsFlag = cli.UintFlag{
 		Name: "max_parts",
 		Usage: "the maximum number of partial payments that may be " +
@@ -144,7 +186,9 @@ func paymentFlags() []cli.Flag {
 		},
 		dataFlag, inflightUpdatesFlag, maxPartsFlag, jsonFlag,
 		maxShardSizeSatFlag, maxShardSizeMsatFlag, ampFlag,
-		ampReuseFlag,
+		ampReuseFlag, minRouteProbabilityFlag, intermediaryFlag,
+		firstHopCltvDeltaFlag, amtRoundingMsatFlag, maxRouteHopsFlag,
+		capacityHintsFlag,
 	}
 }
 
@@ -437,8 +481,19 @@ func sendPaymentRequest(ctx *cli.Context,
 		}
 		req.LastHopPubkey = lastHop[:]
 	}
+	if ctx.IsSet(intermediaryFlag.Name) {
+		intermediary, err := route.NewVertexFromStr(
+			ctx.String(intermediaryFlag.Name),
+		)
+		if err != nil {
+			return err
+		}
+		req.IntermediaryNode = intermediary[:]
+	}
 
 	req.CltvLimit = int32(ctx.Int(cltvLimitFlag.Name))
+	req.FirstHopCltvDelta = uint32(ctx.Uint(firstHopCltvDeltaFlag.Name))
+	req.AmountRoundingMsat = ctx.Uint64(amtRoundingMsatFlag.Name)
 
 	pmtTimeout := ctx.Duration("timeout")
 	if pmtTimeout <= 0 {
@@ -449,6 +504,8 @@ func sendPaymentRequest(ctx *cli.Context,
 	req.AllowSelfPayment = ctx.Bool("allow_self_payment")
 
 	req.MaxParts = uint32(ctx.Uint(maxPartsFlag.Name))
+	req.MinRouteProbability = ctx.Float64(minRouteProbabilityFlag.Name)
+	req.MaxRouteHops = uint32(ctx.Uint(maxRouteHopsFlag.Name))
 
 	switch {
 	// If the max shard size is specified, then it should either be in sat
@@ -467,6 +524,33 @@ func sendPaymentRequest(ctx *cli.Context,
 		))
 	}
 
+	// Parse the balances known to be available on the outgoing channels.
+	capacityHints := ctx.String(capacityHintsFlag.Name)
+	if capacityHints != "" {
+		req.OutgoingChanCapacityHints = make(map[uint64]uint64)
+		for _, h := range strings.Split(capacityHints, ",") {
+			kv := strings.Split(h, "=")
+			if len(kv) != 2 {
+				return fmt.Errorf("invalid capacity hint: "+
+					"%v", h)
+			}
+
+			chanID, err := strconv.ParseUint(kv[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid capacity hint: %v",
+					err)
+			}
+
+			amtMsat, err := strconv.ParseUint(kv[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid capacity hint: %v",
+					err)
+			}
+
+			req.OutgoingChanCapacityHints[chanID] = amtMsat
+		}
+	}
+
 	// Parse custom data records.
 	data := ctx.String(dataFlag.Name)
 	if data != "" {
@@ -808,6 +892,12 @@ var payInvoiceCommand = cli.Command{
 			Usage: "(optional) number of satoshis to fulfill the " +
 				"invoice",
 		},
+		cli.StringFlag{
+			Name: "unsafe_payment_hash_override",
+			Usage: "(optional) UNSAFE: pay to this hex encoded " +
+				"payment hash instead of the invoice's hash; " +
+				"the payment won't settle the invoice",
+		},
 	),
 	Action: actionDecorator(payInvoice),
 }
@@ -831,9 +921,126 @@ func payInvoice(ctx *cli.Context) error {
 		DestCustomRecords: make(map[uint64][]byte),
 	}
 
+	if ctx.IsSet("unsafe_payment_hash_override") {
+		hash, err := hex.DecodeString(
+			ctx.String("unsafe_payment_hash_override"),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to decode payment hash "+
+				"override: %v", err)
+		}
+
+		req.PaymentHashOverride = hash
+		req.UnsafeAllowHashOverride = true
+	}
+
 	return sendPaymentRequest(ctx, req)
 }
 
+var payInvoicesCommand = cli.Command{
+	Name:     "payinvoices",
+	Category: "Payments",
+	Usage:    "Pay a batch of invoices over lightning.",
+	Description: `
+	Pay all of the given invoices in a single call. At most --max_parallel
+	payments are in flight at the same time. The final outcome of each
+	payment is printed as soon as it is known, along with the index of its
+	invoice among the arguments. A payment that fails doesn't affect the
+	others.
+	`,
+	ArgsUsage: "pay_req [pay_req...]",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "fee_limit",
+			Usage: "maximum fee allowed in satoshis when " +
+				"sending each payment",
+		},
+		cli.Int64Flag{
+			Name: "fee_limit_percent",
+			Usage: "percentage of each payment's amount used as " +
+				"the maximum fee allowed when sending it",
+		},
+		cli.DurationFlag{
+			Name: "timeout",
+			Usage: "the maximum amount of time we should spend " +
+				"trying to fulfill each payment, failing " +
+				"after the timeout has elapsed",
+			Value: paymentTimeout,
+		},
+		cli.UintFlag{
+			Name: "max_parallel",
+			Usage: "the maximum number of payments that are in " +
+				"flight at the same time; if not set, lnd's " +
+				"default is used",
+		},
+	},
+	Action: actionDecorator(payInvoices),
+}
+
+func payInvoices(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	if !ctx.Args().Present() {
+		return fmt.Errorf("pay_req argument missing")
+	}
+
+	pmtTimeout := ctx.Duration("timeout")
+	if pmtTimeout <= 0 {
+		return errors.New("payment timeout must be greater than zero")
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := lnrpc.NewLightningClient(conn)
+	routerClient := routerrpc.NewRouterClient(conn)
+
+	req := &routerrpc.SendPaymentsRequest{
+		MaxParallel: uint32(ctx.Uint("max_parallel")),
+	}
+	for _, payReq := range ctx.Args() {
+		// The fee limit may depend on the amount of the invoice, so we
+		// need to decode it first.
+		decodeResp, err := client.DecodePayReq(
+			ctxc, &lnrpc.PayReqString{PayReq: payReq},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to decode %v: %v", payReq,
+				err)
+		}
+
+		feeLimit, err := retrieveFeeLimit(ctx, decodeResp.NumSatoshis)
+		if err != nil {
+			return err
+		}
+
+		req.Payments = append(
+			req.Payments, &routerrpc.SendPaymentRequest{
+				PaymentRequest: payReq,
+				FeeLimitSat:    feeLimit,
+				TimeoutSeconds: int32(pmtTimeout.Seconds()),
+			},
+		)
+	}
+
+	stream, err := routerClient.SendPayments(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		result, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(result)
+	}
+}
+
 var sendToRouteCommand = cli.Command{
 	Name:     "sendtoroute",
 	Category: "Payments",
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var setPeerBackoffCommand = cli.Command{
+	Name:      "setpeerbackoff",
+	Category:  "Peers",
+	Usage:     "Tune the backoff of reconnecting to persistent peers.",
+	ArgsUsage: "[pubkey]",
+	Description: `
+	Set the backoff between attempts to reconnect to the persistent peer
+	with the given public key, or the default backoff of all peers that
+	don't have a backoff of their own if no public key is given.
+
+	The backoff starts at --min_backoff and grows by --multiplier after
+	each failed attempt, until it reaches --max_backoff. Persistent peers
+	are retried at the max backoff indefinitely. Flags that aren't set keep
+	their current value, so that the current backoff is returned if no
+	flag is set. Backoffs set for a peer are lost on restart.
+
+	The --reset flag removes the backoff set for the peer, so that the
+	default backoff applies to it again.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "pubkey",
+			Usage: "the public key of the peer",
+		},
+		cli.DurationFlag{
+			Name: "min_backoff",
+			Usage: "the backoff before the first attempt to " +
+				"reconnect, e.g. 10s",
+		},
+		cli.DurationFlag{
+			Name: "max_backoff",
+			Usage: "the longest backoff between attempts to " +
+				"reconnect, e.g. 30m",
+		},
+		cli.Float64Flag{
+			Name: "multiplier",
+			Usage: "the factor by which the backoff grows after " +
+				"each failed attempt, at least 1",
+		},
+		cli.BoolFlag{
+			Name: "reset",
+			Usage: "remove the backoff set for the peer, so that " +
+				"the default backoff applies again",
+		},
+	},
+	Action: actionDecorator(setPeerBackoff),
+}
+
+func setPeerBackoff(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	pubKey := ctx.String("pubkey")
+	if !ctx.IsSet("pubkey") && ctx.Args().Present() {
+		pubKey = ctx.Args().First()
+	}
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.SetPeerBackoffRequest{
+		PubKey: pubKey,
+		MinBackoffMs: uint64(
+			ctx.Duration("min_backoff") / time.Millisecond,
+		),
+		MaxBackoffMs: uint64(
+			ctx.Duration("max_backoff") / time.Millisecond,
+		),
+		Multiplier: ctx.Float64("multiplier"),
+		Reset_:     ctx.Bool("reset"),
+	}
+
+	resp, err := client.SetPeerBackoff(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
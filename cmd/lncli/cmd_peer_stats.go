@@ -0,0 +1,50 @@
+package main
+
+import (
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var getPeerStatsCommand = cli.Command{
+	Name:     "getpeerstats",
+	Category: "Peers",
+	Usage:    "Get the traffic statistics of connected peers.",
+	Description: `
+	Return the number of bytes and messages sent to and received from each
+	connected peer since the connection was established. If a public key
+	is given, only the statistics of that peer are returned.`,
+	ArgsUsage: "[pubkey]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "node_key",
+			Usage: "The hex-encoded compressed public key of the " +
+				"peer to return the statistics for",
+		},
+	},
+	Action: actionDecorator(getPeerStats),
+}
+
+func getPeerStats(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var pubKey string
+	switch {
+	case ctx.IsSet("node_key"):
+		pubKey = ctx.String("node_key")
+	case ctx.Args().Present():
+		pubKey = ctx.Args().First()
+	}
+
+	req := &lnrpc.GetPeerStatsRequest{
+		PubKey: pubKey,
+	}
+	resp, err := client.GetPeerStats(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
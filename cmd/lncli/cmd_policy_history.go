@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var policyHistoryCommand = cli.Command{
+	Name:     "policyhistory",
+	Category: "Channels",
+	Usage:    "Query the history of the policies of the node's channels.",
+	Description: `
+	Query the forwarding policies that were set on the node's own channels
+	over time, as changed by updatechanpolicy. The changes are grouped by
+	channel and ordered by time. Only the most recent changes of each
+	channel are kept.
+
+	The start and end times are expressed in seconds since the Unix epoch.
+	Alternatively negative time ranges can be used, e.g. "-3d". Supports
+	s(seconds), m(minutes), h(ours), d(ays), w(eeks), M(onths), y(ears).
+	If --start_time isn't provided, the full history is returned. If
+	--end_time isn't provided, then the current time is used.`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "chan_id",
+			Usage: "only return the history of the channel with " +
+				"this short channel id",
+		},
+		cli.StringFlag{
+			Name: "start_time",
+			Usage: "the starting time for the query " +
+				`as unix timestamp or relative e.g. "-1w"`,
+		},
+		cli.StringFlag{
+			Name: "end_time",
+			Usage: "the end time for the query " +
+				`as unix timestamp or relative e.g. "-1w"`,
+		},
+	},
+	Action: actionDecorator(policyHistory),
+}
+
+func policyHistory(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	var (
+		startTime, endTime uint64
+		err                error
+	)
+	now := time.Now()
+
+	if ctx.IsSet("start_time") {
+		startTime, err = parseTime(ctx.String("start_time"), now)
+		if err != nil {
+			return fmt.Errorf("unable to decode start_time: %v",
+				err)
+		}
+	}
+
+	if ctx.IsSet("end_time") {
+		endTime, err = parseTime(ctx.String("end_time"), now)
+		if err != nil {
+			return fmt.Errorf("unable to decode end_time: %v", err)
+		}
+	}
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ChannelPolicyHistoryRequest{
+		ChanId:    ctx.Uint64("chan_id"),
+		StartTime: startTime,
+		EndTime:   endTime,
+	}
+
+	resp, err := client.ChannelPolicyHistory(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var previewSweepCommand = cli.Command{
+	Name:      "previewsweep",
+	Category:  "Channels",
+	Usage:     "Preview the sweep of a force closed channel.",
+	ArgsUsage: "funding_txid [output_index]",
+	Description: `
+	Estimate the transaction that sweeps the outputs of the pending force
+	closed channel identified by the given channel point back to the
+	wallet, at the current fee estimate. The transaction isn't broadcast.
+	Outputs that can't be swept yet, because they aren't mature or must be
+	spent by a second level transaction first, are listed as pending and
+	aren't part of the estimate.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "funding_txid",
+			Usage: "the txid of the channel's funding transaction",
+		},
+		cli.IntFlag{
+			Name: "output_index",
+			Usage: "the output index for the funding output of the " +
+				"funding transaction",
+		},
+	},
+	Action: actionDecorator(previewSweep),
+}
+
+func previewSweep(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		_ = cli.ShowCommandHelp(ctx, "previewsweep")
+		return nil
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.PreviewForceCloseSweepRequest{
+		ChannelPoint: channelPoint,
+	}
+
+	resp, err := client.PreviewForceCloseSweep(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
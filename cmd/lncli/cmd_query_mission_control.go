@@ -10,7 +10,14 @@ var queryMissionControlCommand = cli.Command{
 	Name:     "querymc",
 	Category: "Payments",
 	Usage:    "Query the internal mission control state.",
-	Action:   actionDecorator(queryMissionControl),
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: "verbose",
+			Usage: "also return the recent raw payment results " +
+				"for each node pair",
+		},
+	},
+	Action: actionDecorator(queryMissionControl),
 }
 
 func queryMissionControl(ctx *cli.Context) error {
@@ -20,7 +27,9 @@ func queryMissionControl(ctx *cli.Context) error {
 
 	client := routerrpc.NewRouterClient(conn)
 
-	req := &routerrpc.QueryMissionControlRequest{}
+	req := &routerrpc.QueryMissionControlRequest{
+		Verbose: ctx.Bool("verbose"),
+	}
 	snapshot, err := client.QueryMissionControl(ctxc, req)
 	if err != nil {
 		return err
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var resetFlapCountCommand = cli.Command{
+	Name:     "resetflapcount",
+	Category: "Peers",
+	Usage:    "Reset the flap count of a peer.",
+	Description: `
+	Reset the flap count of the peer identified by its public key to zero.
+	A peer's flap count is used to rate limit the tracking of its online
+	events and decays over time if the peer does not flap. This command
+	forces the reset immediately. The previous flap count is returned.`,
+	ArgsUsage: "<pubkey>",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "node_key",
+			Usage: "The hex-encoded compressed public key of the " +
+				"peer to reset the flap count for",
+		},
+	},
+	Action: actionDecorator(resetFlapCount),
+}
+
+func resetFlapCount(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var pubKey string
+	switch {
+	case ctx.IsSet("node_key"):
+		pubKey = ctx.String("node_key")
+	case ctx.Args().Present():
+		pubKey = ctx.Args().First()
+	default:
+		return fmt.Errorf("must specify target public key")
+	}
+
+	req := &lnrpc.ResetFlapCountRequest{
+		PubKey: pubKey,
+	}
+	resp, err := client.ResetFlapCount(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
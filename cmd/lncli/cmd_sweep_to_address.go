@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var setSweepToAddressCommand = cli.Command{
+	Name:     "setsweeptoaddress",
+	Category: "Channels",
+	Usage:    "Set the address that closed channel funds are swept to.",
+	Description: `
+	Set the address that the funds of closed channels are swept to, instead
+	of a fresh wallet address. It applies to the sweeps of force closed
+	channels and to cooperative closes without an upfront shutdown script.
+	Wallet outputs, like the ones swept to bump a fee, are still swept back
+	into the wallet. Only p2pkh, p2sh, p2wkh and p2wsh addresses of the
+	active network are accepted.
+
+	Use --clear to sweep to fresh wallet addresses again. The change is not
+	persisted across restarts, use the sweep-to-address config option for
+	that.`,
+	ArgsUsage: "[address]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "address",
+			Usage: "the address to sweep the funds of closed channels to",
+		},
+		cli.BoolFlag{
+			Name:  "clear",
+			Usage: "sweep to fresh wallet addresses again",
+		},
+	},
+	Action: actionDecorator(setSweepToAddress),
+}
+
+func setSweepToAddress(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var address string
+	switch {
+	case ctx.IsSet("address"):
+		address = ctx.String("address")
+
+	case ctx.Args().Present():
+		address = ctx.Args().First()
+	}
+
+	switch {
+	case ctx.Bool("clear") && address != "":
+		return fmt.Errorf("address and clear cannot both be set")
+
+	case !ctx.Bool("clear") && address == "":
+		return cli.ShowCommandHelp(ctx, "setsweeptoaddress")
+	}
+
+	req := &lnrpc.SetSweepToAddressRequest{
+		Address: address,
+	}
+	resp, err := client.SetSweepToAddress(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
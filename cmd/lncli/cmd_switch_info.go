@@ -0,0 +1,33 @@
+package main
+
+import (
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var getSwitchInfoCommand = cli.Command{
+	Name:     "getswitchinfo",
+	Category: "Payments",
+	Usage:    "Show the number of HTLC circuits held by the switch.",
+	Description: `
+	Show the number of open and pending HTLC circuits held by the htlcswitch
+	as well as the number of keystones persisted on disk. An idle node
+	should report close to zero circuits, a growing number of circuits
+	without forwarding activity hints at stuck HTLCs.`,
+	Action: actionDecorator(getSwitchInfo),
+}
+
+func getSwitchInfo(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.GetSwitchInfoRequest{}
+	resp, err := client.GetSwitchInfo(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
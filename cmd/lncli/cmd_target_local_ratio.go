@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var setTargetLocalRatioCommand = cli.Command{
+	Name:      "settargetlocalratio",
+	Category:  "Channels",
+	Usage:     "Set the target local balance ratio of a channel.",
+	ArgsUsage: "funding_txid [output_index]",
+	Description: `
+	Set the share of the capacity of the channel identified by the given
+	channel point that should be on the local side of the channel. The
+	ratio must be between 0 and 1. It is only stored locally for external
+	rebalancing tools and reported by listchannels, it is never advertised
+	to the peer or the network.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "funding_txid",
+			Usage: "the txid of the channel's funding transaction",
+		},
+		cli.IntFlag{
+			Name: "output_index",
+			Usage: "the output index for the funding output of the " +
+				"funding transaction",
+		},
+		cli.Float64Flag{
+			Name: "ratio",
+			Usage: "the share of the channel capacity that should " +
+				"be on the local side, between 0 and 1",
+		},
+	},
+	Action: actionDecorator(setTargetLocalRatio),
+}
+
+func setTargetLocalRatio(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		_ = cli.ShowCommandHelp(ctx, "settargetlocalratio")
+		return nil
+	}
+
+	if !ctx.IsSet("ratio") {
+		return fmt.Errorf("ratio argument missing")
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.SetTargetLocalRatioRequest{
+		ChannelPoint:     channelPoint,
+		TargetLocalRatio: ctx.Float64("ratio"),
+	}
+
+	resp, err := client.SetTargetLocalRatio(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
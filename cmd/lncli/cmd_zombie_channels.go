@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var listZombieChannelsCommand = cli.Command{
+	Name:     "listzombiechannels",
+	Category: "Graph",
+	Usage:    "List the channels of the graph marked as zombies.",
+	Description: `
+	List the channels that were pruned from the channel graph because they
+	are considered closed or inactive. Channels whose funding transaction
+	couldn't be found are listed with all-zero node public keys.`,
+	Action: actionDecorator(listZombieChannels),
+}
+
+func listZombieChannels(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListZombieChannelsRequest{}
+
+	resp, err := client.ListZombieChannels(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var restoreZombieChannelCommand = cli.Command{
+	Name:      "restorezombiechannel",
+	Category:  "Graph",
+	Usage:     "Remove a channel from the set of zombies.",
+	ArgsUsage: "chan_id",
+	Description: `
+	Remove the channel with the given channel ID from the set of zombies
+	and add it back to the channel graph, along with the policies that were
+	known when it was marked as a zombie. If its data wasn't kept, the
+	channel is only added back once its announcement is received again. A
+	channel whose funding output is spent can't be restored, as it was
+	closed on chain.`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name:  "chan_id",
+			Usage: "the 8-byte compact channel ID to restore",
+		},
+	},
+	Action: actionDecorator(restoreZombieChannel),
+}
+
+func restoreZombieChannel(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	var (
+		chanID uint64
+		err    error
+	)
+
+	switch {
+	case ctx.IsSet("chan_id"):
+		chanID = ctx.Uint64("chan_id")
+	case ctx.Args().Present():
+		chanID, err = strconv.ParseUint(ctx.Args().First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("error parsing chan_id: %s", err)
+		}
+	default:
+		return fmt.Errorf("chan_id argument missing")
+	}
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.RestoreZombieChannelRequest{
+		ChanId: chanID,
+	}
+
+	resp, err := client.RestoreZombieChannel(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -754,6 +754,14 @@ var closeChannelCommand = cli.Command{
 				"be used if an upfront shutdown address is not " +
 				"already set",
 		},
+		cli.StringFlag{
+			Name: "delivery_addr_type",
+			Usage: "(optional) the type of address to generate " +
+				"for the funds upon cooperative channel " +
+				"closing if no delivery_addr is set, one of " +
+				"p2wkh or np2wkh; ignored if an upfront " +
+				"shutdown address is already set",
+		},
 	},
 	Action: actionDecorator(closeChannel),
 }
@@ -792,6 +800,21 @@ func closeChannel(ctx *cli.Context) error {
 		DeliveryAddress: ctx.String("delivery_addr"),
 	}
 
+	if ctx.IsSet("delivery_addr_type") {
+		switch addrType := ctx.String("delivery_addr_type"); addrType {
+		case "p2wkh":
+			req.DeliveryAddressType =
+				lnrpc.AddressType_WITNESS_PUBKEY_HASH
+		case "np2wkh":
+			req.DeliveryAddressType =
+				lnrpc.AddressType_NESTED_PUBKEY_HASH
+		default:
+			return fmt.Errorf("invalid delivery address type %v, "+
+				"supported address types are: p2wkh and "+
+				"np2wkh", addrType)
+		}
+	}
+
 	// After parsing the request, we'll spin up a goroutine that will
 	// retrieve the closing transaction ID when attempting to close the
 	// channel. We do this to because `executeChannelClose` can block, so we
@@ -1118,9 +1141,8 @@ var abandonChannelCommand = cli.Command{
 	summary. This method can be used to get rid of permanently unusable
 	channels due to bugs fixed in newer versions of lnd.
 
-	Only available when lnd is built in debug mode. The flag
-	--i_know_what_im_doing can be set to override the debug/dev mode
-	requirement.
+	The flag --i_know_what_i_am_doing must be set to attest that the
+	user knows the danger of using this command.
 
 	To view which funding_txids/output_indexes can be used for this command,
 	see the channel_point values within the listchannels command output.
@@ -1138,8 +1160,7 @@ var abandonChannelCommand = cli.Command{
 		},
 		cli.BoolFlag{
 			Name: "i_know_what_i_am_doing",
-			Usage: "override the requirement for lnd needing to " +
-				"be in dev/debug mode to use this command; " +
+			Usage: "required to use this command; " +
 				"when setting this the user attests that " +
 				"they know the danger of using this command " +
 				"on channels and that doing so can lead to " +
@@ -1336,6 +1357,59 @@ func getRecoveryInfo(ctx *cli.Context) error {
 	return nil
 }
 
+var getSyncProgressCommand = cli.Command{
+	Name:  "getsyncprogress",
+	Usage: "Display the progress of the chain and graph sync.",
+	Description: `
+	Display the percentage of the chain and of the channel graph that the
+	node has synced, its current and target block height, and the estimated
+	number of seconds until both syncs complete. The estimate is -1 until
+	the node has observed the sync making progress.
+	`,
+	Action: actionDecorator(getSyncProgress),
+}
+
+func getSyncProgress(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.GetSyncProgressRequest{}
+	resp, err := client.GetSyncProgress(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var getReorgHistoryCommand = cli.Command{
+	Name:  "getreorghistory",
+	Usage: "Display the chain reorganizations observed by the node.",
+	Description: `
+	Display the chain reorganizations that the node observed since it was
+	started, with the old and new tip of the chain, the number of blocks
+	that were disconnected and the time of each reorganization.
+	`,
+	Action: actionDecorator(getReorgHistory),
+}
+
+func getReorgHistory(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.GetReorgHistoryRequest{}
+	resp, err := client.GetReorgHistory(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var pendingChannelsCommand = cli.Command{
 	Name:     "pendingchannels",
 	Category: "Channels",
@@ -1386,6 +1460,17 @@ var listChannelsCommand = cli.Command{
 				"particular peer, accepts 66-byte, " +
 				"hex-encoded pubkeys",
 		},
+		cli.StringFlag{
+			Name: "sort_by",
+			Usage: "(optional) sort the channels by their " +
+				"routing activity, most active first; one " +
+				"of 'forward_count' or 'fee_revenue'",
+		},
+		cli.DurationFlag{
+			Name: "activity_window",
+			Usage: "(optional) the window over which the routing " +
+				"activity is computed, defaults to 30 days",
+		},
 	},
 	Action: actionDecorator(listChannels),
 }
@@ -1417,6 +1502,24 @@ func listChannels(ctx *cli.Context) error {
 		Peer:         peerKey,
 	}
 
+	switch ctx.String("sort_by") {
+	case "":
+	case "forward_count":
+		req.SortBy = lnrpc.ListChannelsRequest_FORWARD_COUNT
+	case "fee_revenue":
+		req.SortBy = lnrpc.ListChannelsRequest_FEE_REVENUE
+	default:
+		return fmt.Errorf("unknown sort order %v, expected "+
+			"'forward_count' or 'fee_revenue'",
+			ctx.String("sort_by"))
+	}
+
+	if ctx.IsSet("activity_window") {
+		req.ActivityWindowSeconds = uint64(
+			ctx.Duration("activity_window").Seconds(),
+		)
+	}
+
 	resp, err := client.ListChannels(ctxc, req)
 	if err != nil {
 		return err
@@ -1591,6 +1694,12 @@ var listPaymentsCommand = cli.Command{
 				"index_offset will be returned, allowing " +
 				"forwards pagination",
 		},
+		cli.BoolFlag{
+			Name: "include_raw_failures",
+			Usage: "if set, the failures of failed htlc attempts " +
+				"include the raw failure message and the " +
+				"onion encrypted failure as they were received",
+		},
 	},
 	Action: actionDecorator(listPayments),
 }
@@ -1601,10 +1710,11 @@ func listPayments(ctx *cli.Context) error {
 	defer cleanUp()
 
 	req := &lnrpc.ListPaymentsRequest{
-		IncludeIncomplete: ctx.Bool("include_incomplete"),
-		IndexOffset:       uint64(ctx.Uint("index_offset")),
-		MaxPayments:       uint64(ctx.Uint("max_payments")),
-		Reversed:          !ctx.Bool("paginate_forwards"),
+		IncludeIncomplete:  ctx.Bool("include_incomplete"),
+		IndexOffset:        uint64(ctx.Uint("index_offset")),
+		MaxPayments:        uint64(ctx.Uint("max_payments")),
+		Reversed:           !ctx.Bool("paginate_forwards"),
+		IncludeRawFailures: ctx.Bool("include_raw_failures"),
 	}
 
 	payments, err := client.ListPayments(ctxc, req)
@@ -1759,7 +1869,13 @@ var queryRoutesCommand = cli.Command{
 			Usage: "(optional) the channel id of the channel " +
 				"that must be taken to the first hop",
 		},
+		cli.BoolFlag{
+			Name:  "allow_self_payment",
+			Usage: "allow querying a circular route to self",
+		},
 		cltvLimitFlag,
+		minRouteProbabilityFlag,
+		maxRouteHopsFlag,
 	},
 	Action: actionDecorator(queryRoutes),
 }
@@ -1812,6 +1928,11 @@ func queryRoutes(ctx *cli.Context) error {
 		UseMissionControl: ctx.Bool("use_mc"),
 		CltvLimit:         uint32(ctx.Uint64(cltvLimitFlag.Name)),
 		OutgoingChanId:    ctx.Uint64("outgoing_chanid"),
+		MinRouteProbability: ctx.Float64(
+			minRouteProbabilityFlag.Name,
+		),
+		AllowSelfPayment: ctx.Bool("allow_self_payment"),
+		MaxRouteHops:     uint32(ctx.Uint(maxRouteHopsFlag.Name)),
 	}
 
 	route, err := client.QueryRoutes(ctxc, req)
@@ -1919,6 +2040,87 @@ func debugLevel(ctx *cli.Context) error {
 	return nil
 }
 
+var getGossipBatchCommand = cli.Command{
+	Name:  "getgossipbatch",
+	Usage: "Show how gossip messages are broadcast.",
+	Description: `
+	Show the parameters that control how each batch of gossip messages is
+	split into sub batches when it's broadcast to our peers.
+	`,
+	Action: actionDecorator(getGossipBatch),
+}
+
+func getGossipBatch(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.GetGossipBatchParamsRequest{}
+	resp, err := client.GetGossipBatchParams(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var setGossipBatchCommand = cli.Command{
+	Name:  "setgossipbatch",
+	Usage: "Adjust how gossip messages are broadcast.",
+	Description: `
+	Adjust the parameters that control how each batch of gossip messages is
+	split into sub batches when it's broadcast to our peers. Parameters
+	whose flags aren't set are left unchanged. Adjusted parameters are only
+	kept until lnd is restarted.
+	`,
+	Flags: []cli.Flag{
+		cli.DurationFlag{
+			Name: "sub_batch_delay",
+			Usage: "the delay between broadcasting sub batches, " +
+				"which must be at least 100ms",
+		},
+		cli.UintFlag{
+			Name: "min_batch_size",
+			Usage: "the minimum number of messages in a sub " +
+				"batch",
+		},
+	},
+	Action: actionDecorator(setGossipBatch),
+}
+
+func setGossipBatch(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	if !ctx.IsSet("sub_batch_delay") && !ctx.IsSet("min_batch_size") {
+		return cli.ShowCommandHelp(ctx, "setgossipbatch")
+	}
+
+	req := &lnrpc.SetGossipBatchParamsRequest{
+		MinBatchSize: uint32(ctx.Uint("min_batch_size")),
+	}
+
+	if ctx.IsSet("sub_batch_delay") {
+		subBatchDelay := ctx.Duration("sub_batch_delay")
+		if subBatchDelay < time.Millisecond {
+			return fmt.Errorf("sub_batch_delay must be at least " +
+				"1ms")
+		}
+		req.SubBatchDelayMs = uint64(subBatchDelay / time.Millisecond)
+	}
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SetGossipBatchParams(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var listChainTxnsCommand = cli.Command{
 	Name:     "listchaintxns",
 	Category: "On-chain",
@@ -2166,14 +2368,16 @@ var updateChannelPolicyCommand = cli.Command{
 		cli.Uint64Flag{
 			Name: "min_htlc_msat",
 			Usage: "if set, the min HTLC size that will be applied " +
-				"to all forwarded HTLCs. If unset, the min HTLC " +
-				"is left unchanged.",
+				"to all HTLCs forwarded out through the " +
+				"channel(s). If unset, the min HTLC is left " +
+				"unchanged.",
 		},
 		cli.Uint64Flag{
 			Name: "max_htlc_msat",
 			Usage: "if set, the max HTLC size that will be applied " +
-				"to all forwarded HTLCs. If unset, the max HTLC " +
-				"is left unchanged.",
+				"to all HTLCs forwarded out through the " +
+				"channel(s). If unset, the max HTLC is left " +
+				"unchanged.",
 		},
 		cli.StringFlag{
 			Name: "chan_point",
@@ -0,0 +1,78 @@
+// +build dev
+
+package main
+
+import (
+	"github.com/lightningnetwork/lnd/lnrpc/devrpc"
+	"github.com/urfave/cli"
+)
+
+// devCommands will return the set of commands to enable for dev builds.
+func devCommands() []cli.Command {
+	return []cli.Command{
+		exportRevocationStateCommand,
+	}
+}
+
+func getDevClient(ctx *cli.Context) (devrpc.DevClient, func()) {
+	conn := getClientConn(ctx, false)
+	cleanup := func() {
+		conn.Close()
+	}
+	return devrpc.NewDevClient(conn), cleanup
+}
+
+var exportRevocationStateCommand = cli.Command{
+	Name:     "exportrevocationstate",
+	Category: "Development",
+	Usage:    "Export the revocation state of a channel.",
+	Description: `
+	Export the revocation state of an open channel for recovery tooling.
+	The producer of the node's own revocation secrets is referenced by the
+	key it was derived from, and the store of the secrets revealed by the
+	remote party is described by their digests. No secrets are exported.
+
+	The format for a channel_point is 'funding_txid:output_index'.`,
+	ArgsUsage: "funding_txid [output_index]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "funding_txid",
+			Usage: "the txid of the channel's funding transaction",
+		},
+		cli.IntFlag{
+			Name: "output_index",
+			Usage: "the output index for the funding output of " +
+				"the funding transaction",
+		},
+	},
+	Action: actionDecorator(exportRevocationState),
+}
+
+func exportRevocationState(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getDevClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "exportrevocationstate")
+		return nil
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &devrpc.ExportRevocationStateRequest{
+		ChannelPoint: channelPoint,
+	}
+
+	resp, err := client.ExportRevocationState(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
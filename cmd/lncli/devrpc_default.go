@@ -0,0 +1,10 @@
+// +build !dev
+
+package main
+
+import "github.com/urfave/cli"
+
+// devCommands will return nil for non-dev builds.
+func devCommands() []cli.Command {
+	return nil
+}
@@ -190,6 +190,13 @@ var addHoldInvoiceCommand = cli.Command{
 				"private channels in order to assist the " +
 				"payer in reaching you",
 		},
+		cli.UintFlag{
+			Name: "max_hints",
+			Usage: "the maximum number of routing hints to " +
+				"encode in the invoice, preferring channels " +
+				"with the most inbound liquidity; if not " +
+				"set, up to 20 hints are encoded",
+		},
 	},
 	Action: actionDecorator(addHoldInvoice),
 }
@@ -241,6 +248,7 @@ func addHoldInvoice(ctx *cli.Context) error {
 		FallbackAddr:    ctx.String("fallback_addr"),
 		Expiry:          ctx.Int64("expiry"),
 		Private:         ctx.Bool("private"),
+		MaxHints:        uint32(ctx.Uint("max_hints")),
 	}
 
 	resp, err := client.AddHoldInvoice(ctxc, invoice)
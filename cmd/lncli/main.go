@@ -333,32 +333,67 @@ func main() {
 		listUnspentCommand,
 		connectCommand,
 		disconnectCommand,
+		setPeerBackoffCommand,
+		resetFlapCountCommand,
+		getPeerStatsCommand,
 		openChannelCommand,
 		closeChannelCommand,
 		closeAllChannelsCommand,
 		abandonChannelCommand,
+		setMinInboundChanSizeCommand,
+		getMaxChanSizeCommand,
+		setSoftMaxChanSizeCommand,
+		getMaxTotalInFlightCommand,
+		setMaxTotalInFlightCommand,
 		listPeersCommand,
 		walletBalanceCommand,
 		channelBalanceCommand,
 		getInfoCommand,
 		getRecoveryInfoCommand,
+		getSyncProgressCommand,
+		getReorgHistoryCommand,
+		getAdvertisedAddrsCommand,
 		pendingChannelsCommand,
 		sendPaymentCommand,
 		payInvoiceCommand,
+		payInvoicesCommand,
 		sendToRouteCommand,
 		addInvoiceCommand,
+		setDefaultInvoiceExpiryCommand,
 		lookupInvoiceCommand,
+		lookupInvoicesCommand,
 		listInvoicesCommand,
 		listChannelsCommand,
+		listRoutableChannelsCommand,
+		chanUptimeHistoryCommand,
+		getCommitmentOutputsCommand,
+		setTargetLocalRatioCommand,
+		setMaxHtlcHoldCommand,
+		listZombieChannelsCommand,
+		restoreZombieChannelCommand,
+		previewSweepCommand,
+		policyHistoryCommand,
+		feeExposureCommand,
+		inboundLiquidityCommand,
+		getSwitchInfoCommand,
+		fwdFailureStatsCommand,
+		setCoopCloseFeeBandCommand,
+		setSweepToAddressCommand,
+		lookupHtlcCircuitCommand,
+		onChainFeeReportCommand,
 		closedChannelsCommand,
+		channelOpenHistoryCommand,
 		listPaymentsCommand,
 		describeGraphCommand,
 		getNodeMetricsCommand,
 		getChanInfoCommand,
+		getChanPoliciesCommand,
 		getNodeInfoCommand,
 		queryRoutesCommand,
 		getNetworkInfoCommand,
 		debugLevelCommand,
+		getGossipBatchCommand,
+		setGossipBatchCommand,
 		decodePayReqCommand,
 		listChainTxnsCommand,
 		stopCommand,
@@ -388,6 +423,7 @@ func main() {
 	app.Commands = append(app.Commands, walletCommands()...)
 	app.Commands = append(app.Commands, watchtowerCommands()...)
 	app.Commands = append(app.Commands, wtclientCommands()...)
+	app.Commands = append(app.Commands, devCommands()...)
 
 	if err := app.Run(os.Args); err != nil {
 		fatal(err)
@@ -58,10 +58,14 @@ func walletCommands() []cli.Command {
 				bumpFeeCommand,
 				bumpCloseFeeCommand,
 				listSweepsCommand,
+				sweepOutputCommand,
 				labelTxCommand,
+				getTxCommand,
 				publishTxCommand,
 				releaseOutputCommand,
 				listLeasesCommand,
+				addressInfoCommand,
+				setDefaultAddrTypeCommand,
 				psbtCommand,
 				accountsCommand,
 			},
@@ -385,6 +389,66 @@ func getWaitingCloseCommitments(client lnrpc.LightningClient,
 	return nil, errors.New("channel not found")
 }
 
+var sweepOutputCommand = cli.Command{
+	Name:      "sweepoutput",
+	Usage:     "Sweep a single wallet output to an address.",
+	ArgsUsage: "outpoint addr",
+	Description: `
+	Sweep a single output under control of the wallet to the given address
+	at the given fee rate. The sweep transaction only spends the given
+	output and is broadcast right away, bypassing lnd's central batching
+	engine.
+
+	Outputs that are currently being swept by the central batching engine,
+	as listed by lncli wallet pendingsweeps, are refused to prevent double
+	spends.
+	`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "sat_per_vbyte",
+			Usage: "a manual fee expressed in sat/vbyte that " +
+				"should be used when sweeping the output",
+		},
+		cli.StringFlag{
+			Name:  "label",
+			Usage: "(optional) a label for the sweep transaction",
+		},
+	},
+	Action: actionDecorator(sweepOutput),
+}
+
+func sweepOutput(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	// Display the command's help message if we do not have the expected
+	// number of arguments/flags.
+	if ctx.NArg() != 2 || !ctx.IsSet("sat_per_vbyte") {
+		return cli.ShowCommandHelp(ctx, "sweepoutput")
+	}
+
+	protoOutPoint, err := NewProtoOutPoint(ctx.Args().Get(0))
+	if err != nil {
+		return err
+	}
+
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SweepOutput(ctxc, &walletrpc.SweepOutputRequest{
+		Outpoint:    protoOutPoint,
+		Addr:        ctx.Args().Get(1),
+		SatPerVbyte: ctx.Uint64("sat_per_vbyte"),
+		Label:       ctx.String("label"),
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 var listSweepsCommand = cli.Command{
 	Name:  "listsweeps",
 	Usage: "Lists all sweeps that have been published by our node.",
@@ -480,6 +544,50 @@ func labelTransaction(ctx *cli.Context) error {
 	return nil
 }
 
+var getTxCommand = cli.Command{
+	Name:      "gettx",
+	Usage:     "Returns a single transaction of the wallet.",
+	ArgsUsage: "txid",
+	Description: `
+	Return the details of a transaction that was sent or received by the
+	wallet, including its label. Labels can be changed with the labeltx
+	command.
+	`,
+	Action: actionDecorator(getTransaction),
+}
+
+func getTransaction(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	// Display the command's help message if we do not have the expected
+	// number of arguments/flags.
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "gettx")
+	}
+
+	// Get the transaction id and check that it is a valid hash.
+	hash, err := chainhash.NewHashFromStr(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+
+	walletClient, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := walletClient.GetTransaction(
+		ctxc, &walletrpc.GetTransactionRequest{
+			Txid: hash[:],
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 var publishTxCommand = cli.Command{
 	Name:      "publishtx",
 	Usage:     "Attempts to publish the passed transaction to the network.",
@@ -1129,3 +1237,86 @@ func importPubKey(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+var setDefaultAddrTypeCommand = cli.Command{
+	Name:      "setdefaultaddrtype",
+	Usage:     "Change the type of addresses the wallet generates.",
+	ArgsUsage: "address_type",
+	Description: `
+	Changes the type of the addresses the wallet generates if no specific
+	type is requested, which includes the change addresses of the
+	transactions crafted by the wallet. The address type must be one of the
+	following: np2wkh, p2wkh.
+
+	The change lasts until lnd is restarted, after which the configured
+	default-address-type is used again.
+	`,
+	Action: actionDecorator(setDefaultAddrType),
+}
+
+func setDefaultAddrType(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	// Display the command's help message if we do not have the expected
+	// number of arguments/flags.
+	if ctx.NArg() != 1 || ctx.NumFlags() > 0 {
+		return cli.ShowCommandHelp(ctx, "setdefaultaddrtype")
+	}
+
+	addrType, err := parseAddrType(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+
+	walletClient, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &walletrpc.SetDefaultAddressTypeRequest{
+		AddressType: addrType,
+	}
+	resp, err := walletClient.SetDefaultAddressType(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var addressInfoCommand = cli.Command{
+	Name:      "addressinfo",
+	Usage:     "Retrieve the wallet's information about an address.",
+	ArgsUsage: "address",
+	Description: `
+	Returns the information the wallet has stored about the given address,
+	such as its BIP32 derivation path, key family and index, and whether
+	the wallet is able to sign for it or only watches it.
+
+	If the address doesn't belong to the wallet, is_mine is false.
+	`,
+	Action: actionDecorator(addressInfo),
+}
+
+func addressInfo(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	// Display the command's help message if we do not have the expected
+	// number of arguments/flags.
+	if ctx.NArg() != 1 || ctx.NumFlags() > 0 {
+		return cli.ShowCommandHelp(ctx, "addressinfo")
+	}
+
+	walletClient, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &walletrpc.GetAddressInfoRequest{
+		Address: ctx.Args().First(),
+	}
+	resp, err := walletClient.GetAddressInfo(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
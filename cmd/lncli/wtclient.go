@@ -20,10 +20,12 @@ func wtclientCommands() []cli.Command {
 			Subcommands: []cli.Command{
 				addTowerCommand,
 				removeTowerCommand,
+				migrateTowerCommand,
 				listTowersCommand,
 				getTowerCommand,
 				statsCommand,
 				policyCommand,
+				listSessionsCommand,
 			},
 		},
 	}
@@ -142,6 +144,61 @@ func removeTower(ctx *cli.Context) error {
 	return nil
 }
 
+var migrateTowerCommand = cli.Command{
+	Name:  "migrate",
+	Usage: "Replace a watchtower by a new one for future backups.",
+	Description: "The old watchtower will no longer be used for future " +
+		"sessions/backups, while the new watchtower is preferred " +
+		"over any other registered watchtower. States that were " +
+		"backed up before the migration remain with the old " +
+		"watchtower. The migration fails if the old watchtower has " +
+		"backups pending to be acknowledged.",
+	ArgsUsage: "old_pubkey new_pubkey@address",
+	Action:    actionDecorator(migrateTower),
+}
+
+func migrateTower(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	// Display the command's help message if the number of arguments/flags
+	// is not what we expect.
+	if ctx.NArg() != 2 || ctx.NumFlags() > 0 {
+		return cli.ShowCommandHelp(ctx, "migrate")
+	}
+
+	oldPubKey, err := hex.DecodeString(ctx.Args().First())
+	if err != nil {
+		return fmt.Errorf("invalid old public key: %v", err)
+	}
+
+	parts := strings.Split(ctx.Args().Get(1), "@")
+	if len(parts) != 2 {
+		return errors.New("expected new tower of format " +
+			"pubkey@address")
+	}
+	newPubKey, err := hex.DecodeString(parts[0])
+	if err != nil {
+		return fmt.Errorf("invalid new public key: %v", err)
+	}
+	address := parts[1]
+
+	client, cleanUp := getWtclient(ctx)
+	defer cleanUp()
+
+	req := &wtclientrpc.MigrateTowerRequest{
+		OldPubkey:  oldPubKey,
+		NewPubkey:  newPubKey,
+		NewAddress: address,
+	}
+	resp, err := client.MigrateTower(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var listTowersCommand = cli.Command{
 	Name:  "towers",
 	Usage: "Display information about all registered watchtowers.",
@@ -307,3 +364,52 @@ func policy(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+var listSessionsCommand = cli.Command{
+	Name: "sessions",
+	Usage: "Display the sessions negotiated with the registered " +
+		"watchtowers.",
+	ArgsUsage: "[pubkey]",
+	Description: `
+	Display the sessions negotiated with each registered watchtower, along
+	with the number of backups stored in them and their remaining capacity.
+	Watchtowers whose sessions are all exhausted are flagged with
+	sessions_exhausted.
+
+	If the hex-encoded public key of a watchtower is given, only the
+	sessions of that watchtower are displayed.`,
+	Action: actionDecorator(listSessions),
+}
+
+func listSessions(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	// Display the command's help message if the number of arguments/flags
+	// is not what we expect.
+	if ctx.NArg() > 1 || ctx.NumFlags() > 0 {
+		return cli.ShowCommandHelp(ctx, "sessions")
+	}
+
+	var pubKey []byte
+	if ctx.NArg() == 1 {
+		var err error
+		pubKey, err = hex.DecodeString(ctx.Args().Get(0))
+		if err != nil {
+			return fmt.Errorf("invalid public key: %v", err)
+		}
+	}
+
+	client, cleanUp := getWtclient(ctx)
+	defer cleanUp()
+
+	req := &wtclientrpc.ListTowerSessionsRequest{
+		Pubkey: pubKey,
+	}
+	resp, err := client.ListTowerSessions(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -34,9 +34,11 @@ import (
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/signrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chancloser"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/signal"
 	"github.com/lightningnetwork/lnd/tor"
@@ -71,6 +73,7 @@ const (
 	defaultMaxLogFileSize                = 10
 	defaultMinBackoff                    = time.Second
 	defaultMaxBackoff                    = time.Hour
+	defaultBackoffMultiplier             = 2
 	defaultLetsEncryptDirname            = "letsencrypt"
 	defaultLetsEncryptListen             = ":80"
 
@@ -160,6 +163,10 @@ const (
 	// defaultCoinSelectionStrategy is the coin selection strategy that is
 	// used by default to fund transactions.
 	defaultCoinSelectionStrategy = "largest"
+
+	// defaultAddressType is the type of the addresses the wallet generates
+	// by default.
+	defaultAddressType = "p2wkh"
 )
 
 var (
@@ -260,6 +267,7 @@ type Config struct {
 	NAT               bool          `long:"nat" description:"Toggle NAT traversal support (using either UPnP or NAT-PMP) to automatically advertise your external IP address to the network -- NOTE this does not support devices behind multiple NATs"`
 	MinBackoff        time.Duration `long:"minbackoff" description:"Shortest backoff when reconnecting to persistent peers. Valid time units are {s, m, h}."`
 	MaxBackoff        time.Duration `long:"maxbackoff" description:"Longest backoff when reconnecting to persistent peers. Valid time units are {s, m, h}."`
+	BackoffMultiplier float64       `long:"backoffmultiplier" description:"The factor by which the backoff grows after each failed attempt to reconnect to a persistent peer, until it reaches maxbackoff. Must be at least 1."`
 	ConnectionTimeout time.Duration `long:"connectiontimeout" description:"The timeout value for network connections. Valid time units are {ms, s, m, h}."`
 
 	DebugLevel string `short:"d" long:"debuglevel" description:"Logging level for all subsystems {trace, debug, info, warn, error, critical} -- You may also specify <global-level>,<subsystem>=<level>,<subsystem2>=<level>,... to set the log level for individual subsystems -- Use show to list available subsystems"`
@@ -304,6 +312,8 @@ type Config struct {
 
 	CoinSelectionStrategy string `long:"coin-selection-strategy" description:"The strategy to use for selecting coins for wallet transactions." choice:"largest" choice:"random"`
 
+	DefaultAddressType string `long:"default-address-type" description:"The type of the addresses the wallet generates if no specific type is requested, including the change addresses of wallet transactions. If np2wkh is used, the coins funding wallet transactions are only selected from np2wkh addresses." choice:"p2wkh" choice:"np2wkh"`
+
 	PaymentsExpirationGracePeriod time.Duration `long:"payments-expiration-grace-period" description:"A period to wait before force closing channels with outgoing htlcs that have timed-out and are a result of this node initiated payments."`
 	TrickleDelay                  int           `long:"trickledelay" description:"Time in milliseconds between each release of announcements to the network"`
 	ChanEnableTimeout             time.Duration `long:"chan-enable-timeout" description:"The duration that a peer connection must be stable before attempting to send a channel update to reenable or cancel a pending disables of the peer's channels on the network."`
@@ -314,7 +324,10 @@ type Config struct {
 	Color                         string        `long:"color" description:"The color of the node in hex format (i.e. '#3399FF'). Used to customize node appearance in intelligence services"`
 	MinChanSize                   int64         `long:"minchansize" description:"The smallest channel size (in satoshis) that we should accept. Incoming channels smaller than this will be rejected"`
 	MaxChanSize                   int64         `long:"maxchansize" description:"The largest channel size (in satoshis) that we should accept. Incoming channels larger than this will be rejected"`
+	MinChanSizeInbound            int64         `long:"min-chan-size-inbound" description:"The smallest channel size (in satoshis) that we should accept from a remote peer. Unlike minchansize, this value can be updated at runtime through the SetMinInboundChanSize RPC. Channels opened by this node are not affected."`
 	CoopCloseTargetConfs          uint32        `long:"coop-close-target-confs" description:"The target number of blocks that a cooperative channel close transaction should confirm in. This is used to estimate the fee to use as the lower bound during fee negotiation for the channel closure."`
+	SweepToAddress                string        `long:"sweep-to-address" description:"The address that the funds of closed channels are swept to, instead of a fresh wallet address. It applies to the sweeps of force closed channels and to cooperative closes without an upfront shutdown script. Wallet outputs, like the ones swept to bump a fee, are still swept back into the wallet. Only p2pkh, p2sh, p2wkh and p2wsh addresses of the active network are accepted. The address can be updated at runtime through the SetSweepToAddress RPC."`
+	CoopCloseFeeBand              uint32        `long:"coop-close-fee-band" description:"The percentage around our last fee proposal within which the fee proposed by the remote peer is accepted during cooperative close fee negotiation. A fee outside of this band is answered with a counter proposal. Valid values are within [10, 100]. The band can be adjusted at runtime through the SetCoopCloseFeeBand RPC."`
 
 	ChannelCommitInterval  time.Duration `long:"channel-commit-interval" description:"The maximum time that is allowed to pass between receiving a channel state update and signing the next commitment. Setting this to a longer duration allows for more efficient channel operations at the cost of latency."`
 	ChannelCommitBatchSize uint32        `long:"channel-commit-batch-size" description:"The maximum number of channel state updates that is accumulated before signing a new commitment."`
@@ -330,6 +343,8 @@ type Config struct {
 
 	RejectHTLC bool `long:"rejecthtlc" description:"If true, lnd will not forward any HTLCs that are meant as onward payments. This option will still allow lnd to send HTLCs and receive HTLCs but lnd won't be used as a hop."`
 
+	MaxTotalInFlightMsat uint64 `long:"max-total-inflight-msat" description:"The maximum total value in millisatoshis of HTLCs that may be in flight across all channels. New outgoing and forwarded HTLCs are rejected while the total exceeds this value. It can be changed at runtime with the SetMaxTotalInFlight RPC. Zero disables the limit."`
+
 	StaggerInitialReconnect bool `long:"stagger-initial-reconnect" description:"If true, will apply a randomized staggering between 0s and 30s when reconnecting to persistent peers on startup. The first 10 reconnections will be attempted instantly, regardless of the flag's value"`
 
 	MaxOutgoingCltvExpiry uint32 `long:"max-cltv-expiry" description:"The maximum number of blocks funds could be locked up for when forwarding payments."`
@@ -338,6 +353,8 @@ type Config struct {
 
 	MaxCommitFeeRateAnchors uint64 `long:"max-commit-fee-rate-anchors" description:"The maximum fee rate in sat/vbyte that will be used for commitments of channels of the anchors type. Must be large enough to ensure transaction propagation"`
 
+	MaxConcurrentPayments uint32 `long:"max-concurrent-payments" description:"The maximum number of outgoing payments that are processed concurrently. New payments are rejected once this limit is reached. A value of 0 means no limit. The limit can be adjusted at runtime through the SetMaxConcurrentPayments RPC."`
+
 	DryRunMigration bool `long:"dry-run-migration" description:"If true, lnd will abort committing a migration if it would otherwise have been successful. This leaves the database unmodified, and still compatible with the previously active version of lnd."`
 
 	net tor.Net
@@ -354,6 +371,8 @@ type Config struct {
 
 	GcCanceledInvoicesOnTheFly bool `long:"gc-canceled-invoices-on-the-fly" description:"If true, we'll delete newly canceled invoices on the fly."`
 
+	DefaultInvoiceExpiry time.Duration `long:"default-invoice-expiry" description:"The expiry of new invoices that don't specify one. Invoices that explicitly set an expiry are not affected. AMP invoices use a separate default expiry of 30 days."`
+
 	Invoices *lncfg.Invoices `group:"invoices" namespace:"invoices"`
 
 	Routing *lncfg.Routing `group:"routing" namespace:"routing"`
@@ -416,13 +435,14 @@ func DefaultConfig() Config {
 		WSPingInterval:    lnrpc.DefaultPingInterval,
 		WSPongWait:        lnrpc.DefaultPongWait,
 		Bitcoin: &lncfg.Chain{
-			MinHTLCIn:     chainreg.DefaultBitcoinMinHTLCInMSat,
-			MinHTLCOut:    chainreg.DefaultBitcoinMinHTLCOutMSat,
-			BaseFee:       chainreg.DefaultBitcoinBaseFeeMSat,
-			FeeRate:       chainreg.DefaultBitcoinFeeRate,
-			TimeLockDelta: chainreg.DefaultBitcoinTimeLockDelta,
-			MaxLocalDelay: defaultMaxLocalCSVDelay,
-			Node:          "btcd",
+			MinHTLCIn:        chainreg.DefaultBitcoinMinHTLCInMSat,
+			MinHTLCOut:       chainreg.DefaultBitcoinMinHTLCOutMSat,
+			BaseFee:          chainreg.DefaultBitcoinBaseFeeMSat,
+			FeeRate:          chainreg.DefaultBitcoinFeeRate,
+			TimeLockDelta:    chainreg.DefaultBitcoinTimeLockDelta,
+			MinTimeLockDelta: minTimeLockDelta,
+			MaxLocalDelay:    defaultMaxLocalCSVDelay,
+			Node:             "btcd",
 		},
 		BtcdMode: &lncfg.Btcd{
 			Dir:     defaultBtcdDir,
@@ -436,13 +456,14 @@ func DefaultConfig() Config {
 			PrunedNodeMaxPeers: defaultPrunedNodeMaxPeers,
 		},
 		Litecoin: &lncfg.Chain{
-			MinHTLCIn:     chainreg.DefaultLitecoinMinHTLCInMSat,
-			MinHTLCOut:    chainreg.DefaultLitecoinMinHTLCOutMSat,
-			BaseFee:       chainreg.DefaultLitecoinBaseFeeMSat,
-			FeeRate:       chainreg.DefaultLitecoinFeeRate,
-			TimeLockDelta: chainreg.DefaultLitecoinTimeLockDelta,
-			MaxLocalDelay: defaultMaxLocalCSVDelay,
-			Node:          "ltcd",
+			MinHTLCIn:        chainreg.DefaultLitecoinMinHTLCInMSat,
+			MinHTLCOut:       chainreg.DefaultLitecoinMinHTLCOutMSat,
+			BaseFee:          chainreg.DefaultLitecoinBaseFeeMSat,
+			FeeRate:          chainreg.DefaultLitecoinFeeRate,
+			TimeLockDelta:    chainreg.DefaultLitecoinTimeLockDelta,
+			MinTimeLockDelta: minTimeLockDelta,
+			MaxLocalDelay:    defaultMaxLocalCSVDelay,
+			Node:             "ltcd",
 		},
 		LtcdMode: &lncfg.Btcd{
 			Dir:     defaultLtcdDir,
@@ -465,6 +486,7 @@ func DefaultConfig() Config {
 		NoSeedBackup:       defaultNoSeedBackup,
 		MinBackoff:         defaultMinBackoff,
 		MaxBackoff:         defaultMaxBackoff,
+		BackoffMultiplier:  defaultBackoffMultiplier,
 		ConnectionTimeout:  tor.DefaultConnTimeout,
 		SubRPCServers: &subRPCServerConfigs{
 			SignRPC:   &signrpc.Config{},
@@ -491,7 +513,9 @@ func DefaultConfig() Config {
 		Color:                         defaultColor,
 		MinChanSize:                   int64(funding.MinChanFundingSize),
 		MaxChanSize:                   int64(0),
+		DefaultInvoiceExpiry:          invoicesrpc.DefaultInvoiceExpiry,
 		CoopCloseTargetConfs:          defaultCoopCloseTargetConfs,
+		CoopCloseFeeBand:              chancloser.DefaultFeeAcceptanceBand,
 		DefaultRemoteMaxHtlcs:         defaultRemoteMaxHtlcs,
 		NumGraphSyncPeers:             defaultMinPeers,
 		HistoricalSyncInterval:        discovery.DefaultHistoricalSyncInterval,
@@ -540,6 +564,8 @@ func DefaultConfig() Config {
 		Gossip: &lncfg.Gossip{
 			MaxChannelUpdateBurst: discovery.DefaultMaxChannelUpdateBurst,
 			ChannelUpdateInterval: discovery.DefaultChannelUpdateInterval,
+			SubBatchDelay:         discovery.DefaultSubBatchDelay,
+			MinimumBatchSize:      discovery.DefaultMinimumBatchSize,
 		},
 		Invoices: &lncfg.Invoices{
 			HoldExpiryDelta: lncfg.DefaultHoldInvoiceExpiryDelta,
@@ -555,6 +581,7 @@ func DefaultConfig() Config {
 		ChannelCommitInterval:   defaultChannelCommitInterval,
 		ChannelCommitBatchSize:  defaultChannelCommitBatchSize,
 		CoinSelectionStrategy:   defaultCoinSelectionStrategy,
+		DefaultAddressType:      defaultAddressType,
 	}
 }
 
@@ -799,6 +826,22 @@ func ValidateConfig(cfg Config, usageMessage string,
 		)
 	}
 
+	// The inbound minimum channel size must be non-negative and cannot
+	// exceed the maximum channel size, otherwise all incoming channels
+	// would be rejected.
+	if cfg.MinChanSizeInbound < 0 {
+		return nil, fmt.Errorf("invalid channel size parameters: "+
+			"min inbound channel size %v must be non-negative",
+			cfg.MinChanSizeInbound)
+	}
+	if cfg.MinChanSizeInbound > cfg.MaxChanSize {
+		return nil, fmt.Errorf("invalid channel size parameters: "+
+			"min inbound channel size %v must be no greater than "+
+			"max chan size %v", cfg.MinChanSizeInbound,
+			cfg.MaxChanSize,
+		)
+	}
+
 	// Don't allow superflous --maxchansize greater than
 	// BOLT 02 soft-limit for non-wumbo channel
 	if !cfg.ProtocolOptions.Wumbo() && cfg.MaxChanSize > int64(MaxFundingAmount) {
@@ -816,6 +859,12 @@ func ValidateConfig(cfg Config, usageMessage string,
 			cfg.MaxChannelFeeAllocation)
 	}
 
+	// Ensure a valid cooperative close fee acceptance band was set.
+	feeBand := cfg.CoopCloseFeeBand
+	if err := chancloser.ValidateFeeAcceptanceBand(feeBand); err != nil {
+		return nil, fmt.Errorf("invalid coop-close-fee-band: %v", err)
+	}
+
 	if cfg.MaxCommitFeeRateAnchors < 1 {
 		return nil, fmt.Errorf("invalid max commit fee rate anchors: "+
 			"%v, must be at least 1 sat/vbyte",
@@ -1152,6 +1201,18 @@ func ValidateConfig(cfg Config, usageMessage string,
 		cfg.registeredChains.RegisterPrimaryChain(chainreg.BitcoinChain)
 	}
 
+	// Ensure that the sweep address, if set, is valid for the active
+	// network.
+	if cfg.SweepToAddress != "" {
+		_, err := parseSweepToAddress(
+			cfg.SweepToAddress, cfg.ActiveNetParams.Params,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sweep-to-address: %v",
+				err)
+		}
+	}
+
 	// Ensure that the user didn't attempt to specify negative values for
 	// any of the autopilot params.
 	if cfg.Autopilot.MaxChannels < 0 {
@@ -1436,6 +1497,11 @@ func ValidateConfig(cfg Config, usageMessage string,
 			"minbackoff")
 	}
 
+	// Ensure that the backoff doesn't shrink between attempts.
+	if cfg.BackoffMultiplier < 1 {
+		return nil, fmt.Errorf("backoffmultiplier must be at least 1")
+	}
+
 	// Newer versions of lnd added a new sub-config for bolt-specific
 	// parameters. However we want to also allow existing users to use the
 	// value on the top-level config. If the outer config value is set,
@@ -1457,6 +1523,23 @@ func ValidateConfig(cfg Config, usageMessage string,
 		return nil, err
 	}
 
+	err = discovery.ValidateBatchParams(
+		cfg.Gossip.SubBatchDelay, cfg.Gossip.MinimumBatchSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that the default invoice expiry is within the bounds that we
+	// accept for invoices that explicitly set an expiry.
+	if cfg.DefaultInvoiceExpiry <= 0 ||
+		cfg.DefaultInvoiceExpiry > invoicesrpc.MaxInvoiceExpiry {
+
+		return nil, fmt.Errorf("default-invoice-expiry must be "+
+			"positive and at most %v, got %v",
+			invoicesrpc.MaxInvoiceExpiry, cfg.DefaultInvoiceExpiry)
+	}
+
 	// Log a warning if our expiry delta is not greater than our incoming
 	// broadcast delta. We do not fail here because this value may be set
 	// to zero to intentionally keep lnd's behavior unchanged from when we
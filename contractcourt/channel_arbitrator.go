@@ -1140,6 +1140,7 @@ func (c *ChannelArbitrator) sweepAnchors(anchors *lnwallet.AnchorResolutions,
 				},
 				Force:          true,
 				ExclusiveGroup: &exclusiveGroup,
+				DeadlineHeight: heightHint + deadline,
 			},
 		)
 		if err != nil {
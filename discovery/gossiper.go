@@ -37,6 +37,19 @@ const (
 	// determine how often we should allow a new update for a specific
 	// channel and direction.
 	DefaultChannelUpdateInterval = time.Minute
+
+	// DefaultSubBatchDelay is the default delay between sending sub batches
+	// of gossip messages.
+	DefaultSubBatchDelay = 5 * time.Second
+
+	// DefaultMinimumBatchSize is the default minimum size of a sub batch of
+	// gossip messages.
+	DefaultMinimumBatchSize = 10
+
+	// MinSubBatchDelay is the lowest delay between sending sub batches of
+	// gossip messages we allow, which bounds the rate at which a batch is
+	// broadcast to our peers.
+	MinSubBatchDelay = 100 * time.Millisecond
 )
 
 var (
@@ -350,6 +363,13 @@ type AuthenticatedGossiper struct {
 	// AuthenticatedGossiper lock.
 	chanUpdateRateLimiter map[uint64][2]*rate.Limiter
 
+	// subBatchDelay and minimumBatchSize control how a batch of
+	// announcements is split into sub batches when it's broadcast. They're
+	// initialized from the config, but can be adjusted at runtime.
+	batchMtx         sync.RWMutex
+	subBatchDelay    time.Duration
+	minimumBatchSize int
+
 	sync.Mutex
 }
 
@@ -366,6 +386,8 @@ func New(cfg Config, selfKey *btcec.PublicKey) *AuthenticatedGossiper {
 		channelMtx:              multimutex.NewMutex(),
 		recentRejects:           make(map[uint64]struct{}),
 		chanUpdateRateLimiter:   make(map[uint64][2]*rate.Limiter),
+		subBatchDelay:           cfg.SubBatchDelay,
+		minimumBatchSize:        cfg.MinimumBatchSize,
 	}
 
 	gossiper.syncMgr = newSyncManager(&SyncManagerCfg{
@@ -389,6 +411,54 @@ func New(cfg Config, selfKey *btcec.PublicKey) *AuthenticatedGossiper {
 	return gossiper
 }
 
+// ValidateBatchParams checks that the delay between sending sub batches of
+// gossip messages and the minimum size of a sub batch are within the bounds we
+// allow. The delay is bounded to avoid flooding our peers with gossip.
+func ValidateBatchParams(subBatchDelay time.Duration,
+	minimumBatchSize int) error {
+
+	if subBatchDelay < MinSubBatchDelay {
+		return fmt.Errorf("sub batch delay of %v is below the minimum "+
+			"of %v", subBatchDelay, MinSubBatchDelay)
+	}
+
+	if minimumBatchSize < 1 {
+		return fmt.Errorf("minimum batch size must be positive, got %v",
+			minimumBatchSize)
+	}
+
+	return nil
+}
+
+// BatchParams returns the delay between sending sub batches of gossip
+// messages and the minimum size of a sub batch currently in use.
+func (d *AuthenticatedGossiper) BatchParams() (time.Duration, int) {
+	d.batchMtx.RLock()
+	defer d.batchMtx.RUnlock()
+
+	return d.subBatchDelay, d.minimumBatchSize
+}
+
+// SetBatchParams adjusts the delay between sending sub batches of gossip
+// messages and the minimum size of a sub batch. The new values are used from
+// the next broadcast of a batch onwards.
+func (d *AuthenticatedGossiper) SetBatchParams(subBatchDelay time.Duration,
+	minimumBatchSize int) error {
+
+	err := ValidateBatchParams(subBatchDelay, minimumBatchSize)
+	if err != nil {
+		return err
+	}
+
+	d.batchMtx.Lock()
+	defer d.batchMtx.Unlock()
+
+	d.subBatchDelay = subBatchDelay
+	d.minimumBatchSize = minimumBatchSize
+
+	return nil
+}
+
 // EdgeWithInfo contains the information that is required to update an edge.
 type EdgeWithInfo struct {
 	// Info describes the channel.
@@ -1111,9 +1181,10 @@ func (d *AuthenticatedGossiper) networkHandler() {
 			// Next, If we have new things to announce then
 			// broadcast them to all our immediately connected
 			// peers.
+			subBatchDelay, minimumBatchSize := d.BatchParams()
 			subBatchSize := calculateSubBatchSize(
-				d.cfg.TrickleDelay, d.cfg.SubBatchDelay, d.cfg.MinimumBatchSize,
-				len(announcementBatch),
+				d.cfg.TrickleDelay, subBatchDelay,
+				minimumBatchSize, len(announcementBatch),
 			)
 
 			splitAnnouncementBatch := splitAnnouncementBatches(
@@ -1129,7 +1200,7 @@ func (d *AuthenticatedGossiper) networkHandler() {
 				for _, announcementBatch := range splitAnnouncementBatch {
 					d.sendBatch(announcementBatch)
 					select {
-					case <-time.After(d.cfg.SubBatchDelay):
+					case <-time.After(subBatchDelay):
 					case <-d.quit:
 						return
 					}
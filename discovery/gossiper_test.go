@@ -3815,6 +3815,40 @@ func TestCalculateCorrectSubBatchSizesDifferentDelay(t *testing.T) {
 	}
 }
 
+// TestSetBatchParams checks that the sub batch parameters can be adjusted at
+// runtime, and that values outside of the allowed bounds are rejected.
+func TestSetBatchParams(t *testing.T) {
+	t.Parallel()
+
+	gossiper := New(Config{
+		SubBatchDelay:    DefaultSubBatchDelay,
+		MinimumBatchSize: DefaultMinimumBatchSize,
+	}, nil)
+
+	subBatchDelay, minimumBatchSize := gossiper.BatchParams()
+	require.Equal(t, DefaultSubBatchDelay, subBatchDelay)
+	require.Equal(t, DefaultMinimumBatchSize, minimumBatchSize)
+
+	err := gossiper.SetBatchParams(MinSubBatchDelay, 1)
+	require.NoError(t, err)
+
+	subBatchDelay, minimumBatchSize = gossiper.BatchParams()
+	require.Equal(t, MinSubBatchDelay, subBatchDelay)
+	require.Equal(t, 1, minimumBatchSize)
+
+	// A delay below the minimum or a non-positive batch size is rejected
+	// and leaves the parameters untouched.
+	err = gossiper.SetBatchParams(MinSubBatchDelay-1, 1)
+	require.Error(t, err)
+
+	err = gossiper.SetBatchParams(MinSubBatchDelay, 0)
+	require.Error(t, err)
+
+	subBatchDelay, minimumBatchSize = gossiper.BatchParams()
+	require.Equal(t, MinSubBatchDelay, subBatchDelay)
+	require.Equal(t, 1, minimumBatchSize)
+}
+
 // markGraphSynced allows us to report that the initial historical sync has
 // completed.
 func (m *SyncManager) markGraphSyncing() {
@@ -151,6 +151,10 @@ type SyncManager struct {
 	// duration of the connection.
 	pinnedActiveSyncers map[route.Vertex]*GossipSyncer
 
+	// historicalSyncer is the syncer we are currently performing the
+	// initial historical sync with, if any. It is guarded by syncersMu.
+	historicalSyncer *GossipSyncer
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -231,6 +235,10 @@ func (m *SyncManager) syncerHandler() {
 		initialHistoricalSyncer = s
 		initialHistoricalSyncSignal = s.ResetSyncedSignal()
 
+		m.syncersMu.Lock()
+		m.historicalSyncer = s
+		m.syncersMu.Unlock()
+
 		// Restart the timer for our new historical sync peer. This will
 		// ensure that all initial syncers recevie an equivalent
 		// duration before attempting the next sync. Without doing so we
@@ -384,6 +392,10 @@ func (m *SyncManager) syncerHandler() {
 			initialHistoricalSyncer = nil
 			initialHistoricalSyncSignal = nil
 
+			m.syncersMu.Lock()
+			m.historicalSyncer = nil
+			m.syncersMu.Unlock()
+
 			log.Debug("Initial historical sync completed")
 
 			// With the initial historical sync complete, we can
@@ -769,3 +781,22 @@ func (m *SyncManager) markGraphSynced() {
 func (m *SyncManager) IsGraphSynced() bool {
 	return atomic.LoadInt32(&m.initialHistoricalSyncCompleted) == 1
 }
+
+// GraphSyncProgress returns the progress of the initial historical sync,
+// ranging from 0 to 1. If the syncer we perform the initial historical sync
+// with is replaced, the progress starts over with the new one.
+func (m *SyncManager) GraphSyncProgress() float64 {
+	if m.IsGraphSynced() {
+		return 1
+	}
+
+	m.syncersMu.Lock()
+	historicalSyncer := m.historicalSyncer
+	m.syncersMu.Unlock()
+
+	if historicalSyncer == nil {
+		return 0
+	}
+
+	return historicalSyncer.syncProgress()
+}
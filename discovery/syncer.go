@@ -311,6 +311,19 @@ type GossipSyncer struct {
 	// NOTE: This variable MUST be used atomically.
 	syncType uint32
 
+	// numChansToQuery is the number of channels we found to be unknown to
+	// us during our latest channel range query, and which we therefore
+	// query from the remote peer.
+	//
+	// NOTE: This variable MUST be used atomically.
+	numChansToQuery uint32
+
+	// numChansQueried is the number of channels out of numChansToQuery
+	// for which the remote peer has fully replied to our queries.
+	//
+	// NOTE: This variable MUST be used atomically.
+	numChansQueried uint32
+
 	// remoteUpdateHorizon is the update horizon of the remote peer. We'll
 	// use this to properly filter out any messages.
 	remoteUpdateHorizon *lnwire.GossipTimestampRange
@@ -704,6 +717,12 @@ func (g *GossipSyncer) sendGossipTimestampRange(firstTimestamp time.Time,
 // required to ensure they fit into a single message. We may re-renter this
 // state in the case that chunking is required.
 func (g *GossipSyncer) synchronizeChanIDs() (bool, error) {
+	// All of our previous queries have been replied to at this point, so
+	// we can update our progress.
+	numChansQueried := atomic.LoadUint32(&g.numChansToQuery) -
+		uint32(len(g.newChansToQuery))
+	atomic.StoreUint32(&g.numChansQueried, numChansQueried)
+
 	// If we're in this state yet there are no more new channels to query
 	// for, then we'll transition to our final synced state and return true
 	// to signal that we're fully synchronized.
@@ -884,6 +903,8 @@ func (g *GossipSyncer) processChanRangeReply(msg *lnwire.ReplyChannelRange) erro
 	// Otherwise, we'll set the set of channels that we need to query for
 	// the next state, and also transition our state.
 	g.newChansToQuery = newChans
+	atomic.StoreUint32(&g.numChansToQuery, uint32(len(newChans)))
+	atomic.StoreUint32(&g.numChansQueried, 0)
 	g.setSyncState(queryNewChannels)
 
 	log.Infof("GossipSyncer(%x): starting query for %v new chans",
@@ -1240,6 +1261,16 @@ func (g *GossipSyncer) ApplyGossipFilter(filter *lnwire.GossipTimestampRange) er
 	return nil
 }
 
+// RemoteUpdateHorizon returns the gossip filter the remote peer has applied, or
+// nil if it hasn't sent one yet. Until a filter is applied, no gossip messages
+// are forwarded to the peer.
+func (g *GossipSyncer) RemoteUpdateHorizon() *lnwire.GossipTimestampRange {
+	g.Lock()
+	defer g.Unlock()
+
+	return g.remoteUpdateHorizon
+}
+
 // FilterGossipMsgs takes a set of gossip messages, and only send it to a peer
 // iff the message is within the bounds of their set gossip filter. If the peer
 // doesn't have a gossip filter set, then no messages will be forwarded.
@@ -1400,6 +1431,31 @@ func (g *GossipSyncer) setSyncState(state syncerState) {
 	atomic.StoreUint32(&g.state, uint32(state))
 }
 
+// syncProgress returns the progress of the latest channel graph sync with the
+// remote peer, ranging from 0 to 1. The progress is measured by the number of
+// channels we've queried from the remote peer out of those it knows of and we
+// don't.
+func (g *GossipSyncer) syncProgress() float64 {
+	switch g.syncState() {
+	case queryNewChannels, waitingQueryChanReply:
+		numChansToQuery := atomic.LoadUint32(&g.numChansToQuery)
+		if numChansToQuery == 0 {
+			return 0
+		}
+
+		numChansQueried := atomic.LoadUint32(&g.numChansQueried)
+		return float64(numChansQueried) / float64(numChansToQuery)
+
+	case chansSynced:
+		return 1
+
+	// We don't know how many channels we'll need to query before the
+	// remote peer has replied to our channel range query.
+	default:
+		return 0
+	}
+}
+
 // syncState returns the current syncerState of the target GossipSyncer.
 func (g *GossipSyncer) syncState() syncerState {
 	return syncerState(atomic.LoadUint32(&g.state))
@@ -397,6 +397,12 @@ func TestGossipSyncerApplyNoHistoricalGossipFilter(t *testing.T) {
 	)
 	syncer.cfg.ignoreHistoricalFilters = true
 
+	// The remote peer hasn't applied a filter yet.
+	if syncer.RemoteUpdateHorizon() != nil {
+		t.Fatalf("expected no remote horizon, got: %v",
+			syncer.RemoteUpdateHorizon())
+	}
+
 	// We'll apply this gossip horizon for the remote peer.
 	remoteHorizon := &lnwire.GossipTimestampRange{
 		FirstTimestamp: unixStamp(25000),
@@ -427,9 +433,9 @@ func TestGossipSyncerApplyNoHistoricalGossipFilter(t *testing.T) {
 	syncer.ApplyGossipFilter(remoteHorizon)
 
 	// Ensure that the syncer's remote horizon was properly updated.
-	if !reflect.DeepEqual(syncer.remoteUpdateHorizon, remoteHorizon) {
+	if !reflect.DeepEqual(syncer.RemoteUpdateHorizon(), remoteHorizon) {
 		t.Fatalf("expected remote horizon: %v, got: %v",
-			remoteHorizon, syncer.remoteUpdateHorizon)
+			remoteHorizon, syncer.RemoteUpdateHorizon())
 	}
 
 	// Wait for the query check to finish.
@@ -1466,6 +1472,59 @@ func TestGossipSyncerSynchronizeChanIDs(t *testing.T) {
 	}
 }
 
+// TestGossipSyncerSyncProgress tests that the sync progress of the
+// GossipSyncer reflects the number of channels that have been queried from the
+// remote peer.
+func TestGossipSyncerSyncProgress(t *testing.T) {
+	t.Parallel()
+
+	const chunkSize = 2
+
+	msgChan, syncer, _ := newTestSyncer(
+		lnwire.NewShortChanIDFromInt(10), defaultEncoding, chunkSize,
+	)
+
+	// Before the remote peer replied to our channel range query, we don't
+	// know how many channels we'll query, so there's no progress.
+	syncer.setSyncState(waitingQueryRangeReply)
+	require.Zero(t, syncer.syncProgress())
+
+	newChanIDs := []lnwire.ShortChannelID{
+		lnwire.NewShortChanIDFromInt(1),
+		lnwire.NewShortChanIDFromInt(2),
+		lnwire.NewShortChanIDFromInt(3),
+		lnwire.NewShortChanIDFromInt(4),
+		lnwire.NewShortChanIDFromInt(5),
+	}
+	syncer.newChansToQuery = newChanIDs
+	syncer.numChansToQuery = uint32(len(newChanIDs))
+	syncer.setSyncState(queryNewChannels)
+
+	// Each chunk only counts towards the progress once the remote peer
+	// has replied to it, which is when we query for the next one.
+	expectedProgress := []float64{0, 0.4, 0.8}
+	for _, progress := range expectedProgress {
+		done, err := syncer.synchronizeChanIDs()
+		require.NoError(t, err)
+		require.False(t, done)
+		require.Equal(t, progress, syncer.syncProgress())
+
+		select {
+		case <-msgChan:
+		case <-time.After(time.Second * 15):
+			t.Fatalf("no msgs received")
+		}
+	}
+
+	done, err := syncer.synchronizeChanIDs()
+	require.NoError(t, err)
+	require.True(t, done)
+	require.Equal(t, float64(1), syncer.syncProgress())
+
+	syncer.setSyncState(chansSynced)
+	require.Equal(t, float64(1), syncer.syncProgress())
+}
+
 // TestGossipSyncerDelayDOS tests that the gossip syncer will begin delaying
 // queries after its prescribed allotment of undelayed query responses. Once
 // this happens, all query replies should be delayed by the configurated
@@ -0,0 +1,70 @@
+package feature
+
+import "github.com/lightningnetwork/lnd/lnwire"
+
+// BlindingSupport describes to which extent a node supports payments over
+// blinded paths.
+type BlindingSupport uint8
+
+const (
+	// BlindingUnsupported indicates that the node doesn't support blinded
+	// paths at all.
+	BlindingUnsupported BlindingSupport = iota
+
+	// BlindingReceiveOnly indicates that the node is able to process
+	// blinded HTLCs, but doesn't advertise this to the network. Senders
+	// are therefore unable to route through it, so it can only receive
+	// payments over blinded paths terminating at itself and can't be part
+	// of a blinded path towards another node.
+	BlindingReceiveOnly
+
+	// BlindingRelay indicates that the node is able to relay blinded
+	// HTLCs and advertises this to the network, which makes it usable as
+	// a hop of a blinded path.
+	BlindingRelay
+)
+
+// String returns a human-readable description of the blinding support.
+func (b BlindingSupport) String() string {
+	switch b {
+	case BlindingUnsupported:
+		return "unsupported"
+
+	case BlindingReceiveOnly:
+		return "receive-only"
+
+	case BlindingRelay:
+		return "relay"
+
+	default:
+		return "unknown"
+	}
+}
+
+// RouteBlindingSupport determines to which extent a node supports payments
+// over blinded paths. The connection features are the ones the node signaled
+// to us in its init message, while the announced features are the ones of its
+// node announcement, which may be nil if the node isn't known to the network.
+// Only a node that signals route blinding to the network can be used as a hop
+// of a blinded path, as senders need to be able to route towards it.
+func RouteBlindingSupport(connFeatures,
+	announcedFeatures *lnwire.FeatureVector) BlindingSupport {
+
+	switch {
+	case !hasRouteBlinding(connFeatures):
+		return BlindingUnsupported
+
+	case !hasRouteBlinding(announcedFeatures):
+		return BlindingReceiveOnly
+
+	default:
+		return BlindingRelay
+	}
+}
+
+// hasRouteBlinding returns true if the passed feature vector signals support
+// for route blinding.
+func hasRouteBlinding(features *lnwire.FeatureVector) bool {
+	return features != nil &&
+		features.HasFeature(lnwire.RouteBlindingOptional)
+}
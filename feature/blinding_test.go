@@ -0,0 +1,101 @@
+package feature
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRouteBlindingSupport asserts that a node's support for blinded paths is
+// correctly derived from its connection and announced features.
+func TestRouteBlindingSupport(t *testing.T) {
+	t.Parallel()
+
+	newFeatures := func(bits ...lnwire.FeatureBit) *lnwire.FeatureVector {
+		return lnwire.NewFeatureVector(
+			lnwire.NewRawFeatureVector(bits...), lnwire.Features,
+		)
+	}
+
+	tests := []struct {
+		name              string
+		connFeatures      *lnwire.FeatureVector
+		announcedFeatures *lnwire.FeatureVector
+		expected          BlindingSupport
+	}{
+		{
+			name:     "no features",
+			expected: BlindingUnsupported,
+		},
+		{
+			name: "unrelated features",
+			connFeatures: newFeatures(
+				lnwire.TLVOnionPayloadOptional,
+			),
+			announcedFeatures: newFeatures(
+				lnwire.TLVOnionPayloadOptional,
+			),
+			expected: BlindingUnsupported,
+		},
+		{
+			name: "only announced",
+			announcedFeatures: newFeatures(
+				lnwire.RouteBlindingOptional,
+			),
+			expected: BlindingUnsupported,
+		},
+		{
+			name: "unannounced node",
+			connFeatures: newFeatures(
+				lnwire.RouteBlindingOptional,
+			),
+			expected: BlindingReceiveOnly,
+		},
+		{
+			name: "not announced to network",
+			connFeatures: newFeatures(
+				lnwire.RouteBlindingOptional,
+			),
+			announcedFeatures: newFeatures(
+				lnwire.TLVOnionPayloadOptional,
+			),
+			expected: BlindingReceiveOnly,
+		},
+		{
+			name: "relay optional",
+			connFeatures: newFeatures(
+				lnwire.RouteBlindingOptional,
+			),
+			announcedFeatures: newFeatures(
+				lnwire.RouteBlindingOptional,
+			),
+			expected: BlindingRelay,
+		},
+		{
+			name: "relay required",
+			connFeatures: newFeatures(
+				lnwire.RouteBlindingRequired,
+			),
+			announcedFeatures: newFeatures(
+				lnwire.RouteBlindingRequired,
+			),
+			expected: BlindingRelay,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(
+				t, test.expected, RouteBlindingSupport(
+					test.connFeatures,
+					test.announcedFeatures,
+				),
+			)
+		})
+	}
+}
@@ -423,6 +423,12 @@ type Config struct {
 	// WUMBO you would like your channel.
 	MaxChanSize btcutil.Amount
 
+	// MinChanSizeInbound is the initial smallest channel size that we'll
+	// accept from a remote peer. Unlike MinChanSize, this value can be
+	// updated at runtime. Channels that we initiate ourselves are never
+	// subject to this limit.
+	MinChanSizeInbound btcutil.Amount
+
 	// MaxPendingChannels is the maximum number of pending channels we
 	// allow for each peer.
 	MaxPendingChannels int
@@ -523,10 +529,84 @@ type Manager struct {
 	handleFundingLockedMtx      sync.RWMutex
 	handleFundingLockedBarriers map[lnwire.ChannelID]struct{}
 
+	// minChanSizeInbound is the smallest channel size that we'll
+	// currently accept from a remote peer. It is initialized from the
+	// config and can be changed at runtime.
+	minChanSizeInboundMtx sync.RWMutex
+	minChanSizeInbound    btcutil.Amount
+
+	// softMaxChanSize is an optional cap on the size of channels that we
+	// open or accept, which can be set at runtime in addition to the
+	// static limits of the config. A value of zero means no cap is set.
+	softMaxChanSizeMtx sync.RWMutex
+	softMaxChanSize    btcutil.Amount
+
+	// fundingStates tracks the step of the funding flow that each pending
+	// channel is in, keyed by the channel's funding outpoint.
+	fundingStatesMtx sync.RWMutex
+	fundingStates    map[wire.OutPoint]FundingState
+
+	// confsRemaining tracks the number of confirmations the funding
+	// transaction of each pending channel still needs, keyed by the
+	// channel's funding outpoint. It is guarded by fundingStatesMtx.
+	confsRemaining map[wire.OutPoint]uint32
+
 	quit chan struct{}
 	wg   sync.WaitGroup
 }
 
+// FundingState describes the step of the funding flow that a pending channel,
+// whose funding transaction has been negotiated, is in.
+type FundingState uint8
+
+const (
+	// FundingStateUnknown is the state of a channel that the funding
+	// manager doesn't track, for example because it hasn't resumed the
+	// funding flow of the channel after a restart yet.
+	FundingStateUnknown FundingState = iota
+
+	// FundingStateAwaitingPublication is the state of a channel whose
+	// funding transaction we still need to publish.
+	FundingStateAwaitingPublication
+
+	// FundingStateAwaitingConfirmation is the state of a channel whose
+	// funding transaction has been published and is waiting for the
+	// required number of confirmations.
+	FundingStateAwaitingConfirmation
+
+	// FundingStateConfirmed is the state of a channel whose funding
+	// transaction has confirmed, while the channel is being marked open.
+	FundingStateConfirmed
+
+	// FundingStateFailed is the state of a channel whose funding flow has
+	// failed. Channels that are abandoned because of the failure only
+	// report this state until they are cleaned up.
+	FundingStateFailed
+)
+
+// String returns a human readable representation of the funding state.
+func (s FundingState) String() string {
+	switch s {
+	case FundingStateUnknown:
+		return "Unknown"
+
+	case FundingStateAwaitingPublication:
+		return "AwaitingPublication"
+
+	case FundingStateAwaitingConfirmation:
+		return "AwaitingConfirmation"
+
+	case FundingStateConfirmed:
+		return "Confirmed"
+
+	case FundingStateFailed:
+		return "Failed"
+
+	default:
+		return fmt.Sprintf("FundingState(%d)", s)
+	}
+}
+
 // channelOpeningState represents the different states a channel can be in
 // between the funding transaction has been confirmed and the channel is
 // announced to the network and ready to be used.
@@ -576,10 +656,128 @@ func NewFundingManager(cfg Config) (*Manager, error) {
 		fundingRequests:             make(chan *InitFundingMsg, msgBufferSize),
 		localDiscoverySignals:       make(map[lnwire.ChannelID]chan struct{}),
 		handleFundingLockedBarriers: make(map[lnwire.ChannelID]struct{}),
+		minChanSizeInbound:          cfg.MinChanSizeInbound,
+		fundingStates:               make(map[wire.OutPoint]FundingState),
+		confsRemaining:              make(map[wire.OutPoint]uint32),
 		quit:                        make(chan struct{}),
 	}, nil
 }
 
+// MinChanSizeInbound returns the smallest channel size that we currently
+// accept from a remote peer.
+func (f *Manager) MinChanSizeInbound() btcutil.Amount {
+	f.minChanSizeInboundMtx.RLock()
+	defer f.minChanSizeInboundMtx.RUnlock()
+
+	return f.minChanSizeInbound
+}
+
+// SetMinChanSizeInbound updates the smallest channel size that we accept from
+// a remote peer and returns the previous value. A value of zero disables the
+// inbound minimum.
+func (f *Manager) SetMinChanSizeInbound(amt btcutil.Amount) btcutil.Amount {
+	f.minChanSizeInboundMtx.Lock()
+	defer f.minChanSizeInboundMtx.Unlock()
+
+	prev := f.minChanSizeInbound
+	f.minChanSizeInbound = amt
+
+	return prev
+}
+
+// SoftMaxChanSize returns the runtime cap on the size of channels that we open
+// or accept. A value of zero means no cap is set.
+func (f *Manager) SoftMaxChanSize() btcutil.Amount {
+	f.softMaxChanSizeMtx.RLock()
+	defer f.softMaxChanSizeMtx.RUnlock()
+
+	return f.softMaxChanSize
+}
+
+// SetSoftMaxChanSize updates the runtime cap on the size of channels that we
+// open or accept and returns the previous value. A value of zero removes the
+// cap. Pending reservations aren't affected by the update.
+func (f *Manager) SetSoftMaxChanSize(amt btcutil.Amount) btcutil.Amount {
+	f.softMaxChanSizeMtx.Lock()
+	defer f.softMaxChanSizeMtx.Unlock()
+
+	prev := f.softMaxChanSize
+	f.softMaxChanSize = amt
+
+	return prev
+}
+
+// EffectiveMaxChanSize returns the largest channel size that we currently
+// accept, which is our max channel size capped by the runtime soft cap, if
+// set.
+func (f *Manager) EffectiveMaxChanSize() btcutil.Amount {
+	maxSize := f.cfg.MaxChanSize
+	if softMax := f.SoftMaxChanSize(); softMax != 0 && softMax < maxSize {
+		maxSize = softMax
+	}
+
+	return maxSize
+}
+
+// FundingState returns the step of the funding flow that the pending channel
+// with the given funding outpoint is in.
+func (f *Manager) FundingState(chanPoint wire.OutPoint) FundingState {
+	f.fundingStatesMtx.RLock()
+	defer f.fundingStatesMtx.RUnlock()
+
+	return f.fundingStates[chanPoint]
+}
+
+// setFundingState records the step of the funding flow that the pending
+// channel with the given funding outpoint is in.
+func (f *Manager) setFundingState(chanPoint wire.OutPoint,
+	state FundingState) {
+
+	f.fundingStatesMtx.Lock()
+	defer f.fundingStatesMtx.Unlock()
+
+	log.Debugf("ChannelPoint(%v) entered funding state %v", chanPoint,
+		state)
+
+	f.fundingStates[chanPoint] = state
+}
+
+// deleteFundingState stops tracking the funding flow of the channel with the
+// given funding outpoint, as it is no longer pending.
+func (f *Manager) deleteFundingState(chanPoint wire.OutPoint) {
+	f.fundingStatesMtx.Lock()
+	defer f.fundingStatesMtx.Unlock()
+
+	delete(f.fundingStates, chanPoint)
+	delete(f.confsRemaining, chanPoint)
+}
+
+// ConfirmationsRemaining returns the number of confirmations the funding
+// transaction of the pending channel with the given funding outpoint still
+// needs before the channel can be opened. The boolean return value is false
+// if we haven't started waiting for the funding transaction to confirm.
+func (f *Manager) ConfirmationsRemaining(chanPoint wire.OutPoint) (uint32,
+	bool) {
+
+	f.fundingStatesMtx.RLock()
+	defer f.fundingStatesMtx.RUnlock()
+
+	numConfs, ok := f.confsRemaining[chanPoint]
+	return numConfs, ok
+}
+
+// setConfirmationsRemaining records the number of confirmations the funding
+// transaction of the pending channel with the given funding outpoint still
+// needs.
+func (f *Manager) setConfirmationsRemaining(chanPoint wire.OutPoint,
+	numConfs uint32) {
+
+	f.fundingStatesMtx.Lock()
+	defer f.fundingStatesMtx.Unlock()
+
+	f.confsRemaining[chanPoint] = numConfs
+}
+
 // Start launches all helper goroutines required for handling requests sent
 // to the funding manager.
 func (f *Manager) Start() error {
@@ -622,6 +820,11 @@ func (f *Manager) start() error {
 
 			f.localDiscoverySignals[chanID] = make(chan struct{})
 
+			f.setFundingState(
+				channel.FundingOutpoint,
+				FundingStateAwaitingConfirmation,
+			)
+
 			// Rebroadcast the funding transaction for any pending
 			// channel that we initiated. No error will be returned
 			// if the transaction already has been broadcast.
@@ -664,6 +867,11 @@ func (f *Manager) start() error {
 						"ChannelPoint(%v): %v",
 						fundingTxBuf.Bytes(),
 						channel.FundingOutpoint, err)
+
+					f.setFundingState(
+						channel.FundingOutpoint,
+						FundingStateAwaitingPublication,
+					)
 				}
 			}
 		}
@@ -1058,7 +1266,10 @@ func (f *Manager) advancePendingChannelState(
 		// We'll get a timeout if the number of blocks mined
 		// since the channel was initiated reaches
 		// maxWaitNumBlocksFundingConf and we are not the
-		// channel initiator.
+		// channel initiator. The channel reports the failure
+		// until it has been closed.
+		f.setFundingState(channel.FundingOutpoint, FundingStateFailed)
+
 		ch := channel
 		localBalance := ch.LocalCommitment.LocalBalance.ToSatoshis()
 		closeInfo := &channeldb.ChannelCloseSummary{
@@ -1081,6 +1292,7 @@ func (f *Manager) advancePendingChannelState(
 			return fmt.Errorf("failed closing channel "+
 				"%v: %v", ch.FundingOutpoint, err)
 		}
+		f.deleteFundingState(ch.FundingOutpoint)
 
 		timeoutErr := fmt.Errorf("timeout waiting for funding tx "+
 			"(%v) to confirm", channel.FundingOutpoint)
@@ -1121,13 +1333,20 @@ func (f *Manager) advancePendingChannelState(
 	log.Debugf("ChannelID(%v) is now fully confirmed! "+
 		"(shortChanID=%v)", chanID, confChannel.shortChanID)
 
+	f.setFundingState(channel.FundingOutpoint, FundingStateConfirmed)
+
 	err = f.handleFundingConfirmation(channel, confChannel)
 	if err != nil {
+		f.setFundingState(channel.FundingOutpoint, FundingStateFailed)
+
 		return fmt.Errorf("unable to handle funding "+
 			"confirmation for ChannelPoint(%v): %v",
 			channel.FundingOutpoint, err)
 	}
 
+	// The channel is marked open now, so it is no longer pending.
+	f.deleteFundingState(channel.FundingOutpoint)
+
 	return nil
 }
 
@@ -1240,6 +1459,29 @@ func (f *Manager) handleFundingOpen(peer lnpeer.Peer,
 		return
 	}
 
+	// Additionally, enforce the inbound minimum channel size which may
+	// have been updated at runtime. As this check lives in the handler for
+	// remote funding requests, our own outbound channels are never subject
+	// to it.
+	if minInbound := f.MinChanSizeInbound(); amt < minInbound {
+		f.failFundingFlow(
+			peer, msg.PendingChannelID,
+			lnwallet.ErrInboundChanTooSmall(amt, minInbound),
+		)
+		return
+	}
+
+	// The runtime soft cap on the channel size, if set, applies to
+	// channels in both directions.
+	softMax := f.SoftMaxChanSize()
+	if softMax != 0 && amt > softMax {
+		f.failFundingFlow(
+			peer, msg.PendingChannelID,
+			lnwallet.ErrChanAboveSoftMax(amt, softMax),
+		)
+		return
+	}
+
 	// If request specifies non-zero push amount and 'rejectpush' is set,
 	// signal an error.
 	if f.cfg.RejectPush && msg.PushAmount > 0 {
@@ -1863,13 +2105,18 @@ func (f *Manager) handleFundingCreated(peer lnpeer.Peer,
 	}
 
 	// The channel is marked IsPending in the database, and can be removed
-	// from the set of active reservations.
+	// from the set of active reservations. The funding transaction is
+	// published by the remote peer, so we can only wait for it to confirm.
 	f.deleteReservationCtx(peerKey, msg.PendingChannelID)
+	f.setFundingState(fundingOut, FundingStateAwaitingConfirmation)
 
 	// If something goes wrong before the funding transaction is confirmed,
 	// we use this convenience method to delete the pending OpenChannel
-	// from the database.
+	// from the database. The channel reports the failure until it has
+	// been deleted.
 	deleteFromDatabase := func() {
+		f.setFundingState(fundingOut, FundingStateFailed)
+
 		localBalance := completeChan.LocalCommitment.LocalBalance.ToSatoshis()
 		closeInfo := &channeldb.ChannelCloseSummary{
 			ChanPoint:               completeChan.FundingOutpoint,
@@ -1890,7 +2137,10 @@ func (f *Manager) handleFundingCreated(peer lnpeer.Peer,
 		); err != nil {
 			log.Errorf("Failed closing channel %v: %v",
 				completeChan.FundingOutpoint, err)
+			return
 		}
+
+		f.deleteFundingState(fundingOut)
 	}
 
 	// A new channel has almost finished the funding process. In order to
@@ -2033,6 +2283,15 @@ func (f *Manager) handleFundingSigned(peer lnpeer.Peer,
 	// delete it from our set of active reservations.
 	f.deleteReservationCtx(peerKey, pendingChanID)
 
+	// If we have the funding transaction, we still need to publish it.
+	// Otherwise it is published by an external party, so we can only wait
+	// for it to confirm.
+	fundingState := FundingStateAwaitingConfirmation
+	if completeChan.ChanType.HasFundingTx() {
+		fundingState = FundingStateAwaitingPublication
+	}
+	f.setFundingState(*fundingPoint, fundingState)
+
 	// Broadcast the finalized funding transaction to the network, but only
 	// if we actually have the funding transaction.
 	if completeChan.ChanType.HasFundingTx() {
@@ -2070,6 +2329,10 @@ func (f *Manager) handleFundingSigned(peer lnpeer.Peer,
 			//
 			// TODO(halseth): retry more often? Handle with CPFP?
 			// Just delete from the DB?
+		} else {
+			f.setFundingState(
+				*fundingPoint, FundingStateAwaitingConfirmation,
+			)
 		}
 	}
 
@@ -2229,26 +2492,45 @@ func (f *Manager) waitForFundingConfirmation(
 	log.Infof("Waiting for funding tx (%v) to reach %v confirmations",
 		txid, numConfs)
 
-	var confDetails *chainntnfs.TxConfirmation
-	var ok bool
+	f.setConfirmationsRemaining(completeChan.FundingOutpoint, numConfs)
+
+	var (
+		confDetails *chainntnfs.TxConfirmation
+		ok          bool
+		confUpdates = confNtfn.Updates
+	)
 
 	// Wait until the specified number of confirmations has been reached,
-	// we get a cancel signal, or the wallet signals a shutdown.
-	select {
-	case confDetails, ok = <-confNtfn.Confirmed:
-		// fallthrough
+	// we get a cancel signal, or the wallet signals a shutdown. In the
+	// meantime, we keep track of the confirmations that are left.
+waitForConfs:
+	for {
+		select {
+		case numConfsLeft, updateOk := <-confUpdates:
+			if !updateOk {
+				confUpdates = nil
+				continue
+			}
 
-	case <-cancelChan:
-		log.Warnf("canceled waiting for funding confirmation, "+
-			"stopping funding flow for ChannelPoint(%v)",
-			completeChan.FundingOutpoint)
-		return
+			f.setConfirmationsRemaining(
+				completeChan.FundingOutpoint, numConfsLeft,
+			)
 
-	case <-f.quit:
-		log.Warnf("fundingManager shutting down, stopping funding "+
-			"flow for ChannelPoint(%v)",
-			completeChan.FundingOutpoint)
-		return
+		case confDetails, ok = <-confNtfn.Confirmed:
+			break waitForConfs
+
+		case <-cancelChan:
+			log.Warnf("canceled waiting for funding confirmation, "+
+				"stopping funding flow for ChannelPoint(%v)",
+				completeChan.FundingOutpoint)
+			return
+
+		case <-f.quit:
+			log.Warnf("fundingManager shutting down, stopping "+
+				"funding flow for ChannelPoint(%v)",
+				completeChan.FundingOutpoint)
+			return
+		}
 	}
 
 	if !ok {
@@ -2262,6 +2544,8 @@ func (f *Manager) waitForFundingConfirmation(
 	log.Infof("ChannelPoint(%v) is now active: ChannelID(%v)",
 		fundingPoint, lnwire.NewChanIDFromOutPoint(&fundingPoint))
 
+	f.setConfirmationsRemaining(fundingPoint, 0)
+
 	// With the block height and the transaction index known, we can
 	// construct the compact chanID which is used on the network to unique
 	// identify channels.
@@ -2281,6 +2565,19 @@ func (f *Manager) waitForFundingConfirmation(
 	}
 }
 
+// FundingExpiryHeight returns the block height at which we'll give up waiting
+// for the funding transaction of the given pending channel to confirm. The
+// boolean return value is false if the channel never expires, which is the
+// case for channels we initiated ourselves as we have funds at stake, and for
+// zero-conf channels that don't wait for a confirmation at all.
+func FundingExpiryHeight(c *channeldb.OpenChannel) (uint32, bool) {
+	if c.IsInitiator || c.NumConfsRequired == 0 {
+		return 0, false
+	}
+
+	return c.FundingBroadcastHeight + maxWaitNumBlocksFundingConf, true
+}
+
 // waitForTimeout will close the timeout channel if maxWaitNumBlocksFundingConf
 // has passed from the broadcast height of the given channel. In case of error,
 // the error is sent on timeoutChan. The wait can be canceled by closing the
@@ -2292,6 +2589,14 @@ func (f *Manager) waitForTimeout(completeChan *channeldb.OpenChannel,
 	cancelChan <-chan struct{}, timeoutChan chan<- error) {
 	defer f.wg.Done()
 
+	// On block maxHeight we will cancel the funding confirmation wait. If
+	// the channel never expires, there's nothing to wait for, and we
+	// leave the timeout channel open.
+	maxHeight, ok := FundingExpiryHeight(completeChan)
+	if !ok {
+		return
+	}
+
 	epochClient, err := f.cfg.Notifier.RegisterBlockEpochNtfn(nil)
 	if err != nil {
 		timeoutChan <- fmt.Errorf("unable to register for epoch "+
@@ -2301,8 +2606,6 @@ func (f *Manager) waitForTimeout(completeChan *channeldb.OpenChannel,
 
 	defer epochClient.Cancel()
 
-	// On block maxHeight we will cancel the funding confirmation wait.
-	maxHeight := completeChan.FundingBroadcastHeight + maxWaitNumBlocksFundingConf
 	for {
 		select {
 		case epoch, ok := <-epochClient.Epochs:
@@ -3154,6 +3457,14 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 		}
 	}
 
+	// Make sure we don't exceed the runtime soft cap on the channel size
+	// if one is set.
+	softMax := f.SoftMaxChanSize()
+	if softMax != 0 && localAmt > softMax {
+		msg.Err <- lnwallet.ErrChanAboveSoftMax(localAmt, softMax)
+		return
+	}
+
 	// Check whether the peer supports upfront shutdown, and get an address
 	// which should be used (either a user specified address or a new
 	// address from the wallet if our node is configured to set shutdown
@@ -885,6 +885,55 @@ func assertNumPendingChannelsBecomes(t *testing.T, node *testNode, expectedNum i
 		expectedNum, numPendingChans)
 }
 
+func assertFundingStateBecomes(t *testing.T, node *testNode,
+	chanPoint wire.OutPoint, expectedState FundingState) {
+
+	t.Helper()
+
+	var state FundingState
+	for i := 0; i < testPollNumTries; i++ {
+		// If this is not the first try, sleep before retrying.
+		if i > 0 {
+			time.Sleep(testPollSleepMs * time.Millisecond)
+		}
+
+		state = node.fundingMgr.FundingState(chanPoint)
+		if state == expectedState {
+			// Success, return.
+			return
+		}
+	}
+
+	t.Fatalf("Expected channel %v to have funding state %v, had %v",
+		chanPoint, expectedState, state)
+}
+
+func assertConfsRemainingBecomes(t *testing.T, node *testNode,
+	chanPoint wire.OutPoint, expectedConfs uint32) {
+
+	t.Helper()
+
+	var (
+		numConfs uint32
+		ok       bool
+	)
+	for i := 0; i < testPollNumTries; i++ {
+		// If this is not the first try, sleep before retrying.
+		if i > 0 {
+			time.Sleep(testPollSleepMs * time.Millisecond)
+		}
+
+		numConfs, ok = node.fundingMgr.ConfirmationsRemaining(chanPoint)
+		if ok && numConfs == expectedConfs {
+			// Success, return.
+			return
+		}
+	}
+
+	t.Fatalf("Expected channel %v to need %v more confirmations, had "+
+		"%v (tracked: %v)", chanPoint, expectedConfs, numConfs, ok)
+}
+
 func assertNumPendingChannelsRemains(t *testing.T, node *testNode, expectedNum int) {
 	t.Helper()
 
@@ -1952,7 +2001,9 @@ func TestFundingManagerFundingTimeout(t *testing.T) {
 
 	// Run through the process of opening the channel, up until the funding
 	// transaction is broadcasted.
-	_, _ = openChannel(t, alice, bob, 500000, 0, 1, updateChan, true)
+	fundingOutPoint, _ := openChannel(
+		t, alice, bob, 500000, 0, 1, updateChan, true,
+	)
 
 	// Bob will at this point be waiting for the funding transaction to be
 	// confirmed, so the channel should be considered pending.
@@ -1965,6 +2016,17 @@ func TestFundingManagerFundingTimeout(t *testing.T) {
 			len(pendingChannels))
 	}
 
+	assertFundingStateBecomes(
+		t, bob, *fundingOutPoint, FundingStateAwaitingConfirmation,
+	)
+
+	// None of the required confirmations of the funding transaction have
+	// been reached yet.
+	assertConfsRemainingBecomes(
+		t, bob, *fundingOutPoint,
+		uint32(pendingChannels[0].NumConfsRequired),
+	)
+
 	// We expect Bob to forget the channel after 2016 blocks (2 weeks), so
 	// mine 2016-1, and check that it is still pending.
 	bob.mockNotifier.epochChan <- &chainntnfs.BlockEpoch{
@@ -1983,6 +2045,16 @@ func TestFundingManagerFundingTimeout(t *testing.T) {
 
 	// Should not be pending anymore.
 	assertNumPendingChannelsBecomes(t, bob, 0)
+
+	// Once the channel has been cleaned up, its funding state is no longer
+	// tracked.
+	assertFundingStateBecomes(t, bob, *fundingOutPoint, FundingStateUnknown)
+
+	_, ok := bob.fundingMgr.ConfirmationsRemaining(*fundingOutPoint)
+	if ok {
+		t.Fatalf("Expected confirmations of channel %v to no longer "+
+			"be tracked", fundingOutPoint)
+	}
 }
 
 // TestFundingManagerFundingNotTimeoutInitiator checks that if the user was
@@ -3103,6 +3175,191 @@ func TestFundingManagerRejectPush(t *testing.T) {
 	}
 }
 
+// TestFundingManagerMinChanSizeInbound checks that incoming channels below the
+// runtime-adjustable inbound minimum are rejected, while our own outbound
+// channels are not subject to it.
+func TestFundingManagerMinChanSizeInbound(t *testing.T) {
+	t.Parallel()
+
+	const minInbound = 600000
+
+	// Set the inbound minimum for both nodes. Alice's outbound request
+	// below her own inbound minimum should still go through.
+	alice, bob := setupFundingManagers(
+		t, func(cfg *Config) {
+			cfg.MinChanSizeInbound = minInbound
+		},
+	)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// Bob raises his inbound minimum at runtime, the previous value
+	// should be returned.
+	prev := bob.fundingMgr.SetMinChanSizeInbound(minInbound + 1)
+	if prev != minInbound {
+		t.Fatalf("expected previous min inbound size %v, got %v",
+			minInbound, prev)
+	}
+
+	// Create a funding request and start the workflow.
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	errChan := make(chan error, 1)
+	initReq := &InitFundingMsg{
+		Peer:            bob,
+		TargetPubkey:    bob.privKey.PubKey(),
+		ChainHash:       *fundingNetParams.GenesisHash,
+		LocalFundingAmt: 500000,
+		Private:         true,
+		Updates:         updateChan,
+		Err:             errChan,
+	}
+
+	alice.fundingMgr.InitFundingWorkflow(initReq)
+
+	// Alice should have sent the OpenChannel message to Bob.
+	var aliceMsg lnwire.Message
+	select {
+	case aliceMsg = <-alice.msgChan:
+	case err := <-initReq.Err:
+		t.Fatalf("error init funding workflow: %v", err)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenChannel message")
+	}
+
+	openChannelReq, ok := aliceMsg.(*lnwire.OpenChannel)
+	if !ok {
+		t.Fatalf("expected OpenChannel to be sent from alice, "+
+			"instead got %T", aliceMsg)
+	}
+
+	// Let Bob handle the init message.
+	bob.fundingMgr.ProcessFundingMsg(openChannelReq, alice)
+
+	// Assert Bob responded with an ErrInboundChanTooSmall error.
+	err := assertFundingMsgSent(t, bob.msgChan, "Error").(*lnwire.Error)
+	if !strings.Contains(err.Error(), "below the min inbound chan size") {
+		t.Fatalf("expected ErrInboundChanTooSmall error, got \"%v\"",
+			err.Error())
+	}
+}
+
+// TestFundingManagerSoftMaxChanSize checks that the runtime soft cap on the
+// channel size is enforced for both outbound and inbound channels.
+func TestFundingManagerSoftMaxChanSize(t *testing.T) {
+	t.Parallel()
+
+	const softMax = 400000
+
+	alice, bob := setupFundingManagers(t)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// Alice sets a soft cap below the size of the channel she's about to
+	// open, her own request should be rejected.
+	prev := alice.fundingMgr.SetSoftMaxChanSize(softMax)
+	if prev != 0 {
+		t.Fatalf("expected no previous soft max, got %v", prev)
+	}
+
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	errChan := make(chan error, 1)
+	initReq := &InitFundingMsg{
+		Peer:            bob,
+		TargetPubkey:    bob.privKey.PubKey(),
+		ChainHash:       *fundingNetParams.GenesisHash,
+		LocalFundingAmt: 500000,
+		Private:         true,
+		Updates:         updateChan,
+		Err:             errChan,
+	}
+
+	alice.fundingMgr.InitFundingWorkflow(initReq)
+
+	select {
+	case err := <-initReq.Err:
+		if !strings.Contains(err.Error(), "soft max chan size") {
+			t.Fatalf("expected ErrChanAboveSoftMax error, got "+
+				"\"%v\"", err)
+		}
+	case <-alice.msgChan:
+		t.Fatalf("alice sent OpenChannel above her soft max")
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not reject the funding request")
+	}
+
+	// Removing the cap again returns the previous value and allows Alice
+	// to open the channel, but this time Bob has a soft cap set.
+	prev = alice.fundingMgr.SetSoftMaxChanSize(0)
+	if prev != softMax {
+		t.Fatalf("expected previous soft max %v, got %v", softMax,
+			prev)
+	}
+	bob.fundingMgr.SetSoftMaxChanSize(softMax)
+
+	alice.fundingMgr.InitFundingWorkflow(initReq)
+
+	// Alice should have sent the OpenChannel message to Bob.
+	var aliceMsg lnwire.Message
+	select {
+	case aliceMsg = <-alice.msgChan:
+	case err := <-initReq.Err:
+		t.Fatalf("error init funding workflow: %v", err)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenChannel message")
+	}
+
+	openChannelReq, ok := aliceMsg.(*lnwire.OpenChannel)
+	if !ok {
+		t.Fatalf("expected OpenChannel to be sent from alice, "+
+			"instead got %T", aliceMsg)
+	}
+
+	// Let Bob handle the init message.
+	bob.fundingMgr.ProcessFundingMsg(openChannelReq, alice)
+
+	// Assert Bob responded with an ErrChanAboveSoftMax error.
+	err := assertFundingMsgSent(t, bob.msgChan, "Error").(*lnwire.Error)
+	if !strings.Contains(err.Error(), "soft max chan size") {
+		t.Fatalf("expected ErrChanAboveSoftMax error, got \"%v\"",
+			err.Error())
+	}
+}
+
+// TestFundingManagerEffectiveMaxChanSize checks that the effective max channel
+// size is the configured max channel size capped by the runtime soft cap.
+func TestFundingManagerEffectiveMaxChanSize(t *testing.T) {
+	t.Parallel()
+
+	const softMax = 400000
+
+	alice, bob := setupFundingManagers(t)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// Without a soft cap, the configured max channel size applies.
+	if maxSize := alice.fundingMgr.EffectiveMaxChanSize(); maxSize !=
+		MaxBtcFundingAmount {
+
+		t.Fatalf("expected max chan size %v, got %v",
+			MaxBtcFundingAmount, maxSize)
+	}
+
+	// A soft cap below the configured max channel size lowers it.
+	alice.fundingMgr.SetSoftMaxChanSize(softMax)
+	if maxSize := alice.fundingMgr.EffectiveMaxChanSize(); maxSize !=
+		softMax {
+
+		t.Fatalf("expected max chan size %v, got %v", softMax,
+			maxSize)
+	}
+
+	// A soft cap above the configured max channel size doesn't raise it.
+	alice.fundingMgr.SetSoftMaxChanSize(MaxBtcFundingAmount + 1)
+	if maxSize := alice.fundingMgr.EffectiveMaxChanSize(); maxSize !=
+		MaxBtcFundingAmount {
+
+		t.Fatalf("expected max chan size %v, got %v",
+			MaxBtcFundingAmount, maxSize)
+	}
+}
+
 // TestFundingManagerMaxConfs ensures that we don't accept a funding proposal
 // that proposes a MinAcceptDepth greater than the maximum number of
 // confirmations we're willing to accept.
@@ -3674,3 +3931,47 @@ func testUpfrontFailure(t *testing.T, pkscript []byte, expectErr bool) {
 		require.True(t, ok, "did not receive AcceptChannel")
 	}
 }
+
+// TestFundingExpiryHeight asserts that only channels initiated by the remote
+// party that wait for a confirmation report a funding expiry height.
+func TestFundingExpiryHeight(t *testing.T) {
+	t.Parallel()
+
+	const broadcastHeight = 100
+
+	testCases := []struct {
+		name           string
+		initiator      bool
+		numConfs       uint16
+		expectedHeight uint32
+		expectedOk     bool
+	}{{
+		name:           "remote initiator",
+		numConfs:       3,
+		expectedHeight: broadcastHeight + maxWaitNumBlocksFundingConf,
+		expectedOk:     true,
+	}, {
+		name:      "local initiator",
+		initiator: true,
+		numConfs:  3,
+	}, {
+		name:     "zero conf",
+		numConfs: 0,
+	}}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			c := &channeldb.OpenChannel{
+				IsInitiator:            testCase.initiator,
+				NumConfsRequired:       testCase.numConfs,
+				FundingBroadcastHeight: broadcastHeight,
+			}
+
+			height, ok := FundingExpiryHeight(c)
+			require.Equal(t, testCase.expectedOk, ok)
+			require.Equal(t, testCase.expectedHeight, height)
+		})
+	}
+}
@@ -118,6 +118,10 @@ type CircuitMap interface {
 	// settles/fails from being accepted for the same circuit.
 	FailCircuit(inKey CircuitKey) (*PaymentCircuit, error)
 
+	// IsClosing returns whether the circuit identified by `inKey` has been
+	// marked as closing in-memory by CloseCircuit or FailCircuit.
+	IsClosing(inKey CircuitKey) bool
+
 	// LookupByPaymentHash queries the circuit map and returns all open
 	// circuits that use the given payment hash.
 	LookupByPaymentHash(hash [32]byte) []*PaymentCircuit
@@ -126,9 +130,17 @@ type CircuitMap interface {
 	// CommitCircuits.
 	NumPending() int
 
+	// InFlightAmount returns the total outgoing amount of the active
+	// circuits that haven't been marked as closing yet.
+	InFlightAmount() lnwire.MilliSatoshi
+
 	// NumOpen returns the number of circuits with HTLCs that have been
 	// forwarded via an outgoing link.
 	NumOpen() int
+
+	// NumKeystones returns the number of keystones that are persisted on
+	// disk.
+	NumKeystones() (int, error)
 }
 
 var (
@@ -813,6 +825,17 @@ func (cm *circuitMap) CloseCircuit(outKey CircuitKey) (*PaymentCircuit, error) {
 	return circuit, nil
 }
 
+// IsClosing returns whether the circuit identified by `inKey` has been marked
+// as closing in-memory by CloseCircuit or FailCircuit. As this state isn't
+// persisted, it is reset when the circuit map is restarted.
+func (cm *circuitMap) IsClosing(inKey CircuitKey) bool {
+	cm.mtx.RLock()
+	defer cm.mtx.RUnlock()
+
+	_, ok := cm.closed[inKey]
+	return ok
+}
+
 // DeleteCircuits destroys the target circuits by removing them from the circuit
 // map, additionally removing the circuits' keystones if any HTLCs were
 // forwarded through an outgoing link. The circuits should be identified by its
@@ -944,6 +967,26 @@ func (cm *circuitMap) NumPending() int {
 	return len(cm.pending)
 }
 
+// InFlightAmount returns the total outgoing amount of the active circuits in
+// the circuit map. Circuits that are marked as closing are excluded, so that
+// htlcs that are being settled or failed free up their amount as soon as the
+// resolution reaches the switch.
+func (cm *circuitMap) InFlightAmount() lnwire.MilliSatoshi {
+	cm.mtx.RLock()
+	defer cm.mtx.RUnlock()
+
+	var total lnwire.MilliSatoshi
+	for inKey, circuit := range cm.pending {
+		if _, ok := cm.closed[inKey]; ok {
+			continue
+		}
+
+		total += circuit.OutgoingAmount
+	}
+
+	return total
+}
+
 // NumOpen returns the number of circuits that have been opened by way of
 // setting their keystones. This is the number of HTLCs that are waiting for a
 // settle/fail response from a remote peer.
@@ -953,3 +996,28 @@ func (cm *circuitMap) NumOpen() int {
 
 	return len(cm.opened)
 }
+
+// NumKeystones returns the number of keystones that are persisted on disk.
+// Every open circuit is backed by a keystone, so this number exceeding the
+// number of open circuits hints at keystones that are never cleaned up.
+func (cm *circuitMap) NumKeystones() (int, error) {
+	var numKeystones int
+	err := kvdb.View(cm.cfg.DB, func(tx kvdb.RTx) error {
+		keystoneBkt := tx.ReadBucket(circuitKeystoneKey)
+		if keystoneBkt == nil {
+			return ErrCorruptedCircuitMap
+		}
+
+		return keystoneBkt.ForEach(func(_, _ []byte) error {
+			numKeystones++
+			return nil
+		})
+	}, func() {
+		numKeystones = 0
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return numKeystones, nil
+}
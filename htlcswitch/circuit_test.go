@@ -802,6 +802,13 @@ func TestCircuitMapOpenCircuits(t *testing.T) {
 	if !circuit2.HasKeystone() {
 		t.Fatalf("open circuit should have keystone")
 	}
+	numKeystones, err := circuitMap.NumKeystones()
+	if err != nil {
+		t.Fatalf("unable to count keystones: %v", err)
+	}
+	if numKeystones != 1 {
+		t.Fatalf("expected 1 keystone, found %d", numKeystones)
+	}
 	if !reflect.DeepEqual(&keystone.OutKey, circuit2.Outgoing) {
 		t.Fatalf("expected open circuit to have outgoing key: %v, found %v",
 			&keystone.OutKey, circuit2.Outgoing)
@@ -1164,10 +1171,16 @@ func TestCircuitMapCloseOpenCircuits(t *testing.T) {
 	}
 
 	// Close the open circuit for the first time, which should succeed.
+	if circuitMap.IsClosing(circuit.Incoming) {
+		t.Fatalf("circuit closing before being closed")
+	}
 	_, err = circuitMap.FailCircuit(circuit.Incoming)
 	if err != nil {
 		t.Fatalf("unable to close unopened circuit")
 	}
+	if !circuitMap.IsClosing(circuit.Incoming) {
+		t.Fatalf("closed circuit not closing")
+	}
 
 	// Closing the circuit a second time should result in a failure.
 	_, err = circuitMap.FailCircuit(circuit.Incoming)
@@ -1182,6 +1195,9 @@ func TestCircuitMapCloseOpenCircuits(t *testing.T) {
 	// NOTE: The channel db doesn't have any channel data, so no keystones
 	// will be trimmed.
 	_, circuitMap = restartCircuitMap(t, cfg)
+	if circuitMap.IsClosing(circuit.Incoming) {
+		t.Fatalf("closing state persisted across restart")
+	}
 
 	// Close the open circuit for the first time, which should succeed.
 	_, err = circuitMap.FailCircuit(circuit.Incoming)
@@ -1384,3 +1400,64 @@ func TestCircuitMapDeleteOpenCircuit(t *testing.T) {
 			circuit2, nil)
 	}
 }
+
+// TestCircuitMapInFlightAmount tests that the in-flight amount of the circuit
+// map covers the outgoing amount of all active circuits, and that a circuit no
+// longer counts towards it once it is closing.
+func TestCircuitMapInFlightAmount(t *testing.T) {
+	t.Parallel()
+
+	chan1 := lnwire.NewShortChanIDFromInt(1)
+
+	_, circuitMap := newCircuitMap(t)
+
+	if amt := circuitMap.InFlightAmount(); amt != 0 {
+		t.Fatalf("expected no amount in flight, got %v", amt)
+	}
+
+	newCircuit := func(htlcID uint64,
+		amt lnwire.MilliSatoshi) *htlcswitch.PaymentCircuit {
+
+		return &htlcswitch.PaymentCircuit{
+			Incoming: htlcswitch.CircuitKey{
+				ChanID: chan1,
+				HtlcID: htlcID,
+			},
+			OutgoingAmount: amt,
+			ErrorEncrypter: &hop.SphinxErrorEncrypter{
+				EphemeralKey: testEphemeralKey,
+			},
+		}
+	}
+
+	circuit1 := newCircuit(1, 1000)
+	circuit2 := newCircuit(2, 2000)
+	_, err := circuitMap.CommitCircuits(circuit1, circuit2)
+	if err != nil {
+		t.Fatalf("failed to commit circuits: %v", err)
+	}
+
+	if amt := circuitMap.InFlightAmount(); amt != 3000 {
+		t.Fatalf("expected 3000 msat in flight, got %v", amt)
+	}
+
+	// Failing the first circuit marks it as closing, which must release
+	// its amount right away, before the circuit is deleted.
+	_, err = circuitMap.FailCircuit(circuit1.Incoming)
+	if err != nil {
+		t.Fatalf("unable to fail circuit: %v", err)
+	}
+
+	if amt := circuitMap.InFlightAmount(); amt != 2000 {
+		t.Fatalf("expected 2000 msat in flight, got %v", amt)
+	}
+
+	err = circuitMap.DeleteCircuits(circuit1.Incoming)
+	if err != nil {
+		t.Fatalf("unable to delete circuit: %v", err)
+	}
+
+	if amt := circuitMap.InFlightAmount(); amt != 2000 {
+		t.Fatalf("expected 2000 msat in flight, got %v", amt)
+	}
+}
@@ -92,6 +92,15 @@ type ForwardingError struct {
 	// be nil in the case where we fail to decode failure message sent by
 	// a peer.
 	msg lnwire.FailureMessage
+
+	// RawMessage is the failure message as it was decrypted from the
+	// onion, before it was decoded. It includes the length prefix and the
+	// padding of the message.
+	RawMessage []byte
+
+	// EncryptedReason is the onion encrypted failure as it was received
+	// from our peer.
+	EncryptedReason lnwire.OpaqueReason
 }
 
 // WireMessage extracts a valid wire failure message from an internal
@@ -184,13 +193,21 @@ func (s *SphinxErrorDecrypter) DecryptError(reason lnwire.OpaqueReason) (
 
 	// Decode the failure. If an error occurs, we leave the failure message
 	// field nil.
+	var fErr *ForwardingError
 	r := bytes.NewReader(failure.Message)
 	failureMsg, err := lnwire.DecodeFailure(r, 0)
 	if err != nil {
-		return NewUnknownForwardingError(failure.SenderIdx), nil
+		fErr = NewUnknownForwardingError(failure.SenderIdx)
+	} else {
+		fErr = NewForwardingError(failureMsg, failure.SenderIdx)
 	}
 
-	return NewForwardingError(failureMsg, failure.SenderIdx), nil
+	// Keep the failure as it was received, so that it can be inspected
+	// even if we were unable to decode it.
+	fErr.RawMessage = failure.Message
+	fErr.EncryptedReason = reason
+
+	return fErr, nil
 }
 
 // A compile time check to ensure ErrorDecrypter implements the Deobfuscator
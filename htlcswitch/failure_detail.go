@@ -54,6 +54,11 @@ const (
 	// OutgoingFailureForwardsDisabled is returned when the switch is
 	// configured to disallow forwards.
 	OutgoingFailureForwardsDisabled
+
+	// OutgoingFailureMaxTotalInFlight is returned when the total value of
+	// the htlcs in flight through the switch exceeds its configured
+	// maximum.
+	OutgoingFailureMaxTotalInFlight
 )
 
 // FailureString returns the string representation of a failure detail.
@@ -91,6 +96,9 @@ func (fd OutgoingFailure) FailureString() string {
 	case OutgoingFailureForwardsDisabled:
 		return "node configured to disallow forwards"
 
+	case OutgoingFailureMaxTotalInFlight:
+		return "total in-flight htlc value exceeds maximum"
+
 	default:
 		return "unknown failure detail"
 	}
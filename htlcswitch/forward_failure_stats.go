@@ -0,0 +1,229 @@
+package htlcswitch
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// forwardFailureBucketSize is the granularity at which forwarding
+	// failures are counted. A query window always covers whole buckets.
+	forwardFailureBucketSize = time.Minute
+
+	// ForwardFailureStatsRetention is the maximum duration for which
+	// forwarding failures are counted.
+	ForwardFailureStatsRetention = 24 * time.Hour
+)
+
+// ForwardFailureReason classifies the reason why we rejected the forward of
+// an htlc.
+type ForwardFailureReason uint8
+
+const (
+	// ForwardFailureOther is the reason of all rejected forwards that
+	// don't fall into any other category.
+	ForwardFailureOther ForwardFailureReason = iota
+
+	// ForwardFailureInsufficientBalance is the reason of forwards that
+	// were rejected because the outgoing channel lacked the balance.
+	ForwardFailureInsufficientBalance
+
+	// ForwardFailureFeeInsufficient is the reason of forwards that were
+	// rejected because they didn't pay the fee of the outgoing channel.
+	ForwardFailureFeeInsufficient
+
+	// ForwardFailureExpiryTooSoon is the reason of forwards that were
+	// rejected because their expiry was too close to the current height.
+	ForwardFailureExpiryTooSoon
+
+	// ForwardFailureChannelDisabled is the reason of forwards that were
+	// rejected because the outgoing channel or forwarding in general was
+	// disabled.
+	ForwardFailureChannelDisabled
+
+	// numForwardFailureReasons is the number of forwarding failure
+	// reasons.
+	numForwardFailureReasons
+)
+
+// String returns a human readable representation of the forwarding failure
+// reason.
+func (r ForwardFailureReason) String() string {
+	switch r {
+	case ForwardFailureOther:
+		return "Other"
+
+	case ForwardFailureInsufficientBalance:
+		return "InsufficientBalance"
+
+	case ForwardFailureFeeInsufficient:
+		return "FeeInsufficient"
+
+	case ForwardFailureExpiryTooSoon:
+		return "ExpiryTooSoon"
+
+	case ForwardFailureChannelDisabled:
+		return "ChannelDisabled"
+
+	default:
+		return fmt.Sprintf("Unknown(%d)", uint8(r))
+	}
+}
+
+// classifyForwardFailure returns the reason of a forwarding failure that
+// occurred at our node.
+func classifyForwardFailure(linkErr *LinkError) ForwardFailureReason {
+	if linkErr == nil {
+		return ForwardFailureOther
+	}
+
+	if linkErr.FailureDetail == OutgoingFailureInsufficientBalance {
+		return ForwardFailureInsufficientBalance
+	}
+
+	switch linkErr.WireMessage().(type) {
+	case *lnwire.FailFeeInsufficient:
+		return ForwardFailureFeeInsufficient
+
+	case *lnwire.FailExpiryTooSoon:
+		return ForwardFailureExpiryTooSoon
+
+	case *lnwire.FailChannelDisabled:
+		return ForwardFailureChannelDisabled
+
+	default:
+		return ForwardFailureOther
+	}
+}
+
+// forwardFailureBucket holds the number of forwarding failures per reason
+// that occurred within one bucket.
+type forwardFailureBucket struct {
+	start  time.Time
+	counts [numForwardFailureReasons]uint64
+}
+
+// ForwardFailureStats counts the forwards that we rejected by reason. The
+// counts are kept for ForwardFailureStatsRetention and can be reset.
+type ForwardFailureStats struct {
+	// now returns the current time, it is set to allow for timestamp
+	// mocking in tests.
+	now func() time.Time
+
+	// buckets holds the counts of the retained buckets, ordered from the
+	// oldest to the most recent one.
+	buckets []*forwardFailureBucket
+
+	// lastReset is the time at which counting started.
+	lastReset time.Time
+
+	mu sync.Mutex
+}
+
+// NewForwardFailureStats creates a new forwarding failure counter that starts
+// counting now.
+func NewForwardFailureStats(now func() time.Time) *ForwardFailureStats {
+	return &ForwardFailureStats{
+		now:       now,
+		lastReset: now(),
+	}
+}
+
+// Record counts a forward that was rejected for the given reason.
+func (s *ForwardFailureStats) Record(reason ForwardFailureReason) {
+	if reason >= numForwardFailureReasons {
+		reason = ForwardFailureOther
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.prune(now)
+
+	start := now.Truncate(forwardFailureBucketSize)
+	n := len(s.buckets)
+	if n == 0 || !s.buckets[n-1].start.Equal(start) {
+		s.buckets = append(s.buckets, &forwardFailureBucket{
+			start: start,
+		})
+		n++
+	}
+
+	s.buckets[n-1].counts[reason]++
+}
+
+// Query returns the number of rejected forwards per reason within the given
+// window, and the time from which on they were counted. This is the later of
+// the start of the window and the last reset. A zero window counts all
+// rejected forwards that are retained.
+func (s *ForwardFailureStats) Query(window time.Duration) (
+	map[ForwardFailureReason]uint64, time.Time, error) {
+
+	if window < 0 || window > ForwardFailureStatsRetention {
+		return nil, time.Time{}, fmt.Errorf("window of %v must be "+
+			"within [0, %v]", window, ForwardFailureStatsRetention)
+	}
+	if window == 0 {
+		window = ForwardFailureStatsRetention
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.prune(now)
+
+	since := now.Add(-window)
+	if since.Before(s.lastReset) {
+		since = s.lastReset
+	}
+
+	counts := make(map[ForwardFailureReason]uint64)
+	for _, bucket := range s.buckets {
+		end := bucket.start.Add(forwardFailureBucketSize)
+		if !end.After(since) {
+			continue
+		}
+
+		for reason, count := range bucket.counts {
+			if count == 0 {
+				continue
+			}
+
+			counts[ForwardFailureReason(reason)] += count
+		}
+	}
+
+	return counts, since, nil
+}
+
+// Reset clears all counts and restarts counting now.
+func (s *ForwardFailureStats) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.buckets = nil
+	s.lastReset = s.now()
+}
+
+// prune removes all buckets that ended before the retention period.
+//
+// NOTE: The mutex must be held when calling this method.
+func (s *ForwardFailureStats) prune(now time.Time) {
+	cutoff := now.Add(-ForwardFailureStatsRetention)
+
+	var i int
+	for i < len(s.buckets) {
+		end := s.buckets[i].start.Add(forwardFailureBucketSize)
+		if end.After(cutoff) {
+			break
+		}
+		i++
+	}
+
+	s.buckets = s.buckets[i:]
+}
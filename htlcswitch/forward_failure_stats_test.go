@@ -0,0 +1,139 @@
+package htlcswitch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestClassifyForwardFailure asserts that link errors are mapped to the
+// expected forwarding failure reasons.
+func TestClassifyForwardFailure(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		linkErr  *LinkError
+		expected ForwardFailureReason
+	}{
+		{
+			name: "insufficient balance",
+			linkErr: NewDetailedLinkError(
+				&lnwire.FailTemporaryChannelFailure{},
+				OutgoingFailureInsufficientBalance,
+			),
+			expected: ForwardFailureInsufficientBalance,
+		},
+		{
+			name: "fee insufficient",
+			linkErr: NewLinkError(
+				&lnwire.FailFeeInsufficient{},
+			),
+			expected: ForwardFailureFeeInsufficient,
+		},
+		{
+			name: "expiry too soon",
+			linkErr: NewLinkError(
+				&lnwire.FailExpiryTooSoon{},
+			),
+			expected: ForwardFailureExpiryTooSoon,
+		},
+		{
+			name: "forwards disabled",
+			linkErr: NewDetailedLinkError(
+				&lnwire.FailChannelDisabled{},
+				OutgoingFailureForwardsDisabled,
+			),
+			expected: ForwardFailureChannelDisabled,
+		},
+		{
+			name: "other",
+			linkErr: NewLinkError(
+				&lnwire.FailUnknownNextPeer{},
+			),
+			expected: ForwardFailureOther,
+		},
+		{
+			name:     "no link error",
+			expected: ForwardFailureOther,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(
+				t, test.expected,
+				classifyForwardFailure(test.linkErr),
+			)
+		})
+	}
+}
+
+// TestForwardFailureStats asserts that rejected forwards are counted within
+// the queried window, are pruned after the retention period and are cleared
+// on reset.
+func TestForwardFailureStats(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000000, 0)
+	stats := NewForwardFailureStats(func() time.Time {
+		return now
+	})
+	start := now
+
+	stats.Record(ForwardFailureFeeInsufficient)
+	stats.Record(ForwardFailureFeeInsufficient)
+
+	now = now.Add(time.Hour)
+	stats.Record(ForwardFailureInsufficientBalance)
+
+	// A window that only covers the last hour excludes the failures of
+	// the first bucket.
+	counts, since, err := stats.Query(30 * time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(-30*time.Minute), since)
+	require.Equal(t, map[ForwardFailureReason]uint64{
+		ForwardFailureInsufficientBalance: 1,
+	}, counts)
+
+	// Without a window, everything since the start is counted.
+	counts, since, err = stats.Query(0)
+	require.NoError(t, err)
+	require.Equal(t, start, since)
+	require.Equal(t, map[ForwardFailureReason]uint64{
+		ForwardFailureFeeInsufficient:     2,
+		ForwardFailureInsufficientBalance: 1,
+	}, counts)
+
+	// Windows beyond the retention period are rejected.
+	_, _, err = stats.Query(ForwardFailureStatsRetention + time.Second)
+	require.Error(t, err)
+
+	// Once the retention period has passed for the first bucket, its
+	// failures are no longer counted.
+	now = start.Add(ForwardFailureStatsRetention + time.Minute)
+	counts, _, err = stats.Query(0)
+	require.NoError(t, err)
+	require.Equal(t, map[ForwardFailureReason]uint64{
+		ForwardFailureInsufficientBalance: 1,
+	}, counts)
+
+	// A reset clears all counts, and counting restarts at the time of
+	// the reset.
+	stats.Reset()
+	counts, since, err = stats.Query(0)
+	require.NoError(t, err)
+	require.Equal(t, now, since)
+	require.Empty(t, counts)
+
+	stats.Record(ForwardFailureExpiryTooSoon)
+	counts, _, err = stats.Query(time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, map[ForwardFailureReason]uint64{
+		ForwardFailureExpiryTooSoon: 1,
+	}, counts)
+}
@@ -55,6 +55,11 @@ const (
 	// BogusSettle attempts to settle back any incoming HTLC for which we
 	// are the exit node with a bogus preimage.
 	BogusSettle
+
+	// BogusOnion corrupts the onion of any outgoing ADD before it is added
+	// to the in-memory commitment state of the link, so that the next hop
+	// is unable to process it.
+	BogusOnion
 )
 
 // String returns a human-readable identifier for a given Flag.
@@ -78,6 +83,8 @@ func (f Flag) String() string {
 		return "Commit"
 	case BogusSettle:
 		return "BogusSettle"
+	case BogusOnion:
+		return "BogusOnion"
 	default:
 		return "UnknownHodlFlag"
 	}
@@ -106,6 +113,8 @@ func (f Flag) Warning() string {
 		msg = "will not commit pending channel updates"
 	case BogusSettle:
 		msg = "will settle HTLC with bogus preimage"
+	case BogusOnion:
+		msg = "will forward ADD with corrupted onion"
 	default:
 		msg = "incorrect hodl flag usage"
 	}
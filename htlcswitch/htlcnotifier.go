@@ -63,6 +63,10 @@ type HtlcNotifier struct {
 	// for timestamp mocking in tests.
 	now func() time.Time
 
+	// failureStats counts the forwards that failed at our node by
+	// reason.
+	failureStats *ForwardFailureStats
+
 	ntfnServer *subscribe.Server
 }
 
@@ -71,8 +75,9 @@ type HtlcNotifier struct {
 // and sends notifications to subscribing clients.
 func NewHtlcNotifier(now func() time.Time) *HtlcNotifier {
 	return &HtlcNotifier{
-		now:        now,
-		ntfnServer: subscribe.NewServer(),
+		now:          now,
+		failureStats: NewForwardFailureStats(now),
+		ntfnServer:   subscribe.NewServer(),
 	}
 }
 
@@ -104,6 +109,12 @@ func (h *HtlcNotifier) SubscribeHtlcEvents() (*subscribe.Client, error) {
 	return h.ntfnServer.Subscribe()
 }
 
+// ForwardFailureStats returns the counts of the forwards that failed at our
+// node by reason.
+func (h *HtlcNotifier) ForwardFailureStats() *ForwardFailureStats {
+	return h.failureStats
+}
+
 // HtlcKey uniquely identifies the htlc.
 type HtlcKey struct {
 	// IncomingCircuit is the channel an htlc id of the incoming htlc.
@@ -334,6 +345,10 @@ func (h *HtlcNotifier) NotifyLinkFailEvent(key HtlcKey, info HtlcInfo,
 	log.Tracef("Notifying link failure event: %v over %v, %v", eventType,
 		key, info)
 
+	if eventType == HtlcEventTypeForward {
+		h.failureStats.Record(classifyForwardFailure(linkErr))
+	}
+
 	if err := h.ntfnServer.SendUpdate(event); err != nil {
 		log.Warnf("Unable to send link fail event: %v", err)
 	}
@@ -137,6 +137,11 @@ type ChannelLinkConfig struct {
 	// BestHeight returns the best known height.
 	BestHeight func() uint32
 
+	// CheckMaxTotalInFlight returns a LinkError if the total value of the
+	// htlcs in flight through the switch exceeds its configured maximum.
+	// It is consulted right before an htlc is added to the channel.
+	CheckMaxTotalInFlight func(lnwire.ShortChannelID) *LinkError
+
 	// ForwardPackets attempts to forward the batch of htlcs through the
 	// switch. The function returns and error in case it fails to send one or
 	// more packets. The link's quit signal should be provided to allow
@@ -1316,6 +1321,29 @@ func (l *channelLink) handleDownstreamUpdateAdd(pkt *htlcPacket) error {
 		return nil
 	}
 
+	// If the link is in hodl.BogusOnion mode, we corrupt the HMAC at the
+	// end of the onion, so that the remote peer fails the HTLC back as
+	// malformed.
+	if l.cfg.HodlMask.Active(hodl.BogusOnion) {
+		l.log.Warnf(hodl.BogusOnion.Warning())
+		htlc.OnionBlob[lnwire.OnionPacketSize-1] ^= 0xff
+	}
+
+	// Before adding the htlc, we'll make sure that the total value of the
+	// htlcs in flight through the switch doesn't exceed its maximum. The
+	// circuit of this htlc has already been committed, so its value is
+	// part of the total. Performing the check here, right before the add,
+	// means that no other add over this link can happen in between.
+	linkErr := l.cfg.CheckMaxTotalInFlight(l.ShortChanID())
+	if linkErr != nil {
+		l.log.Debugf("Unable to handle downstream add HTLC: %v",
+			linkErr)
+
+		l.mailBox.FailAddWithDetail(pkt, linkErr.FailureDetail)
+
+		return linkErr
+	}
+
 	// A new payment has been initiated via the downstream channel,
 	// so we add the new HTLC to our local log, then update the
 	// commitment chains.
@@ -1339,6 +1339,67 @@ func TestChannelLinkMultiHopInsufficientPayment(t *testing.T) {
 	}
 }
 
+// TestChannelLinkMaxTotalInFlight asserts that the outgoing link rejects htlcs
+// that push the total value of htlcs in flight through its switch above the
+// configured maximum, both for local payments and for forwards.
+func TestChannelLinkMaxTotalInFlight(t *testing.T) {
+	t.Parallel()
+
+	channels, cleanUp, _, err := createClusterChannels(
+		btcutil.SatoshiPerBitcoin*3,
+		btcutil.SatoshiPerBitcoin*5)
+	require.NoError(t, err)
+	defer cleanUp()
+
+	n := newThreeHopNetwork(t, channels.aliceToBob, channels.bobToAlice,
+		channels.bobToCarol, channels.carolToBob, testStartingHeight)
+	require.NoError(t, n.start())
+	defer n.stop()
+
+	amount := lnwire.NewMSatFromSatoshis(btcutil.SatoshiPerBitcoin)
+	htlcAmt, totalTimelock, hops := generateHops(amount, testStartingHeight,
+		n.firstBobChannelLink, n.carolChannelLink)
+	firstHop := n.firstBobChannelLink.ShortChanID()
+
+	pay := func() error {
+		_, err := makePayment(
+			n.aliceServer, n.carolServer, firstHop, hops, amount,
+			htlcAmt, totalTimelock,
+		).Wait(30 * time.Second)
+
+		return err
+	}
+
+	// A local payment exceeding the maximum of Alice's switch should be
+	// rejected by her outgoing link with the corresponding detail.
+	n.aliceServer.htlcSwitch.SetMaxTotalInFlight(htlcAmt - 1)
+
+	err = pay()
+	linkErr, ok := err.(*LinkError)
+	require.True(t, ok, "expected link error, got: %v", err)
+	require.Equal(
+		t, OutgoingFailureMaxTotalInFlight, linkErr.FailureDetail,
+	)
+	assertFailureCode(t, err, lnwire.CodeTemporaryChannelFailure)
+
+	// A forward exceeding the maximum of Bob's switch should be rejected
+	// by his outgoing link and failed back to Alice.
+	n.aliceServer.htlcSwitch.SetMaxTotalInFlight(0)
+	n.bobServer.htlcSwitch.SetMaxTotalInFlight(amount - 1)
+
+	err = pay()
+	require.Error(t, err)
+	assertFailureCode(t, err, lnwire.CodeTemporaryChannelFailure)
+
+	// Once the maximum allows for it, the payment should succeed, which
+	// also asserts that the circuits of the rejected htlcs are no longer
+	// counted towards the total.
+	n.bobServer.htlcSwitch.SetMaxTotalInFlight(amount)
+	n.aliceServer.htlcSwitch.SetMaxTotalInFlight(htlcAmt)
+
+	require.NoError(t, pay())
+}
+
 // TestChannelLinkMultiHopUnknownPaymentHash checks that we receive remote error
 // from Alice if she received not suitable payment hash for htlc.
 func TestChannelLinkMultiHopUnknownPaymentHash(t *testing.T) {
@@ -1960,6 +2021,7 @@ func newSingleLinkTestHarness(chanAmt, chanReserve btcutil.Amount) (
 			return obfuscator, lnwire.CodeNone
 		},
 		FetchLastChannelUpdate: mockGetChanUpdateMessage,
+		CheckMaxTotalInFlight:  aliceSwitch.CheckMaxTotalInFlight,
 		PreimageCache:          pCache,
 		OnChannelFailure: func(lnwire.ChannelID,
 			lnwire.ShortChannelID, LinkFailureError) {
@@ -4464,6 +4526,7 @@ func (h *persistentLinkHarness) restartLink(
 			return obfuscator, lnwire.CodeNone
 		},
 		FetchLastChannelUpdate: mockGetChanUpdateMessage,
+		CheckMaxTotalInFlight:  aliceSwitch.CheckMaxTotalInFlight,
 		PreimageCache:          pCache,
 		OnChannelFailure: func(lnwire.ChannelID,
 			lnwire.ShortChannelID, LinkFailureError) {
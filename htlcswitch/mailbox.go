@@ -52,6 +52,11 @@ type MailBox interface {
 	// OutgoingFailureDownstreamHtlcAdd FailureDetail.
 	FailAdd(pkt *htlcPacket)
 
+	// FailAddWithDetail fails an UpdateAddHTLC that exists within the
+	// mailbox in the same way as FailAdd, but the generated LinkError will
+	// show the passed FailureDetail.
+	FailAddWithDetail(pkt *htlcPacket, detail FailureDetail)
+
 	// MessageOutBox returns a channel that any new messages ready for
 	// delivery will be sent on.
 	MessageOutBox() chan lnwire.Message
@@ -66,6 +71,11 @@ type MailBox interface {
 	// Reset the packet head to point at the first element in the list.
 	ResetPackets() error
 
+	// SetExpiry sets the interval after which Adds will be cancelled if
+	// they have not yet been delivered. Adds that are already queued are
+	// cancelled no later than this interval from now.
+	SetExpiry(expiry time.Duration)
+
 	// Start starts the mailbox and any goroutines it needs to operate
 	// properly.
 	Start()
@@ -128,6 +138,10 @@ type memoryMailBox struct {
 	pktOutbox chan *htlcPacket
 	pktReset  chan chan struct{}
 
+	// expiryUpdate signals the packet courier that the deadlines of the
+	// queued Adds have changed.
+	expiryUpdate chan struct{}
+
 	wireShutdown chan struct{}
 	pktShutdown  chan struct{}
 	quit         chan struct{}
@@ -144,6 +158,7 @@ func newMemoryMailBox(cfg *mailBoxConfig) *memoryMailBox {
 		pktOutbox:     make(chan *htlcPacket),
 		msgReset:      make(chan chan struct{}, 1),
 		pktReset:      make(chan chan struct{}, 1),
+		expiryUpdate:  make(chan struct{}, 1),
 		repIndex:      make(map[CircuitKey]*list.Element),
 		addIndex:      make(map[CircuitKey]*list.Element),
 		wireShutdown:  make(chan struct{}),
@@ -526,6 +541,10 @@ func (m *memoryMailBox) mailCourier(cType courierType) {
 			case <-deadline:
 				m.FailAdd(add)
 
+			// The deadline of the next Add may have changed, so we
+			// loop around to recompute it.
+			case <-m.expiryUpdate:
+
 			case pktDone := <-m.pktReset:
 				m.pktCond.L.Lock()
 				m.repHead = m.repPkts.Front()
@@ -610,12 +629,56 @@ func (m *memoryMailBox) AddPacket(pkt *htlcPacket) error {
 	return nil
 }
 
+// SetExpiry sets the interval after which Adds will be cancelled if they have
+// not yet been delivered. The deadline of Adds that are already queued is
+// brought forward if it would otherwise exceed the new interval, which keeps
+// the Adds ordered by their deadline.
+//
+// NOTE: This method is part of the MailBox interface.
+func (m *memoryMailBox) SetExpiry(expiry time.Duration) {
+	m.pktCond.L.Lock()
+	m.cfg.expiry = expiry
+
+	maxExpiry := m.cfg.clock.Now().Add(expiry)
+	for e := m.addPkts.Front(); e != nil; e = e.Next() {
+		// The courier may be reading the current entry without
+		// holding the lock, so we replace it instead of modifying it.
+		pkt := e.Value.(*pktWithExpiry)
+		if pkt.expiry.After(maxExpiry) {
+			e.Value = &pktWithExpiry{
+				pkt:    pkt.pkt,
+				expiry: maxExpiry,
+			}
+		}
+	}
+	m.pktCond.L.Unlock()
+
+	// Wake up the courier if it is waiting for the deadline of an Add, so
+	// that it picks up the new one. If a wake up is already pending, the
+	// courier will see the new deadline anyway.
+	select {
+	case m.expiryUpdate <- struct{}{}:
+	default:
+	}
+}
+
 // FailAdd fails an UpdateAddHTLC that exists within the mailbox, removing it
 // from the in-memory replay buffer. This will prevent the packet from being
 // delivered after the link restarts if the switch has remained online. The
 // generated LinkError will show an OutgoingFailureDownstreamHtlcAdd
 // FailureDetail.
 func (m *memoryMailBox) FailAdd(pkt *htlcPacket) {
+	m.FailAddWithDetail(pkt, OutgoingFailureDownstreamHtlcAdd)
+}
+
+// FailAddWithDetail fails an UpdateAddHTLC that exists within the mailbox in
+// the same way as FailAdd, but the generated LinkError will show the passed
+// FailureDetail.
+//
+// NOTE: This method is part of the MailBox interface.
+func (m *memoryMailBox) FailAddWithDetail(pkt *htlcPacket,
+	detail FailureDetail) {
+
 	// First, remove the packet from mailbox. If we didn't find the packet
 	// because it has already been acked, we'll exit early to avoid sending
 	// a duplicate fail message through the switch.
@@ -662,10 +725,8 @@ func (m *memoryMailBox) FailAdd(pkt *htlcPacket) {
 	}
 
 	// Create a link error containing the temporary channel failure and a
-	// detail which indicates the we failed to add the htlc.
-	linkError := NewDetailedLinkError(
-		failure, OutgoingFailureDownstreamHtlcAdd,
-	)
+	// detail which indicates why we failed to add the htlc.
+	linkError := NewDetailedLinkError(failure, detail)
 
 	failPkt := &htlcPacket{
 		incomingChanID: pkt.incomingChanID,
@@ -730,6 +791,11 @@ type mailOrchestrator struct {
 	// unclaimedPackets maps a live short chan id to queue of packets if no
 	// mailbox has been created.
 	unclaimedPackets map[lnwire.ShortChannelID][]*htlcPacket
+
+	// expiries maps a channel id to the interval after which Adds will be
+	// cancelled from its mailbox, for channels that don't use the default
+	// expiry of the orchestrator.
+	expiries map[lnwire.ChannelID]time.Duration
 }
 
 type mailOrchConfig struct {
@@ -758,6 +824,7 @@ func newMailOrchestrator(cfg *mailOrchConfig) *mailOrchestrator {
 		mailboxes:        make(map[lnwire.ChannelID]MailBox),
 		liveIndex:        make(map[lnwire.ShortChannelID]lnwire.ChannelID),
 		unclaimedPackets: make(map[lnwire.ShortChannelID][]*htlcPacket),
+		expiries:         make(map[lnwire.ChannelID]time.Duration),
 	}
 }
 
@@ -801,12 +868,17 @@ func (mo *mailOrchestrator) exclusiveGetOrCreateMailBox(
 
 	mailbox, ok := mo.mailboxes[chanID]
 	if !ok {
+		expiry, ok := mo.expiries[chanID]
+		if !ok {
+			expiry = mo.cfg.expiry
+		}
+
 		mailbox = newMemoryMailBox(&mailBoxConfig{
 			shortChanID:    shortChanID,
 			fetchUpdate:    mo.cfg.fetchUpdate,
 			forwardPackets: mo.cfg.forwardPackets,
 			clock:          mo.cfg.clock,
-			expiry:         mo.cfg.expiry,
+			expiry:         expiry,
 		})
 		mailbox.Start()
 		mo.mailboxes[chanID] = mailbox
@@ -815,6 +887,28 @@ func (mo *mailOrchestrator) exclusiveGetOrCreateMailBox(
 	return mailbox
 }
 
+// SetExpiry sets the interval after which Adds will be cancelled from the
+// mailbox of the given channel if they have not yet been delivered. The
+// interval is also used for the mailbox if it is only created later on. A zero
+// interval restores the default expiry of the orchestrator.
+func (mo *mailOrchestrator) SetExpiry(chanID lnwire.ChannelID,
+	expiry time.Duration) {
+
+	mo.mu.Lock()
+	defer mo.mu.Unlock()
+
+	if expiry == 0 {
+		delete(mo.expiries, chanID)
+		expiry = mo.cfg.expiry
+	} else {
+		mo.expiries[chanID] = expiry
+	}
+
+	if mailbox, ok := mo.mailboxes[chanID]; ok {
+		mailbox.SetExpiry(expiry)
+	}
+}
+
 // BindLiveShortChanID registers that messages bound for a particular short
 // channel id should be forwarded to the mailbox corresponding to the given
 // channel id. This method also checks to see if there are any unclaimed
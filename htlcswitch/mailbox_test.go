@@ -497,6 +497,49 @@ func TestMailBoxAddExpiry(t *testing.T) {
 	ctx.checkFails(secondBatch)
 }
 
+// TestMailBoxSetExpiry asserts that changing the expiry of a mailbox brings
+// forward the deadline of queued Adds that would otherwise exceed it, and that
+// later Adds use the new expiry.
+func TestMailBoxSetExpiry(t *testing.T) {
+	var (
+		expiry           = time.Minute
+		shortExpiry      = 10 * time.Second
+		firstBatchStart  = time.Now()
+		firstBatchExpiry = firstBatchStart.Add(shortExpiry)
+		secondBatchStart = firstBatchExpiry
+		thirdBatchStart  = secondBatchStart.Add(time.Second)
+		thirdBatchExpiry = thirdBatchStart.Add(shortExpiry)
+	)
+
+	ctx := newMailboxContext(t, firstBatchStart, expiry)
+	defer ctx.mailbox.Stop()
+
+	const numBatchPackets = 5
+
+	// Shortening the expiry must apply to the Adds that are already
+	// queued.
+	firstBatch := ctx.sendAdds(0, numBatchPackets)
+	ctx.mailbox.SetExpiry(shortExpiry)
+
+	ctx.clock.SetTime(firstBatchExpiry)
+	ctx.checkFails(firstBatch)
+
+	// Extending the expiry again must not extend the deadline of queued
+	// Adds, while new Adds use the extended expiry.
+	ctx.mailbox.SetExpiry(expiry)
+	secondBatch := ctx.sendAdds(numBatchPackets, numBatchPackets)
+
+	ctx.clock.SetTime(thirdBatchStart)
+	ctx.mailbox.SetExpiry(shortExpiry)
+	thirdBatch := ctx.sendAdds(2*numBatchPackets, numBatchPackets)
+
+	// The second batch was queued with the long expiry, but is now
+	// capped at the short one from the time of the last change, at which
+	// point the third batch expires as well.
+	ctx.clock.SetTime(thirdBatchExpiry)
+	ctx.checkFails(append(secondBatch, thirdBatch...))
+}
+
 // TestMailBoxDuplicateAddPacket asserts that the mailbox returns an
 // ErrPacketAlreadyExists failure when two htlcPackets are added with identical
 // incoming circuit keys.
@@ -712,6 +712,13 @@ func (f *mockChannelLink) handleSwitchPacket(pkt *htlcPacket) error {
 }
 
 func (f *mockChannelLink) handleLocalAddPacket(pkt *htlcPacket) error {
+	// Like the real link, we check the total value in flight through the
+	// switch right before the add.
+	linkErr := f.htlcSwitch.CheckMaxTotalInFlight(f.shortChanID)
+	if linkErr != nil {
+		return linkErr
+	}
+
 	_ = f.mailBox.AddPacket(pkt)
 	return nil
 }
@@ -917,6 +924,10 @@ func (m *mockCircuitMap) FailCircuit(inKey CircuitKey) (*PaymentCircuit,
 	return nil, nil
 }
 
+func (m *mockCircuitMap) IsClosing(inKey CircuitKey) bool {
+	return false
+}
+
 func (m *mockCircuitMap) LookupCircuit(inKey CircuitKey) *PaymentCircuit {
 	return <-m.lookup
 }
@@ -933,10 +944,18 @@ func (m *mockCircuitMap) NumPending() int {
 	return 0
 }
 
+func (m *mockCircuitMap) InFlightAmount() lnwire.MilliSatoshi {
+	return 0
+}
+
 func (m *mockCircuitMap) NumOpen() int {
 	return 0
 }
 
+func (m *mockCircuitMap) NumKeystones() (int, error) {
+	return 0, nil
+}
+
 type mockOnionErrorDecryptor struct {
 	sourceIdx int
 	message   []byte
@@ -184,6 +184,13 @@ type Config struct {
 	// HTLCs that are not from the source hop.
 	RejectHTLC bool
 
+	// MaxTotalInFlight is the maximum total value of htlcs that may be in
+	// flight through the switch. New outgoing and forwarded htlcs are
+	// rejected while the total exceeds this value. A value of zero
+	// disables the limit. It can be changed at runtime through
+	// SetMaxTotalInFlight.
+	MaxTotalInFlight lnwire.MilliSatoshi
+
 	// Clock is a time source for the switch.
 	Clock clock.Clock
 
@@ -211,6 +218,10 @@ type Switch struct {
 	// This will be retrieved by the registered links atomically.
 	bestHeight uint32
 
+	// maxTotalInFlight is the current maximum total value in msat of htlcs
+	// in flight through the switch, or zero if there is no maximum.
+	maxTotalInFlight uint64 // To be used atomically.
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 
@@ -303,6 +314,7 @@ func New(cfg Config, currentHeight uint32) (*Switch, error) {
 
 	s := &Switch{
 		bestHeight:        currentHeight,
+		maxTotalInFlight:  uint64(cfg.MaxTotalInFlight),
 		cfg:               &cfg,
 		circuits:          circuitMap,
 		linkIndex:         make(map[lnwire.ChannelID]ChannelLink),
@@ -467,6 +479,7 @@ func (s *Switch) SendHTLC(firstHop lnwire.ShortChannelID, attemptID uint64,
 		incomingHTLCID: attemptID,
 		outgoingChanID: firstHop,
 		htlc:           htlc,
+		amount:         htlc.Amount,
 	}
 
 	circuit := newPaymentCircuit(&htlc.PaymentHash, packet)
@@ -509,7 +522,45 @@ func (s *Switch) SendHTLC(firstHop lnwire.ShortChannelID, attemptID uint64,
 		return linkErr
 	}
 
-	return link.handleLocalAddPacket(packet)
+	// If the link refuses to add the htlc to its channel, the circuit will
+	// never be opened. We remove it right away so that it no longer counts
+	// towards the value of the htlcs in flight.
+	err = link.handleLocalAddPacket(packet)
+	if linkErr, ok := err.(*LinkError); ok {
+		inKey := packet.inKey()
+		if err := s.circuits.DeleteCircuits(inKey); err != nil {
+			log.Errorf("Unable to delete circuit for refused "+
+				"htlc %v: %v", inKey, err)
+		}
+
+		return linkErr
+	}
+
+	return err
+}
+
+// MaxTotalInFlight returns the maximum total value of htlcs that may be in
+// flight through the switch. Zero means that there is no maximum.
+func (s *Switch) MaxTotalInFlight() lnwire.MilliSatoshi {
+	return lnwire.MilliSatoshi(atomic.LoadUint64(&s.maxTotalInFlight))
+}
+
+// SetMaxTotalInFlight sets the maximum total value of htlcs that may be in
+// flight through the switch and returns the previous maximum. Zero removes the
+// maximum. Htlcs that are already in flight are not affected, but new htlcs
+// are rejected for as long as the total exceeds the new maximum.
+func (s *Switch) SetMaxTotalInFlight(
+	maxInFlight lnwire.MilliSatoshi) lnwire.MilliSatoshi {
+
+	prev := atomic.SwapUint64(&s.maxTotalInFlight, uint64(maxInFlight))
+
+	return lnwire.MilliSatoshi(prev)
+}
+
+// TotalInFlight returns the total value of the htlcs that are currently in
+// flight through the switch.
+func (s *Switch) TotalInFlight() lnwire.MilliSatoshi {
+	return s.circuits.InFlightAmount()
 }
 
 // UpdateForwardingPolicies sends a message to the switch to update the
@@ -1206,6 +1257,42 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 	}
 }
 
+// CheckMaxTotalInFlight returns a link error if the total value of htlcs in
+// flight through the switch exceeds the configured maximum. It is called by
+// the outgoing link right before it adds an htlc to its channel, at which
+// point the circuit of the htlc has already been committed. As every htlc is
+// part of the total before it is checked, concurrent adds over different links
+// can't exceed the maximum together.
+func (s *Switch) CheckMaxTotalInFlight(
+	outgoing lnwire.ShortChannelID) *LinkError {
+
+	maxInFlight := s.MaxTotalInFlight()
+	if maxInFlight == 0 {
+		return nil
+	}
+
+	inFlight := s.circuits.InFlightAmount()
+	if inFlight <= maxInFlight {
+		return nil
+	}
+
+	log.Debugf("Rejecting htlc over %v: total in flight %v exceeds "+
+		"maximum of %v", outgoing, inFlight, maxInFlight)
+
+	// The limit is only temporary, so we include the latest update of the
+	// outgoing channel, if we have one, to signal that the channel itself
+	// is fine.
+	update, err := s.cfg.FetchLastChannelUpdate(outgoing)
+	if err != nil {
+		update = nil
+	}
+
+	return NewDetailedLinkError(
+		lnwire.NewTemporaryChannelFailure(update),
+		OutgoingFailureMaxTotalInFlight,
+	)
+}
+
 // checkCircularForward checks whether a forward is circular (arrives and
 // departs on the same link) and returns a link error if the switch is
 // configured to disallow this behaviour.
@@ -2054,6 +2141,53 @@ func (s *Switch) getLink(chanID lnwire.ChannelID) (ChannelLink, error) {
 	return link, nil
 }
 
+// SetMaxHtlcHold sets the maximum duration that an Add forwarded over the
+// given channel may wait in the channel's mailbox before it is failed back
+// with a temporary channel failure. Only Adds that haven't been delivered to
+// the channel's link yet are affected. Once the link has accepted an Add, it
+// may be locked in on the channel, and failing it back before the remote party
+// has removed it would risk a loss of funds. A zero duration restores the
+// switch's default HTLCExpiry.
+func (s *Switch) SetMaxHtlcHold(chanID lnwire.ChannelID, hold time.Duration) {
+	log.Debugf("Setting max htlc hold of ChannelID(%v) to %v", chanID,
+		hold)
+
+	s.mailOrchestrator.SetExpiry(chanID, hold)
+}
+
+// ValidateMaxHtlcHold checks that a maximum htlc hold duration is safely below
+// the expiry of the incoming HTLCs it applies to. An incoming HTLC expires at
+// least timeLockDelta blocks after the HTLC forwarded over the channel, and is
+// resolved on chain once it is within broadcastDelta blocks of its expiry.
+// Assuming the given block interval, the hold duration must pass before that
+// happens, so that the HTLC is failed back off-chain instead.
+func ValidateMaxHtlcHold(hold, blockInterval time.Duration, timeLockDelta,
+	broadcastDelta uint32) error {
+
+	if hold <= 0 {
+		return fmt.Errorf("max htlc hold must be positive")
+	}
+
+	if timeLockDelta <= broadcastDelta {
+		return fmt.Errorf("time lock delta of %v blocks leaves no "+
+			"time to hold htlcs before they are resolved on "+
+			"chain %v blocks before expiry", timeLockDelta,
+			broadcastDelta)
+	}
+
+	// We round up, as a partial block may be mined at any time.
+	maxBlocks := timeLockDelta - broadcastDelta
+	holdBlocks := uint64((hold + blockInterval - 1) / blockInterval)
+	if holdBlocks >= uint64(maxBlocks) {
+		return fmt.Errorf("max htlc hold of %v spans %d blocks, must "+
+			"be less than %d blocks to fail htlcs back before "+
+			"they are resolved on chain", hold, holdBlocks,
+			maxBlocks)
+	}
+
+	return nil
+}
+
 // getLinkByShortID attempts to return the link which possesses the target
 // short channel ID.
 //
@@ -2227,6 +2361,105 @@ func (s *Switch) CircuitLookup() CircuitLookup {
 	return s.circuits
 }
 
+// CircuitStats summarizes the contents of the switch's circuit map.
+type CircuitStats struct {
+	// NumPending is the number of circuits that have been committed but
+	// not yet forwarded via an outgoing link.
+	NumPending int
+
+	// NumOpen is the number of circuits that have been forwarded via an
+	// outgoing link and are waiting for a settle or fail.
+	NumOpen int
+
+	// NumKeystones is the number of keystones persisted on disk.
+	NumKeystones int
+}
+
+// CircuitStats returns the number of circuits and keystones currently held by
+// the switch.
+func (s *Switch) CircuitStats() (*CircuitStats, error) {
+	numKeystones, err := s.circuits.NumKeystones()
+	if err != nil {
+		return nil, err
+	}
+
+	// The pending circuits include those that have been opened already.
+	numOpen := s.circuits.NumOpen()
+
+	return &CircuitStats{
+		NumPending:   s.circuits.NumPending() - numOpen,
+		NumOpen:      numOpen,
+		NumKeystones: numKeystones,
+	}, nil
+}
+
+// CircuitState describes how far an HTLC has progressed through its circuit.
+type CircuitState uint8
+
+const (
+	// CircuitNotTracked indicates that no circuit is known for the HTLC,
+	// either because it was never forwarded or because its circuit has
+	// already been resolved and removed.
+	CircuitNotTracked CircuitState = iota
+
+	// CircuitPending indicates that the circuit has been committed, but
+	// the HTLC hasn't been added to the outgoing channel yet.
+	CircuitPending
+
+	// CircuitOpen indicates that the HTLC has been added to the outgoing
+	// channel and is waiting for a settle or fail from downstream.
+	CircuitOpen
+
+	// CircuitClosing indicates that a settle or fail has been received,
+	// which is waiting to be committed on the incoming channel.
+	CircuitClosing
+)
+
+// String returns a human readable representation of the circuit state.
+func (c CircuitState) String() string {
+	switch c {
+	case CircuitNotTracked:
+		return "NotTracked"
+	case CircuitPending:
+		return "Pending"
+	case CircuitOpen:
+		return "Open"
+	case CircuitClosing:
+		return "Closing"
+	default:
+		return fmt.Sprintf("CircuitState(%d)", c)
+	}
+}
+
+// LookupCircuit returns the circuit that contains the HTLC identified by the
+// given circuit key, together with its state. The outgoing flag determines
+// whether the key identifies the incoming or the outgoing HTLC of the circuit.
+// If no circuit is found, nil and CircuitNotTracked are returned.
+func (s *Switch) LookupCircuit(key CircuitKey, outgoing bool) (*PaymentCircuit,
+	CircuitState) {
+
+	var circuit *PaymentCircuit
+	if outgoing {
+		circuit = s.circuits.LookupOpenCircuit(key)
+	} else {
+		circuit = s.circuits.LookupCircuit(key)
+	}
+	if circuit == nil {
+		return nil, CircuitNotTracked
+	}
+
+	switch {
+	case s.circuits.IsClosing(circuit.Incoming):
+		return circuit, CircuitClosing
+
+	case circuit.HasKeystone():
+		return circuit, CircuitOpen
+
+	default:
+		return circuit, CircuitPending
+	}
+}
+
 // commitCircuits persistently adds a circuit to the switch's circuit map.
 func (s *Switch) commitCircuits(circuits ...*PaymentCircuit) (
 	*CircuitFwdActions, error) {
@@ -1,6 +1,7 @@
 package htlcswitch
 
 import (
+	"bytes"
 	"crypto/rand"
 	"crypto/sha256"
 	"fmt"
@@ -2750,6 +2751,17 @@ func TestInvalidFailure(t *testing.T) {
 			t.Fatal("expected empty failure message")
 		}
 
+		// The undecodable failure must still be available as it was
+		// received.
+		if !bytes.Equal(source.RawMessage, []byte{200}) {
+			t.Fatalf("unexpected raw failure message: %x",
+				source.RawMessage)
+		}
+		if !bytes.Equal(source.EncryptedReason, []byte{1, 2, 3}) {
+			t.Fatalf("unexpected encrypted failure: %x",
+				source.EncryptedReason)
+		}
+
 	case <-time.After(time.Second):
 		t.Fatal("err wasn't received")
 	}
@@ -3318,3 +3330,180 @@ func TestSwitchHoldForward(t *testing.T) {
 	assertOutgoingLinkReceive(t, aliceChannelLink, true)
 	assertNumCircuits(t, s, 0, 0)
 }
+
+// TestSwitchSendHTLCMaxTotalInFlight asserts that the circuit of a local htlc
+// that the outgoing link refuses because of the maximum total value in flight
+// is removed from the circuit map, also while the same htlc is sent
+// concurrently, and that the htlc can be retried once the maximum allows it.
+func TestSwitchSendHTLCMaxTotalInFlight(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(
+		t, "alice", testStartingHeight, nil, testDefaultDelta,
+	)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, _, aliceChanID, _ := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add link: %v", err)
+	}
+
+	preimage, err := genPreimage()
+	if err != nil {
+		t.Fatalf("unable to generate preimage: %v", err)
+	}
+	rhash := sha256.Sum256(preimage[:])
+
+	const (
+		amt       = lnwire.MilliSatoshi(1000)
+		paymentID = uint64(123)
+	)
+	newAdd := func() *lnwire.UpdateAddHTLC {
+		return &lnwire.UpdateAddHTLC{
+			PaymentHash: rhash,
+			Amount:      amt,
+		}
+	}
+
+	s.SetMaxTotalInFlight(amt - 1)
+
+	// Send the same htlc concurrently. Every attempt must either be
+	// refused by the link, or be dropped as a duplicate of an attempt
+	// whose circuit hasn't been removed yet.
+	const numSends = 10
+	errChan := make(chan error, numSends)
+	for i := 0; i < numSends; i++ {
+		go func() {
+			errChan <- s.SendHTLC(
+				aliceChannelLink.ShortChanID(), paymentID,
+				newAdd(),
+			)
+		}()
+	}
+
+	var numRefused int
+	for i := 0; i < numSends; i++ {
+		err := <-errChan
+		if err == ErrDuplicateAdd {
+			continue
+		}
+
+		linkErr, ok := err.(*LinkError)
+		if !ok {
+			t.Fatalf("expected link error, got: %v", err)
+		}
+		if linkErr.FailureDetail != OutgoingFailureMaxTotalInFlight {
+			t.Fatalf("expected failure detail %v, got %v",
+				OutgoingFailureMaxTotalInFlight,
+				linkErr.FailureDetail)
+		}
+		numRefused++
+	}
+	if numRefused == 0 {
+		t.Fatalf("expected at least one refused htlc")
+	}
+
+	// None of the refused htlcs may leave its circuit behind.
+	assertNumCircuits(t, s, 0, 0)
+	if inFlight := s.TotalInFlight(); inFlight != 0 {
+		t.Fatalf("expected nothing in flight, got %v", inFlight)
+	}
+
+	// Once the maximum allows for it, the same htlc can be retried.
+	s.SetMaxTotalInFlight(amt)
+	err = s.SendHTLC(aliceChannelLink.ShortChanID(), paymentID, newAdd())
+	if err != nil {
+		t.Fatalf("unable to retry htlc: %v", err)
+	}
+
+	select {
+	case packet := <-aliceChannelLink.packets:
+		if err := aliceChannelLink.completeCircuit(packet); err != nil {
+			t.Fatalf("unable to complete payment circuit: %v", err)
+		}
+
+	case <-time.After(time.Second):
+		t.Fatal("htlc wasn't forwarded to the link")
+	}
+	assertNumCircuits(t, s, 1, 1)
+}
+
+// TestValidateMaxHtlcHold tests that only max htlc hold durations that pass
+// before an incoming htlc is resolved on chain are accepted.
+func TestValidateMaxHtlcHold(t *testing.T) {
+	t.Parallel()
+
+	const blockInterval = 10 * time.Minute
+
+	tests := []struct {
+		name           string
+		hold           time.Duration
+		timeLockDelta  uint32
+		broadcastDelta uint32
+		valid          bool
+	}{
+		{
+			name:           "zero hold",
+			hold:           0,
+			timeLockDelta:  40,
+			broadcastDelta: 10,
+			valid:          false,
+		},
+		{
+			name:           "hold below delta",
+			hold:           time.Hour,
+			timeLockDelta:  40,
+			broadcastDelta: 10,
+			valid:          true,
+		},
+		{
+			name:           "hold spans all blocks",
+			hold:           30 * blockInterval,
+			timeLockDelta:  40,
+			broadcastDelta: 10,
+			valid:          false,
+		},
+		{
+			name:           "partial block rounds up",
+			hold:           29*blockInterval + time.Second,
+			timeLockDelta:  40,
+			broadcastDelta: 10,
+			valid:          false,
+		},
+		{
+			name:           "delta within broadcast delta",
+			hold:           time.Second,
+			timeLockDelta:  10,
+			broadcastDelta: 10,
+			valid:          false,
+		},
+	}
+
+	for _, test := range tests {
+		err := ValidateMaxHtlcHold(
+			test.hold, blockInterval, test.timeLockDelta,
+			test.broadcastDelta,
+		)
+		if test.valid && err != nil {
+			t.Fatalf("%v: unexpected error: %v", test.name, err)
+		}
+		if !test.valid && err == nil {
+			t.Fatalf("%v: expected error", test.name)
+		}
+	}
+}
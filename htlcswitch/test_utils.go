@@ -1130,6 +1130,7 @@ func (h *hopNetwork) createChannelLink(server, peer *mockServer,
 				return h.obfuscator, lnwire.CodeNone
 			},
 			FetchLastChannelUpdate: mockGetChanUpdateMessage,
+			CheckMaxTotalInFlight:  server.htlcSwitch.CheckMaxTotalInFlight,
 			Registry:               server.registry,
 			FeeEstimator:           h.feeEstimator,
 			PreimageCache:          server.pCache,
@@ -88,8 +88,9 @@ type InvoiceExpiryWatcher struct {
 	// currentHash is the block hash for our current height.
 	currentHash *chainhash.Hash
 
-	// cancelInvoice is a template method that cancels an expired invoice.
-	cancelInvoice func(lntypes.Hash, bool) error
+	// cancelInvoice is a template method that cancels an expired invoice
+	// and records the passed reason for the cancellation.
+	cancelInvoice func(lntypes.Hash, bool, channeldb.CancelReason) error
 
 	// timestampExpiryQueue holds invoiceExpiry items and is used to find
 	// the next invoice to expire.
@@ -134,8 +135,8 @@ func NewInvoiceExpiryWatcher(clock clock.Clock,
 // return with error if InvoiceExpiryWatcher is already started. Start()
 // expects a cancellation function passed that will be use to cancel expired
 // invoices by their payment hash.
-func (ew *InvoiceExpiryWatcher) Start(
-	cancelInvoice func(lntypes.Hash, bool) error) error {
+func (ew *InvoiceExpiryWatcher) Start(cancelInvoice func(lntypes.Hash, bool,
+	channeldb.CancelReason) error) error {
 
 	ew.Lock()
 	defer ew.Unlock()
@@ -318,7 +319,10 @@ func (ew *InvoiceExpiryWatcher) cancelNextExpiredInvoice() {
 		// field would never be used. Enabling cancellation for accepted
 		// keysend invoices creates a safety mechanism that can prevents
 		// channel force-closes.
-		ew.expireInvoice(top.PaymentHash, top.Keysend)
+		ew.expireInvoice(
+			top.PaymentHash, top.Keysend,
+			channeldb.CancelReasonExpired,
+		)
 		ew.timestampExpiryQueue.Pop()
 	}
 }
@@ -338,14 +342,18 @@ func (ew *InvoiceExpiryWatcher) cancelNextHeightExpiredInvoice() {
 	// We always force-cancel block-based expiry so that we can
 	// cancel invoices that have been accepted but not yet resolved.
 	// This helps us avoid force closes.
-	ew.expireInvoice(top.paymentHash, true)
+	ew.expireInvoice(
+		top.paymentHash, true, channeldb.CancelReasonAutoCanceled,
+	)
 	ew.blockExpiryQueue.Pop()
 }
 
 // expireInvoice attempts to expire an invoice and logs an error if we get an
 // unexpected error.
-func (ew *InvoiceExpiryWatcher) expireInvoice(hash lntypes.Hash, force bool) {
-	err := ew.cancelInvoice(hash, force)
+func (ew *InvoiceExpiryWatcher) expireInvoice(hash lntypes.Hash, force bool,
+	reason channeldb.CancelReason) {
+
+	err := ew.cancelInvoice(hash, force, reason)
 	switch err {
 	case nil:
 
@@ -63,7 +63,7 @@ func newInvoiceExpiryWatcherTest(t *testing.T, now time.Time,
 	test.wg.Add(numExpiredInvoices)
 
 	err := test.watcher.Start(func(paymentHash lntypes.Hash,
-		force bool) error {
+		force bool, _ channeldb.CancelReason) error {
 
 		test.canceledInvoices = append(
 			test.canceledInvoices, paymentHash,
@@ -117,7 +117,7 @@ func TestInvoiceExpiryWatcherStartStop(t *testing.T) {
 		clock.NewTestClock(testTime), 0, uint32(testCurrentHeight), nil,
 		newMockNotifier(),
 	)
-	cancel := func(lntypes.Hash, bool) error {
+	cancel := func(lntypes.Hash, bool, channeldb.CancelReason) error {
 		t.Fatalf("unexpected call")
 		return nil
 	}
@@ -72,6 +72,10 @@ type RegistryConfig struct {
 	// KeysendHoldTime indicates for how long we want to accept and hold
 	// spontaneous keysend payments.
 	KeysendHoldTime time.Duration
+
+	// DefaultInvoiceExpiry is the expiry of new invoices that don't
+	// specify one. It can be updated at runtime.
+	DefaultInvoiceExpiry time.Duration
 }
 
 // htlcReleaseEvent describes an htlc auto-release event. It is used to release
@@ -106,6 +110,11 @@ type InvoiceRegistry struct {
 	// cfg contains the registry's configuration parameters.
 	cfg *RegistryConfig
 
+	// defaultExpiry is the expiry of new invoices that don't specify one,
+	// initialized from the config and updated at runtime.
+	defaultExpiry    time.Duration
+	defaultExpiryMtx sync.RWMutex
+
 	clientMtx                 sync.Mutex
 	nextClientID              uint32
 	notificationClients       map[uint32]*InvoiceSubscription
@@ -153,6 +162,7 @@ func NewRegistry(cdb *channeldb.DB, expiryWatcher *InvoiceExpiryWatcher,
 		hodlSubscriptions:         make(map[channeldb.CircuitKey]map[chan<- interface{}]struct{}),
 		hodlReverseSubscriptions:  make(map[chan<- interface{}]map[channeldb.CircuitKey]struct{}),
 		cfg:                       cfg,
+		defaultExpiry:             cfg.DefaultInvoiceExpiry,
 		htlcAutoReleaseChan:       make(chan *htlcReleaseEvent),
 		expiryWatcher:             expiryWatcher,
 		quit:                      make(chan struct{}),
@@ -591,6 +601,30 @@ func (i *InvoiceRegistry) AddInvoice(invoice *channeldb.Invoice,
 	return addIndex, nil
 }
 
+// DefaultInvoiceExpiry returns the expiry of new invoices that don't specify
+// one.
+func (i *InvoiceRegistry) DefaultInvoiceExpiry() time.Duration {
+	i.defaultExpiryMtx.RLock()
+	defer i.defaultExpiryMtx.RUnlock()
+
+	return i.defaultExpiry
+}
+
+// SetDefaultInvoiceExpiry updates the expiry of new invoices that don't
+// specify one and returns the previous value. Existing invoices aren't
+// affected.
+func (i *InvoiceRegistry) SetDefaultInvoiceExpiry(
+	expiry time.Duration) time.Duration {
+
+	i.defaultExpiryMtx.Lock()
+	defer i.defaultExpiryMtx.Unlock()
+
+	prevExpiry := i.defaultExpiry
+	i.defaultExpiry = expiry
+
+	return prevExpiry
+}
+
 // LookupInvoice looks up an invoice by its payment hash (R-Hash), if found
 // then we're able to pull the funds pending within an HTLC.
 //
@@ -604,6 +638,14 @@ func (i *InvoiceRegistry) LookupInvoice(rHash lntypes.Hash) (channeldb.Invoice,
 	return i.cdb.LookupInvoice(ref)
 }
 
+// LookupInvoiceByRef looks up an invoice by the given reference, which allows
+// callers to look up AMP invoices by their set id.
+func (i *InvoiceRegistry) LookupInvoiceByRef(
+	ref channeldb.InvoiceRef) (channeldb.Invoice, error) {
+
+	return i.cdb.LookupInvoice(ref)
+}
+
 // startHtlcTimer starts a new timer via the invoice registry main loop that
 // cancels a single htlc on an invoice when the htlc hold duration has passed.
 func (i *InvoiceRegistry) startHtlcTimer(invoiceRef channeldb.InvoiceRef,
@@ -1207,7 +1249,9 @@ func (i *InvoiceRegistry) SettleHodlInvoice(preimage lntypes.Preimage) error {
 // CancelInvoice attempts to cancel the invoice corresponding to the passed
 // payment hash.
 func (i *InvoiceRegistry) CancelInvoice(payHash lntypes.Hash) error {
-	return i.cancelInvoiceImpl(payHash, true)
+	return i.cancelInvoiceImpl(
+		payHash, true, channeldb.CancelReasonUserCanceled,
+	)
 }
 
 // shouldCancel examines the state of an invoice and whether we want to
@@ -1226,10 +1270,11 @@ func shouldCancel(state channeldb.ContractState, cancelAccepted bool) bool {
 
 // cancelInvoice attempts to cancel the invoice corresponding to the passed
 // payment hash. Accepted invoices will only be canceled if explicitly
-// requested to do so. It notifies subscribing links and resolvers that
-// the associated htlcs were canceled if they change state.
+// requested to do so. The passed reason is recorded with the invoice if it
+// is canceled. It notifies subscribing links and resolvers that the
+// associated htlcs were canceled if they change state.
 func (i *InvoiceRegistry) cancelInvoiceImpl(payHash lntypes.Hash,
-	cancelAccepted bool) error {
+	cancelAccepted bool, reason channeldb.CancelReason) error {
 
 	i.Lock()
 	defer i.Unlock()
@@ -1249,7 +1294,8 @@ func (i *InvoiceRegistry) cancelInvoiceImpl(payHash lntypes.Hash,
 		// settled or canceled.
 		return &channeldb.InvoiceUpdateDesc{
 			State: &channeldb.InvoiceStateUpdateDesc{
-				NewState: channeldb.ContractCanceled,
+				NewState:     channeldb.ContractCanceled,
+				CancelReason: reason,
 			},
 		}, nil
 	}
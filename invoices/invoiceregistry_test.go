@@ -269,6 +269,10 @@ func testCancelInvoice(t *testing.T, gc bool) {
 				update.State,
 			)
 		}
+		require.Equal(
+			t, channeldb.CancelReasonUserCanceled,
+			update.CancelReason,
+		)
 	case <-time.After(testTimeout):
 		t.Fatal("no update received")
 	}
@@ -1039,6 +1043,12 @@ func TestInvoiceExpiryWithRegistry(t *testing.T) {
 		if invoice.State != channeldb.ContractCanceled {
 			t.Fatalf("expected canceled invoice, got: %v", invoice.State)
 		}
+
+		if invoice.CancelReason != channeldb.CancelReasonExpired {
+			t.Fatalf("expected cancel reason %v, got: %v",
+				channeldb.CancelReasonExpired,
+				invoice.CancelReason)
+		}
 	}
 }
 
@@ -1242,6 +1252,14 @@ func testHeightExpiryWithRegistry(t *testing.T, numParts int, settle bool) {
 	require.NoError(t, err)
 	require.Equal(t, expectedState, inv.State, "expected "+
 		"hold invoice: %v, got: %v", expectedState, inv.State)
+
+	// An invoice that is canceled because its htlcs are about to expire
+	// is recorded as auto-canceled.
+	if !settle {
+		require.Equal(
+			t, channeldb.CancelReasonAutoCanceled, inv.CancelReason,
+		)
+	}
 }
 
 // TestMultipleSetHeightExpiry pays a hold invoice with two mpp sets, testing
@@ -1759,4 +1777,18 @@ func testSpontaneousAmpPayment(
 			checkSettleResolution(t, resolution, preimage)
 		}
 	}
+
+	// A failed reconstruction should be recorded as the reason for
+	// canceling the invoice.
+	if failReconstruction {
+		inv, err := ctx.cdb.LookupInvoice(
+			channeldb.InvoiceRefByAddr(payAddr),
+		)
+		require.NoError(t, err)
+		require.Equal(t, channeldb.ContractCanceled, inv.State)
+		require.Equal(
+			t, channeldb.CancelReasonAMPReconstructionFailed,
+			inv.CancelReason,
+		)
+	}
 }
@@ -252,9 +252,11 @@ func updateMpp(ctx *invoiceUpdateCtx,
 		var failRes *HtlcFailResolution
 		htlcPreimages, failRes = reconstructAMPPreimages(ctx, htlcSet)
 		if failRes != nil {
+			cancelReason := channeldb.CancelReasonAMPReconstructionFailed
 			update.State = &channeldb.InvoiceStateUpdateDesc{
-				NewState: channeldb.ContractCanceled,
-				SetID:    setID,
+				NewState:     channeldb.ContractCanceled,
+				CancelReason: cancelReason,
+				SetID:        setID,
 			}
 			return &update, failRes, nil
 		}
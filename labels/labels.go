@@ -16,6 +16,7 @@ package labels
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/btcsuite/btcwallet/wtxmgr"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -90,3 +91,28 @@ func MakeLabel(labelType LabelType, channelID *lnwire.ShortChannelID) string {
 	return fmt.Sprintf("%v:%v:%v-%v", LabelVersionZero, labelType,
 		ShortChanID, channelID.ToUint64())
 }
+
+// ParseType returns the label type of a label created by MakeLabel. False is
+// returned for labels that weren't created by MakeLabel, such as labels
+// provided by the user.
+func ParseType(label string) (LabelType, bool) {
+	parts := strings.Split(label, ":")
+	if len(parts) < 2 {
+		return "", false
+	}
+
+	if parts[0] != fmt.Sprintf("%v", LabelVersionZero) {
+		return "", false
+	}
+
+	labelType := LabelType(parts[1])
+	switch labelType {
+	case LabelTypeChannelOpen, LabelTypeChannelClose,
+		LabelTypeJusticeTransaction, LabelTypeSweepTransaction:
+
+		return labelType, true
+
+	default:
+		return "", false
+	}
+}
@@ -29,16 +29,22 @@ type Chain struct {
 	BaseFee             lnwire.MilliSatoshi `long:"basefee" description:"The base fee in millisatoshi we will charge for forwarding payments on our channels"`
 	FeeRate             lnwire.MilliSatoshi `long:"feerate" description:"The fee rate used when forwarding payments on our channels. The total fee charged is basefee + (amount * feerate / 1000000), where amount is the forwarded amount."`
 	TimeLockDelta       uint32              `long:"timelockdelta" description:"The CLTV delta we will subtract from a forwarded HTLC's timelock value"`
+	MinTimeLockDelta    uint32              `long:"mintimelockdelta" description:"The smallest CLTV delta that policy updates are allowed to advertise for our channels. Must be at least the protocol minimum and at most timelockdelta"`
 	DNSSeeds            []string            `long:"dnsseed" description:"The seed DNS server(s) to use for initial peer discovery. Must be specified as a '<primary_dns>[,<soa_primary_dns>]' tuple where the SOA address is needed for DNS resolution through Tor but is optional for clearnet users. Multiple tuples can be specified, will overwrite the default seed servers."`
 }
 
 // Validate performs validation on our chain config.
 func (c *Chain) Validate(minTimeLockDelta uint32, minDelay uint16) error {
-	if c.TimeLockDelta < minTimeLockDelta {
-		return fmt.Errorf("timelockdelta must be at least %v",
+	if c.MinTimeLockDelta < minTimeLockDelta {
+		return fmt.Errorf("mintimelockdelta must be at least %v",
 			minTimeLockDelta)
 	}
 
+	if c.TimeLockDelta < c.MinTimeLockDelta {
+		return fmt.Errorf("timelockdelta must be at least %v",
+			c.MinTimeLockDelta)
+	}
+
 	// Check that our max local delay isn't set below some reasonable
 	// minimum value. We do this to prevent setting an unreasonably low
 	// delay, which would mean that the node would accept no channels.
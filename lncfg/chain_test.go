@@ -0,0 +1,82 @@
+package lncfg_test
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lncfg"
+)
+
+const (
+	testMinTimeLockDelta = 18
+	testMinDelay         = 144
+)
+
+// TestValidateChain asserts that validating the Chain config only succeeds if
+// the minimum time lock delta is at least the protocol minimum and the time
+// lock delta is at least the configured minimum.
+func TestValidateChain(t *testing.T) {
+	tests := []struct {
+		name  string
+		cfg   *lncfg.Chain
+		valid bool
+	}{
+		{
+			name: "protocol minimum",
+			cfg: &lncfg.Chain{
+				TimeLockDelta:    testMinTimeLockDelta,
+				MinTimeLockDelta: testMinTimeLockDelta,
+				MaxLocalDelay:    testMinDelay,
+			},
+			valid: true,
+		},
+		{
+			name: "raised minimum",
+			cfg: &lncfg.Chain{
+				TimeLockDelta:    40,
+				MinTimeLockDelta: 40,
+				MaxLocalDelay:    testMinDelay,
+			},
+			valid: true,
+		},
+		{
+			name: "minimum below protocol minimum",
+			cfg: &lncfg.Chain{
+				TimeLockDelta:    40,
+				MinTimeLockDelta: testMinTimeLockDelta - 1,
+				MaxLocalDelay:    testMinDelay,
+			},
+		},
+		{
+			name: "delta below minimum",
+			cfg: &lncfg.Chain{
+				TimeLockDelta:    39,
+				MinTimeLockDelta: 40,
+				MaxLocalDelay:    testMinDelay,
+			},
+		},
+		{
+			name: "max local delay below minimum",
+			cfg: &lncfg.Chain{
+				TimeLockDelta:    40,
+				MinTimeLockDelta: testMinTimeLockDelta,
+				MaxLocalDelay:    testMinDelay - 1,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			err := test.cfg.Validate(
+				testMinTimeLockDelta, testMinDelay,
+			)
+			switch {
+			case test.valid && err != nil:
+				t.Fatalf("valid config was invalid: %v", err)
+			case !test.valid && err == nil:
+				t.Fatalf("invalid config was valid")
+			}
+		})
+	}
+}
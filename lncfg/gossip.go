@@ -15,6 +15,10 @@ type Gossip struct {
 	MaxChannelUpdateBurst int `long:"max-channel-update-burst" description:"The maximum number of updates for a specific channel and direction that lnd will accept over the channel update interval."`
 
 	ChannelUpdateInterval time.Duration `long:"channel-update-interval" description:"The interval used to determine how often lnd should allow a burst of new updates for a specific channel and direction."`
+
+	SubBatchDelay time.Duration `long:"sub-batch-delay" description:"The delay between broadcasting the sub batches a batch of gossip messages is split into. Lowering it speeds up the propagation of gossip, e.g. on test or private networks. Must be at least 100ms."`
+
+	MinimumBatchSize int `long:"min-batch-size" description:"The minimum number of gossip messages broadcast in each sub batch."`
 }
 
 // Parse the pubkeys for the pinned syncers.
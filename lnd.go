@@ -722,6 +722,20 @@ func Main(cfg *Config, lisCfg ListenerCfg, interceptor signal.Interceptor) error
 			cfg.CoinSelectionStrategy)
 	}
 
+	// Parse the default address type.
+	switch cfg.DefaultAddressType {
+	case "p2wkh":
+		chainControlCfg.DefaultAddressType = lnwallet.WitnessPubKey
+
+	case "np2wkh":
+		chainControlCfg.DefaultAddressType =
+			lnwallet.NestedWitnessPubKey
+
+	default:
+		return fmt.Errorf("unknown default address type %v",
+			cfg.DefaultAddressType)
+	}
+
 	activeChainControl, cleanup, err := chainreg.NewChainControl(
 		chainControlCfg, blockCache,
 	)
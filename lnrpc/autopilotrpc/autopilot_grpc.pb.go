@@ -34,6 +34,13 @@ type AutopilotClient interface {
 	//SetScores attempts to set the scores used by the running autopilot agent,
 	//if the external scoring heuristic is enabled.
 	SetScores(ctx context.Context, in *SetScoresRequest, opts ...grpc.CallOption) (*SetScoresResponse, error)
+	//
+	//QueryCandidates returns the nodes the autopilot agent would consider
+	//opening channels to given the current state, sorted by the score of the
+	//agent's heuristic. No channels are opened. The candidates are also computed
+	//if the agent is disabled, which allows previewing its choices before
+	//enabling it.
+	QueryCandidates(ctx context.Context, in *QueryCandidatesRequest, opts ...grpc.CallOption) (*QueryCandidatesResponse, error)
 }
 
 type autopilotClient struct {
@@ -80,6 +87,15 @@ func (c *autopilotClient) SetScores(ctx context.Context, in *SetScoresRequest, o
 	return out, nil
 }
 
+func (c *autopilotClient) QueryCandidates(ctx context.Context, in *QueryCandidatesRequest, opts ...grpc.CallOption) (*QueryCandidatesResponse, error) {
+	out := new(QueryCandidatesResponse)
+	err := c.cc.Invoke(ctx, "/autopilotrpc.Autopilot/QueryCandidates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AutopilotServer is the server API for Autopilot service.
 // All implementations must embed UnimplementedAutopilotServer
 // for forward compatibility
@@ -100,6 +116,13 @@ type AutopilotServer interface {
 	//SetScores attempts to set the scores used by the running autopilot agent,
 	//if the external scoring heuristic is enabled.
 	SetScores(context.Context, *SetScoresRequest) (*SetScoresResponse, error)
+	//
+	//QueryCandidates returns the nodes the autopilot agent would consider
+	//opening channels to given the current state, sorted by the score of the
+	//agent's heuristic. No channels are opened. The candidates are also computed
+	//if the agent is disabled, which allows previewing its choices before
+	//enabling it.
+	QueryCandidates(context.Context, *QueryCandidatesRequest) (*QueryCandidatesResponse, error)
 	mustEmbedUnimplementedAutopilotServer()
 }
 
@@ -119,6 +142,9 @@ func (UnimplementedAutopilotServer) QueryScores(context.Context, *QueryScoresReq
 func (UnimplementedAutopilotServer) SetScores(context.Context, *SetScoresRequest) (*SetScoresResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetScores not implemented")
 }
+func (UnimplementedAutopilotServer) QueryCandidates(context.Context, *QueryCandidatesRequest) (*QueryCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCandidates not implemented")
+}
 func (UnimplementedAutopilotServer) mustEmbedUnimplementedAutopilotServer() {}
 
 // UnsafeAutopilotServer may be embedded to opt out of forward compatibility for this service.
@@ -204,6 +230,24 @@ func _Autopilot_SetScores_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Autopilot_QueryCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutopilotServer).QueryCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/autopilotrpc.Autopilot/QueryCandidates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutopilotServer).QueryCandidates(ctx, req.(*QueryCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Autopilot_ServiceDesc is the grpc.ServiceDesc for Autopilot service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -227,6 +271,10 @@ var Autopilot_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetScores",
 			Handler:    _Autopilot_SetScores_Handler,
 		},
+		{
+			MethodName: "QueryCandidates",
+			Handler:    _Autopilot_QueryCandidates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "autopilotrpc/autopilot.proto",
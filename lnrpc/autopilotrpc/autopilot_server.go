@@ -41,6 +41,10 @@ var (
 			Entity: "info",
 			Action: "read",
 		}},
+		"/autopilotrpc.Autopilot/QueryCandidates": {{
+			Entity: "info",
+			Action: "read",
+		}},
 		"/autopilotrpc.Autopilot/SetScores": {{
 			Entity: "onchain",
 			Action: "write",
@@ -296,3 +300,43 @@ func (s *Server) SetScores(ctx context.Context,
 
 	return &SetScoresResponse{}, nil
 }
+
+// QueryCandidates returns the nodes the autopilot agent would consider opening
+// channels to given the current state, without opening any. The candidates are
+// also computed if the agent isn't active.
+//
+// NOTE: Part of the AutopilotServer interface.
+func (s *Server) QueryCandidates(ctx context.Context,
+	in *QueryCandidatesRequest) (*QueryCandidatesResponse, error) {
+
+	preview, err := s.manager.ChannelCandidates()
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := preview.Candidates
+	if in.MaxCandidates > 0 && int(in.MaxCandidates) < len(candidates) {
+		candidates = candidates[:in.MaxCandidates]
+	}
+
+	resp := &QueryCandidatesResponse{
+		Active:            s.manager.IsActive(),
+		NumChannels:       preview.NumChans,
+		ChanSizeSat:       int64(preview.ChanSize),
+		AvailableFundsSat: int64(preview.AvailableFunds),
+		Candidates: make(
+			[]*QueryCandidatesResponse_Candidate, 0,
+			len(candidates),
+		),
+	}
+	for _, c := range candidates {
+		resp.Candidates = append(
+			resp.Candidates, &QueryCandidatesResponse_Candidate{
+				Pubkey: hex.EncodeToString(c.NodeID[:]),
+				Score:  c.Score,
+			},
+		)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,18 @@
+// +build dev
+
+package devrpc
+
+import (
+	"github.com/lightningnetwork/lnd/channeldb"
+)
+
+// Config is the primary configuration struct for the dev RPC server. It
+// contains all the items required for the dev rpc server to carry out its
+// duties. Any fields with struct tags are meant to be parsed as normal
+// configuration options, while if able to be populated, the latter fields MUST
+// also be specified.
+type Config struct {
+	// ChanStateDB is the database that holds the state of the channels the
+	// dev RPC server exports.
+	ChanStateDB *channeldb.DB
+}
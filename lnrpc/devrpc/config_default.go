@@ -0,0 +1,6 @@
+// +build !dev
+
+package devrpc
+
+// Config is empty for non-dev builds.
+type Config struct{}
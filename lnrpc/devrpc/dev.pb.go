@@ -0,0 +1,395 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.6.1
+// source: devrpc/dev.proto
+
+package devrpc
+
+import (
+	lnrpc "github.com/lightningnetwork/lnd/lnrpc"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ExportRevocationStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The channel to export the revocation state of.
+	ChannelPoint *lnrpc.ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+}
+
+func (x *ExportRevocationStateRequest) Reset() {
+	*x = ExportRevocationStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_devrpc_dev_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportRevocationStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRevocationStateRequest) ProtoMessage() {}
+
+func (x *ExportRevocationStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_devrpc_dev_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRevocationStateRequest.ProtoReflect.Descriptor instead.
+func (*ExportRevocationStateRequest) Descriptor() ([]byte, []int) {
+	return file_devrpc_dev_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExportRevocationStateRequest) GetChannelPoint() *lnrpc.ChannelPoint {
+	if x != nil {
+		return x.ChannelPoint
+	}
+	return nil
+}
+
+type RevocationStoreElement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The number of secrets that were revealed to us before this one, which is
+	//the index the remote party produced it at.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// The SHA256 digest of the secret.
+	Digest []byte `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+}
+
+func (x *RevocationStoreElement) Reset() {
+	*x = RevocationStoreElement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_devrpc_dev_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevocationStoreElement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevocationStoreElement) ProtoMessage() {}
+
+func (x *RevocationStoreElement) ProtoReflect() protoreflect.Message {
+	mi := &file_devrpc_dev_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevocationStoreElement.ProtoReflect.Descriptor instead.
+func (*RevocationStoreElement) Descriptor() ([]byte, []int) {
+	return file_devrpc_dev_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RevocationStoreElement) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *RevocationStoreElement) GetDigest() []byte {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+type ExportRevocationStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The family of the key our revocation producer was derived from. Not set
+	//for legacy channels, see producer_root_pubkey.
+	ProducerKeyFamily uint32 `protobuf:"varint,1,opt,name=producer_key_family,json=producerKeyFamily,proto3" json:"producer_key_family,omitempty"`
+	// The index of the key our revocation producer was derived from.
+	ProducerKeyIndex uint32 `protobuf:"varint,2,opt,name=producer_key_index,json=producerKeyIndex,proto3" json:"producer_key_index,omitempty"`
+	//
+	//For legacy channels whose revocation producer wasn't derived from a key
+	//locator, the public key of the producer's root.
+	ProducerRootPubkey []byte `protobuf:"bytes,3,opt,name=producer_root_pubkey,json=producerRootPubkey,proto3" json:"producer_root_pubkey,omitempty"`
+	// The number of revocation secrets the remote party revealed to us.
+	NumRevocations uint64 `protobuf:"varint,4,opt,name=num_revocations,json=numRevocations,proto3" json:"num_revocations,omitempty"`
+	// The SHA256 digest of the serialized revocation store.
+	StoreDigest []byte `protobuf:"bytes,5,opt,name=store_digest,json=storeDigest,proto3" json:"store_digest,omitempty"`
+	//
+	//The digests of the secrets the revocation store holds, from which all
+	//secrets revealed to us can be derived.
+	StoreElements []*RevocationStoreElement `protobuf:"bytes,6,rep,name=store_elements,json=storeElements,proto3" json:"store_elements,omitempty"`
+	// The height of our current commitment.
+	LocalCommitHeight uint64 `protobuf:"varint,7,opt,name=local_commit_height,json=localCommitHeight,proto3" json:"local_commit_height,omitempty"`
+	// The height of the remote party's current commitment.
+	RemoteCommitHeight uint64 `protobuf:"varint,8,opt,name=remote_commit_height,json=remoteCommitHeight,proto3" json:"remote_commit_height,omitempty"`
+}
+
+func (x *ExportRevocationStateResponse) Reset() {
+	*x = ExportRevocationStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_devrpc_dev_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportRevocationStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRevocationStateResponse) ProtoMessage() {}
+
+func (x *ExportRevocationStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_devrpc_dev_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRevocationStateResponse.ProtoReflect.Descriptor instead.
+func (*ExportRevocationStateResponse) Descriptor() ([]byte, []int) {
+	return file_devrpc_dev_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExportRevocationStateResponse) GetProducerKeyFamily() uint32 {
+	if x != nil {
+		return x.ProducerKeyFamily
+	}
+	return 0
+}
+
+func (x *ExportRevocationStateResponse) GetProducerKeyIndex() uint32 {
+	if x != nil {
+		return x.ProducerKeyIndex
+	}
+	return 0
+}
+
+func (x *ExportRevocationStateResponse) GetProducerRootPubkey() []byte {
+	if x != nil {
+		return x.ProducerRootPubkey
+	}
+	return nil
+}
+
+func (x *ExportRevocationStateResponse) GetNumRevocations() uint64 {
+	if x != nil {
+		return x.NumRevocations
+	}
+	return 0
+}
+
+func (x *ExportRevocationStateResponse) GetStoreDigest() []byte {
+	if x != nil {
+		return x.StoreDigest
+	}
+	return nil
+}
+
+func (x *ExportRevocationStateResponse) GetStoreElements() []*RevocationStoreElement {
+	if x != nil {
+		return x.StoreElements
+	}
+	return nil
+}
+
+func (x *ExportRevocationStateResponse) GetLocalCommitHeight() uint64 {
+	if x != nil {
+		return x.LocalCommitHeight
+	}
+	return 0
+}
+
+func (x *ExportRevocationStateResponse) GetRemoteCommitHeight() uint64 {
+	if x != nil {
+		return x.RemoteCommitHeight
+	}
+	return 0
+}
+
+var File_devrpc_dev_proto protoreflect.FileDescriptor
+
+var file_devrpc_dev_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x64, 0x65, 0x76, 0x72, 0x70, 0x63, 0x2f, 0x64, 0x65, 0x76, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x06, 0x64, 0x65, 0x76, 0x72, 0x70, 0x63, 0x1a, 0x0f, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x58, 0x0a, 0x1c, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0d, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x48, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x22,
+	0xa4, 0x03, 0x0a, 0x1d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x6b, 0x65,
+	0x79, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x46, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x6b, 0x65,
+	0x79, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x30, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x52, 0x6f, 0x6f, 0x74, 0x50, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x52,
+	0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a,
+	0x0e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x65, 0x76, 0x72, 0x70, 0x63, 0x2e, 0x52,
+	0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x11, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x12, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x32, 0x6b, 0x0a, 0x03, 0x44, 0x65, 0x76, 0x12, 0x64, 0x0a,
+	0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x64, 0x65, 0x76, 0x72, 0x70, 0x63, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64,
+	0x65, 0x76, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2f, 0x6c, 0x6e, 0x64, 0x2f, 0x6c, 0x6e, 0x72, 0x70, 0x63, 0x2f, 0x64, 0x65, 0x76,
+	0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_devrpc_dev_proto_rawDescOnce sync.Once
+	file_devrpc_dev_proto_rawDescData = file_devrpc_dev_proto_rawDesc
+)
+
+func file_devrpc_dev_proto_rawDescGZIP() []byte {
+	file_devrpc_dev_proto_rawDescOnce.Do(func() {
+		file_devrpc_dev_proto_rawDescData = protoimpl.X.CompressGZIP(file_devrpc_dev_proto_rawDescData)
+	})
+	return file_devrpc_dev_proto_rawDescData
+}
+
+var file_devrpc_dev_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_devrpc_dev_proto_goTypes = []interface{}{
+	(*ExportRevocationStateRequest)(nil),  // 0: devrpc.ExportRevocationStateRequest
+	(*RevocationStoreElement)(nil),        // 1: devrpc.RevocationStoreElement
+	(*ExportRevocationStateResponse)(nil), // 2: devrpc.ExportRevocationStateResponse
+	(*lnrpc.ChannelPoint)(nil),            // 3: lnrpc.ChannelPoint
+}
+var file_devrpc_dev_proto_depIdxs = []int32{
+	3, // 0: devrpc.ExportRevocationStateRequest.channel_point:type_name -> lnrpc.ChannelPoint
+	1, // 1: devrpc.ExportRevocationStateResponse.store_elements:type_name -> devrpc.RevocationStoreElement
+	0, // 2: devrpc.Dev.ExportRevocationState:input_type -> devrpc.ExportRevocationStateRequest
+	2, // 3: devrpc.Dev.ExportRevocationState:output_type -> devrpc.ExportRevocationStateResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_devrpc_dev_proto_init() }
+func file_devrpc_dev_proto_init() {
+	if File_devrpc_dev_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_devrpc_dev_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportRevocationStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_devrpc_dev_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevocationStoreElement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_devrpc_dev_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportRevocationStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_devrpc_dev_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_devrpc_dev_proto_goTypes,
+		DependencyIndexes: file_devrpc_dev_proto_depIdxs,
+		MessageInfos:      file_devrpc_dev_proto_msgTypes,
+	}.Build()
+	File_devrpc_dev_proto = out.File
+	file_devrpc_dev_proto_rawDesc = nil
+	file_devrpc_dev_proto_goTypes = nil
+	file_devrpc_dev_proto_depIdxs = nil
+}
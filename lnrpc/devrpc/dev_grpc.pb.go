@@ -0,0 +1,111 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package devrpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// DevClient is the client API for Dev service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DevClient interface {
+	//
+	//ExportRevocationState returns the revocation state of a channel for
+	//recovery tooling. The producer of our own revocation secrets is referenced
+	//by the key it was derived from, and the store of the secrets revealed by
+	//the remote party is described by their digests. No secrets are returned.
+	ExportRevocationState(ctx context.Context, in *ExportRevocationStateRequest, opts ...grpc.CallOption) (*ExportRevocationStateResponse, error)
+}
+
+type devClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDevClient(cc grpc.ClientConnInterface) DevClient {
+	return &devClient{cc}
+}
+
+func (c *devClient) ExportRevocationState(ctx context.Context, in *ExportRevocationStateRequest, opts ...grpc.CallOption) (*ExportRevocationStateResponse, error) {
+	out := new(ExportRevocationStateResponse)
+	err := c.cc.Invoke(ctx, "/devrpc.Dev/ExportRevocationState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DevServer is the server API for Dev service.
+// All implementations must embed UnimplementedDevServer
+// for forward compatibility
+type DevServer interface {
+	//
+	//ExportRevocationState returns the revocation state of a channel for
+	//recovery tooling. The producer of our own revocation secrets is referenced
+	//by the key it was derived from, and the store of the secrets revealed by
+	//the remote party is described by their digests. No secrets are returned.
+	ExportRevocationState(context.Context, *ExportRevocationStateRequest) (*ExportRevocationStateResponse, error)
+	mustEmbedUnimplementedDevServer()
+}
+
+// UnimplementedDevServer must be embedded to have forward compatible implementations.
+type UnimplementedDevServer struct {
+}
+
+func (UnimplementedDevServer) ExportRevocationState(context.Context, *ExportRevocationStateRequest) (*ExportRevocationStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportRevocationState not implemented")
+}
+func (UnimplementedDevServer) mustEmbedUnimplementedDevServer() {}
+
+// UnsafeDevServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DevServer will
+// result in compilation errors.
+type UnsafeDevServer interface {
+	mustEmbedUnimplementedDevServer()
+}
+
+func RegisterDevServer(s grpc.ServiceRegistrar, srv DevServer) {
+	s.RegisterService(&Dev_ServiceDesc, srv)
+}
+
+func _Dev_ExportRevocationState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRevocationStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevServer).ExportRevocationState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/devrpc.Dev/ExportRevocationState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevServer).ExportRevocationState(ctx, req.(*ExportRevocationStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Dev_ServiceDesc is the grpc.ServiceDesc for Dev service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Dev_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "devrpc.Dev",
+	HandlerType: (*DevServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportRevocationState",
+			Handler:    _Dev_ExportRevocationState_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "devrpc/dev.proto",
+}
@@ -0,0 +1,74 @@
+// +build dev
+
+package devrpc
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/shachain"
+)
+
+// exportRevocationState describes the revocation state of the channel, which
+// consists of the producer of our own revocation secrets and the store of the
+// secrets the remote party revealed to us. None of the secrets are exported.
+// The producer is referenced by the locator of the key it was derived from,
+// or for legacy channels by the public key of its root, just like in a static
+// channel backup. The store is described by the digests of the secrets it
+// holds.
+func exportRevocationState(channel *channeldb.OpenChannel) (
+	*ExportRevocationStateResponse, error) {
+
+	resp := &ExportRevocationStateResponse{
+		LocalCommitHeight:  channel.LocalCommitment.CommitHeight,
+		RemoteCommitHeight: channel.RemoteCommitment.CommitHeight,
+	}
+
+	locator := channel.RevocationKeyLocator
+	if locator.Family == keychain.KeyFamilyRevocationRoot {
+		resp.ProducerKeyFamily = uint32(locator.Family)
+		resp.ProducerKeyIndex = locator.Index
+	} else {
+		var b bytes.Buffer
+		if err := channel.RevocationProducer.Encode(&b); err != nil {
+			return nil, fmt.Errorf("unable to encode revocation "+
+				"producer: %v", err)
+		}
+
+		_, rootPoint := btcec.PrivKeyFromBytes(btcec.S256(), b.Bytes())
+		resp.ProducerRootPubkey = rootPoint.SerializeCompressed()
+	}
+
+	store, ok := channel.RevocationStore.(*shachain.RevocationStore)
+	if !ok {
+		return nil, fmt.Errorf("unsupported revocation store %T",
+			channel.RevocationStore)
+	}
+
+	var b bytes.Buffer
+	if err := store.Encode(&b); err != nil {
+		return nil, fmt.Errorf("unable to encode revocation store: %v",
+			err)
+	}
+	storeDigest := sha256.Sum256(b.Bytes())
+
+	resp.NumRevocations = store.NumEntries()
+	resp.StoreDigest = storeDigest[:]
+
+	for _, digest := range store.ElementDigests() {
+		digest := digest
+
+		resp.StoreElements = append(
+			resp.StoreElements, &RevocationStoreElement{
+				Height: digest.Height,
+				Digest: digest.Digest[:],
+			},
+		)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,89 @@
+// +build dev
+
+package devrpc
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/shachain"
+	"github.com/stretchr/testify/require"
+)
+
+// TestExportRevocationState tests that the exported revocation state
+// references the producer and describes the store without revealing any
+// secrets.
+func TestExportRevocationState(t *testing.T) {
+	t.Parallel()
+
+	const numRevocations = 20
+
+	root := chainhash.DoubleHashH([]byte("revocationstate"))
+	producer := shachain.NewRevocationProducer(root)
+
+	// The remote party's secrets are revealed to us in order.
+	remoteRoot := chainhash.DoubleHashH([]byte("remoterevocationstate"))
+	remoteProducer := shachain.NewRevocationProducer(remoteRoot)
+	store := shachain.NewRevocationStore()
+
+	var secrets [][]byte
+	for i := uint64(0); i < numRevocations; i++ {
+		secret, err := remoteProducer.AtIndex(i)
+		require.NoError(t, err)
+		require.NoError(t, store.AddNextEntry(secret))
+
+		secrets = append(secrets, secret[:])
+	}
+
+	newChannel := func(locator keychain.KeyLocator) *channeldb.OpenChannel {
+		return &channeldb.OpenChannel{
+			LocalCommitment: channeldb.ChannelCommitment{
+				CommitHeight: numRevocations,
+			},
+			RemoteCommitment: channeldb.ChannelCommitment{
+				CommitHeight: numRevocations + 1,
+			},
+			RevocationProducer:   producer,
+			RevocationStore:      store,
+			RevocationKeyLocator: locator,
+		}
+	}
+
+	// A channel whose producer was derived from a key is referenced by
+	// the key's locator.
+	resp, err := exportRevocationState(newChannel(keychain.KeyLocator{
+		Family: keychain.KeyFamilyRevocationRoot,
+		Index:  7,
+	}))
+	require.NoError(t, err)
+	require.Equal(
+		t, uint32(keychain.KeyFamilyRevocationRoot),
+		resp.ProducerKeyFamily,
+	)
+	require.Equal(t, uint32(7), resp.ProducerKeyIndex)
+	require.Empty(t, resp.ProducerRootPubkey)
+
+	require.Equal(t, uint64(numRevocations), resp.LocalCommitHeight)
+	require.Equal(t, uint64(numRevocations+1), resp.RemoteCommitHeight)
+	require.Equal(t, uint64(numRevocations), resp.NumRevocations)
+	require.Len(t, resp.StoreDigest, 32)
+	require.Len(t, resp.StoreElements, len(store.ElementDigests()))
+
+	// None of the secrets may be part of the exported state.
+	for _, element := range resp.StoreElements {
+		for _, secret := range secrets {
+			require.False(t, bytes.Equal(secret, element.Digest))
+		}
+	}
+
+	// A legacy channel's producer is referenced by the public key of its
+	// root.
+	resp, err = exportRevocationState(newChannel(keychain.KeyLocator{}))
+	require.NoError(t, err)
+	require.Zero(t, resp.ProducerKeyFamily)
+	require.Len(t, resp.ProducerRootPubkey, 33)
+	require.False(t, bytes.Contains(resp.ProducerRootPubkey, root[:]))
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"time"
 
 	"github.com/btcsuite/btcd/chaincfg"
@@ -31,6 +32,14 @@ const (
 	// DefaultAMPInvoiceExpiry is the default invoice expiry for new AMP
 	// invoices.
 	DefaultAMPInvoiceExpiry = 30 * 24 * time.Hour
+
+	// MaxInvoiceExpiry is the maximum expiry of new invoices.
+	MaxInvoiceExpiry = 365 * 24 * time.Hour
+
+	// DefaultMaxHopHints is the default and maximum number of route hints
+	// that are included in an invoice with route hints for private
+	// channels.
+	DefaultMaxHopHints = 20
 )
 
 // AddInvoiceConfig contains dependencies for invoice creation.
@@ -68,6 +77,10 @@ type AddInvoiceConfig struct {
 	// GenAmpInvoiceFeatures returns a feature containing feature bits that
 	// should be advertised on freshly generated AMP invoices.
 	GenAmpInvoiceFeatures func() *lnwire.FeatureVector
+
+	// DefaultExpiry is the expiry of new MPP invoices that don't specify
+	// one. If zero, DefaultInvoiceExpiry is used.
+	DefaultExpiry time.Duration
 }
 
 // AddInvoiceData contains the required data to create a new invoice.
@@ -121,6 +134,11 @@ type AddInvoiceData struct {
 	// RouteHints are optional route hints that can each be individually used
 	// to assist in reaching the invoice's destination.
 	RouteHints [][]zpay32.HopHint
+
+	// MaxHints is an optional limit on the number of route hints in the
+	// invoice, including the ones passed in RouteHints. If zero,
+	// DefaultMaxHopHints is used.
+	MaxHints int
 }
 
 // paymentHashAndPreimage returns the payment hash and preimage for this invoice
@@ -290,21 +308,24 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 		// We'll ensure that the specified expiry is restricted to sane
 		// number of seconds. As a result, we'll reject an invoice with
 		// an expiry greater than 1 year.
-		maxExpiry := time.Hour * 24 * 365
 		expSeconds := invoice.Expiry
 
-		if float64(expSeconds) > maxExpiry.Seconds() {
+		if float64(expSeconds) > MaxInvoiceExpiry.Seconds() {
 			return nil, nil, fmt.Errorf("expiry of %v seconds "+
 				"greater than max expiry of %v seconds",
-				float64(expSeconds), maxExpiry.Seconds())
+				float64(expSeconds), MaxInvoiceExpiry.Seconds())
 		}
 
 		expiry := time.Duration(invoice.Expiry) * time.Second
 		options = append(options, zpay32.Expiry(expiry))
 
-	// If no custom expiry is provided, use the default MPP expiry.
+	// If no custom expiry is provided, use the node's default MPP expiry.
 	case !invoice.Amp:
-		options = append(options, zpay32.Expiry(DefaultInvoiceExpiry))
+		expiry := cfg.DefaultExpiry
+		if expiry == 0 {
+			expiry = DefaultInvoiceExpiry
+		}
+		options = append(options, zpay32.Expiry(expiry))
 
 	// Otherwise, use the default AMP expiry.
 	default:
@@ -389,13 +410,21 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 			}
 
 			// We'll restrict the number of individual route hints
-			// to 20 to avoid creating overly large invoices.
-			numMaxHophints := 20 - len(forcedHints)
-			hopHints := selectHopHints(
-				amtMSat, cfg, filteredChannels, numMaxHophints,
-			)
+			// to avoid creating overly large invoices.
+			maxHints := DefaultMaxHopHints
+			if invoice.MaxHints > 0 && invoice.MaxHints < maxHints {
+				maxHints = invoice.MaxHints
+			}
+
+			numMaxHophints := maxHints - len(forcedHints)
+			if numMaxHophints > 0 {
+				hopHints := selectHopHints(
+					amtMSat, cfg, filteredChannels,
+					numMaxHophints,
+				)
 
-			options = append(options, hopHints...)
+				options = append(options, hopHints...)
+			}
 		}
 	}
 
@@ -534,6 +563,54 @@ func chanCanBeHopHint(channel *channeldb.OpenChannel, cfg *AddInvoiceConfig) (
 	return remotePolicy, true
 }
 
+// HopHintChannels returns the funding outpoints of our channels that are
+// referenced by the route hints of the passed payment request, in the order
+// of the route hints. Route hints that don't reference any of our channels are
+// skipped, so an invoice without route hints results in an empty set.
+func HopHintChannels(cfg *AddInvoiceConfig,
+	payReq string) ([]wire.OutPoint, error) {
+
+	invoice, err := zpay32.Decode(payReq, cfg.ChainParams)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(invoice.RouteHints) == 0 {
+		return nil, nil
+	}
+
+	openChannels, err := cfg.ChanDB.FetchAllChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	chanPoints := make(map[uint64]wire.OutPoint, len(openChannels))
+	for _, channel := range openChannels {
+		chanID := channel.ShortChanID().ToUint64()
+		chanPoints[chanID] = channel.FundingOutpoint
+	}
+
+	var hintChans []wire.OutPoint
+	seen := make(map[wire.OutPoint]struct{})
+	for _, routeHint := range invoice.RouteHints {
+		for _, hopHint := range routeHint {
+			chanPoint, ok := chanPoints[hopHint.ChannelID]
+			if !ok {
+				continue
+			}
+
+			if _, ok := seen[chanPoint]; ok {
+				continue
+			}
+			seen[chanPoint] = struct{}{}
+
+			hintChans = append(hintChans, chanPoint)
+		}
+	}
+
+	return hintChans, nil
+}
+
 // addHopHint creates a hop hint out of the passed channel and channel policy.
 // The new hop hint is appended to the passed slice.
 func addHopHint(hopHints *[]func(*zpay32.Invoice),
@@ -554,14 +631,24 @@ func addHopHint(hopHints *[]func(*zpay32.Invoice),
 }
 
 // selectHopHints will select up to numMaxHophints from the set of passed open
-// channels. The set of hop hints will be returned as a slice of functional
-// options that'll append the route hint to the set of all route hints.
+// channels. Channels with more inbound liquidity are preferred. The set of hop
+// hints will be returned as a slice of functional options that'll append the
+// route hint to the set of all route hints.
 //
 // TODO(roasbeef): do proper sub-set sum max hints usually << numChans
 func selectHopHints(amtMSat lnwire.MilliSatoshi, cfg *AddInvoiceConfig,
 	openChannels []*channeldb.OpenChannel,
 	numMaxHophints int) []func(*zpay32.Invoice) {
 
+	// Sort a copy of the channels by their inbound liquidity, so that the
+	// channels most likely to carry the payment are selected first if
+	// there are more eligible channels than hints.
+	openChannels = append([]*channeldb.OpenChannel(nil), openChannels...)
+	sort.SliceStable(openChannels, func(i, j int) bool {
+		return openChannels[i].LocalCommitment.RemoteBalance >
+			openChannels[j].LocalCommitment.RemoteBalance
+	})
+
 	// We'll add our hop hints in two passes, first we'll add all channels
 	// that are eligible to be hop hints, and also have a local balance
 	// above the payment amount.
@@ -569,6 +656,10 @@ func selectHopHints(amtMSat lnwire.MilliSatoshi, cfg *AddInvoiceConfig,
 	hopHintChans := make(map[wire.OutPoint]struct{})
 	hopHints := make([]func(*zpay32.Invoice), 0, numMaxHophints)
 	for _, channel := range openChannels {
+		if len(hopHints) >= numMaxHophints {
+			break
+		}
+
 		// If this channel can't be a hop hint, then skip it.
 		edgePolicy, canBeHopHint := chanCanBeHopHint(channel, cfg)
 		if edgePolicy == nil || !canBeHopHint {
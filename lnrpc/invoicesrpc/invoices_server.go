@@ -310,8 +310,9 @@ func (s *Server) CancelInvoice(ctx context.Context,
 func (s *Server) AddHoldInvoice(ctx context.Context,
 	invoice *AddHoldInvoiceRequest) (*AddHoldInvoiceResp, error) {
 
+	registry := s.cfg.InvoiceRegistry
 	addInvoiceCfg := &AddInvoiceConfig{
-		AddInvoice:            s.cfg.InvoiceRegistry.AddInvoice,
+		AddInvoice:            registry.AddInvoice,
 		IsChannelActive:       s.cfg.IsChannelActive,
 		ChainParams:           s.cfg.ChainParams,
 		NodeSigner:            s.cfg.NodeSigner,
@@ -320,6 +321,7 @@ func (s *Server) AddHoldInvoice(ctx context.Context,
 		Graph:                 s.cfg.GraphDB,
 		GenInvoiceFeatures:    s.cfg.GenInvoiceFeatures,
 		GenAmpInvoiceFeatures: s.cfg.GenAmpInvoiceFeatures,
+		DefaultExpiry:         registry.DefaultInvoiceExpiry(),
 	}
 
 	hash, err := lntypes.MakeHash(invoice.Hash)
@@ -349,6 +351,7 @@ func (s *Server) AddHoldInvoice(ctx context.Context,
 		HodlInvoice:     true,
 		Preimage:        nil,
 		RouteHints:      routeHints,
+		MaxHints:        int(invoice.MaxHints),
 	}
 
 	_, dbInvoice, err := AddInvoice(ctx, addInvoiceCfg, addInvoiceData)
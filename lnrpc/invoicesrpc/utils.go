@@ -94,6 +94,20 @@ func CreateRPCInvoice(invoice *channeldb.Invoice,
 			invoice.State)
 	}
 
+	var cancelReason lnrpc.Invoice_CancelReason
+	switch invoice.CancelReason {
+	case channeldb.CancelReasonExpired:
+		cancelReason = lnrpc.Invoice_EXPIRED
+	case channeldb.CancelReasonUserCanceled:
+		cancelReason = lnrpc.Invoice_USER_CANCELED
+	case channeldb.CancelReasonAutoCanceled:
+		cancelReason = lnrpc.Invoice_AUTO_CANCELED
+	case channeldb.CancelReasonAMPReconstructionFailed:
+		cancelReason = lnrpc.Invoice_AMP_RECONSTRUCTION_FAILED
+	default:
+		cancelReason = lnrpc.Invoice_UNKNOWN
+	}
+
 	rpcHtlcs := make([]*lnrpc.InvoiceHTLC, 0, len(invoice.Htlcs))
 	for key, htlc := range invoice.Htlcs {
 		var state lnrpc.InvoiceHTLCState
@@ -175,15 +189,67 @@ func CreateRPCInvoice(invoice *channeldb.Invoice,
 		IsKeysend:       len(invoice.PaymentRequest) == 0 && !isAmp,
 		PaymentAddr:     invoice.Terms.PaymentAddr[:],
 		IsAmp:           isAmp,
+		CancelReason:    cancelReason,
 	}
 
 	if preimage != nil {
 		rpcInvoice.RPreimage = preimage[:]
 	}
 
+	if isAmp {
+		rpcInvoice.AmpInvoiceState = createRPCAMPInvoiceStates(invoice)
+	}
+
 	return rpcInvoice, nil
 }
 
+// createRPCAMPInvoiceStates groups the AMP HTLCs that paid to an invoice by
+// their set id, and reports the state of each set keyed by the hex-encoded set
+// id.
+func createRPCAMPInvoiceStates(
+	invoice *channeldb.Invoice) map[string]*lnrpc.AMPInvoiceState {
+
+	states := make(map[string]*lnrpc.AMPInvoiceState)
+	for _, htlc := range invoice.Htlcs {
+		if htlc.AMP == nil {
+			continue
+		}
+
+		setID := htlc.AMP.Record.SetID()
+		key := hex.EncodeToString(setID[:])
+
+		// A set is considered canceled until we find any of its HTLCs
+		// that isn't.
+		state, ok := states[key]
+		if !ok {
+			state = &lnrpc.AMPInvoiceState{
+				State: lnrpc.InvoiceHTLCState_CANCELED,
+			}
+			states[key] = state
+		}
+
+		state.NumHtlcs++
+
+		switch htlc.State {
+		case channeldb.HtlcStateSettled:
+			state.State = lnrpc.InvoiceHTLCState_SETTLED
+			state.AmtPaidMsat += int64(htlc.Amt)
+
+			settleTime := htlc.ResolveTime.Unix()
+			if settleTime > state.SettleTime {
+				state.SettleTime = settleTime
+			}
+
+		case channeldb.HtlcStateAccepted:
+			if state.State == lnrpc.InvoiceHTLCState_CANCELED {
+				state.State = lnrpc.InvoiceHTLCState_ACCEPTED
+			}
+		}
+	}
+
+	return states
+}
+
 // CreateRPCFeatures maps a feature vector into a list of lnrpc.Features.
 func CreateRPCFeatures(fv *lnwire.FeatureVector) map[uint32]*lnrpc.Feature {
 	if fv == nil {
@@ -28,6 +28,12 @@ type LightningClient interface {
 	//categorized in local/remote, pending local/remote and unsettled local/remote
 	//balances.
 	ChannelBalance(ctx context.Context, in *ChannelBalanceRequest, opts ...grpc.CallOption) (*ChannelBalanceResponse, error)
+	// lncli: `inboundliquidity`
+	//InboundLiquidity returns the total amount the node is able to receive
+	//across its open channels, which is the sum of the remote balances minus the
+	//reserves the remote parties are required to keep. Channels with offline
+	//peers can't be used to receive and are reported separately.
+	InboundLiquidity(ctx context.Context, in *InboundLiquidityRequest, opts ...grpc.CallOption) (*InboundLiquidityResponse, error)
 	// lncli: `listchaintxns`
 	//GetTransactions returns a list describing all the known transactions
 	//relevant to the wallet.
@@ -41,6 +47,12 @@ type LightningClient interface {
 	//map type doesn't appear in the REST API documentation because of a bug in
 	//the grpc-gateway library.
 	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
+	// lncli: `feeexposure`
+	//GetFeeExposure returns the aggregate on-chain fees the node has committed
+	//to across its open channels, the wallet reserve kept for fee bumping
+	//anchor channels and the worst-case fee exposure at the current fee
+	//estimate.
+	GetFeeExposure(ctx context.Context, in *GetFeeExposureRequest, opts ...grpc.CallOption) (*GetFeeExposureResponse, error)
 	// lncli: `sendcoins`
 	//SendCoins executes a request to send coins to a particular address. Unlike
 	//SendMany, this RPC call only allows creating a single output at a time. If
@@ -89,9 +101,29 @@ type LightningClient interface {
 	//given pubKey. In the case that we currently have a pending or active channel
 	//with the target peer, then this action will be not be allowed.
 	DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*DisconnectPeerResponse, error)
+	// lncli: `setpeerbackoff`
+	//SetPeerBackoff tunes the backoff between attempts to reconnect to a
+	//persistent peer, or to all persistent peers that don't have a backoff of
+	//their own if no peer is given. The backoff starts at the min backoff and
+	//grows by the multiplier after each failed attempt, until it reaches the
+	//max backoff. Persistent peers are retried at the max backoff indefinitely.
+	//Unset fields keep their current value, so that a request without fields
+	//returns the current backoff. Backoffs set for a peer aren't persisted
+	//across restarts.
+	SetPeerBackoff(ctx context.Context, in *SetPeerBackoffRequest, opts ...grpc.CallOption) (*SetPeerBackoffResponse, error)
 	// lncli: `listpeers`
 	//ListPeers returns a verbose listing of all currently active peers.
 	ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error)
+	// lncli: `resetflapcount`
+	//ResetFlapCount resets the flap count of a peer to zero. A peer's flap count
+	//decays over time if the peer has not flapped for a while, this call forces
+	//the reset immediately, lifting any flap based rate limiting of the peer's
+	//online events. The flap count prior to the reset is returned.
+	ResetFlapCount(ctx context.Context, in *ResetFlapCountRequest, opts ...grpc.CallOption) (*ResetFlapCountResponse, error)
+	// lncli: `getpeerstats`
+	//GetPeerStats returns the number of bytes and messages exchanged with each
+	//connected peer since the connection was established.
+	GetPeerStats(ctx context.Context, in *GetPeerStatsRequest, opts ...grpc.CallOption) (*GetPeerStatsResponse, error)
 	//
 	//SubscribePeerEvents creates a uni-directional stream from the server to
 	//the client in which any events relevant to the state of peers are sent
@@ -107,6 +139,23 @@ type LightningClient interface {
 	//whether it's in a recovery mode, whether the recovery is finished, and the
 	//progress made so far.
 	GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error)
+	// lncli: `getsyncprogress`
+	//GetSyncProgress returns the progress of the node's sync to the chain and
+	//to the channel graph, along with an estimate of the time remaining until
+	//both are complete. A fully synced node reports 100 percent for both.
+	GetSyncProgress(ctx context.Context, in *GetSyncProgressRequest, opts ...grpc.CallOption) (*GetSyncProgressResponse, error)
+	// lncli: `getreorghistory`
+	//GetReorgHistory returns the chain reorganizations that the node's chain
+	//notifier observed since the node was started. A node on a stable chain
+	//returns an empty history.
+	GetReorgHistory(ctx context.Context, in *GetReorgHistoryRequest, opts ...grpc.CallOption) (*GetReorgHistoryResponse, error)
+	// lncli: `getadvertisedaddrs`
+	//GetAdvertisedAddresses returns the addresses that the node advertises in
+	//its node announcement, which may differ from the addresses it listens on.
+	//Both the addresses of the node's latest announcement and the addresses of
+	//the announcement that has been processed by the gossiper are returned, so
+	//that a pending address change can be detected.
+	GetAdvertisedAddresses(ctx context.Context, in *GetAdvertisedAddressesRequest, opts ...grpc.CallOption) (*GetAdvertisedAddressesResponse, error)
 	// lncli: `pendingchannels`
 	//PendingChannels returns a list of all the channels that are currently
 	//considered "pending". A channel is pending if it has finished the funding
@@ -117,6 +166,40 @@ type LightningClient interface {
 	//ListChannels returns a description of all the open channels that this node
 	//is a participant in.
 	ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error)
+	// lncli: `chanuptimehistory`
+	//ChannelUptimeHistory returns the uptime of the peers of our open channels
+	//over a requested window, split into buckets of a requested size. Channels
+	//that are younger than the window only report the uptime since they were
+	//opened.
+	ChannelUptimeHistory(ctx context.Context, in *ChannelUptimeHistoryRequest, opts ...grpc.CallOption) (*ChannelUptimeHistoryResponse, error)
+	// lncli: `getcommitmentoutputs`
+	//GetCommitmentOutputs returns the outputs of our current commitment
+	//transaction of an open channel along with their amounts and script types.
+	//Outputs of a side without balance aren't part of the commitment
+	//transaction and are therefore omitted.
+	GetCommitmentOutputs(ctx context.Context, in *GetCommitmentOutputsRequest, opts ...grpc.CallOption) (*GetCommitmentOutputsResponse, error)
+	// lncli: `settargetlocalratio`
+	//SetTargetLocalRatio stores the share of the capacity of an open channel
+	//that the user would like to be on the local side of the channel. The ratio
+	//is only kept locally for external rebalancing tools and is reported by
+	//ListChannels. It is never advertised to the peer or the network.
+	SetTargetLocalRatio(ctx context.Context, in *SetTargetLocalRatioRequest, opts ...grpc.CallOption) (*SetTargetLocalRatioResponse, error)
+	// lncli: `setmaxhtlchold`
+	//SetMaxHtlcHold sets the maximum duration that an HTLC forwarded over an
+	//open channel may wait to be added to the channel, for example while the
+	//peer is offline. Once it passes, the incoming HTLC is failed back with a
+	//temporary channel failure. HTLCs that the channel has already accepted
+	//are only failed back once the peer has removed them, so the duration
+	//doesn't bound how long they are held. The duration must pass before the
+	//incoming HTLC would have to be resolved on chain, given the time lock
+	//delta of the channel.
+	SetMaxHtlcHold(ctx context.Context, in *SetMaxHtlcHoldRequest, opts ...grpc.CallOption) (*SetMaxHtlcHoldResponse, error)
+	// lncli: `listroutablechannels`
+	//ListRoutableChannels returns the set of active channels that currently have
+	//enough spendable balance to originate a payment of the given amount. The
+	//spendable balance takes the channel reserve and any in-flight HTLCs into
+	//account.
+	ListRoutableChannels(ctx context.Context, in *ListRoutableChannelsRequest, opts ...grpc.CallOption) (*ListRoutableChannelsResponse, error)
 	//
 	//SubscribeChannelEvents creates a uni-directional stream from the server to
 	//the client in which any updates relevant to the state of the channels are
@@ -127,6 +210,18 @@ type LightningClient interface {
 	//ClosedChannels returns a description of all the closed channels that
 	//this node was a participant in.
 	ClosedChannels(ctx context.Context, in *ClosedChannelsRequest, opts ...grpc.CallOption) (*ClosedChannelsResponse, error)
+	// lncli: `previewsweep`
+	//PreviewForceCloseSweep estimates the transaction that sweeps the outputs of
+	//a force closed channel back to the wallet at the current fee estimate,
+	//without broadcasting it. Outputs that can't be swept yet are reported as
+	//pending.
+	PreviewForceCloseSweep(ctx context.Context, in *PreviewForceCloseSweepRequest, opts ...grpc.CallOption) (*PreviewForceCloseSweepResponse, error)
+	// lncli: `channelopenhistory`
+	//ChannelOpenHistory returns the most recent channel opens that this node
+	//initiated, together with their outcome. Opens that haven't completed yet
+	//are reported as pending. The history is kept in memory only and is reset
+	//when the node restarts.
+	ChannelOpenHistory(ctx context.Context, in *ChannelOpenHistoryRequest, opts ...grpc.CallOption) (*ChannelOpenHistoryResponse, error)
 	//
 	//OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
 	//call is meant to be consumed by clients to the REST proxy. As with all
@@ -160,6 +255,46 @@ type LightningClient interface {
 	//node operators to specify their own criteria for accepting inbound channels
 	//through a single persistent connection.
 	ChannelAcceptor(ctx context.Context, opts ...grpc.CallOption) (Lightning_ChannelAcceptorClient, error)
+	// lncli: `setmininboundchansize`
+	//SetMinInboundChanSize allows the caller to update the smallest channel size
+	//that the node will accept from a remote peer at runtime. Channels opened
+	//by the local node are not subject to this limit.
+	SetMinInboundChanSize(ctx context.Context, in *SetMinInboundChanSizeRequest, opts ...grpc.CallOption) (*SetMinInboundChanSizeResponse, error)
+	// lncli: `setcoopclosefeeband`
+	//SetCoopCloseFeeBand allows the caller to query and update the percentage
+	//around the node's last fee proposal within which the fee proposed by the
+	//remote peer is accepted during cooperative close fee negotiation. A fee
+	//outside of this band is answered with a counter proposal. Negotiations
+	//that are already in progress are not affected.
+	SetCoopCloseFeeBand(ctx context.Context, in *SetCoopCloseFeeBandRequest, opts ...grpc.CallOption) (*SetCoopCloseFeeBandResponse, error)
+	// lncli: `setsweeptoaddress`
+	//SetSweepToAddress allows the caller to set the address that the funds of
+	//closed channels are swept to, instead of a fresh wallet address. It
+	//applies to the sweeps of force closed channels and to cooperative closes
+	//without an upfront shutdown script. Wallet outputs, like the ones swept to
+	//bump a fee, are still swept back into the wallet. Closes that are already
+	//in progress are not affected.
+	SetSweepToAddress(ctx context.Context, in *SetSweepToAddressRequest, opts ...grpc.CallOption) (*SetSweepToAddressResponse, error)
+	// lncli: `getmaxchansize`
+	//GetMaxChanSize returns the limits on the size of channels that the node
+	//opens or accepts, including the soft cap set at runtime, if any.
+	GetMaxChanSize(ctx context.Context, in *GetMaxChanSizeRequest, opts ...grpc.CallOption) (*GetMaxChanSizeResponse, error)
+	// lncli: `setsoftmaxchansize`
+	//SetSoftMaxChanSize allows the caller to set a soft cap on the size of
+	//channels that the node opens or accepts at runtime. The cap can only be set
+	//if wumbo channels are enabled and must not exceed the maximum size of wumbo
+	//channels.
+	SetSoftMaxChanSize(ctx context.Context, in *SetSoftMaxChanSizeRequest, opts ...grpc.CallOption) (*SetSoftMaxChanSizeResponse, error)
+	// lncli: `getmaxtotalinflight`
+	//GetMaxTotalInFlight returns the maximum total value of HTLCs that may be in
+	//flight across all channels, along with the value currently in flight.
+	GetMaxTotalInFlight(ctx context.Context, in *GetMaxTotalInFlightRequest, opts ...grpc.CallOption) (*GetMaxTotalInFlightResponse, error)
+	// lncli: `setmaxtotalinflight`
+	//SetMaxTotalInFlight sets the maximum total value of HTLCs that may be in
+	//flight across all channels at runtime. While the total exceeds the
+	//maximum, new outgoing and forwarded HTLCs are rejected. HTLCs that are
+	//already in flight are not affected.
+	SetMaxTotalInFlight(ctx context.Context, in *SetMaxTotalInFlightRequest, opts ...grpc.CallOption) (*SetMaxTotalInFlightResponse, error)
 	// lncli: `closechannel`
 	//CloseChannel attempts to close an active channel identified by its channel
 	//outpoint (ChannelPoint). The actions of this method can additionally be
@@ -174,8 +309,8 @@ type LightningClient interface {
 	//close summary. This method can be used to get rid of permanently unusable
 	//channels due to bugs fixed in newer versions of lnd. This method can also be
 	//used to remove externally funded channels where the funding transaction was
-	//never broadcast. Only available for non-externally funded channels in dev
-	//build.
+	//never broadcast. Abandoning any other channel requires the
+	//i_know_what_i_am_doing flag to be set.
 	AbandonChannel(ctx context.Context, in *AbandonChannelRequest, opts ...grpc.CallOption) (*AbandonChannelResponse, error)
 	// Deprecated: Do not use.
 	// lncli: `sendpayment`
@@ -208,6 +343,12 @@ type LightningClient interface {
 	//duplicated invoices are rejected, therefore all invoices *must* have a
 	//unique payment preimage.
 	AddInvoice(ctx context.Context, in *Invoice, opts ...grpc.CallOption) (*AddInvoiceResponse, error)
+	// lncli: `setdefaultinvoiceexpiry`
+	//SetDefaultInvoiceExpiry updates the expiry of new invoices that don't
+	//specify one. Invoices that explicitly set an expiry and existing invoices
+	//are not affected. The change only lasts until the next restart, after
+	//which the default-invoice-expiry config value is used again.
+	SetDefaultInvoiceExpiry(ctx context.Context, in *SetDefaultInvoiceExpiryRequest, opts ...grpc.CallOption) (*SetDefaultInvoiceExpiryResponse, error)
 	// lncli: `listinvoices`
 	//ListInvoices returns a list of all the invoices currently stored within the
 	//database. Any active debug invoices are ignored. It has full support for
@@ -222,6 +363,12 @@ type LightningClient interface {
 	//The passed payment hash *must* be exactly 32 bytes, if not, an error is
 	//returned.
 	LookupInvoice(ctx context.Context, in *PaymentHash, opts ...grpc.CallOption) (*Invoice, error)
+	// lncli: `lookupinvoices`
+	//LookupInvoices looks up several invoices at once according to their
+	//payment hashes. Each payment hash must be exactly 32 bytes, otherwise an
+	//error is returned. A result is returned for every requested hash, in the
+	//order of the request, indicating whether a matching invoice was found.
+	LookupInvoices(ctx context.Context, in *LookupInvoicesRequest, opts ...grpc.CallOption) (*LookupInvoicesResponse, error)
 	//
 	//SubscribeInvoices returns a uni-directional stream (server -> client) for
 	//notifying the client of newly added/settled invoices. The caller can
@@ -262,6 +409,12 @@ type LightningClient interface {
 	//uniquely identifies the location of transaction's funding output within the
 	//blockchain.
 	GetChanInfo(ctx context.Context, in *ChanInfoRequest, opts ...grpc.CallOption) (*ChannelEdge, error)
+	// lncli: `getchanpolicies`
+	//GetChanPolicies returns the routing policies of one of our own channels,
+	//separated into the policy advertised by our node and the policy advertised
+	//by the remote peer. This makes it possible to inspect the fees and limits
+	//that apply to inbound traffic over the channel.
+	GetChanPolicies(ctx context.Context, in *ChanInfoRequest, opts ...grpc.CallOption) (*ChanPoliciesResponse, error)
 	// lncli: `getnodeinfo`
 	//GetNodeInfo returns the latest advertised, aggregated, and authenticated
 	//channel information for the specified node identified by its public key.
@@ -282,6 +435,18 @@ type LightningClient interface {
 	//GetNetworkInfo returns some basic stats about the known channel graph from
 	//the point of view of the node.
 	GetNetworkInfo(ctx context.Context, in *NetworkInfoRequest, opts ...grpc.CallOption) (*NetworkInfo, error)
+	// lncli: `listzombiechannels`
+	//ListZombieChannels returns the channels of the graph that are marked as
+	//zombies, which are channels that were pruned because they're considered
+	//closed or inactive.
+	ListZombieChannels(ctx context.Context, in *ListZombieChannelsRequest, opts ...grpc.CallOption) (*ListZombieChannelsResponse, error)
+	// lncli: `restorezombiechannel`
+	//RestoreZombieChannel removes a channel from the set of zombies, as long as
+	//its funding output is still unspent, and adds it back to the graph along
+	//with the policies that were known when it was marked as a zombie. A channel
+	//whose data wasn't kept, for example because it was never part of the graph,
+	//is only added back once its announcement is received again.
+	RestoreZombieChannel(ctx context.Context, in *RestoreZombieChannelRequest, opts ...grpc.CallOption) (*RestoreZombieChannelResponse, error)
 	// lncli: `stop`
 	//StopDaemon will send a shutdown request to the interrupt handler, triggering
 	//a graceful shutdown of the daemon.
@@ -300,10 +465,26 @@ type LightningClient interface {
 	//level, or in a granular fashion to specify the logging for a target
 	//sub-system.
 	DebugLevel(ctx context.Context, in *DebugLevelRequest, opts ...grpc.CallOption) (*DebugLevelResponse, error)
+	// lncli: `getgossipbatch`
+	//GetGossipBatchParams returns the parameters that control how a batch of
+	//gossip messages is split into sub batches when it's broadcast to our
+	//peers.
+	GetGossipBatchParams(ctx context.Context, in *GetGossipBatchParamsRequest, opts ...grpc.CallOption) (*GetGossipBatchParamsResponse, error)
+	// lncli: `setgossipbatch`
+	//SetGossipBatchParams adjusts the parameters that control how a batch of
+	//gossip messages is split into sub batches when it's broadcast to our
+	//peers. Adjusted parameters are only kept until lnd is restarted.
+	SetGossipBatchParams(ctx context.Context, in *SetGossipBatchParamsRequest, opts ...grpc.CallOption) (*SetGossipBatchParamsResponse, error)
 	// lncli: `feereport`
 	//FeeReport allows the caller to obtain a report detailing the current fee
 	//schedule enforced by the node globally for each channel.
 	FeeReport(ctx context.Context, in *FeeReportRequest, opts ...grpc.CallOption) (*FeeReportResponse, error)
+	// lncli: `onchainfeereport`
+	//OnChainFeeReport returns the total on-chain fees paid by the node for
+	//transactions confirmed within a time range, broken down by category.
+	//Transactions are attributed to the range by the time of the block they
+	//confirmed in.
+	OnChainFeeReport(ctx context.Context, in *OnChainFeeReportRequest, opts ...grpc.CallOption) (*OnChainFeeReportResponse, error)
 	// lncli: `updatechanpolicy`
 	//UpdateChannelPolicy allows the caller to update the fee schedule and
 	//channel policies for all channels globally, or a particular channel.
@@ -321,6 +502,34 @@ type LightningClient interface {
 	//the index offset of the last entry. The index offset can be provided to the
 	//request to allow the caller to skip a series of records.
 	ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error)
+	// lncli: `policyhistory`
+	//ChannelPolicyHistory returns the forwarding policies that were set on the
+	//node's own channels over time, ordered by time for each channel. A policy
+	//is recorded whenever it is changed with UpdateChannelPolicy. Only the most
+	//recent changes of each channel are kept.
+	ChannelPolicyHistory(ctx context.Context, in *ChannelPolicyHistoryRequest, opts ...grpc.CallOption) (*ChannelPolicyHistoryResponse, error)
+	// lncli: `getswitchinfo`
+	//GetSwitchInfo returns the number of HTLC circuits currently held by the
+	//htlcswitch. A steadily growing number of circuits on a node that doesn't
+	//forward HTLCs hints at stuck HTLCs.
+	GetSwitchInfo(ctx context.Context, in *GetSwitchInfoRequest, opts ...grpc.CallOption) (*GetSwitchInfoResponse, error)
+	// lncli: `fwdfailurestats`
+	//ForwardingFailureStats returns the number of HTLC forwards that were
+	//rejected by this node within a time window, grouped by the reason of the
+	//rejection. The counts are kept in memory for up to 24 hours and are lost
+	//on restart.
+	ForwardingFailureStats(ctx context.Context, in *ForwardingFailureStatsRequest, opts ...grpc.CallOption) (*ForwardingFailureStatsResponse, error)
+	// lncli: `fwdfailurestats --reset`
+	//ResetForwardingFailureStats clears the counts of rejected HTLC forwards
+	//reported by ForwardingFailureStats and restarts counting.
+	ResetForwardingFailureStats(ctx context.Context, in *ResetForwardingFailureStatsRequest, opts ...grpc.CallOption) (*ResetForwardingFailureStatsResponse, error)
+	// lncli: `lookuphtlccircuit`
+	//LookupHtlcCircuit returns the circuit of the HTLC identified by a channel
+	//point and HTLC index, which maps the incoming HTLC of a forward to its
+	//outgoing HTLC, together with the state of the circuit. This helps to
+	//diagnose stuck forwards. Circuits are removed once they are resolved, so
+	//resolved HTLCs are reported as no longer tracked.
+	LookupHtlcCircuit(ctx context.Context, in *LookupHtlcCircuitRequest, opts ...grpc.CallOption) (*LookupHtlcCircuitResponse, error)
 	// lncli: `exportchanbackup`
 	//ExportChannelBackup attempts to return an encrypted static channel backup
 	//for the target channel identified by it channel point. The backup is
@@ -400,6 +609,15 @@ func (c *lightningClient) ChannelBalance(ctx context.Context, in *ChannelBalance
 	return out, nil
 }
 
+func (c *lightningClient) InboundLiquidity(ctx context.Context, in *InboundLiquidityRequest, opts ...grpc.CallOption) (*InboundLiquidityResponse, error) {
+	out := new(InboundLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/InboundLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*TransactionDetails, error) {
 	out := new(TransactionDetails)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetTransactions", in, out, opts...)
@@ -418,6 +636,15 @@ func (c *lightningClient) EstimateFee(ctx context.Context, in *EstimateFeeReques
 	return out, nil
 }
 
+func (c *lightningClient) GetFeeExposure(ctx context.Context, in *GetFeeExposureRequest, opts ...grpc.CallOption) (*GetFeeExposureResponse, error) {
+	out := new(GetFeeExposureResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetFeeExposure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) SendCoins(ctx context.Context, in *SendCoinsRequest, opts ...grpc.CallOption) (*SendCoinsResponse, error) {
 	out := new(SendCoinsResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SendCoins", in, out, opts...)
@@ -522,6 +749,15 @@ func (c *lightningClient) DisconnectPeer(ctx context.Context, in *DisconnectPeer
 	return out, nil
 }
 
+func (c *lightningClient) SetPeerBackoff(ctx context.Context, in *SetPeerBackoffRequest, opts ...grpc.CallOption) (*SetPeerBackoffResponse, error) {
+	out := new(SetPeerBackoffResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SetPeerBackoff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error) {
 	out := new(ListPeersResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListPeers", in, out, opts...)
@@ -531,6 +767,24 @@ func (c *lightningClient) ListPeers(ctx context.Context, in *ListPeersRequest, o
 	return out, nil
 }
 
+func (c *lightningClient) ResetFlapCount(ctx context.Context, in *ResetFlapCountRequest, opts ...grpc.CallOption) (*ResetFlapCountResponse, error) {
+	out := new(ResetFlapCountResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ResetFlapCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetPeerStats(ctx context.Context, in *GetPeerStatsRequest, opts ...grpc.CallOption) (*GetPeerStatsResponse, error) {
+	out := new(GetPeerStatsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetPeerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) SubscribePeerEvents(ctx context.Context, in *PeerEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribePeerEventsClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Lightning_ServiceDesc.Streams[1], "/lnrpc.Lightning/SubscribePeerEvents", opts...)
 	if err != nil {
@@ -581,6 +835,33 @@ func (c *lightningClient) GetRecoveryInfo(ctx context.Context, in *GetRecoveryIn
 	return out, nil
 }
 
+func (c *lightningClient) GetSyncProgress(ctx context.Context, in *GetSyncProgressRequest, opts ...grpc.CallOption) (*GetSyncProgressResponse, error) {
+	out := new(GetSyncProgressResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetSyncProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetReorgHistory(ctx context.Context, in *GetReorgHistoryRequest, opts ...grpc.CallOption) (*GetReorgHistoryResponse, error) {
+	out := new(GetReorgHistoryResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetReorgHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetAdvertisedAddresses(ctx context.Context, in *GetAdvertisedAddressesRequest, opts ...grpc.CallOption) (*GetAdvertisedAddressesResponse, error) {
+	out := new(GetAdvertisedAddressesResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetAdvertisedAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) PendingChannels(ctx context.Context, in *PendingChannelsRequest, opts ...grpc.CallOption) (*PendingChannelsResponse, error) {
 	out := new(PendingChannelsResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/PendingChannels", in, out, opts...)
@@ -599,6 +880,51 @@ func (c *lightningClient) ListChannels(ctx context.Context, in *ListChannelsRequ
 	return out, nil
 }
 
+func (c *lightningClient) ChannelUptimeHistory(ctx context.Context, in *ChannelUptimeHistoryRequest, opts ...grpc.CallOption) (*ChannelUptimeHistoryResponse, error) {
+	out := new(ChannelUptimeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ChannelUptimeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetCommitmentOutputs(ctx context.Context, in *GetCommitmentOutputsRequest, opts ...grpc.CallOption) (*GetCommitmentOutputsResponse, error) {
+	out := new(GetCommitmentOutputsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetCommitmentOutputs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SetTargetLocalRatio(ctx context.Context, in *SetTargetLocalRatioRequest, opts ...grpc.CallOption) (*SetTargetLocalRatioResponse, error) {
+	out := new(SetTargetLocalRatioResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SetTargetLocalRatio", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SetMaxHtlcHold(ctx context.Context, in *SetMaxHtlcHoldRequest, opts ...grpc.CallOption) (*SetMaxHtlcHoldResponse, error) {
+	out := new(SetMaxHtlcHoldResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SetMaxHtlcHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListRoutableChannels(ctx context.Context, in *ListRoutableChannelsRequest, opts ...grpc.CallOption) (*ListRoutableChannelsResponse, error) {
+	out := new(ListRoutableChannelsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListRoutableChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) SubscribeChannelEvents(ctx context.Context, in *ChannelEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelEventsClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Lightning_ServiceDesc.Streams[2], "/lnrpc.Lightning/SubscribeChannelEvents", opts...)
 	if err != nil {
@@ -640,6 +966,24 @@ func (c *lightningClient) ClosedChannels(ctx context.Context, in *ClosedChannels
 	return out, nil
 }
 
+func (c *lightningClient) PreviewForceCloseSweep(ctx context.Context, in *PreviewForceCloseSweepRequest, opts ...grpc.CallOption) (*PreviewForceCloseSweepResponse, error) {
+	out := new(PreviewForceCloseSweepResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/PreviewForceCloseSweep", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ChannelOpenHistory(ctx context.Context, in *ChannelOpenHistoryRequest, opts ...grpc.CallOption) (*ChannelOpenHistoryResponse, error) {
+	out := new(ChannelOpenHistoryResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ChannelOpenHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) OpenChannelSync(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (*ChannelPoint, error) {
 	out := new(ChannelPoint)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/OpenChannelSync", in, out, opts...)
@@ -721,6 +1065,69 @@ func (x *lightningChannelAcceptorClient) Recv() (*ChannelAcceptRequest, error) {
 	return m, nil
 }
 
+func (c *lightningClient) SetMinInboundChanSize(ctx context.Context, in *SetMinInboundChanSizeRequest, opts ...grpc.CallOption) (*SetMinInboundChanSizeResponse, error) {
+	out := new(SetMinInboundChanSizeResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SetMinInboundChanSize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SetCoopCloseFeeBand(ctx context.Context, in *SetCoopCloseFeeBandRequest, opts ...grpc.CallOption) (*SetCoopCloseFeeBandResponse, error) {
+	out := new(SetCoopCloseFeeBandResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SetCoopCloseFeeBand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SetSweepToAddress(ctx context.Context, in *SetSweepToAddressRequest, opts ...grpc.CallOption) (*SetSweepToAddressResponse, error) {
+	out := new(SetSweepToAddressResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SetSweepToAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetMaxChanSize(ctx context.Context, in *GetMaxChanSizeRequest, opts ...grpc.CallOption) (*GetMaxChanSizeResponse, error) {
+	out := new(GetMaxChanSizeResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetMaxChanSize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SetSoftMaxChanSize(ctx context.Context, in *SetSoftMaxChanSizeRequest, opts ...grpc.CallOption) (*SetSoftMaxChanSizeResponse, error) {
+	out := new(SetSoftMaxChanSizeResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SetSoftMaxChanSize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetMaxTotalInFlight(ctx context.Context, in *GetMaxTotalInFlightRequest, opts ...grpc.CallOption) (*GetMaxTotalInFlightResponse, error) {
+	out := new(GetMaxTotalInFlightResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetMaxTotalInFlight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SetMaxTotalInFlight(ctx context.Context, in *SetMaxTotalInFlightRequest, opts ...grpc.CallOption) (*SetMaxTotalInFlightResponse, error) {
+	out := new(SetMaxTotalInFlightResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SetMaxTotalInFlight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (Lightning_CloseChannelClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Lightning_ServiceDesc.Streams[5], "/lnrpc.Lightning/CloseChannel", opts...)
 	if err != nil {
@@ -853,6 +1260,15 @@ func (c *lightningClient) AddInvoice(ctx context.Context, in *Invoice, opts ...g
 	return out, nil
 }
 
+func (c *lightningClient) SetDefaultInvoiceExpiry(ctx context.Context, in *SetDefaultInvoiceExpiryRequest, opts ...grpc.CallOption) (*SetDefaultInvoiceExpiryResponse, error) {
+	out := new(SetDefaultInvoiceExpiryResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SetDefaultInvoiceExpiry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) ListInvoices(ctx context.Context, in *ListInvoiceRequest, opts ...grpc.CallOption) (*ListInvoiceResponse, error) {
 	out := new(ListInvoiceResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListInvoices", in, out, opts...)
@@ -871,6 +1287,15 @@ func (c *lightningClient) LookupInvoice(ctx context.Context, in *PaymentHash, op
 	return out, nil
 }
 
+func (c *lightningClient) LookupInvoices(ctx context.Context, in *LookupInvoicesRequest, opts ...grpc.CallOption) (*LookupInvoicesResponse, error) {
+	out := new(LookupInvoicesResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/LookupInvoices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) SubscribeInvoices(ctx context.Context, in *InvoiceSubscription, opts ...grpc.CallOption) (Lightning_SubscribeInvoicesClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Lightning_ServiceDesc.Streams[8], "/lnrpc.Lightning/SubscribeInvoices", opts...)
 	if err != nil {
@@ -957,6 +1382,15 @@ func (c *lightningClient) GetChanInfo(ctx context.Context, in *ChanInfoRequest,
 	return out, nil
 }
 
+func (c *lightningClient) GetChanPolicies(ctx context.Context, in *ChanInfoRequest, opts ...grpc.CallOption) (*ChanPoliciesResponse, error) {
+	out := new(ChanPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetChanPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) GetNodeInfo(ctx context.Context, in *NodeInfoRequest, opts ...grpc.CallOption) (*NodeInfo, error) {
 	out := new(NodeInfo)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetNodeInfo", in, out, opts...)
@@ -984,6 +1418,24 @@ func (c *lightningClient) GetNetworkInfo(ctx context.Context, in *NetworkInfoReq
 	return out, nil
 }
 
+func (c *lightningClient) ListZombieChannels(ctx context.Context, in *ListZombieChannelsRequest, opts ...grpc.CallOption) (*ListZombieChannelsResponse, error) {
+	out := new(ListZombieChannelsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListZombieChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) RestoreZombieChannel(ctx context.Context, in *RestoreZombieChannelRequest, opts ...grpc.CallOption) (*RestoreZombieChannelResponse, error) {
+	out := new(RestoreZombieChannelResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/RestoreZombieChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) StopDaemon(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error) {
 	out := new(StopResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/StopDaemon", in, out, opts...)
@@ -1034,6 +1486,24 @@ func (c *lightningClient) DebugLevel(ctx context.Context, in *DebugLevelRequest,
 	return out, nil
 }
 
+func (c *lightningClient) GetGossipBatchParams(ctx context.Context, in *GetGossipBatchParamsRequest, opts ...grpc.CallOption) (*GetGossipBatchParamsResponse, error) {
+	out := new(GetGossipBatchParamsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetGossipBatchParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SetGossipBatchParams(ctx context.Context, in *SetGossipBatchParamsRequest, opts ...grpc.CallOption) (*SetGossipBatchParamsResponse, error) {
+	out := new(SetGossipBatchParamsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SetGossipBatchParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) FeeReport(ctx context.Context, in *FeeReportRequest, opts ...grpc.CallOption) (*FeeReportResponse, error) {
 	out := new(FeeReportResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/FeeReport", in, out, opts...)
@@ -1043,6 +1513,15 @@ func (c *lightningClient) FeeReport(ctx context.Context, in *FeeReportRequest, o
 	return out, nil
 }
 
+func (c *lightningClient) OnChainFeeReport(ctx context.Context, in *OnChainFeeReportRequest, opts ...grpc.CallOption) (*OnChainFeeReportResponse, error) {
+	out := new(OnChainFeeReportResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/OnChainFeeReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) UpdateChannelPolicy(ctx context.Context, in *PolicyUpdateRequest, opts ...grpc.CallOption) (*PolicyUpdateResponse, error) {
 	out := new(PolicyUpdateResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/UpdateChannelPolicy", in, out, opts...)
@@ -1061,6 +1540,51 @@ func (c *lightningClient) ForwardingHistory(ctx context.Context, in *ForwardingH
 	return out, nil
 }
 
+func (c *lightningClient) ChannelPolicyHistory(ctx context.Context, in *ChannelPolicyHistoryRequest, opts ...grpc.CallOption) (*ChannelPolicyHistoryResponse, error) {
+	out := new(ChannelPolicyHistoryResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ChannelPolicyHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetSwitchInfo(ctx context.Context, in *GetSwitchInfoRequest, opts ...grpc.CallOption) (*GetSwitchInfoResponse, error) {
+	out := new(GetSwitchInfoResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetSwitchInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ForwardingFailureStats(ctx context.Context, in *ForwardingFailureStatsRequest, opts ...grpc.CallOption) (*ForwardingFailureStatsResponse, error) {
+	out := new(ForwardingFailureStatsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ForwardingFailureStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ResetForwardingFailureStats(ctx context.Context, in *ResetForwardingFailureStatsRequest, opts ...grpc.CallOption) (*ResetForwardingFailureStatsResponse, error) {
+	out := new(ResetForwardingFailureStatsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ResetForwardingFailureStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) LookupHtlcCircuit(ctx context.Context, in *LookupHtlcCircuitRequest, opts ...grpc.CallOption) (*LookupHtlcCircuitResponse, error) {
+	out := new(LookupHtlcCircuitResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/LookupHtlcCircuit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) ExportChannelBackup(ctx context.Context, in *ExportChannelBackupRequest, opts ...grpc.CallOption) (*ChannelBackup, error) {
 	out := new(ChannelBackup)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ExportChannelBackup", in, out, opts...)
@@ -1179,6 +1703,12 @@ type LightningServer interface {
 	//categorized in local/remote, pending local/remote and unsettled local/remote
 	//balances.
 	ChannelBalance(context.Context, *ChannelBalanceRequest) (*ChannelBalanceResponse, error)
+	// lncli: `inboundliquidity`
+	//InboundLiquidity returns the total amount the node is able to receive
+	//across its open channels, which is the sum of the remote balances minus the
+	//reserves the remote parties are required to keep. Channels with offline
+	//peers can't be used to receive and are reported separately.
+	InboundLiquidity(context.Context, *InboundLiquidityRequest) (*InboundLiquidityResponse, error)
 	// lncli: `listchaintxns`
 	//GetTransactions returns a list describing all the known transactions
 	//relevant to the wallet.
@@ -1192,6 +1722,12 @@ type LightningServer interface {
 	//map type doesn't appear in the REST API documentation because of a bug in
 	//the grpc-gateway library.
 	EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error)
+	// lncli: `feeexposure`
+	//GetFeeExposure returns the aggregate on-chain fees the node has committed
+	//to across its open channels, the wallet reserve kept for fee bumping
+	//anchor channels and the worst-case fee exposure at the current fee
+	//estimate.
+	GetFeeExposure(context.Context, *GetFeeExposureRequest) (*GetFeeExposureResponse, error)
 	// lncli: `sendcoins`
 	//SendCoins executes a request to send coins to a particular address. Unlike
 	//SendMany, this RPC call only allows creating a single output at a time. If
@@ -1240,9 +1776,29 @@ type LightningServer interface {
 	//given pubKey. In the case that we currently have a pending or active channel
 	//with the target peer, then this action will be not be allowed.
 	DisconnectPeer(context.Context, *DisconnectPeerRequest) (*DisconnectPeerResponse, error)
+	// lncli: `setpeerbackoff`
+	//SetPeerBackoff tunes the backoff between attempts to reconnect to a
+	//persistent peer, or to all persistent peers that don't have a backoff of
+	//their own if no peer is given. The backoff starts at the min backoff and
+	//grows by the multiplier after each failed attempt, until it reaches the
+	//max backoff. Persistent peers are retried at the max backoff indefinitely.
+	//Unset fields keep their current value, so that a request without fields
+	//returns the current backoff. Backoffs set for a peer aren't persisted
+	//across restarts.
+	SetPeerBackoff(context.Context, *SetPeerBackoffRequest) (*SetPeerBackoffResponse, error)
 	// lncli: `listpeers`
 	//ListPeers returns a verbose listing of all currently active peers.
 	ListPeers(context.Context, *ListPeersRequest) (*ListPeersResponse, error)
+	// lncli: `resetflapcount`
+	//ResetFlapCount resets the flap count of a peer to zero. A peer's flap count
+	//decays over time if the peer has not flapped for a while, this call forces
+	//the reset immediately, lifting any flap based rate limiting of the peer's
+	//online events. The flap count prior to the reset is returned.
+	ResetFlapCount(context.Context, *ResetFlapCountRequest) (*ResetFlapCountResponse, error)
+	// lncli: `getpeerstats`
+	//GetPeerStats returns the number of bytes and messages exchanged with each
+	//connected peer since the connection was established.
+	GetPeerStats(context.Context, *GetPeerStatsRequest) (*GetPeerStatsResponse, error)
 	//
 	//SubscribePeerEvents creates a uni-directional stream from the server to
 	//the client in which any events relevant to the state of peers are sent
@@ -1258,6 +1814,23 @@ type LightningServer interface {
 	//whether it's in a recovery mode, whether the recovery is finished, and the
 	//progress made so far.
 	GetRecoveryInfo(context.Context, *GetRecoveryInfoRequest) (*GetRecoveryInfoResponse, error)
+	// lncli: `getsyncprogress`
+	//GetSyncProgress returns the progress of the node's sync to the chain and
+	//to the channel graph, along with an estimate of the time remaining until
+	//both are complete. A fully synced node reports 100 percent for both.
+	GetSyncProgress(context.Context, *GetSyncProgressRequest) (*GetSyncProgressResponse, error)
+	// lncli: `getreorghistory`
+	//GetReorgHistory returns the chain reorganizations that the node's chain
+	//notifier observed since the node was started. A node on a stable chain
+	//returns an empty history.
+	GetReorgHistory(context.Context, *GetReorgHistoryRequest) (*GetReorgHistoryResponse, error)
+	// lncli: `getadvertisedaddrs`
+	//GetAdvertisedAddresses returns the addresses that the node advertises in
+	//its node announcement, which may differ from the addresses it listens on.
+	//Both the addresses of the node's latest announcement and the addresses of
+	//the announcement that has been processed by the gossiper are returned, so
+	//that a pending address change can be detected.
+	GetAdvertisedAddresses(context.Context, *GetAdvertisedAddressesRequest) (*GetAdvertisedAddressesResponse, error)
 	// lncli: `pendingchannels`
 	//PendingChannels returns a list of all the channels that are currently
 	//considered "pending". A channel is pending if it has finished the funding
@@ -1268,6 +1841,40 @@ type LightningServer interface {
 	//ListChannels returns a description of all the open channels that this node
 	//is a participant in.
 	ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error)
+	// lncli: `chanuptimehistory`
+	//ChannelUptimeHistory returns the uptime of the peers of our open channels
+	//over a requested window, split into buckets of a requested size. Channels
+	//that are younger than the window only report the uptime since they were
+	//opened.
+	ChannelUptimeHistory(context.Context, *ChannelUptimeHistoryRequest) (*ChannelUptimeHistoryResponse, error)
+	// lncli: `getcommitmentoutputs`
+	//GetCommitmentOutputs returns the outputs of our current commitment
+	//transaction of an open channel along with their amounts and script types.
+	//Outputs of a side without balance aren't part of the commitment
+	//transaction and are therefore omitted.
+	GetCommitmentOutputs(context.Context, *GetCommitmentOutputsRequest) (*GetCommitmentOutputsResponse, error)
+	// lncli: `settargetlocalratio`
+	//SetTargetLocalRatio stores the share of the capacity of an open channel
+	//that the user would like to be on the local side of the channel. The ratio
+	//is only kept locally for external rebalancing tools and is reported by
+	//ListChannels. It is never advertised to the peer or the network.
+	SetTargetLocalRatio(context.Context, *SetTargetLocalRatioRequest) (*SetTargetLocalRatioResponse, error)
+	// lncli: `setmaxhtlchold`
+	//SetMaxHtlcHold sets the maximum duration that an HTLC forwarded over an
+	//open channel may wait to be added to the channel, for example while the
+	//peer is offline. Once it passes, the incoming HTLC is failed back with a
+	//temporary channel failure. HTLCs that the channel has already accepted
+	//are only failed back once the peer has removed them, so the duration
+	//doesn't bound how long they are held. The duration must pass before the
+	//incoming HTLC would have to be resolved on chain, given the time lock
+	//delta of the channel.
+	SetMaxHtlcHold(context.Context, *SetMaxHtlcHoldRequest) (*SetMaxHtlcHoldResponse, error)
+	// lncli: `listroutablechannels`
+	//ListRoutableChannels returns the set of active channels that currently have
+	//enough spendable balance to originate a payment of the given amount. The
+	//spendable balance takes the channel reserve and any in-flight HTLCs into
+	//account.
+	ListRoutableChannels(context.Context, *ListRoutableChannelsRequest) (*ListRoutableChannelsResponse, error)
 	//
 	//SubscribeChannelEvents creates a uni-directional stream from the server to
 	//the client in which any updates relevant to the state of the channels are
@@ -1278,6 +1885,18 @@ type LightningServer interface {
 	//ClosedChannels returns a description of all the closed channels that
 	//this node was a participant in.
 	ClosedChannels(context.Context, *ClosedChannelsRequest) (*ClosedChannelsResponse, error)
+	// lncli: `previewsweep`
+	//PreviewForceCloseSweep estimates the transaction that sweeps the outputs of
+	//a force closed channel back to the wallet at the current fee estimate,
+	//without broadcasting it. Outputs that can't be swept yet are reported as
+	//pending.
+	PreviewForceCloseSweep(context.Context, *PreviewForceCloseSweepRequest) (*PreviewForceCloseSweepResponse, error)
+	// lncli: `channelopenhistory`
+	//ChannelOpenHistory returns the most recent channel opens that this node
+	//initiated, together with their outcome. Opens that haven't completed yet
+	//are reported as pending. The history is kept in memory only and is reset
+	//when the node restarts.
+	ChannelOpenHistory(context.Context, *ChannelOpenHistoryRequest) (*ChannelOpenHistoryResponse, error)
 	//
 	//OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
 	//call is meant to be consumed by clients to the REST proxy. As with all
@@ -1311,6 +1930,46 @@ type LightningServer interface {
 	//node operators to specify their own criteria for accepting inbound channels
 	//through a single persistent connection.
 	ChannelAcceptor(Lightning_ChannelAcceptorServer) error
+	// lncli: `setmininboundchansize`
+	//SetMinInboundChanSize allows the caller to update the smallest channel size
+	//that the node will accept from a remote peer at runtime. Channels opened
+	//by the local node are not subject to this limit.
+	SetMinInboundChanSize(context.Context, *SetMinInboundChanSizeRequest) (*SetMinInboundChanSizeResponse, error)
+	// lncli: `setcoopclosefeeband`
+	//SetCoopCloseFeeBand allows the caller to query and update the percentage
+	//around the node's last fee proposal within which the fee proposed by the
+	//remote peer is accepted during cooperative close fee negotiation. A fee
+	//outside of this band is answered with a counter proposal. Negotiations
+	//that are already in progress are not affected.
+	SetCoopCloseFeeBand(context.Context, *SetCoopCloseFeeBandRequest) (*SetCoopCloseFeeBandResponse, error)
+	// lncli: `setsweeptoaddress`
+	//SetSweepToAddress allows the caller to set the address that the funds of
+	//closed channels are swept to, instead of a fresh wallet address. It
+	//applies to the sweeps of force closed channels and to cooperative closes
+	//without an upfront shutdown script. Wallet outputs, like the ones swept to
+	//bump a fee, are still swept back into the wallet. Closes that are already
+	//in progress are not affected.
+	SetSweepToAddress(context.Context, *SetSweepToAddressRequest) (*SetSweepToAddressResponse, error)
+	// lncli: `getmaxchansize`
+	//GetMaxChanSize returns the limits on the size of channels that the node
+	//opens or accepts, including the soft cap set at runtime, if any.
+	GetMaxChanSize(context.Context, *GetMaxChanSizeRequest) (*GetMaxChanSizeResponse, error)
+	// lncli: `setsoftmaxchansize`
+	//SetSoftMaxChanSize allows the caller to set a soft cap on the size of
+	//channels that the node opens or accepts at runtime. The cap can only be set
+	//if wumbo channels are enabled and must not exceed the maximum size of wumbo
+	//channels.
+	SetSoftMaxChanSize(context.Context, *SetSoftMaxChanSizeRequest) (*SetSoftMaxChanSizeResponse, error)
+	// lncli: `getmaxtotalinflight`
+	//GetMaxTotalInFlight returns the maximum total value of HTLCs that may be in
+	//flight across all channels, along with the value currently in flight.
+	GetMaxTotalInFlight(context.Context, *GetMaxTotalInFlightRequest) (*GetMaxTotalInFlightResponse, error)
+	// lncli: `setmaxtotalinflight`
+	//SetMaxTotalInFlight sets the maximum total value of HTLCs that may be in
+	//flight across all channels at runtime. While the total exceeds the
+	//maximum, new outgoing and forwarded HTLCs are rejected. HTLCs that are
+	//already in flight are not affected.
+	SetMaxTotalInFlight(context.Context, *SetMaxTotalInFlightRequest) (*SetMaxTotalInFlightResponse, error)
 	// lncli: `closechannel`
 	//CloseChannel attempts to close an active channel identified by its channel
 	//outpoint (ChannelPoint). The actions of this method can additionally be
@@ -1325,8 +1984,8 @@ type LightningServer interface {
 	//close summary. This method can be used to get rid of permanently unusable
 	//channels due to bugs fixed in newer versions of lnd. This method can also be
 	//used to remove externally funded channels where the funding transaction was
-	//never broadcast. Only available for non-externally funded channels in dev
-	//build.
+	//never broadcast. Abandoning any other channel requires the
+	//i_know_what_i_am_doing flag to be set.
 	AbandonChannel(context.Context, *AbandonChannelRequest) (*AbandonChannelResponse, error)
 	// Deprecated: Do not use.
 	// lncli: `sendpayment`
@@ -1359,6 +2018,12 @@ type LightningServer interface {
 	//duplicated invoices are rejected, therefore all invoices *must* have a
 	//unique payment preimage.
 	AddInvoice(context.Context, *Invoice) (*AddInvoiceResponse, error)
+	// lncli: `setdefaultinvoiceexpiry`
+	//SetDefaultInvoiceExpiry updates the expiry of new invoices that don't
+	//specify one. Invoices that explicitly set an expiry and existing invoices
+	//are not affected. The change only lasts until the next restart, after
+	//which the default-invoice-expiry config value is used again.
+	SetDefaultInvoiceExpiry(context.Context, *SetDefaultInvoiceExpiryRequest) (*SetDefaultInvoiceExpiryResponse, error)
 	// lncli: `listinvoices`
 	//ListInvoices returns a list of all the invoices currently stored within the
 	//database. Any active debug invoices are ignored. It has full support for
@@ -1373,6 +2038,12 @@ type LightningServer interface {
 	//The passed payment hash *must* be exactly 32 bytes, if not, an error is
 	//returned.
 	LookupInvoice(context.Context, *PaymentHash) (*Invoice, error)
+	// lncli: `lookupinvoices`
+	//LookupInvoices looks up several invoices at once according to their
+	//payment hashes. Each payment hash must be exactly 32 bytes, otherwise an
+	//error is returned. A result is returned for every requested hash, in the
+	//order of the request, indicating whether a matching invoice was found.
+	LookupInvoices(context.Context, *LookupInvoicesRequest) (*LookupInvoicesResponse, error)
 	//
 	//SubscribeInvoices returns a uni-directional stream (server -> client) for
 	//notifying the client of newly added/settled invoices. The caller can
@@ -1413,6 +2084,12 @@ type LightningServer interface {
 	//uniquely identifies the location of transaction's funding output within the
 	//blockchain.
 	GetChanInfo(context.Context, *ChanInfoRequest) (*ChannelEdge, error)
+	// lncli: `getchanpolicies`
+	//GetChanPolicies returns the routing policies of one of our own channels,
+	//separated into the policy advertised by our node and the policy advertised
+	//by the remote peer. This makes it possible to inspect the fees and limits
+	//that apply to inbound traffic over the channel.
+	GetChanPolicies(context.Context, *ChanInfoRequest) (*ChanPoliciesResponse, error)
 	// lncli: `getnodeinfo`
 	//GetNodeInfo returns the latest advertised, aggregated, and authenticated
 	//channel information for the specified node identified by its public key.
@@ -1433,6 +2110,18 @@ type LightningServer interface {
 	//GetNetworkInfo returns some basic stats about the known channel graph from
 	//the point of view of the node.
 	GetNetworkInfo(context.Context, *NetworkInfoRequest) (*NetworkInfo, error)
+	// lncli: `listzombiechannels`
+	//ListZombieChannels returns the channels of the graph that are marked as
+	//zombies, which are channels that were pruned because they're considered
+	//closed or inactive.
+	ListZombieChannels(context.Context, *ListZombieChannelsRequest) (*ListZombieChannelsResponse, error)
+	// lncli: `restorezombiechannel`
+	//RestoreZombieChannel removes a channel from the set of zombies, as long as
+	//its funding output is still unspent, and adds it back to the graph along
+	//with the policies that were known when it was marked as a zombie. A channel
+	//whose data wasn't kept, for example because it was never part of the graph,
+	//is only added back once its announcement is received again.
+	RestoreZombieChannel(context.Context, *RestoreZombieChannelRequest) (*RestoreZombieChannelResponse, error)
 	// lncli: `stop`
 	//StopDaemon will send a shutdown request to the interrupt handler, triggering
 	//a graceful shutdown of the daemon.
@@ -1451,10 +2140,26 @@ type LightningServer interface {
 	//level, or in a granular fashion to specify the logging for a target
 	//sub-system.
 	DebugLevel(context.Context, *DebugLevelRequest) (*DebugLevelResponse, error)
+	// lncli: `getgossipbatch`
+	//GetGossipBatchParams returns the parameters that control how a batch of
+	//gossip messages is split into sub batches when it's broadcast to our
+	//peers.
+	GetGossipBatchParams(context.Context, *GetGossipBatchParamsRequest) (*GetGossipBatchParamsResponse, error)
+	// lncli: `setgossipbatch`
+	//SetGossipBatchParams adjusts the parameters that control how a batch of
+	//gossip messages is split into sub batches when it's broadcast to our
+	//peers. Adjusted parameters are only kept until lnd is restarted.
+	SetGossipBatchParams(context.Context, *SetGossipBatchParamsRequest) (*SetGossipBatchParamsResponse, error)
 	// lncli: `feereport`
 	//FeeReport allows the caller to obtain a report detailing the current fee
 	//schedule enforced by the node globally for each channel.
 	FeeReport(context.Context, *FeeReportRequest) (*FeeReportResponse, error)
+	// lncli: `onchainfeereport`
+	//OnChainFeeReport returns the total on-chain fees paid by the node for
+	//transactions confirmed within a time range, broken down by category.
+	//Transactions are attributed to the range by the time of the block they
+	//confirmed in.
+	OnChainFeeReport(context.Context, *OnChainFeeReportRequest) (*OnChainFeeReportResponse, error)
 	// lncli: `updatechanpolicy`
 	//UpdateChannelPolicy allows the caller to update the fee schedule and
 	//channel policies for all channels globally, or a particular channel.
@@ -1472,6 +2177,34 @@ type LightningServer interface {
 	//the index offset of the last entry. The index offset can be provided to the
 	//request to allow the caller to skip a series of records.
 	ForwardingHistory(context.Context, *ForwardingHistoryRequest) (*ForwardingHistoryResponse, error)
+	// lncli: `policyhistory`
+	//ChannelPolicyHistory returns the forwarding policies that were set on the
+	//node's own channels over time, ordered by time for each channel. A policy
+	//is recorded whenever it is changed with UpdateChannelPolicy. Only the most
+	//recent changes of each channel are kept.
+	ChannelPolicyHistory(context.Context, *ChannelPolicyHistoryRequest) (*ChannelPolicyHistoryResponse, error)
+	// lncli: `getswitchinfo`
+	//GetSwitchInfo returns the number of HTLC circuits currently held by the
+	//htlcswitch. A steadily growing number of circuits on a node that doesn't
+	//forward HTLCs hints at stuck HTLCs.
+	GetSwitchInfo(context.Context, *GetSwitchInfoRequest) (*GetSwitchInfoResponse, error)
+	// lncli: `fwdfailurestats`
+	//ForwardingFailureStats returns the number of HTLC forwards that were
+	//rejected by this node within a time window, grouped by the reason of the
+	//rejection. The counts are kept in memory for up to 24 hours and are lost
+	//on restart.
+	ForwardingFailureStats(context.Context, *ForwardingFailureStatsRequest) (*ForwardingFailureStatsResponse, error)
+	// lncli: `fwdfailurestats --reset`
+	//ResetForwardingFailureStats clears the counts of rejected HTLC forwards
+	//reported by ForwardingFailureStats and restarts counting.
+	ResetForwardingFailureStats(context.Context, *ResetForwardingFailureStatsRequest) (*ResetForwardingFailureStatsResponse, error)
+	// lncli: `lookuphtlccircuit`
+	//LookupHtlcCircuit returns the circuit of the HTLC identified by a channel
+	//point and HTLC index, which maps the incoming HTLC of a forward to its
+	//outgoing HTLC, together with the state of the circuit. This helps to
+	//diagnose stuck forwards. Circuits are removed once they are resolved, so
+	//resolved HTLCs are reported as no longer tracked.
+	LookupHtlcCircuit(context.Context, *LookupHtlcCircuitRequest) (*LookupHtlcCircuitResponse, error)
 	// lncli: `exportchanbackup`
 	//ExportChannelBackup attempts to return an encrypted static channel backup
 	//for the target channel identified by it channel point. The backup is
@@ -1536,12 +2269,18 @@ func (UnimplementedLightningServer) WalletBalance(context.Context, *WalletBalanc
 func (UnimplementedLightningServer) ChannelBalance(context.Context, *ChannelBalanceRequest) (*ChannelBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChannelBalance not implemented")
 }
+func (UnimplementedLightningServer) InboundLiquidity(context.Context, *InboundLiquidityRequest) (*InboundLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InboundLiquidity not implemented")
+}
 func (UnimplementedLightningServer) GetTransactions(context.Context, *GetTransactionsRequest) (*TransactionDetails, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTransactions not implemented")
 }
 func (UnimplementedLightningServer) EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateFee not implemented")
 }
+func (UnimplementedLightningServer) GetFeeExposure(context.Context, *GetFeeExposureRequest) (*GetFeeExposureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeeExposure not implemented")
+}
 func (UnimplementedLightningServer) SendCoins(context.Context, *SendCoinsRequest) (*SendCoinsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendCoins not implemented")
 }
@@ -1569,9 +2308,18 @@ func (UnimplementedLightningServer) ConnectPeer(context.Context, *ConnectPeerReq
 func (UnimplementedLightningServer) DisconnectPeer(context.Context, *DisconnectPeerRequest) (*DisconnectPeerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisconnectPeer not implemented")
 }
+func (UnimplementedLightningServer) SetPeerBackoff(context.Context, *SetPeerBackoffRequest) (*SetPeerBackoffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPeerBackoff not implemented")
+}
 func (UnimplementedLightningServer) ListPeers(context.Context, *ListPeersRequest) (*ListPeersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPeers not implemented")
 }
+func (UnimplementedLightningServer) ResetFlapCount(context.Context, *ResetFlapCountRequest) (*ResetFlapCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetFlapCount not implemented")
+}
+func (UnimplementedLightningServer) GetPeerStats(context.Context, *GetPeerStatsRequest) (*GetPeerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerStats not implemented")
+}
 func (UnimplementedLightningServer) SubscribePeerEvents(*PeerEventSubscription, Lightning_SubscribePeerEventsServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribePeerEvents not implemented")
 }
@@ -1581,18 +2329,48 @@ func (UnimplementedLightningServer) GetInfo(context.Context, *GetInfoRequest) (*
 func (UnimplementedLightningServer) GetRecoveryInfo(context.Context, *GetRecoveryInfoRequest) (*GetRecoveryInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRecoveryInfo not implemented")
 }
+func (UnimplementedLightningServer) GetSyncProgress(context.Context, *GetSyncProgressRequest) (*GetSyncProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyncProgress not implemented")
+}
+func (UnimplementedLightningServer) GetReorgHistory(context.Context, *GetReorgHistoryRequest) (*GetReorgHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReorgHistory not implemented")
+}
+func (UnimplementedLightningServer) GetAdvertisedAddresses(context.Context, *GetAdvertisedAddressesRequest) (*GetAdvertisedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdvertisedAddresses not implemented")
+}
 func (UnimplementedLightningServer) PendingChannels(context.Context, *PendingChannelsRequest) (*PendingChannelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PendingChannels not implemented")
 }
 func (UnimplementedLightningServer) ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListChannels not implemented")
 }
+func (UnimplementedLightningServer) ChannelUptimeHistory(context.Context, *ChannelUptimeHistoryRequest) (*ChannelUptimeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelUptimeHistory not implemented")
+}
+func (UnimplementedLightningServer) GetCommitmentOutputs(context.Context, *GetCommitmentOutputsRequest) (*GetCommitmentOutputsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommitmentOutputs not implemented")
+}
+func (UnimplementedLightningServer) SetTargetLocalRatio(context.Context, *SetTargetLocalRatioRequest) (*SetTargetLocalRatioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTargetLocalRatio not implemented")
+}
+func (UnimplementedLightningServer) SetMaxHtlcHold(context.Context, *SetMaxHtlcHoldRequest) (*SetMaxHtlcHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxHtlcHold not implemented")
+}
+func (UnimplementedLightningServer) ListRoutableChannels(context.Context, *ListRoutableChannelsRequest) (*ListRoutableChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoutableChannels not implemented")
+}
 func (UnimplementedLightningServer) SubscribeChannelEvents(*ChannelEventSubscription, Lightning_SubscribeChannelEventsServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeChannelEvents not implemented")
 }
 func (UnimplementedLightningServer) ClosedChannels(context.Context, *ClosedChannelsRequest) (*ClosedChannelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClosedChannels not implemented")
 }
+func (UnimplementedLightningServer) PreviewForceCloseSweep(context.Context, *PreviewForceCloseSweepRequest) (*PreviewForceCloseSweepResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewForceCloseSweep not implemented")
+}
+func (UnimplementedLightningServer) ChannelOpenHistory(context.Context, *ChannelOpenHistoryRequest) (*ChannelOpenHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelOpenHistory not implemented")
+}
 func (UnimplementedLightningServer) OpenChannelSync(context.Context, *OpenChannelRequest) (*ChannelPoint, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OpenChannelSync not implemented")
 }
@@ -1605,6 +2383,27 @@ func (UnimplementedLightningServer) FundingStateStep(context.Context, *FundingTr
 func (UnimplementedLightningServer) ChannelAcceptor(Lightning_ChannelAcceptorServer) error {
 	return status.Errorf(codes.Unimplemented, "method ChannelAcceptor not implemented")
 }
+func (UnimplementedLightningServer) SetMinInboundChanSize(context.Context, *SetMinInboundChanSizeRequest) (*SetMinInboundChanSizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMinInboundChanSize not implemented")
+}
+func (UnimplementedLightningServer) SetCoopCloseFeeBand(context.Context, *SetCoopCloseFeeBandRequest) (*SetCoopCloseFeeBandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCoopCloseFeeBand not implemented")
+}
+func (UnimplementedLightningServer) SetSweepToAddress(context.Context, *SetSweepToAddressRequest) (*SetSweepToAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSweepToAddress not implemented")
+}
+func (UnimplementedLightningServer) GetMaxChanSize(context.Context, *GetMaxChanSizeRequest) (*GetMaxChanSizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaxChanSize not implemented")
+}
+func (UnimplementedLightningServer) SetSoftMaxChanSize(context.Context, *SetSoftMaxChanSizeRequest) (*SetSoftMaxChanSizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSoftMaxChanSize not implemented")
+}
+func (UnimplementedLightningServer) GetMaxTotalInFlight(context.Context, *GetMaxTotalInFlightRequest) (*GetMaxTotalInFlightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaxTotalInFlight not implemented")
+}
+func (UnimplementedLightningServer) SetMaxTotalInFlight(context.Context, *SetMaxTotalInFlightRequest) (*SetMaxTotalInFlightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxTotalInFlight not implemented")
+}
 func (UnimplementedLightningServer) CloseChannel(*CloseChannelRequest, Lightning_CloseChannelServer) error {
 	return status.Errorf(codes.Unimplemented, "method CloseChannel not implemented")
 }
@@ -1626,12 +2425,18 @@ func (UnimplementedLightningServer) SendToRouteSync(context.Context, *SendToRout
 func (UnimplementedLightningServer) AddInvoice(context.Context, *Invoice) (*AddInvoiceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddInvoice not implemented")
 }
+func (UnimplementedLightningServer) SetDefaultInvoiceExpiry(context.Context, *SetDefaultInvoiceExpiryRequest) (*SetDefaultInvoiceExpiryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDefaultInvoiceExpiry not implemented")
+}
 func (UnimplementedLightningServer) ListInvoices(context.Context, *ListInvoiceRequest) (*ListInvoiceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListInvoices not implemented")
 }
 func (UnimplementedLightningServer) LookupInvoice(context.Context, *PaymentHash) (*Invoice, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LookupInvoice not implemented")
 }
+func (UnimplementedLightningServer) LookupInvoices(context.Context, *LookupInvoicesRequest) (*LookupInvoicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupInvoices not implemented")
+}
 func (UnimplementedLightningServer) SubscribeInvoices(*InvoiceSubscription, Lightning_SubscribeInvoicesServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeInvoices not implemented")
 }
@@ -1653,6 +2458,9 @@ func (UnimplementedLightningServer) GetNodeMetrics(context.Context, *NodeMetrics
 func (UnimplementedLightningServer) GetChanInfo(context.Context, *ChanInfoRequest) (*ChannelEdge, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChanInfo not implemented")
 }
+func (UnimplementedLightningServer) GetChanPolicies(context.Context, *ChanInfoRequest) (*ChanPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChanPolicies not implemented")
+}
 func (UnimplementedLightningServer) GetNodeInfo(context.Context, *NodeInfoRequest) (*NodeInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNodeInfo not implemented")
 }
@@ -1662,6 +2470,12 @@ func (UnimplementedLightningServer) QueryRoutes(context.Context, *QueryRoutesReq
 func (UnimplementedLightningServer) GetNetworkInfo(context.Context, *NetworkInfoRequest) (*NetworkInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkInfo not implemented")
 }
+func (UnimplementedLightningServer) ListZombieChannels(context.Context, *ListZombieChannelsRequest) (*ListZombieChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListZombieChannels not implemented")
+}
+func (UnimplementedLightningServer) RestoreZombieChannel(context.Context, *RestoreZombieChannelRequest) (*RestoreZombieChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreZombieChannel not implemented")
+}
 func (UnimplementedLightningServer) StopDaemon(context.Context, *StopRequest) (*StopResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopDaemon not implemented")
 }
@@ -1671,15 +2485,39 @@ func (UnimplementedLightningServer) SubscribeChannelGraph(*GraphTopologySubscrip
 func (UnimplementedLightningServer) DebugLevel(context.Context, *DebugLevelRequest) (*DebugLevelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DebugLevel not implemented")
 }
+func (UnimplementedLightningServer) GetGossipBatchParams(context.Context, *GetGossipBatchParamsRequest) (*GetGossipBatchParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGossipBatchParams not implemented")
+}
+func (UnimplementedLightningServer) SetGossipBatchParams(context.Context, *SetGossipBatchParamsRequest) (*SetGossipBatchParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGossipBatchParams not implemented")
+}
 func (UnimplementedLightningServer) FeeReport(context.Context, *FeeReportRequest) (*FeeReportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FeeReport not implemented")
 }
+func (UnimplementedLightningServer) OnChainFeeReport(context.Context, *OnChainFeeReportRequest) (*OnChainFeeReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnChainFeeReport not implemented")
+}
 func (UnimplementedLightningServer) UpdateChannelPolicy(context.Context, *PolicyUpdateRequest) (*PolicyUpdateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannelPolicy not implemented")
 }
 func (UnimplementedLightningServer) ForwardingHistory(context.Context, *ForwardingHistoryRequest) (*ForwardingHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForwardingHistory not implemented")
 }
+func (UnimplementedLightningServer) ChannelPolicyHistory(context.Context, *ChannelPolicyHistoryRequest) (*ChannelPolicyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelPolicyHistory not implemented")
+}
+func (UnimplementedLightningServer) GetSwitchInfo(context.Context, *GetSwitchInfoRequest) (*GetSwitchInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSwitchInfo not implemented")
+}
+func (UnimplementedLightningServer) ForwardingFailureStats(context.Context, *ForwardingFailureStatsRequest) (*ForwardingFailureStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardingFailureStats not implemented")
+}
+func (UnimplementedLightningServer) ResetForwardingFailureStats(context.Context, *ResetForwardingFailureStatsRequest) (*ResetForwardingFailureStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetForwardingFailureStats not implemented")
+}
+func (UnimplementedLightningServer) LookupHtlcCircuit(context.Context, *LookupHtlcCircuitRequest) (*LookupHtlcCircuitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupHtlcCircuit not implemented")
+}
 func (UnimplementedLightningServer) ExportChannelBackup(context.Context, *ExportChannelBackupRequest) (*ChannelBackup, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExportChannelBackup not implemented")
 }
@@ -1756,26 +2594,44 @@ func _Lightning_ChannelBalance_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionsRequest)
+func _Lightning_InboundLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InboundLiquidityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).GetTransactions(ctx, in)
+		return srv.(LightningServer).InboundLiquidity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/GetTransactions",
+		FullMethod: "/lnrpc.Lightning/InboundLiquidity",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).GetTransactions(ctx, req.(*GetTransactionsRequest))
+		return srv.(LightningServer).InboundLiquidity(ctx, req.(*InboundLiquidityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateFeeRequest)
+func _Lightning_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetTransactions(ctx, req.(*GetTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateFeeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1792,6 +2648,24 @@ func _Lightning_EstimateFee_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_GetFeeExposure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeeExposureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetFeeExposure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetFeeExposure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetFeeExposure(ctx, req.(*GetFeeExposureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_SendCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendCoinsRequest)
 	if err := dec(in); err != nil {
@@ -1957,6 +2831,24 @@ func _Lightning_DisconnectPeer_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_SetPeerBackoff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPeerBackoffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SetPeerBackoff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SetPeerBackoff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SetPeerBackoff(ctx, req.(*SetPeerBackoffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListPeersRequest)
 	if err := dec(in); err != nil {
@@ -1975,6 +2867,42 @@ func _Lightning_ListPeers_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_ResetFlapCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetFlapCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ResetFlapCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ResetFlapCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ResetFlapCount(ctx, req.(*ResetFlapCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_GetPeerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPeerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetPeerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetPeerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetPeerStats(ctx, req.(*GetPeerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_SubscribePeerEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(PeerEventSubscription)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2032,6 +2960,60 @@ func _Lightning_GetRecoveryInfo_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_GetSyncProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSyncProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetSyncProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetSyncProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetSyncProgress(ctx, req.(*GetSyncProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_GetReorgHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReorgHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetReorgHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetReorgHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetReorgHistory(ctx, req.(*GetReorgHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_GetAdvertisedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdvertisedAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetAdvertisedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetAdvertisedAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetAdvertisedAddresses(ctx, req.(*GetAdvertisedAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_PendingChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PendingChannelsRequest)
 	if err := dec(in); err != nil {
@@ -2068,6 +3050,96 @@ func _Lightning_ListChannels_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_ChannelUptimeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelUptimeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ChannelUptimeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ChannelUptimeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ChannelUptimeHistory(ctx, req.(*ChannelUptimeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_GetCommitmentOutputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommitmentOutputsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetCommitmentOutputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetCommitmentOutputs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetCommitmentOutputs(ctx, req.(*GetCommitmentOutputsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SetTargetLocalRatio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTargetLocalRatioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SetTargetLocalRatio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SetTargetLocalRatio",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SetTargetLocalRatio(ctx, req.(*SetTargetLocalRatioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SetMaxHtlcHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMaxHtlcHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SetMaxHtlcHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SetMaxHtlcHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SetMaxHtlcHold(ctx, req.(*SetMaxHtlcHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListRoutableChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoutableChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListRoutableChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListRoutableChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListRoutableChannels(ctx, req.(*ListRoutableChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_SubscribeChannelEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ChannelEventSubscription)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2107,6 +3179,42 @@ func _Lightning_ClosedChannels_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_PreviewForceCloseSweep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewForceCloseSweepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).PreviewForceCloseSweep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/PreviewForceCloseSweep",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).PreviewForceCloseSweep(ctx, req.(*PreviewForceCloseSweepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ChannelOpenHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelOpenHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ChannelOpenHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ChannelOpenHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ChannelOpenHistory(ctx, req.(*ChannelOpenHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_OpenChannelSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OpenChannelRequest)
 	if err := dec(in); err != nil {
@@ -2182,12 +3290,138 @@ func (x *lightningChannelAcceptorServer) Send(m *ChannelAcceptRequest) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func (x *lightningChannelAcceptorServer) Recv() (*ChannelAcceptResponse, error) {
-	m := new(ChannelAcceptResponse)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func (x *lightningChannelAcceptorServer) Recv() (*ChannelAcceptResponse, error) {
+	m := new(ChannelAcceptResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Lightning_SetMinInboundChanSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMinInboundChanSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SetMinInboundChanSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SetMinInboundChanSize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SetMinInboundChanSize(ctx, req.(*SetMinInboundChanSizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SetCoopCloseFeeBand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCoopCloseFeeBandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SetCoopCloseFeeBand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SetCoopCloseFeeBand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SetCoopCloseFeeBand(ctx, req.(*SetCoopCloseFeeBandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SetSweepToAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSweepToAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SetSweepToAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SetSweepToAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SetSweepToAddress(ctx, req.(*SetSweepToAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_GetMaxChanSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaxChanSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetMaxChanSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetMaxChanSize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetMaxChanSize(ctx, req.(*GetMaxChanSizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SetSoftMaxChanSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSoftMaxChanSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SetSoftMaxChanSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SetSoftMaxChanSize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SetSoftMaxChanSize(ctx, req.(*SetSoftMaxChanSizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_GetMaxTotalInFlight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaxTotalInFlightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetMaxTotalInFlight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetMaxTotalInFlight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetMaxTotalInFlight(ctx, req.(*GetMaxTotalInFlightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SetMaxTotalInFlight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMaxTotalInFlightRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(LightningServer).SetMaxTotalInFlight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SetMaxTotalInFlight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SetMaxTotalInFlight(ctx, req.(*SetMaxTotalInFlightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
 func _Lightning_CloseChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
@@ -2335,6 +3569,24 @@ func _Lightning_AddInvoice_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_SetDefaultInvoiceExpiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDefaultInvoiceExpiryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SetDefaultInvoiceExpiry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SetDefaultInvoiceExpiry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SetDefaultInvoiceExpiry(ctx, req.(*SetDefaultInvoiceExpiryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_ListInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListInvoiceRequest)
 	if err := dec(in); err != nil {
@@ -2371,6 +3623,24 @@ func _Lightning_LookupInvoice_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_LookupInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupInvoicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).LookupInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/LookupInvoices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).LookupInvoices(ctx, req.(*LookupInvoicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_SubscribeInvoices_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(InvoiceSubscription)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2500,6 +3770,24 @@ func _Lightning_GetChanInfo_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_GetChanPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChanInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetChanPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetChanPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetChanPolicies(ctx, req.(*ChanInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_GetNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NodeInfoRequest)
 	if err := dec(in); err != nil {
@@ -2554,6 +3842,42 @@ func _Lightning_GetNetworkInfo_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_ListZombieChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListZombieChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListZombieChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListZombieChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListZombieChannels(ctx, req.(*ListZombieChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_RestoreZombieChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreZombieChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).RestoreZombieChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/RestoreZombieChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).RestoreZombieChannel(ctx, req.(*RestoreZombieChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_StopDaemon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StopRequest)
 	if err := dec(in); err != nil {
@@ -2611,6 +3935,42 @@ func _Lightning_DebugLevel_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_GetGossipBatchParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGossipBatchParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetGossipBatchParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetGossipBatchParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetGossipBatchParams(ctx, req.(*GetGossipBatchParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SetGossipBatchParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGossipBatchParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SetGossipBatchParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SetGossipBatchParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SetGossipBatchParams(ctx, req.(*SetGossipBatchParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_FeeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FeeReportRequest)
 	if err := dec(in); err != nil {
@@ -2629,6 +3989,24 @@ func _Lightning_FeeReport_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_OnChainFeeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnChainFeeReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).OnChainFeeReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/OnChainFeeReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).OnChainFeeReport(ctx, req.(*OnChainFeeReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_UpdateChannelPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PolicyUpdateRequest)
 	if err := dec(in); err != nil {
@@ -2665,6 +4043,96 @@ func _Lightning_ForwardingHistory_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_ChannelPolicyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelPolicyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ChannelPolicyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ChannelPolicyHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ChannelPolicyHistory(ctx, req.(*ChannelPolicyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_GetSwitchInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSwitchInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetSwitchInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetSwitchInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetSwitchInfo(ctx, req.(*GetSwitchInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ForwardingFailureStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForwardingFailureStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ForwardingFailureStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ForwardingFailureStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ForwardingFailureStats(ctx, req.(*ForwardingFailureStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ResetForwardingFailureStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetForwardingFailureStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ResetForwardingFailureStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ResetForwardingFailureStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ResetForwardingFailureStats(ctx, req.(*ResetForwardingFailureStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_LookupHtlcCircuit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupHtlcCircuitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).LookupHtlcCircuit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/LookupHtlcCircuit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).LookupHtlcCircuit(ctx, req.(*LookupHtlcCircuitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_ExportChannelBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExportChannelBackupRequest)
 	if err := dec(in); err != nil {
@@ -2845,6 +4313,10 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChannelBalance",
 			Handler:    _Lightning_ChannelBalance_Handler,
 		},
+		{
+			MethodName: "InboundLiquidity",
+			Handler:    _Lightning_InboundLiquidity_Handler,
+		},
 		{
 			MethodName: "GetTransactions",
 			Handler:    _Lightning_GetTransactions_Handler,
@@ -2853,6 +4325,10 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateFee",
 			Handler:    _Lightning_EstimateFee_Handler,
 		},
+		{
+			MethodName: "GetFeeExposure",
+			Handler:    _Lightning_GetFeeExposure_Handler,
+		},
 		{
 			MethodName: "SendCoins",
 			Handler:    _Lightning_SendCoins_Handler,
@@ -2885,10 +4361,22 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DisconnectPeer",
 			Handler:    _Lightning_DisconnectPeer_Handler,
 		},
+		{
+			MethodName: "SetPeerBackoff",
+			Handler:    _Lightning_SetPeerBackoff_Handler,
+		},
 		{
 			MethodName: "ListPeers",
 			Handler:    _Lightning_ListPeers_Handler,
 		},
+		{
+			MethodName: "ResetFlapCount",
+			Handler:    _Lightning_ResetFlapCount_Handler,
+		},
+		{
+			MethodName: "GetPeerStats",
+			Handler:    _Lightning_GetPeerStats_Handler,
+		},
 		{
 			MethodName: "GetInfo",
 			Handler:    _Lightning_GetInfo_Handler,
@@ -2897,6 +4385,18 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRecoveryInfo",
 			Handler:    _Lightning_GetRecoveryInfo_Handler,
 		},
+		{
+			MethodName: "GetSyncProgress",
+			Handler:    _Lightning_GetSyncProgress_Handler,
+		},
+		{
+			MethodName: "GetReorgHistory",
+			Handler:    _Lightning_GetReorgHistory_Handler,
+		},
+		{
+			MethodName: "GetAdvertisedAddresses",
+			Handler:    _Lightning_GetAdvertisedAddresses_Handler,
+		},
 		{
 			MethodName: "PendingChannels",
 			Handler:    _Lightning_PendingChannels_Handler,
@@ -2905,10 +4405,38 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListChannels",
 			Handler:    _Lightning_ListChannels_Handler,
 		},
+		{
+			MethodName: "ChannelUptimeHistory",
+			Handler:    _Lightning_ChannelUptimeHistory_Handler,
+		},
+		{
+			MethodName: "GetCommitmentOutputs",
+			Handler:    _Lightning_GetCommitmentOutputs_Handler,
+		},
+		{
+			MethodName: "SetTargetLocalRatio",
+			Handler:    _Lightning_SetTargetLocalRatio_Handler,
+		},
+		{
+			MethodName: "SetMaxHtlcHold",
+			Handler:    _Lightning_SetMaxHtlcHold_Handler,
+		},
+		{
+			MethodName: "ListRoutableChannels",
+			Handler:    _Lightning_ListRoutableChannels_Handler,
+		},
 		{
 			MethodName: "ClosedChannels",
 			Handler:    _Lightning_ClosedChannels_Handler,
 		},
+		{
+			MethodName: "PreviewForceCloseSweep",
+			Handler:    _Lightning_PreviewForceCloseSweep_Handler,
+		},
+		{
+			MethodName: "ChannelOpenHistory",
+			Handler:    _Lightning_ChannelOpenHistory_Handler,
+		},
 		{
 			MethodName: "OpenChannelSync",
 			Handler:    _Lightning_OpenChannelSync_Handler,
@@ -2917,6 +4445,34 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FundingStateStep",
 			Handler:    _Lightning_FundingStateStep_Handler,
 		},
+		{
+			MethodName: "SetMinInboundChanSize",
+			Handler:    _Lightning_SetMinInboundChanSize_Handler,
+		},
+		{
+			MethodName: "SetCoopCloseFeeBand",
+			Handler:    _Lightning_SetCoopCloseFeeBand_Handler,
+		},
+		{
+			MethodName: "SetSweepToAddress",
+			Handler:    _Lightning_SetSweepToAddress_Handler,
+		},
+		{
+			MethodName: "GetMaxChanSize",
+			Handler:    _Lightning_GetMaxChanSize_Handler,
+		},
+		{
+			MethodName: "SetSoftMaxChanSize",
+			Handler:    _Lightning_SetSoftMaxChanSize_Handler,
+		},
+		{
+			MethodName: "GetMaxTotalInFlight",
+			Handler:    _Lightning_GetMaxTotalInFlight_Handler,
+		},
+		{
+			MethodName: "SetMaxTotalInFlight",
+			Handler:    _Lightning_SetMaxTotalInFlight_Handler,
+		},
 		{
 			MethodName: "AbandonChannel",
 			Handler:    _Lightning_AbandonChannel_Handler,
@@ -2933,6 +4489,10 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddInvoice",
 			Handler:    _Lightning_AddInvoice_Handler,
 		},
+		{
+			MethodName: "SetDefaultInvoiceExpiry",
+			Handler:    _Lightning_SetDefaultInvoiceExpiry_Handler,
+		},
 		{
 			MethodName: "ListInvoices",
 			Handler:    _Lightning_ListInvoices_Handler,
@@ -2941,6 +4501,10 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LookupInvoice",
 			Handler:    _Lightning_LookupInvoice_Handler,
 		},
+		{
+			MethodName: "LookupInvoices",
+			Handler:    _Lightning_LookupInvoices_Handler,
+		},
 		{
 			MethodName: "DecodePayReq",
 			Handler:    _Lightning_DecodePayReq_Handler,
@@ -2965,6 +4529,10 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetChanInfo",
 			Handler:    _Lightning_GetChanInfo_Handler,
 		},
+		{
+			MethodName: "GetChanPolicies",
+			Handler:    _Lightning_GetChanPolicies_Handler,
+		},
 		{
 			MethodName: "GetNodeInfo",
 			Handler:    _Lightning_GetNodeInfo_Handler,
@@ -2977,6 +4545,14 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNetworkInfo",
 			Handler:    _Lightning_GetNetworkInfo_Handler,
 		},
+		{
+			MethodName: "ListZombieChannels",
+			Handler:    _Lightning_ListZombieChannels_Handler,
+		},
+		{
+			MethodName: "RestoreZombieChannel",
+			Handler:    _Lightning_RestoreZombieChannel_Handler,
+		},
 		{
 			MethodName: "StopDaemon",
 			Handler:    _Lightning_StopDaemon_Handler,
@@ -2985,10 +4561,22 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DebugLevel",
 			Handler:    _Lightning_DebugLevel_Handler,
 		},
+		{
+			MethodName: "GetGossipBatchParams",
+			Handler:    _Lightning_GetGossipBatchParams_Handler,
+		},
+		{
+			MethodName: "SetGossipBatchParams",
+			Handler:    _Lightning_SetGossipBatchParams_Handler,
+		},
 		{
 			MethodName: "FeeReport",
 			Handler:    _Lightning_FeeReport_Handler,
 		},
+		{
+			MethodName: "OnChainFeeReport",
+			Handler:    _Lightning_OnChainFeeReport_Handler,
+		},
 		{
 			MethodName: "UpdateChannelPolicy",
 			Handler:    _Lightning_UpdateChannelPolicy_Handler,
@@ -2997,6 +4585,26 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ForwardingHistory",
 			Handler:    _Lightning_ForwardingHistory_Handler,
 		},
+		{
+			MethodName: "ChannelPolicyHistory",
+			Handler:    _Lightning_ChannelPolicyHistory_Handler,
+		},
+		{
+			MethodName: "GetSwitchInfo",
+			Handler:    _Lightning_GetSwitchInfo_Handler,
+		},
+		{
+			MethodName: "ForwardingFailureStats",
+			Handler:    _Lightning_ForwardingFailureStats_Handler,
+		},
+		{
+			MethodName: "ResetForwardingFailureStats",
+			Handler:    _Lightning_ResetForwardingFailureStats_Handler,
+		},
+		{
+			MethodName: "LookupHtlcCircuit",
+			Handler:    _Lightning_LookupHtlcCircuit_Handler,
+		},
 		{
 			MethodName: "ExportChannelBackup",
 			Handler:    _Lightning_ExportChannelBackup_Handler,
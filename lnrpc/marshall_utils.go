@@ -9,6 +9,7 @@ import (
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
@@ -80,6 +81,26 @@ func ParseConfs(min, max int32) (int32, int32, error) {
 	}
 }
 
+// MarshalFeeEstimateSource returns the RPC representation of the source of a
+// fee estimate.
+func MarshalFeeEstimateSource(
+	source chainfee.EstimateSource) FeeEstimateSource {
+
+	switch source {
+	case chainfee.EstimateSourceBackend:
+		return FeeEstimateSource_BACKEND_ESTIMATE
+
+	case chainfee.EstimateSourceFallback:
+		return FeeEstimateSource_FALLBACK_FEE
+
+	case chainfee.EstimateSourceStatic:
+		return FeeEstimateSource_STATIC_FEE
+
+	default:
+		return FeeEstimateSource_UNKNOWN_FEE_SOURCE
+	}
+}
+
 // MarshalUtxos translates a []*lnwallet.Utxo into a []*lnrpc.Utxo.
 func MarshalUtxos(utxos []*lnwallet.Utxo, activeNetParams *chaincfg.Params) (
 	[]*Utxo, error) {
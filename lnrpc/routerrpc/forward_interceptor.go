@@ -19,6 +19,10 @@ var (
 	// ErrMissingPreimage is an error returned when the caller tries to settle
 	// a forward and doesn't provide a preimage.
 	ErrMissingPreimage = errors.New("missing preimage")
+
+	// ErrInvalidPreimage is an error returned when the caller tries to
+	// settle a forward with a preimage that doesn't match its hash.
+	ErrInvalidPreimage = errors.New("preimage does not match hash")
 )
 
 // forwardInterceptor is a helper struct that handles the lifecycle of an rpc
@@ -191,11 +195,15 @@ func (r *forwardInterceptor) resolveFromClient(
 	case ResolveHoldForwardAction_FAIL:
 		return interceptedForward.Fail()
 	case ResolveHoldForwardAction_SETTLE:
-		if in.Preimage == nil {
-			return ErrMissingPreimage
-		}
-		preimage, err := lntypes.MakePreimage(in.Preimage)
+		preimage, err := settlePreimage(interceptedForward, in.Preimage)
 		if err != nil {
+			// The forward is no longer held, so we fail it back
+			// instead of leaving it unresolved until it expires.
+			failErr := interceptedForward.Fail()
+			if failErr != nil {
+				log.Errorf("failed to fail back forward with "+
+					"invalid settle: %v", failErr)
+			}
 			return err
 		}
 		return interceptedForward.Settle(preimage)
@@ -204,6 +212,27 @@ func (r *forwardInterceptor) resolveFromClient(
 	}
 }
 
+// settlePreimage validates the preimage that the client provided to settle the
+// given forward.
+func settlePreimage(forward htlcswitch.InterceptedForward,
+	rawPreimage []byte) (lntypes.Preimage, error) {
+
+	if rawPreimage == nil {
+		return lntypes.Preimage{}, ErrMissingPreimage
+	}
+
+	preimage, err := lntypes.MakePreimage(rawPreimage)
+	if err != nil {
+		return lntypes.Preimage{}, err
+	}
+
+	if !preimage.Matches(forward.Packet().Hash) {
+		return lntypes.Preimage{}, ErrInvalidPreimage
+	}
+
+	return preimage, nil
+}
+
 // onDisconnect removes all previousely held forwards from
 // the store. Before they are removed it ensure to resume as the default
 // behavior.
@@ -0,0 +1,136 @@
+package routerrpc
+
+import (
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+const (
+	// defaultAttemptLatency is the assumed latency of an HTLC attempt if
+	// none of the attempts of a payment have been resolved yet.
+	defaultAttemptLatency = 5 * time.Second
+
+	// heldHtlcLatencyFactor is the multiple of the expected attempt
+	// latency after which an in-flight HTLC is assumed to be held by the
+	// recipient.
+	heldHtlcLatencyFactor = 10
+
+	// minHeldHtlcDuration is the minimum duration an HTLC needs to be in
+	// flight before it is assumed to be held by the recipient.
+	minHeldHtlcDuration = time.Minute
+
+	// maxEstimatedRetries is the maximum number of additional attempts we
+	// account for when estimating the remaining time of a payment.
+	maxEstimatedRetries = 10
+)
+
+// estimateCompletion estimates the remaining time until the given in-flight
+// payment completes. The estimate is based on the average latency of the
+// payment's resolved HTLC attempts, the time its in-flight HTLCs have already
+// been outstanding, and the number of additional attempts that are expected
+// given the failure rate of the payment so far. If an HTLC has been in flight
+// for much longer than the expected latency, it is assumed to be held by the
+// recipient, in which case no estimate can be made and false is returned.
+func estimateCompletion(payment *channeldb.MPPayment,
+	now time.Time) (time.Duration, bool) {
+
+	// Determine the average latency of the resolved attempts, as well as
+	// the share of them that failed.
+	var (
+		totalLatency time.Duration
+		numResolved  int
+		numFailed    int
+	)
+	for _, htlc := range payment.HTLCs {
+		switch {
+		case htlc.Settle != nil:
+			totalLatency += htlc.Settle.SettleTime.Sub(
+				htlc.AttemptTime,
+			)
+
+		case htlc.Failure != nil:
+			totalLatency += htlc.Failure.FailTime.Sub(
+				htlc.AttemptTime,
+			)
+			numFailed++
+
+		default:
+			continue
+		}
+
+		numResolved++
+	}
+
+	latency := defaultAttemptLatency
+	if numResolved > 0 && totalLatency > 0 {
+		latency = totalLatency / time.Duration(numResolved)
+	}
+
+	heldThreshold := latency * heldHtlcLatencyFactor
+	if heldThreshold < minHeldHtlcDuration {
+		heldThreshold = minHeldHtlcDuration
+	}
+
+	// The in-flight HTLCs are expected to resolve after the average
+	// latency. If one of them is outstanding for much longer than that,
+	// it is likely held by the recipient, and there's no telling when it
+	// will be resolved.
+	inFlight := payment.InFlightHTLCs()
+	var remaining time.Duration
+	for _, htlc := range inFlight {
+		elapsed := now.Sub(htlc.AttemptTime)
+		if elapsed > heldThreshold {
+			return 0, false
+		}
+
+		if latency-elapsed > remaining {
+			remaining = latency - elapsed
+		}
+	}
+
+	// If there are no HTLCs in flight, at least one more attempt is
+	// required to complete the payment.
+	if len(inFlight) == 0 {
+		remaining = latency
+	}
+
+	// Account for the additional attempts we expect to make based on the
+	// failure rate of the attempts so far. With a failure rate of p, the
+	// expected number of retries is p / (1 - p).
+	retries := float64(0)
+	switch {
+	case numFailed == 0:
+
+	case numFailed == numResolved:
+		retries = maxEstimatedRetries
+
+	default:
+		failRate := float64(numFailed) / float64(numResolved)
+		retries = failRate / (1 - failRate)
+		if retries > maxEstimatedRetries {
+			retries = maxEstimatedRetries
+		}
+	}
+	remaining += time.Duration(retries * float64(latency))
+
+	return remaining, true
+}
+
+// marshallCompletionEstimate estimates the remaining time of the given
+// in-flight payment and converts it into its RPC representation.
+func marshallCompletionEstimate(
+	payment *channeldb.MPPayment) *lnrpc.PaymentCompletionEstimate {
+
+	remaining, ok := estimateCompletion(payment, time.Now())
+	if !ok {
+		return &lnrpc.PaymentCompletionEstimate{
+			Indefinite: true,
+		}
+	}
+
+	return &lnrpc.PaymentCompletionEstimate{
+		RemainingNs: remaining.Nanoseconds(),
+	}
+}
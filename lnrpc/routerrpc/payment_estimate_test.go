@@ -0,0 +1,119 @@
+package routerrpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEstimateCompletion tests the estimation of the remaining time of an
+// in-flight payment.
+func TestEstimateCompletion(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+
+	inFlight := func(elapsed time.Duration) channeldb.HTLCAttempt {
+		return channeldb.HTLCAttempt{
+			HTLCAttemptInfo: channeldb.HTLCAttemptInfo{
+				AttemptTime: now.Add(-elapsed),
+			},
+		}
+	}
+
+	failed := func(latency time.Duration) channeldb.HTLCAttempt {
+		attemptTime := now.Add(-time.Hour)
+		return channeldb.HTLCAttempt{
+			HTLCAttemptInfo: channeldb.HTLCAttemptInfo{
+				AttemptTime: attemptTime,
+			},
+			Failure: &channeldb.HTLCFailInfo{
+				FailTime: attemptTime.Add(latency),
+			},
+		}
+	}
+
+	settled := func(latency time.Duration) channeldb.HTLCAttempt {
+		attemptTime := now.Add(-time.Hour)
+		return channeldb.HTLCAttempt{
+			HTLCAttemptInfo: channeldb.HTLCAttemptInfo{
+				AttemptTime: attemptTime,
+			},
+			Settle: &channeldb.HTLCSettleInfo{
+				SettleTime: attemptTime.Add(latency),
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		htlcs      []channeldb.HTLCAttempt
+		expected   time.Duration
+		indefinite bool
+	}{
+		{
+			name:     "no attempts",
+			expected: defaultAttemptLatency,
+		},
+		{
+			name: "single in-flight attempt",
+			htlcs: []channeldb.HTLCAttempt{
+				inFlight(2 * time.Second),
+			},
+			expected: defaultAttemptLatency - 2*time.Second,
+		},
+		{
+			name: "overdue in-flight attempt",
+			htlcs: []channeldb.HTLCAttempt{
+				inFlight(10 * time.Second),
+			},
+			expected: 0,
+		},
+		{
+			name: "retry after failure",
+			htlcs: []channeldb.HTLCAttempt{
+				failed(2 * time.Second),
+				settled(4 * time.Second),
+				inFlight(time.Second),
+			},
+
+			// The average latency is 3s, of which 1s has elapsed.
+			// With a failure rate of 1/2, we expect one retry.
+			expected: 2*time.Second + 3*time.Second,
+		},
+		{
+			name: "all attempts failed",
+			htlcs: []channeldb.HTLCAttempt{
+				failed(time.Second),
+			},
+			expected: time.Second + maxEstimatedRetries*time.Second,
+		},
+		{
+			name: "held htlc",
+			htlcs: []channeldb.HTLCAttempt{
+				settled(time.Second),
+				inFlight(2 * time.Minute),
+			},
+			indefinite: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			payment := &channeldb.MPPayment{
+				HTLCs:  test.htlcs,
+				Status: channeldb.StatusInFlight,
+			}
+
+			remaining, ok := estimateCompletion(payment, now)
+			require.Equal(t, !test.indefinite, ok)
+			require.Equal(t, test.expected, remaining)
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	sphinx "github.com/lightningnetwork/lightning-onion"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/feature"
 	"github.com/lightningnetwork/lnd/htlcswitch"
@@ -117,6 +118,10 @@ type MissionControl interface {
 	// persisted across restarts.
 	ImportHistory(*routing.MissionControlSnapshot) error
 
+	// GetPairObservations returns the recent raw payment results per node
+	// pair. Pairs without results are omitted.
+	GetPairObservations() routing.PairObservations
+
 	// GetPairHistorySnapshot returns the stored history for a given node
 	// pair.
 	GetPairHistorySnapshot(fromNode,
@@ -170,6 +175,11 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 		sourcePubKey = r.SelfNode
 	}
 
+	// Circular routes are only returned if explicitly requested.
+	if !in.AllowSelfPayment && targetPubKey == sourcePubKey {
+		return nil, errors.New("self-payments not allowed")
+	}
+
 	// Currently, within the bootstrap phase of the network, we limit the
 	// largest payment size allotted to (2^32) - 1 mSAT or 4.29 million
 	// satoshis.
@@ -248,6 +258,16 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 
 	cltvLimit -= uint32(finalCLTVDelta)
 
+	err = validateMinRouteProbability(in.MinRouteProbability)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateMaxRouteHops(in.MaxRouteHops)
+	if err != nil {
+		return nil, err
+	}
+
 	// Parse destination feature bits.
 	features, err := UnmarshalFeatures(in.DestFeatures)
 	if err != nil {
@@ -282,6 +302,8 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 		DestCustomRecords: record.CustomSet(in.DestCustomRecords),
 		CltvLimit:         cltvLimit,
 		DestFeatures:      features,
+		MinProbability:    in.MinRouteProbability,
+		MaxHops:           in.MaxRouteHops,
 	}
 
 	// Pass along an outgoing channel restriction if specified.
@@ -402,6 +424,7 @@ func (r *RouterBackend) MarshallRoute(route *route.Route) (*lnrpc.Route, error)
 		TotalAmt:      int64(route.TotalAmount.ToSatoshis()),
 		TotalAmtMsat:  int64(route.TotalAmount),
 		Hops:          make([]*lnrpc.Hop, len(route.Hops)),
+		IsCircular:    route.IsCircular(),
 	}
 	incomingAmt := route.TotalAmount
 	for i, hop := range route.Hops {
@@ -574,6 +597,19 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		)
 	}
 
+	// Pass along the balances that are known to be available on the
+	// outgoing channels.
+	if len(rpcPayReq.OutgoingChanCapacityHints) > 0 {
+		payIntent.OutgoingChanCapacityHints = make(
+			map[uint64]lnwire.MilliSatoshi,
+			len(rpcPayReq.OutgoingChanCapacityHints),
+		)
+		for chanID, hint := range rpcPayReq.OutgoingChanCapacityHints {
+			payIntent.OutgoingChanCapacityHints[chanID] =
+				lnwire.MilliSatoshi(hint)
+		}
+	}
+
 	// Pass along a last hop restriction if specified.
 	if len(rpcPayReq.LastHopPubkey) > 0 {
 		lastHop, err := route.NewVertexFromBytes(
@@ -585,6 +621,25 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		payIntent.LastHop = &lastHop
 	}
 
+	// Pass along an intermediary node to route through if specified.
+	if len(rpcPayReq.IntermediaryNode) > 0 {
+		intermediary, err := route.NewVertexFromBytes(
+			rpcPayReq.IntermediaryNode,
+		)
+		if err != nil {
+			return nil, err
+		}
+		payIntent.Intermediary = &intermediary
+	}
+
+	// Pass along a buffer for the time lock of the first hop if specified.
+	if rpcPayReq.FirstHopCltvDelta > math.MaxUint16 {
+		return nil, fmt.Errorf("first hop cltv delta %v exceeds "+
+			"maximum of %v", rpcPayReq.FirstHopCltvDelta,
+			math.MaxUint16)
+	}
+	payIntent.FirstHopCltvDelta = uint16(rpcPayReq.FirstHopCltvDelta)
+
 	// Take the CLTV limit from the request if set, otherwise use the max.
 	cltvLimit, err := ValidateCLTVLimit(
 		uint32(rpcPayReq.CltvLimit), r.MaxTotalTimelock,
@@ -619,6 +674,30 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		return nil, err
 	}
 
+	// Take the optional cost function from the request.
+	if rpcPayReq.MaxCostFunction != nil {
+		payIntent.CostFunction, err = unmarshallCostFunction(
+			rpcPayReq.MaxCostFunction,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Take the optional minimum route probability from the request.
+	err = validateMinRouteProbability(rpcPayReq.MinRouteProbability)
+	if err != nil {
+		return nil, err
+	}
+	payIntent.MinProbability = rpcPayReq.MinRouteProbability
+
+	// Take the optional maximum number of route hops from the request.
+	err = validateMaxRouteHops(rpcPayReq.MaxRouteHops)
+	if err != nil {
+		return nil, err
+	}
+	payIntent.MaxHops = rpcPayReq.MaxRouteHops
+
 	// Set payment attempt timeout.
 	if rpcPayReq.TimeoutSeconds == 0 {
 		return nil, errors.New("timeout_seconds must be specified")
@@ -650,6 +729,24 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		return nil, err
 	}
 
+	// Keep track of whether the receiver accepts any amount, which allows
+	// rounding up the amount to send without a bound.
+	var anyAmount bool
+
+	// A payment hash override replaces the hash of an invoice, which won't
+	// be settled by the payment. So it must be explicitly acknowledged.
+	if len(rpcPayReq.PaymentHashOverride) > 0 {
+		switch {
+		case rpcPayReq.PaymentRequest == "":
+			return nil, errors.New("payment_hash_override " +
+				"requires payment_request")
+
+		case !rpcPayReq.UnsafeAllowHashOverride:
+			return nil, errors.New("payment_hash_override " +
+				"requires unsafe_allow_hash_override")
+		}
+	}
+
 	// If the payment request field isn't blank, then the details of the
 	// invoice are encoded entirely within the encoded payReq.  So we'll
 	// attempt to decode it, populating the payment accordingly.
@@ -676,6 +773,14 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 			return nil, err
 		}
 
+		// The payment hash of an AMP payment is derived from the shares
+		// of each shard, so it can't be overridden.
+		isAMP := payReq.Features.HasFeature(lnwire.AMPOptional)
+		if len(rpcPayReq.PaymentHashOverride) > 0 && isAMP {
+			return nil, errors.New("payment_hash_override is not " +
+				"supported for AMP invoices")
+		}
+
 		// Next, we'll ensure that this payreq hasn't already expired.
 		err = ValidatePayReqExpiry(payReq)
 		if err != nil {
@@ -694,6 +799,7 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 			}
 
 			payIntent.Amount = reqAmt
+			anyAmount = true
 		} else {
 			if reqAmt != 0 {
 				return nil, errors.New("amount must not be " +
@@ -743,7 +849,21 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 				payAddr = &addr
 			}
 		} else {
-			err = payIntent.SetPaymentHash(*payReq.PaymentHash)
+			payHash := *payReq.PaymentHash
+			if len(rpcPayReq.PaymentHashOverride) > 0 {
+				payHash, err = lntypes.MakeHash(
+					rpcPayReq.PaymentHashOverride,
+				)
+				if err != nil {
+					return nil, err
+				}
+
+				log.Warnf("Paying invoice %v to overridden "+
+					"payment hash %v", payReq.PaymentHash,
+					payHash)
+			}
+
+			err = payIntent.SetPaymentHash(payHash)
 			if err != nil {
 				return nil, err
 			}
@@ -786,6 +906,11 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 
 		payIntent.Amount = reqAmt
 
+		// Without an invoice, only keysend and AMP payments are known
+		// to be accepted for any amount.
+		_, keySend := customRecords[record.KeySendType]
+		anyAmount = keySend || rpcPayReq.Amp
+
 		// Parse destination feature bits.
 		features, err := UnmarshalFeatures(rpcPayReq.DestFeatures)
 		if err != nil {
@@ -880,6 +1005,27 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		payIntent.DestFeatures = features
 	}
 
+	// Round up the amount to send if requested. If the receiver expects
+	// a fixed amount, we may overpay by at most that amount, as BOLT 4
+	// allows the receiver to reject a payment of more than twice the
+	// amount it expects.
+	if rpcPayReq.AmountRoundingMsat != 0 {
+		granularity := lnwire.MilliSatoshi(rpcPayReq.AmountRoundingMsat)
+		rounded, err := roundUpAmount(payIntent.Amount, granularity)
+		if err != nil {
+			return nil, err
+		}
+
+		overpayment := rounded - payIntent.Amount
+		if !anyAmount && overpayment > payIntent.Amount {
+			return nil, fmt.Errorf("rounding %v to a multiple of "+
+				"%v overpays the invoice by more than its "+
+				"amount", payIntent.Amount, granularity)
+		}
+
+		payIntent.Amount = rounded
+	}
+
 	// Do bounds checking with the block padding so the router isn't
 	// left with a zombie payment in case the user messes up.
 	err = routing.ValidateCLTVLimit(
@@ -889,11 +1035,35 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		return nil, err
 	}
 
+	// The buffer on the time lock of the first hop must not push the
+	// route above the cltv limit either.
+	minCltvLimit := uint32(payIntent.FinalCLTVDelta) +
+		uint32(routing.BlockPadding) +
+		uint32(payIntent.FirstHopCltvDelta)
+	if payIntent.CltvLimit <= minCltvLimit {
+		return nil, fmt.Errorf("cltv limit %v should be greater than "+
+			"%v including the first hop cltv delta of %v",
+			payIntent.CltvLimit, minCltvLimit,
+			payIntent.FirstHopCltvDelta)
+	}
+
 	// Check for disallowed payments to self.
 	if !rpcPayReq.AllowSelfPayment && payIntent.Target == r.SelfNode {
 		return nil, errors.New("self-payments not allowed")
 	}
 
+	// The intermediary node must be a proper intermediary of the route.
+	if payIntent.Intermediary != nil {
+		switch *payIntent.Intermediary {
+		case r.SelfNode:
+			return nil, errors.New("intermediary node cannot be self")
+
+		case payIntent.Target:
+			return nil, errors.New("intermediary node cannot be " +
+				"the destination")
+		}
+	}
+
 	return payIntent, nil
 }
 
@@ -920,6 +1090,45 @@ func unmarshallRouteHints(rpcRouteHints []*lnrpc.RouteHint) (
 	return routeHints, nil
 }
 
+// unmarshallCostFunction unmarshalls a path finding cost function. At least one
+// of the weights must be non-zero, otherwise all routes would be ranked
+// equally.
+func unmarshallCostFunction(rpcCostFunc *CostFunction) (
+	*routing.CostFunction, error) {
+
+	if rpcCostFunc.FeeWeight == 0 && rpcCostFunc.TimeLockWeight == 0 {
+		return nil, errors.New("cost function requires a non-zero " +
+			"fee or time lock weight")
+	}
+
+	return &routing.CostFunction{
+		FeeWeight:      rpcCostFunc.FeeWeight,
+		TimeLockWeight: rpcCostFunc.TimeLockWeight,
+	}, nil
+}
+
+// validateMinRouteProbability checks that the given minimum route probability
+// is a valid probability value.
+func validateMinRouteProbability(minProbability float64) error {
+	if minProbability < 0 || minProbability > 1 {
+		return fmt.Errorf("min route probability %v must be within "+
+			"[0, 1]", minProbability)
+	}
+
+	return nil
+}
+
+// validateMaxRouteHops checks that the given maximum number of route hops
+// doesn't exceed the number of hops that fit into the onion packet.
+func validateMaxRouteHops(maxHops uint32) error {
+	if maxHops > sphinx.NumMaxHops {
+		return fmt.Errorf("max route hops %v exceeds maximum of %v",
+			maxHops, sphinx.NumMaxHops)
+	}
+
+	return nil
+}
+
 // unmarshallHopHint unmarshalls a single hop hint.
 func unmarshallHopHint(rpcHint *lnrpc.HopHint) (zpay32.HopHint, error) {
 	pubBytes, err := hex.DecodeString(rpcHint.NodeId)
@@ -964,6 +1173,25 @@ func UnmarshalFeatures(
 	return lnwire.NewFeatureVector(raw, lnwire.Features), nil
 }
 
+// roundUpAmount rounds the given amount up to the nearest multiple of the
+// given granularity.
+func roundUpAmount(amt, granularity lnwire.MilliSatoshi) (lnwire.MilliSatoshi,
+	error) {
+
+	remainder := amt % granularity
+	if remainder == 0 {
+		return amt, nil
+	}
+
+	rounded := amt + granularity - remainder
+	if rounded < amt {
+		return 0, fmt.Errorf("rounding %v to a multiple of %v "+
+			"overflows", amt, granularity)
+	}
+
+	return rounded, nil
+}
+
 // ValidatePayReqExpiry checks if the passed payment request has expired. In
 // the case it has expired, an error will be returned.
 func ValidatePayReqExpiry(payReq *zpay32.Invoice) error {
@@ -1105,8 +1333,14 @@ func (r *RouterBackend) MarshalHTLCAttempt(
 func marshallHtlcFailure(failure *channeldb.HTLCFailInfo) (*lnrpc.Failure,
 	error) {
 
+	// Failures that we can't read carry no source index, so only the
+	// remaining failures with a zero index originated at our node.
+	isLocal := failure.FailureSourceIndex == 0 &&
+		failure.Reason != channeldb.HTLCFailUnreadable
+
 	rpcFailure := &lnrpc.Failure{
 		FailureSourceIndex: failure.FailureSourceIndex,
+		LocalFailure:       isLocal,
 	}
 
 	switch failure.Reason {
@@ -1133,6 +1367,23 @@ func marshallHtlcFailure(failure *channeldb.HTLCFailInfo) (*lnrpc.Failure,
 	return rpcFailure, nil
 }
 
+// MarshalRawFailures adds the raw failures of the payment's failed htlc
+// attempts to the rpc representation of the payment that was created by
+// MarshallPayment.
+func MarshalRawFailures(payment *channeldb.MPPayment,
+	rpcPayment *lnrpc.Payment) {
+
+	for i, htlc := range payment.HTLCs {
+		if htlc.Failure == nil {
+			continue
+		}
+
+		rpcFailure := rpcPayment.Htlcs[i].Failure
+		rpcFailure.RawFailureMessage = htlc.Failure.RawMessage
+		rpcFailure.EncryptedFailure = htlc.Failure.EncryptedReason
+	}
+}
+
 // MarshalTimeNano converts a time.Time into its nanosecond representation. If
 // the time is zero, this method simply returns 0, since calling UnixNano() on a
 // zero-valued time is undefined.
@@ -1427,6 +1678,9 @@ func marshallPaymentFailureReason(reason *channeldb.FailureReason) (
 
 	case channeldb.FailureReasonInsufficientBalance:
 		return lnrpc.PaymentFailureReason_FAILURE_REASON_INSUFFICIENT_BALANCE, nil
+
+	case channeldb.FailureReasonIntermediaryNoRoute:
+		return lnrpc.PaymentFailureReason_FAILURE_REASON_INTERMEDIARY_NO_ROUTE, nil
 	}
 
 	return 0, errors.New("unknown failure reason")
@@ -4,14 +4,21 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"math"
 	"testing"
+	"time"
 
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/record"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/zpay32"
 	"github.com/stretchr/testify/require"
 
 	"github.com/lightningnetwork/lnd/lnrpc"
@@ -253,6 +260,10 @@ func (m *mockMissionControl) GetHistorySnapshot() *routing.MissionControlSnapsho
 	return nil
 }
 
+func (m *mockMissionControl) GetPairObservations() routing.PairObservations {
+	return nil
+}
+
 func (m *mockMissionControl) GetPairHistorySnapshot(fromNode,
 	toNode route.Vertex) routing.TimedPairResult {
 
@@ -470,3 +481,162 @@ func testUnmarshalAMP(t *testing.T, test unmarshalAMPTest) {
 		t.Fatalf("test case has non-standard outcome")
 	}
 }
+
+// TestAmountRounding asserts that the amount of a payment is rounded up without
+// a bound if the receiver accepts any amount, while rounding the amount of an
+// invoice that encodes one may overpay it by at most its amount.
+func TestAmountRounding(t *testing.T) {
+	t.Parallel()
+
+	backend := &RouterBackend{
+		MaxTotalTimelock:      1000,
+		DefaultFinalCltvDelta: 40,
+		ActiveNetParams:       &chaincfg.RegressionNetParams,
+	}
+
+	dest, err := hex.DecodeString(destKey)
+	require.NoError(t, err)
+
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+
+	invoice, err := zpay32.NewInvoice(
+		&chaincfg.RegressionNetParams, lntypes.Hash{1}, time.Now(),
+		zpay32.Amount(1234000), zpay32.Description("test"),
+	)
+	require.NoError(t, err)
+
+	payReq, err := invoice.Encode(zpay32.MessageSigner{
+		SignCompact: func(msg []byte) ([]byte, error) {
+			return btcec.SignCompact(
+				btcec.S256(), privKey, chainhash.HashB(msg),
+				true,
+			)
+		},
+	})
+	require.NoError(t, err)
+
+	newKeySendRequest := func(rounding uint64) *SendPaymentRequest {
+		return &SendPaymentRequest{
+			Dest:               dest,
+			Amt:                1234,
+			PaymentHash:        make([]byte, 32),
+			TimeoutSeconds:     60,
+			AmountRoundingMsat: rounding,
+			DestCustomRecords: map[uint64][]byte{
+				record.KeySendType: make([]byte, 32),
+			},
+		}
+	}
+
+	newInvoiceRequest := func(rounding uint64) *SendPaymentRequest {
+		return &SendPaymentRequest{
+			PaymentRequest:     payReq,
+			TimeoutSeconds:     60,
+			AmountRoundingMsat: rounding,
+		}
+	}
+
+	// A keysend payment is rounded up to the next multiple, no matter how
+	// much that adds to the amount.
+	payIntent, err := backend.extractIntentFromSendRequest(
+		newKeySendRequest(1000000),
+	)
+	require.NoError(t, err)
+	require.Equal(t, lnwire.MilliSatoshi(2000000), payIntent.Amount)
+
+	payIntent, err = backend.extractIntentFromSendRequest(
+		newKeySendRequest(10000000),
+	)
+	require.NoError(t, err)
+	require.Equal(t, lnwire.MilliSatoshi(10000000), payIntent.Amount)
+
+	// The amount of an invoice that encodes one is rounded up as long as
+	// the invoice isn't overpaid by more than its amount.
+	payIntent, err = backend.extractIntentFromSendRequest(
+		newInvoiceRequest(1000000),
+	)
+	require.NoError(t, err)
+	require.Equal(t, lnwire.MilliSatoshi(2000000), payIntent.Amount)
+
+	payIntent, err = backend.extractIntentFromSendRequest(
+		newInvoiceRequest(2468000),
+	)
+	require.NoError(t, err)
+	require.Equal(t, lnwire.MilliSatoshi(2468000), payIntent.Amount)
+
+	// Rounding that overpays the invoice by more than its amount is
+	// rejected.
+	_, err = backend.extractIntentFromSendRequest(
+		newInvoiceRequest(2468001),
+	)
+	require.Error(t, err)
+
+	// Amounts that are already a multiple are left untouched, while
+	// rounding that overflows is rejected.
+	amt, err := roundUpAmount(3000, 1000)
+	require.NoError(t, err)
+	require.Equal(t, lnwire.MilliSatoshi(3000), amt)
+
+	_, err = roundUpAmount(math.MaxUint64-10, 1000)
+	require.Error(t, err)
+}
+
+// TestPaymentHashOverride asserts that the payment hash of an invoice can only
+// be overridden if the override is explicitly acknowledged as unsafe.
+func TestPaymentHashOverride(t *testing.T) {
+	t.Parallel()
+
+	backend := &RouterBackend{
+		MaxTotalTimelock:      1000,
+		DefaultFinalCltvDelta: 40,
+		ActiveNetParams:       &chaincfg.RegressionNetParams,
+	}
+
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+
+	invoiceHash := lntypes.Hash{1}
+	invoice, err := zpay32.NewInvoice(
+		&chaincfg.RegressionNetParams, invoiceHash, time.Now(),
+		zpay32.Amount(1000), zpay32.Description("test"),
+	)
+	require.NoError(t, err)
+
+	payReq, err := invoice.Encode(zpay32.MessageSigner{
+		SignCompact: func(msg []byte) ([]byte, error) {
+			return btcec.SignCompact(
+				btcec.S256(), privKey, chainhash.HashB(msg),
+				true,
+			)
+		},
+	})
+	require.NoError(t, err)
+
+	overrideHash := lntypes.Hash{2}
+	newRequest := func(payReq string, unsafe bool) *SendPaymentRequest {
+		return &SendPaymentRequest{
+			PaymentRequest:          payReq,
+			TimeoutSeconds:          60,
+			PaymentHashOverride:     overrideHash[:],
+			UnsafeAllowHashOverride: unsafe,
+		}
+	}
+
+	// The override must be acknowledged as unsafe.
+	_, err = backend.extractIntentFromSendRequest(newRequest(payReq, false))
+	require.Error(t, err)
+
+	// The override only applies to the hash of an invoice.
+	_, err = backend.extractIntentFromSendRequest(newRequest("", true))
+	require.Error(t, err)
+
+	// If acknowledged, the payment is made to the overridden hash, while
+	// the amount is still taken from the invoice.
+	payIntent, err := backend.extractIntentFromSendRequest(
+		newRequest(payReq, true),
+	)
+	require.NoError(t, err)
+	require.Equal(t, [32]byte(overrideHash), payIntent.Identifier())
+	require.Equal(t, lnwire.MilliSatoshi(1000), payIntent.Amount)
+}
@@ -25,6 +25,12 @@ type RouterClient interface {
 	//payment updates.
 	SendPaymentV2(ctx context.Context, in *SendPaymentRequest, opts ...grpc.CallOption) (Router_SendPaymentV2Client, error)
 	//
+	//SendPayments sends a batch of payments, of which at most max_parallel are
+	//in flight at the same time. The final outcome of each payment is streamed
+	//back as soon as it is known. A payment that fails doesn't affect the
+	//others.
+	SendPayments(ctx context.Context, in *SendPaymentsRequest, opts ...grpc.CallOption) (Router_SendPaymentsClient, error)
+	//
 	//TrackPaymentV2 returns an update stream for the payment identified by the
 	//payment hash.
 	TrackPaymentV2(ctx context.Context, in *TrackPaymentRequest, opts ...grpc.CallOption) (Router_TrackPaymentV2Client, error)
@@ -104,6 +110,24 @@ type RouterClient interface {
 	//channel to stay disabled until a subsequent manual request of either
 	//"enable" or "auto".
 	UpdateChanStatus(ctx context.Context, in *UpdateChanStatusRequest, opts ...grpc.CallOption) (*UpdateChanStatusResponse, error)
+	//
+	//SetMaxConcurrentPayments updates the maximum number of outgoing payments
+	//that are processed concurrently. Once the limit is reached, new payments
+	//are rejected until in-flight payments complete. A value of zero removes the
+	//limit. The change only lasts until the next restart, after which the
+	//max-concurrent-payments config value is used again.
+	SetMaxConcurrentPayments(ctx context.Context, in *SetMaxConcurrentPaymentsRequest, opts ...grpc.CallOption) (*SetMaxConcurrentPaymentsResponse, error)
+	//
+	//ListInFlightPayments lists all payments that are tracked as in flight. A
+	//new payment to the hash of an in flight payment is refused.
+	ListInFlightPayments(ctx context.Context, in *ListInFlightPaymentsRequest, opts ...grpc.CallOption) (*ListInFlightPaymentsResponse, error)
+	//
+	//ClearInFlightPayment fails an in flight payment that has no HTLCs in
+	//flight, allowing a new payment to its hash to be made. This can be used to
+	//clear a payment that got stuck in flight, for example after a crash.
+	//Payments that still have HTLCs in flight can't be cleared, since these
+	//HTLCs may still be settled.
+	ClearInFlightPayment(ctx context.Context, in *ClearInFlightPaymentRequest, opts ...grpc.CallOption) (*ClearInFlightPaymentResponse, error)
 }
 
 type routerClient struct {
@@ -146,8 +170,40 @@ func (x *routerSendPaymentV2Client) Recv() (*lnrpc.Payment, error) {
 	return m, nil
 }
 
+func (c *routerClient) SendPayments(ctx context.Context, in *SendPaymentsRequest, opts ...grpc.CallOption) (Router_SendPaymentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[1], "/routerrpc.Router/SendPayments", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &routerSendPaymentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Router_SendPaymentsClient interface {
+	Recv() (*SendPaymentsResponse, error)
+	grpc.ClientStream
+}
+
+type routerSendPaymentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *routerSendPaymentsClient) Recv() (*SendPaymentsResponse, error) {
+	m := new(SendPaymentsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *routerClient) TrackPaymentV2(ctx context.Context, in *TrackPaymentRequest, opts ...grpc.CallOption) (Router_TrackPaymentV2Client, error) {
-	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[1], "/routerrpc.Router/TrackPaymentV2", opts...)
+	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[2], "/routerrpc.Router/TrackPaymentV2", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -270,7 +326,7 @@ func (c *routerClient) BuildRoute(ctx context.Context, in *BuildRouteRequest, op
 }
 
 func (c *routerClient) SubscribeHtlcEvents(ctx context.Context, in *SubscribeHtlcEventsRequest, opts ...grpc.CallOption) (Router_SubscribeHtlcEventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[2], "/routerrpc.Router/SubscribeHtlcEvents", opts...)
+	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[3], "/routerrpc.Router/SubscribeHtlcEvents", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -303,7 +359,7 @@ func (x *routerSubscribeHtlcEventsClient) Recv() (*HtlcEvent, error) {
 
 // Deprecated: Do not use.
 func (c *routerClient) SendPayment(ctx context.Context, in *SendPaymentRequest, opts ...grpc.CallOption) (Router_SendPaymentClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[3], "/routerrpc.Router/SendPayment", opts...)
+	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[4], "/routerrpc.Router/SendPayment", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -336,7 +392,7 @@ func (x *routerSendPaymentClient) Recv() (*PaymentStatus, error) {
 
 // Deprecated: Do not use.
 func (c *routerClient) TrackPayment(ctx context.Context, in *TrackPaymentRequest, opts ...grpc.CallOption) (Router_TrackPaymentClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[4], "/routerrpc.Router/TrackPayment", opts...)
+	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[5], "/routerrpc.Router/TrackPayment", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -368,7 +424,7 @@ func (x *routerTrackPaymentClient) Recv() (*PaymentStatus, error) {
 }
 
 func (c *routerClient) HtlcInterceptor(ctx context.Context, opts ...grpc.CallOption) (Router_HtlcInterceptorClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[5], "/routerrpc.Router/HtlcInterceptor", opts...)
+	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[6], "/routerrpc.Router/HtlcInterceptor", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -407,6 +463,33 @@ func (c *routerClient) UpdateChanStatus(ctx context.Context, in *UpdateChanStatu
 	return out, nil
 }
 
+func (c *routerClient) SetMaxConcurrentPayments(ctx context.Context, in *SetMaxConcurrentPaymentsRequest, opts ...grpc.CallOption) (*SetMaxConcurrentPaymentsResponse, error) {
+	out := new(SetMaxConcurrentPaymentsResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/SetMaxConcurrentPayments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) ListInFlightPayments(ctx context.Context, in *ListInFlightPaymentsRequest, opts ...grpc.CallOption) (*ListInFlightPaymentsResponse, error) {
+	out := new(ListInFlightPaymentsResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/ListInFlightPayments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) ClearInFlightPayment(ctx context.Context, in *ClearInFlightPaymentRequest, opts ...grpc.CallOption) (*ClearInFlightPaymentResponse, error) {
+	out := new(ClearInFlightPaymentResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/ClearInFlightPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RouterServer is the server API for Router service.
 // All implementations must embed UnimplementedRouterServer
 // for forward compatibility
@@ -417,6 +500,12 @@ type RouterServer interface {
 	//payment updates.
 	SendPaymentV2(*SendPaymentRequest, Router_SendPaymentV2Server) error
 	//
+	//SendPayments sends a batch of payments, of which at most max_parallel are
+	//in flight at the same time. The final outcome of each payment is streamed
+	//back as soon as it is known. A payment that fails doesn't affect the
+	//others.
+	SendPayments(*SendPaymentsRequest, Router_SendPaymentsServer) error
+	//
 	//TrackPaymentV2 returns an update stream for the payment identified by the
 	//payment hash.
 	TrackPaymentV2(*TrackPaymentRequest, Router_TrackPaymentV2Server) error
@@ -496,6 +585,24 @@ type RouterServer interface {
 	//channel to stay disabled until a subsequent manual request of either
 	//"enable" or "auto".
 	UpdateChanStatus(context.Context, *UpdateChanStatusRequest) (*UpdateChanStatusResponse, error)
+	//
+	//SetMaxConcurrentPayments updates the maximum number of outgoing payments
+	//that are processed concurrently. Once the limit is reached, new payments
+	//are rejected until in-flight payments complete. A value of zero removes the
+	//limit. The change only lasts until the next restart, after which the
+	//max-concurrent-payments config value is used again.
+	SetMaxConcurrentPayments(context.Context, *SetMaxConcurrentPaymentsRequest) (*SetMaxConcurrentPaymentsResponse, error)
+	//
+	//ListInFlightPayments lists all payments that are tracked as in flight. A
+	//new payment to the hash of an in flight payment is refused.
+	ListInFlightPayments(context.Context, *ListInFlightPaymentsRequest) (*ListInFlightPaymentsResponse, error)
+	//
+	//ClearInFlightPayment fails an in flight payment that has no HTLCs in
+	//flight, allowing a new payment to its hash to be made. This can be used to
+	//clear a payment that got stuck in flight, for example after a crash.
+	//Payments that still have HTLCs in flight can't be cleared, since these
+	//HTLCs may still be settled.
+	ClearInFlightPayment(context.Context, *ClearInFlightPaymentRequest) (*ClearInFlightPaymentResponse, error)
 	mustEmbedUnimplementedRouterServer()
 }
 
@@ -506,6 +613,9 @@ type UnimplementedRouterServer struct {
 func (UnimplementedRouterServer) SendPaymentV2(*SendPaymentRequest, Router_SendPaymentV2Server) error {
 	return status.Errorf(codes.Unimplemented, "method SendPaymentV2 not implemented")
 }
+func (UnimplementedRouterServer) SendPayments(*SendPaymentsRequest, Router_SendPaymentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SendPayments not implemented")
+}
 func (UnimplementedRouterServer) TrackPaymentV2(*TrackPaymentRequest, Router_TrackPaymentV2Server) error {
 	return status.Errorf(codes.Unimplemented, "method TrackPaymentV2 not implemented")
 }
@@ -554,6 +664,15 @@ func (UnimplementedRouterServer) HtlcInterceptor(Router_HtlcInterceptorServer) e
 func (UnimplementedRouterServer) UpdateChanStatus(context.Context, *UpdateChanStatusRequest) (*UpdateChanStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateChanStatus not implemented")
 }
+func (UnimplementedRouterServer) SetMaxConcurrentPayments(context.Context, *SetMaxConcurrentPaymentsRequest) (*SetMaxConcurrentPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxConcurrentPayments not implemented")
+}
+func (UnimplementedRouterServer) ListInFlightPayments(context.Context, *ListInFlightPaymentsRequest) (*ListInFlightPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInFlightPayments not implemented")
+}
+func (UnimplementedRouterServer) ClearInFlightPayment(context.Context, *ClearInFlightPaymentRequest) (*ClearInFlightPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearInFlightPayment not implemented")
+}
 func (UnimplementedRouterServer) mustEmbedUnimplementedRouterServer() {}
 
 // UnsafeRouterServer may be embedded to opt out of forward compatibility for this service.
@@ -588,6 +707,27 @@ func (x *routerSendPaymentV2Server) Send(m *lnrpc.Payment) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Router_SendPayments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SendPaymentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RouterServer).SendPayments(m, &routerSendPaymentsServer{stream})
+}
+
+type Router_SendPaymentsServer interface {
+	Send(*SendPaymentsResponse) error
+	grpc.ServerStream
+}
+
+type routerSendPaymentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *routerSendPaymentsServer) Send(m *SendPaymentsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Router_TrackPaymentV2_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(TrackPaymentRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -896,6 +1036,60 @@ func _Router_UpdateChanStatus_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Router_SetMaxConcurrentPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMaxConcurrentPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).SetMaxConcurrentPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/SetMaxConcurrentPayments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).SetMaxConcurrentPayments(ctx, req.(*SetMaxConcurrentPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_ListInFlightPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInFlightPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).ListInFlightPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/ListInFlightPayments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).ListInFlightPayments(ctx, req.(*ListInFlightPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_ClearInFlightPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearInFlightPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).ClearInFlightPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/ClearInFlightPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).ClearInFlightPayment(ctx, req.(*ClearInFlightPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Router_ServiceDesc is the grpc.ServiceDesc for Router service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -947,6 +1141,18 @@ var Router_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateChanStatus",
 			Handler:    _Router_UpdateChanStatus_Handler,
 		},
+		{
+			MethodName: "SetMaxConcurrentPayments",
+			Handler:    _Router_SetMaxConcurrentPayments_Handler,
+		},
+		{
+			MethodName: "ListInFlightPayments",
+			Handler:    _Router_ListInFlightPayments_Handler,
+		},
+		{
+			MethodName: "ClearInFlightPayment",
+			Handler:    _Router_ClearInFlightPayment_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -954,6 +1160,11 @@ var Router_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Router_SendPaymentV2_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SendPayments",
+			Handler:       _Router_SendPayments_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "TrackPaymentV2",
 			Handler:       _Router_TrackPaymentV2_Handler,
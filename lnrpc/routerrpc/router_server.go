@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -32,6 +33,10 @@ const (
 	// to register ourselves, and we also require that the main
 	// SubServerConfigDispatcher instance recognize as the name of our
 	subServerName = "RouterRPC"
+
+	// DefaultMaxParallelPayments is the number of payments of a batch
+	// that are sent concurrently if the caller doesn't specify a limit.
+	DefaultMaxParallelPayments = 5
 )
 
 var (
@@ -61,6 +66,10 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/routerrpc.Router/SendPayments": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/routerrpc.Router/SendToRouteV2": {{
 			Entity: "offchain",
 			Action: "write",
@@ -125,6 +134,18 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/routerrpc.Router/SetMaxConcurrentPayments": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/ListInFlightPayments": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/ClearInFlightPayment": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 	}
 
 	// DefaultRouterMacFilename is the default name of the router macaroon
@@ -329,13 +350,167 @@ func (s *Server) SendPaymentV2(req *SendPaymentRequest,
 			)
 		}
 
+		// Signal the caller to back off if we're already processing
+		// the maximum number of concurrent payments.
+		if err == routing.ErrMaxConcurrentPayments {
+			log.Debugf("SendPayment async result for payment "+
+				"%x: %v", payment.Identifier(), err)
+
+			return status.Error(
+				codes.ResourceExhausted, err.Error(),
+			)
+		}
+
 		log.Errorf("SendPayment async error for payment %x: %v",
 			payment.Identifier(), err)
 
 		return err
 	}
 
-	return s.trackPayment(payment.Identifier(), stream, req.NoInflightUpdates)
+	return s.trackPayment(
+		payment.Identifier(), stream, req.NoInflightUpdates, false,
+	)
+}
+
+// SendPayments sends a batch of payments, of which at most the requested
+// number are in flight at the same time. The final outcome of each payment is
+// streamed back as soon as it is known, so the results arrive in the order the
+// payments complete rather than the order they were requested in. A payment
+// that can't be sent or fails doesn't affect the others.
+func (s *Server) SendPayments(req *SendPaymentsRequest,
+	stream Router_SendPaymentsServer) error {
+
+	if len(req.Payments) == 0 {
+		return status.Error(
+			codes.InvalidArgument, "no payments to send",
+		)
+	}
+
+	maxParallel := req.MaxParallel
+	if maxParallel == 0 {
+		maxParallel = DefaultMaxParallelPayments
+	}
+
+	log.Debugf("Sending batch of %d payments with at most %d in "+
+		"parallel", len(req.Payments), maxParallel)
+
+	var (
+		results   = make(chan *SendPaymentsResponse)
+		semaphore = make(chan struct{}, maxParallel)
+		done      = make(chan struct{})
+		wg        sync.WaitGroup
+	)
+
+	// Make sure all payment goroutines exit if we return early because
+	// the stream failed. The payments themselves are already owned by the
+	// router at that point and carry on regardless.
+	defer close(done)
+
+	go func() {
+		defer close(results)
+		defer wg.Wait()
+
+		for i, payReq := range req.Payments {
+			select {
+			case semaphore <- struct{}{}:
+			case <-done:
+				return
+			}
+
+			wg.Add(1)
+			go func(index uint32, payReq *SendPaymentRequest) {
+				defer wg.Done()
+				defer func() { <-semaphore }()
+
+				result := s.sendBatchedPayment(
+					stream.Context(), index, payReq,
+				)
+
+				select {
+				case results <- result:
+				case <-done:
+				}
+			}(uint32(i), payReq)
+		}
+	}()
+
+	for result := range results {
+		if err := stream.Send(result); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendBatchedPayment sends a single payment of a batch and waits for its final
+// outcome. Any error is returned as part of the result, so that it only
+// affects this payment.
+func (s *Server) sendBatchedPayment(ctx context.Context, index uint32,
+	req *SendPaymentRequest) *SendPaymentsResponse {
+
+	result := &SendPaymentsResponse{
+		Index: index,
+	}
+
+	router := s.cfg.RouterBackend
+
+	payment, err := router.extractIntentFromSendRequest(req)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	err = s.cfg.Router.SendPaymentAsync(payment)
+	if err != nil {
+		log.Debugf("SendPayments async result for payment %x: %v",
+			payment.Identifier(), err)
+
+		result.Error = err.Error()
+		return result
+	}
+
+	subscription, err := router.Tower.SubscribePayment(
+		payment.Identifier(),
+	)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	defer subscription.Close()
+
+	for {
+		select {
+		case item, ok := <-subscription.Updates:
+			if !ok {
+				result.Error = "payment subscription closed " +
+					"before final outcome"
+				return result
+			}
+
+			update := item.(*channeldb.MPPayment)
+			if update.Status == channeldb.StatusInFlight {
+				continue
+			}
+
+			rpcPayment, err := router.MarshallPayment(update)
+			if err != nil {
+				result.Error = err.Error()
+				return result
+			}
+
+			result.Payment = rpcPayment
+			return result
+
+		case <-s.quit:
+			result.Error = errServerShuttingDown.Error()
+			return result
+
+		case <-ctx.Done():
+			result.Error = ctx.Err().Error()
+			return result
+		}
+	}
 }
 
 // EstimateRouteFee allows callers to obtain a lower bound w.r.t how much it
@@ -505,9 +680,53 @@ func (s *Server) QueryMissionControl(ctx context.Context,
 		Pairs: rpcPairs,
 	}
 
+	if !req.Verbose {
+		return &response, nil
+	}
+
+	mc := s.cfg.RouterBackend.MissionControl
+	for p, observations := range mc.GetPairObservations() {
+		// Pairs without any history are omitted rather than returned
+		// empty.
+		if len(observations) == 0 {
+			continue
+		}
+
+		// Prevent binding to loop variable.
+		pair := p
+
+		rpcObservations := make(
+			[]*PairObservation, 0, len(observations),
+		)
+		for _, o := range observations {
+			rpcObservations = append(
+				rpcObservations, toRPCPairObservation(o),
+			)
+		}
+
+		response.PairObservations = append(
+			response.PairObservations, &PairObservations{
+				NodeFrom:     pair.From[:],
+				NodeTo:       pair.To[:],
+				Observations: rpcObservations,
+			},
+		)
+	}
+
 	return &response, nil
 }
 
+// toRPCPairObservation marshalls a mission control pair observation to the rpc
+// struct.
+func toRPCPairObservation(o routing.PairObservation) *PairObservation {
+	return &PairObservation{
+		Timestamp: o.Timestamp.Unix(),
+		AmtSat:    int64(o.Amt.ToSatoshis()),
+		AmtMsat:   int64(o.Amt),
+		Success:   o.Success,
+	}
+}
+
 // toRPCPairData marshalls mission control pair data to the rpc struct.
 func toRPCPairData(data *routing.TimedPairResult) *PairData {
 	rpcData := PairData{
@@ -720,12 +939,18 @@ func (s *Server) TrackPaymentV2(request *TrackPaymentRequest,
 
 	log.Debugf("TrackPayment called for payment %v", paymentHash)
 
-	return s.trackPayment(paymentHash, stream, request.NoInflightUpdates)
+	return s.trackPayment(
+		paymentHash, stream, request.NoInflightUpdates,
+		request.IncludeRawFailures,
+	)
 }
 
-// trackPayment writes payment status updates to the provided stream.
+// trackPayment writes payment status updates to the provided stream. If
+// includeRawFailures is set, the raw failures of failed htlc attempts are
+// included in the updates.
 func (s *Server) trackPayment(identifier lntypes.Hash,
-	stream Router_TrackPaymentV2Server, noInflightUpdates bool) error {
+	stream Router_TrackPaymentV2Server, noInflightUpdates,
+	includeRawFailures bool) error {
 
 	router := s.cfg.RouterBackend
 
@@ -764,6 +989,17 @@ func (s *Server) trackPayment(identifier lntypes.Hash,
 				return err
 			}
 
+			if includeRawFailures {
+				MarshalRawFailures(result, rpcPayment)
+			}
+
+			// Give the client an indication of how long the
+			// payment is still expected to take.
+			if result.Status == channeldb.StatusInFlight {
+				rpcPayment.CompletionEstimate =
+					marshallCompletionEstimate(result)
+			}
+
 			// Send event to the client.
 			err = stream.Send(rpcPayment)
 			if err != nil {
@@ -932,3 +1168,84 @@ func (s *Server) UpdateChanStatus(ctx context.Context,
 	}
 	return &UpdateChanStatusResponse{}, nil
 }
+
+// SetMaxConcurrentPayments updates the maximum number of outgoing payments
+// that are processed concurrently.
+func (s *Server) SetMaxConcurrentPayments(ctx context.Context,
+	req *SetMaxConcurrentPaymentsRequest) (
+	*SetMaxConcurrentPaymentsResponse, error) {
+
+	prevMax := s.cfg.Router.SetMaxConcurrentPayments(
+		req.MaxConcurrentPayments,
+	)
+	inFlight, _ := s.cfg.Router.PaymentConcurrency()
+
+	log.Infof("Updated maximum number of concurrent payments from %v to "+
+		"%v, %v payments in flight", prevMax, req.MaxConcurrentPayments,
+		inFlight)
+
+	return &SetMaxConcurrentPaymentsResponse{
+		PrevMaxConcurrentPayments: prevMax,
+		InFlightPayments:          inFlight,
+	}, nil
+}
+
+// ListInFlightPayments lists all payments that are tracked as in flight.
+func (s *Server) ListInFlightPayments(ctx context.Context,
+	req *ListInFlightPaymentsRequest) (*ListInFlightPaymentsResponse,
+	error) {
+
+	payments, err := s.cfg.Router.InFlightPayments()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListInFlightPaymentsResponse{}
+	for _, payment := range payments {
+		rpcPayment, err := s.cfg.RouterBackend.MarshallPayment(payment)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Payments = append(resp.Payments, rpcPayment)
+	}
+
+	return resp, nil
+}
+
+// ClearInFlightPayment fails an in flight payment that has no HTLCs in flight,
+// allowing a new payment to its hash to be made.
+func (s *Server) ClearInFlightPayment(ctx context.Context,
+	req *ClearInFlightPaymentRequest) (*ClearInFlightPaymentResponse,
+	error) {
+
+	hash, err := lntypes.MakeHash(req.PaymentHash)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.cfg.Router.ClearInFlightPayment(hash)
+	switch {
+	case err == channeldb.ErrPaymentNotInitiated:
+		return nil, status.Errorf(
+			codes.NotFound, "payment %v not found", hash,
+		)
+
+	case err == routing.ErrPaymentNotInFlight:
+		return nil, status.Errorf(
+			codes.FailedPrecondition, "payment %v not in flight",
+			hash,
+		)
+
+	case err == routing.ErrPaymentHasInFlightHTLCs:
+		return nil, status.Errorf(
+			codes.FailedPrecondition, "payment %v has HTLCs in "+
+				"flight, refusing to clear it", hash,
+		)
+
+	case err != nil:
+		return nil, err
+	}
+
+	return &ClearInFlightPaymentResponse{}, nil
+}
@@ -32,7 +32,9 @@ func (i *legacyTrackPaymentServer) Send(p *lnrpc.Payment) error {
 		case lnrpc.PaymentFailureReason_FAILURE_REASON_TIMEOUT:
 			state = PaymentState_FAILED_TIMEOUT
 
-		case lnrpc.PaymentFailureReason_FAILURE_REASON_NO_ROUTE:
+		case lnrpc.PaymentFailureReason_FAILURE_REASON_NO_ROUTE,
+			lnrpc.PaymentFailureReason_FAILURE_REASON_INTERMEDIARY_NO_ROUTE:
+
 			state = PaymentState_FAILED_NO_ROUTE
 
 		case lnrpc.PaymentFailureReason_FAILURE_REASON_ERROR:
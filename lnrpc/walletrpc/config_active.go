@@ -4,6 +4,7 @@ package walletrpc
 
 import (
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
@@ -57,6 +58,10 @@ type Config struct {
 	// about the backing chain of the wallet.
 	Chain lnwallet.BlockChainIO
 
+	// Signer is the signer the WalletKit will use to sign transactions
+	// spending outputs of the wallet.
+	Signer input.Signer
+
 	// ChainParams are the parameters of the wallet's backing chain.
 	ChainParams *chaincfg.Params
 }
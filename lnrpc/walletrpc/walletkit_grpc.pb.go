@@ -4,6 +4,7 @@ package walletrpc
 
 import (
 	context "context"
+	lnrpc "github.com/lightningnetwork/lnd/lnrpc"
 	signrpc "github.com/lightningnetwork/lnd/lnrpc/signrpc"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -88,6 +89,19 @@ type WalletKitClient interface {
 	//supported later on.
 	ImportPublicKey(ctx context.Context, in *ImportPublicKeyRequest, opts ...grpc.CallOption) (*ImportPublicKeyResponse, error)
 	//
+	//GetAddressInfo returns the information the wallet has stored about the
+	//given address, such as its BIP32 derivation path and whether the wallet
+	//holds the private key for it. Addresses that don't belong to the wallet
+	//result in a response with is_mine set to false.
+	GetAddressInfo(ctx context.Context, in *GetAddressInfoRequest, opts ...grpc.CallOption) (*GetAddressInfoResponse, error)
+	//
+	//SetDefaultAddressType changes the type of the addresses the wallet
+	//generates if no specific type is requested, which includes the change
+	//addresses of the transactions crafted by the wallet. The change lasts until
+	//lnd is restarted, after which the configured default-address-type is used
+	//again.
+	SetDefaultAddressType(ctx context.Context, in *SetDefaultAddressTypeRequest, opts ...grpc.CallOption) (*SetDefaultAddressTypeResponse, error)
+	//
 	//PublishTransaction attempts to publish the passed transaction to the
 	//network. Once this returns without an error, the wallet will continually
 	//attempt to re-broadcast the transaction on start up, until it enters the
@@ -146,12 +160,24 @@ type WalletKitClient interface {
 	//broadcast, not confirmation.
 	ListSweeps(ctx context.Context, in *ListSweepsRequest, opts ...grpc.CallOption) (*ListSweepsResponse, error)
 	//
+	//SweepOutput sweeps a single output under control of the wallet to the given
+	//address at the given fee rate, bypassing the central batching engine. The
+	//sweep transaction only spends the given output and is broadcast right away.
+	//Outputs that are currently being swept by the batching engine are refused to
+	//prevent double spends.
+	SweepOutput(ctx context.Context, in *SweepOutputRequest, opts ...grpc.CallOption) (*SweepOutputResponse, error)
+	//
 	//LabelTransaction adds a label to a transaction. If the transaction already
 	//has a label the call will fail unless the overwrite bool is set. This will
 	//overwrite the exiting transaction label. Labels must not be empty, and
 	//cannot exceed 500 characters.
 	LabelTransaction(ctx context.Context, in *LabelTransactionRequest, opts ...grpc.CallOption) (*LabelTransactionResponse, error)
 	//
+	//GetTransaction returns a single transaction of the wallet, including its
+	//label. Both transactions sent by the wallet and transactions received by
+	//it are returned, and can be relabeled with LabelTransaction.
+	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*lnrpc.Transaction, error)
+	//
 	//FundPsbt creates a fully populated PSBT that contains enough inputs to fund
 	//the outputs specified in the template. There are two ways of specifying a
 	//template: Either by passing in a PSBT with at least one output declared or
@@ -284,6 +310,24 @@ func (c *walletKitClient) ImportPublicKey(ctx context.Context, in *ImportPublicK
 	return out, nil
 }
 
+func (c *walletKitClient) GetAddressInfo(ctx context.Context, in *GetAddressInfoRequest, opts ...grpc.CallOption) (*GetAddressInfoResponse, error) {
+	out := new(GetAddressInfoResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/GetAddressInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) SetDefaultAddressType(ctx context.Context, in *SetDefaultAddressTypeRequest, opts ...grpc.CallOption) (*SetDefaultAddressTypeResponse, error) {
+	out := new(SetDefaultAddressTypeResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/SetDefaultAddressType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *walletKitClient) PublishTransaction(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*PublishResponse, error) {
 	out := new(PublishResponse)
 	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/PublishTransaction", in, out, opts...)
@@ -338,6 +382,15 @@ func (c *walletKitClient) ListSweeps(ctx context.Context, in *ListSweepsRequest,
 	return out, nil
 }
 
+func (c *walletKitClient) SweepOutput(ctx context.Context, in *SweepOutputRequest, opts ...grpc.CallOption) (*SweepOutputResponse, error) {
+	out := new(SweepOutputResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/SweepOutput", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *walletKitClient) LabelTransaction(ctx context.Context, in *LabelTransactionRequest, opts ...grpc.CallOption) (*LabelTransactionResponse, error) {
 	out := new(LabelTransactionResponse)
 	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/LabelTransaction", in, out, opts...)
@@ -347,6 +400,15 @@ func (c *walletKitClient) LabelTransaction(ctx context.Context, in *LabelTransac
 	return out, nil
 }
 
+func (c *walletKitClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*lnrpc.Transaction, error) {
+	out := new(lnrpc.Transaction)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/GetTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *walletKitClient) FundPsbt(ctx context.Context, in *FundPsbtRequest, opts ...grpc.CallOption) (*FundPsbtResponse, error) {
 	out := new(FundPsbtResponse)
 	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/FundPsbt", in, out, opts...)
@@ -438,6 +500,19 @@ type WalletKitServer interface {
 	//supported later on.
 	ImportPublicKey(context.Context, *ImportPublicKeyRequest) (*ImportPublicKeyResponse, error)
 	//
+	//GetAddressInfo returns the information the wallet has stored about the
+	//given address, such as its BIP32 derivation path and whether the wallet
+	//holds the private key for it. Addresses that don't belong to the wallet
+	//result in a response with is_mine set to false.
+	GetAddressInfo(context.Context, *GetAddressInfoRequest) (*GetAddressInfoResponse, error)
+	//
+	//SetDefaultAddressType changes the type of the addresses the wallet
+	//generates if no specific type is requested, which includes the change
+	//addresses of the transactions crafted by the wallet. The change lasts until
+	//lnd is restarted, after which the configured default-address-type is used
+	//again.
+	SetDefaultAddressType(context.Context, *SetDefaultAddressTypeRequest) (*SetDefaultAddressTypeResponse, error)
+	//
 	//PublishTransaction attempts to publish the passed transaction to the
 	//network. Once this returns without an error, the wallet will continually
 	//attempt to re-broadcast the transaction on start up, until it enters the
@@ -496,12 +571,24 @@ type WalletKitServer interface {
 	//broadcast, not confirmation.
 	ListSweeps(context.Context, *ListSweepsRequest) (*ListSweepsResponse, error)
 	//
+	//SweepOutput sweeps a single output under control of the wallet to the given
+	//address at the given fee rate, bypassing the central batching engine. The
+	//sweep transaction only spends the given output and is broadcast right away.
+	//Outputs that are currently being swept by the batching engine are refused to
+	//prevent double spends.
+	SweepOutput(context.Context, *SweepOutputRequest) (*SweepOutputResponse, error)
+	//
 	//LabelTransaction adds a label to a transaction. If the transaction already
 	//has a label the call will fail unless the overwrite bool is set. This will
 	//overwrite the exiting transaction label. Labels must not be empty, and
 	//cannot exceed 500 characters.
 	LabelTransaction(context.Context, *LabelTransactionRequest) (*LabelTransactionResponse, error)
 	//
+	//GetTransaction returns a single transaction of the wallet, including its
+	//label. Both transactions sent by the wallet and transactions received by
+	//it are returned, and can be relabeled with LabelTransaction.
+	GetTransaction(context.Context, *GetTransactionRequest) (*lnrpc.Transaction, error)
+	//
 	//FundPsbt creates a fully populated PSBT that contains enough inputs to fund
 	//the outputs specified in the template. There are two ways of specifying a
 	//template: Either by passing in a PSBT with at least one output declared or
@@ -571,6 +658,12 @@ func (UnimplementedWalletKitServer) ImportAccount(context.Context, *ImportAccoun
 func (UnimplementedWalletKitServer) ImportPublicKey(context.Context, *ImportPublicKeyRequest) (*ImportPublicKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportPublicKey not implemented")
 }
+func (UnimplementedWalletKitServer) GetAddressInfo(context.Context, *GetAddressInfoRequest) (*GetAddressInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddressInfo not implemented")
+}
+func (UnimplementedWalletKitServer) SetDefaultAddressType(context.Context, *SetDefaultAddressTypeRequest) (*SetDefaultAddressTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDefaultAddressType not implemented")
+}
 func (UnimplementedWalletKitServer) PublishTransaction(context.Context, *Transaction) (*PublishResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PublishTransaction not implemented")
 }
@@ -589,9 +682,15 @@ func (UnimplementedWalletKitServer) BumpFee(context.Context, *BumpFeeRequest) (*
 func (UnimplementedWalletKitServer) ListSweeps(context.Context, *ListSweepsRequest) (*ListSweepsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSweeps not implemented")
 }
+func (UnimplementedWalletKitServer) SweepOutput(context.Context, *SweepOutputRequest) (*SweepOutputResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SweepOutput not implemented")
+}
 func (UnimplementedWalletKitServer) LabelTransaction(context.Context, *LabelTransactionRequest) (*LabelTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LabelTransaction not implemented")
 }
+func (UnimplementedWalletKitServer) GetTransaction(context.Context, *GetTransactionRequest) (*lnrpc.Transaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+}
 func (UnimplementedWalletKitServer) FundPsbt(context.Context, *FundPsbtRequest) (*FundPsbtResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FundPsbt not implemented")
 }
@@ -791,6 +890,42 @@ func _WalletKit_ImportPublicKey_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletKit_GetAddressInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAddressInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).GetAddressInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/GetAddressInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).GetAddressInfo(ctx, req.(*GetAddressInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_SetDefaultAddressType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDefaultAddressTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).SetDefaultAddressType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/SetDefaultAddressType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).SetDefaultAddressType(ctx, req.(*SetDefaultAddressTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WalletKit_PublishTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Transaction)
 	if err := dec(in); err != nil {
@@ -899,6 +1034,24 @@ func _WalletKit_ListSweeps_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletKit_SweepOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SweepOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).SweepOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/SweepOutput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).SweepOutput(ctx, req.(*SweepOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WalletKit_LabelTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LabelTransactionRequest)
 	if err := dec(in); err != nil {
@@ -917,6 +1070,24 @@ func _WalletKit_LabelTransaction_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletKit_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/GetTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).GetTransaction(ctx, req.(*GetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WalletKit_FundPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FundPsbtRequest)
 	if err := dec(in); err != nil {
@@ -1000,6 +1171,14 @@ var WalletKit_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ImportPublicKey",
 			Handler:    _WalletKit_ImportPublicKey_Handler,
 		},
+		{
+			MethodName: "GetAddressInfo",
+			Handler:    _WalletKit_GetAddressInfo_Handler,
+		},
+		{
+			MethodName: "SetDefaultAddressType",
+			Handler:    _WalletKit_SetDefaultAddressType_Handler,
+		},
 		{
 			MethodName: "PublishTransaction",
 			Handler:    _WalletKit_PublishTransaction_Handler,
@@ -1024,10 +1203,18 @@ var WalletKit_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListSweeps",
 			Handler:    _WalletKit_ListSweeps_Handler,
 		},
+		{
+			MethodName: "SweepOutput",
+			Handler:    _WalletKit_SweepOutput_Handler,
+		},
 		{
 			MethodName: "LabelTransaction",
 			Handler:    _WalletKit_LabelTransaction_Handler,
 		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _WalletKit_GetTransaction_Handler,
+		},
 		{
 			MethodName: "FundPsbt",
 			Handler:    _WalletKit_FundPsbt_Handler,
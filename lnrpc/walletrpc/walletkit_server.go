@@ -104,10 +104,18 @@ var (
 			Entity: "onchain",
 			Action: "read",
 		}},
+		"/walletrpc.WalletKit/SweepOutput": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
 		"/walletrpc.WalletKit/LabelTransaction": {{
 			Entity: "onchain",
 			Action: "write",
 		}},
+		"/walletrpc.WalletKit/GetTransaction": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
 		"/walletrpc.WalletKit/LeaseOutput": {{
 			Entity: "onchain",
 			Action: "write",
@@ -144,6 +152,14 @@ var (
 			Entity: "onchain",
 			Action: "write",
 		}},
+		"/walletrpc.WalletKit/SetDefaultAddressType": {{
+			Entity: "address",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/GetAddressInfo": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
 	}
 
 	// DefaultWalletKitMacFilename is the default name of the wallet kit
@@ -168,6 +184,10 @@ var (
 // an empty label.
 var ErrZeroLabel = errors.New("cannot label transaction with empty label")
 
+// ErrTxNotFound is returned when a transaction is requested that the wallet
+// doesn't know of.
+var ErrTxNotFound = errors.New("transaction not found in wallet")
+
 // ServerShell is a shell struct holding a reference to the actual sub-server.
 // It is used to register the gRPC sub-server with the root server before we
 // have the necessary dependencies to populate the actual sub-server.
@@ -514,7 +534,7 @@ func (w *WalletKit) NextAddr(ctx context.Context,
 	}
 
 	addr, err := w.cfg.Wallet.NewAddress(
-		lnwallet.WitnessPubKey, false, account,
+		w.cfg.Wallet.DefaultAddressType(), false, account,
 	)
 	if err != nil {
 		return nil, err
@@ -628,15 +648,17 @@ func (w *WalletKit) EstimateFee(ctx context.Context,
 			"than 1")
 	}
 
-	satPerKw, err := w.cfg.FeeEstimator.EstimateFeePerKW(
-		uint32(req.ConfTarget),
+	satPerKw, source, err := chainfee.EstimateFeePerKWWithSource(
+		w.cfg.FeeEstimator, uint32(req.ConfTarget),
 	)
 	if err != nil {
 		return nil, err
 	}
 
 	return &EstimateFeeResponse{
-		SatPerKw: int64(satPerKw),
+		SatPerKw:   int64(satPerKw),
+		ConfTarget: uint32(req.ConfTarget),
+		Source:     lnrpc.MarshalFeeEstimateSource(source),
 	}, nil
 }
 
@@ -655,6 +677,12 @@ func (w *WalletKit) PendingSweeps(ctx context.Context,
 		return nil, err
 	}
 
+	// The confirmation estimates are relative to the current height.
+	_, bestHeight, err := w.cfg.Chain.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
 	// Convert them into their respective RPC format.
 	rpcPendingSweeps := make([]*PendingSweep, 0, len(pendingInputs))
 	for _, pendingInput := range pendingInputs {
@@ -703,6 +731,32 @@ func (w *WalletKit) PendingSweeps(ctx context.Context,
 		requestedFee := pendingInput.Params.Fee
 		requestedFeeRate := uint64(requestedFee.FeeRate.FeePerKVByte() / 1000)
 
+		// Determine the fee rate that a sweep of the input would use
+		// now, and estimate when the sweep confirms. If the input was
+		// already swept, the fee rate of that sweep is what counts.
+		currentFeeRate, err := sweep.DetermineFeePerKw(
+			w.cfg.FeeEstimator, requestedFee,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		sweepFeeRate := pendingInput.LastFeeRate
+		if sweepFeeRate == 0 {
+			sweepFeeRate = currentFeeRate
+		}
+
+		var estimatedConfHeight uint32
+		confTarget, ok, err := sweep.EstimateConfTarget(
+			w.cfg.FeeEstimator, sweepFeeRate,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			estimatedConfHeight = uint32(bestHeight) + confTarget
+		}
+
 		rpcPendingSweeps = append(rpcPendingSweeps, &PendingSweep{
 			Outpoint:             op,
 			WitnessType:          witnessType,
@@ -713,6 +767,11 @@ func (w *WalletKit) PendingSweeps(ctx context.Context,
 			RequestedSatPerVbyte: requestedFeeRate,
 			RequestedConfTarget:  requestedFee.ConfTarget,
 			Force:                pendingInput.Params.Force,
+			CurrentSatPerVbyte: uint64(
+				currentFeeRate.FeePerKVByte() / 1000,
+			),
+			EstimatedConfHeight: estimatedConfHeight,
+			DeadlineHeight:      pendingInput.Params.DeadlineHeight,
 		})
 	}
 
@@ -863,6 +922,134 @@ func (w *WalletKit) BumpFee(ctx context.Context,
 	return &BumpFeeResponse{}, nil
 }
 
+// SweepOutput sweeps a single output under control of the wallet to the given
+// address at the given fee rate, bypassing the UtxoSweeper. Outputs that are
+// currently being swept by the UtxoSweeper are refused to prevent double
+// spends.
+func (w *WalletKit) SweepOutput(ctx context.Context,
+	req *SweepOutputRequest) (*SweepOutputResponse, error) {
+
+	op, err := unmarshallOutPoint(req.Outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.SatPerVbyte == 0 {
+		return nil, errors.New("sat_per_vbyte must be set")
+	}
+	feeRate := chainfee.SatPerKVByte(
+		req.SatPerVbyte * 1000,
+	).FeePerKWeight()
+	if feeRate < chainfee.FeePerKwFloor {
+		return nil, fmt.Errorf("fee rate of %v sat/kw is below the "+
+			"minimum of %v sat/kw", int64(feeRate),
+			int64(chainfee.FeePerKwFloor))
+	}
+
+	addr, err := btcutil.DecodeAddress(req.Addr, w.cfg.ChainParams)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode address: %v", err)
+	}
+	if !addr.IsForNet(w.cfg.ChainParams) {
+		return nil, fmt.Errorf("address %v is not valid for %v",
+			req.Addr, w.cfg.ChainParams.Name)
+	}
+
+	label := labels.MakeLabel(labels.LabelTypeSweepTransaction, nil)
+	if req.Label != "" {
+		label, err = labels.ValidateAPI(req.Label)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// We'll lease the output before anything else, so that no concurrent
+	// coin selection or sweep attempt can pick it up while we're checking
+	// whether it's safe to spend it. If we fail to sweep it, the lease is
+	// released again.
+	_, err = w.cfg.Wallet.LeaseOutput(
+		LndInternalLockID, *op, DefaultLockDuration,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to lease output %v: %v", op,
+			err)
+	}
+
+	sweepTx, err := w.sweepLeasedOutput(op, addr, feeRate, label)
+	if err != nil {
+		if err := w.cfg.Wallet.ReleaseOutput(
+			LndInternalLockID, *op,
+		); err != nil {
+
+			log.Errorf("Unable to release lease on output %v: %v",
+				op, err)
+		}
+
+		return nil, err
+	}
+
+	var rawTx bytes.Buffer
+	if err := sweepTx.Serialize(&rawTx); err != nil {
+		return nil, err
+	}
+
+	return &SweepOutputResponse{
+		RawTx: rawTx.Bytes(),
+		Txid:  sweepTx.TxHash().String(),
+	}, nil
+}
+
+// sweepLeasedOutput crafts and publishes a transaction sweeping the given
+// output to the given address. The output must already be leased by the
+// caller, to make sure the UtxoSweeper check below can't race with another
+// attempt to spend it.
+func (w *WalletKit) sweepLeasedOutput(op *wire.OutPoint, addr btcutil.Address,
+	feeRate chainfee.SatPerKWeight, label string) (*wire.MsgTx, error) {
+
+	// Spending an output the UtxoSweeper is already attempting to sweep
+	// would result in a double spend, so we refuse to do so.
+	pendingInputs, err := w.cfg.Sweeper.PendingInputs()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := pendingInputs[*op]; ok {
+		return nil, fmt.Errorf("output %v is already being swept by "+
+			"the sweeper", op)
+	}
+
+	utxo, err := w.cfg.Wallet.FetchInputInfo(op)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch output %v: %v", op,
+			err)
+	}
+
+	_, bestHeight, err := w.cfg.Chain.GetBestBlock()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve current height: %v",
+			err)
+	}
+
+	sweepPkg, err := sweep.CraftSweepOutputTx(
+		feeRate, lnwallet.DefaultDustLimit(), uint32(bestHeight), utxo,
+		addr, w.cfg.CoinSelectionLocker, w.cfg.Wallet, w.cfg.Signer,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sweepTx := sweepPkg.SweepTx
+	log.Infof("Sweeping output %v to %v with tx %v", op, addr,
+		sweepTx.TxHash())
+
+	err = w.cfg.Wallet.PublishTransaction(sweepTx, label)
+	if err != nil {
+		sweepPkg.CancelSweepAttempt()
+		return nil, err
+	}
+
+	return sweepTx, nil
+}
+
 // ListSweeps returns a list of the sweeps that our node has published.
 func (w *WalletKit) ListSweeps(ctx context.Context,
 	in *ListSweepsRequest) (*ListSweepsResponse, error) {
@@ -953,6 +1140,41 @@ func (w *WalletKit) LabelTransaction(ctx context.Context,
 	return &LabelTransactionResponse{}, err
 }
 
+// GetTransaction returns a single transaction of the wallet, including its
+// label.
+func (w *WalletKit) GetTransaction(ctx context.Context,
+	req *GetTransactionRequest) (*lnrpc.Transaction, error) {
+
+	hash, err := chainhash.NewHash(req.Txid)
+	if err != nil {
+		return nil, err
+	}
+
+	// The wallet doesn't index its transactions by hash, so we look up
+	// the transaction among all of them, including unconfirmed ones. We
+	// don't filter by account, as received transactions are returned as
+	// well.
+	transactions, err := w.cfg.Wallet.ListTransactionDetails(
+		0, btcwallet.UnconfirmedHeight, "",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tx := range transactions {
+		if tx.Hash != *hash {
+			continue
+		}
+
+		txDetails := lnrpc.RPCTransactionDetails(
+			[]*lnwallet.TransactionDetail{tx},
+		)
+		return txDetails.Transactions[0], nil
+	}
+
+	return nil, ErrTxNotFound
+}
+
 // FundPsbt creates a fully populated PSBT that contains enough inputs to fund
 // the outputs specified in the template. There are two ways of specifying a
 // template: Either by passing in a PSBT with at least one output declared or
@@ -1474,3 +1696,113 @@ func (w *WalletKit) ImportPublicKey(ctx context.Context,
 
 	return &ImportPublicKeyResponse{}, nil
 }
+
+// SetDefaultAddressType changes the type of the addresses the wallet generates
+// if no specific type is requested, including change addresses.
+func (w *WalletKit) SetDefaultAddressType(ctx context.Context,
+	req *SetDefaultAddressTypeRequest) (*SetDefaultAddressTypeResponse,
+	error) {
+
+	var addrType lnwallet.AddressType
+	switch req.AddressType {
+	case AddressType_WITNESS_PUBKEY_HASH:
+		addrType = lnwallet.WitnessPubKey
+
+	case AddressType_NESTED_WITNESS_PUBKEY_HASH:
+		addrType = lnwallet.NestedWitnessPubKey
+
+	default:
+		return nil, fmt.Errorf("unsupported default address type %v",
+			req.AddressType)
+	}
+
+	prevAddrType := w.cfg.Wallet.DefaultAddressType()
+	if err := w.cfg.Wallet.SetDefaultAddressType(addrType); err != nil {
+		return nil, err
+	}
+
+	prevRPCAddrType := AddressType_WITNESS_PUBKEY_HASH
+	if prevAddrType == lnwallet.NestedWitnessPubKey {
+		prevRPCAddrType = AddressType_NESTED_WITNESS_PUBKEY_HASH
+	}
+
+	return &SetDefaultAddressTypeResponse{
+		PreviousAddressType: prevRPCAddrType,
+	}, nil
+}
+
+// GetAddressInfo returns the information the wallet has stored about the given
+// address, such as its BIP32 derivation path and whether the wallet holds the
+// private key for it. Addresses that don't belong to the wallet result in a
+// response with IsMine set to false.
+func (w *WalletKit) GetAddressInfo(ctx context.Context,
+	req *GetAddressInfoRequest) (*GetAddressInfoResponse, error) {
+
+	addr, err := btcutil.DecodeAddress(req.Address, w.cfg.ChainParams)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode address: %v", err)
+	}
+	if !addr.IsForNet(w.cfg.ChainParams) {
+		return nil, fmt.Errorf("address %v is not valid for %v",
+			req.Address, w.cfg.ChainParams.Name)
+	}
+
+	managedAddr, err := w.cfg.Wallet.AddressInfo(addr)
+	switch {
+	case err == lnwallet.ErrNotMine:
+		return &GetAddressInfoResponse{IsMine: false}, nil
+
+	case err != nil:
+		return nil, err
+	}
+
+	resp := &GetAddressInfoResponse{
+		IsMine:   true,
+		Internal: managedAddr.Internal(),
+	}
+
+	// Individually imported keys don't carry any derivation information,
+	// and we only ever import their public keys.
+	var (
+		keyScope waddrmgr.KeyScope
+		path     waddrmgr.DerivationPath
+		hasPath  bool
+	)
+	pubKeyAddr, ok := managedAddr.(waddrmgr.ManagedPubKeyAddress)
+	if ok {
+		keyScope, path, hasPath = pubKeyAddr.DerivationInfo()
+	}
+	if !hasPath {
+		resp.Account = waddrmgr.ImportedAddrAccountName
+		resp.WatchOnly = true
+		return resp, nil
+	}
+
+	resp.DerivationPath = fmt.Sprintf("%v/%v'/%v/%v", keyScope,
+		path.Account, path.Branch, path.Index)
+	resp.KeyFamily = path.Account
+	resp.KeyIndex = path.Index
+	if path.MasterKeyFingerprint != 0 {
+		var mkfp [4]byte
+		binary.BigEndian.PutUint32(mkfp[:], path.MasterKeyFingerprint)
+		resp.MasterKeyFingerprint = mkfp[:]
+	}
+
+	// Whether we hold the private key depends on the account the address
+	// was derived from, so we'll look it up within the address' key scope.
+	accounts, err := w.cfg.Wallet.ListAccounts("", &keyScope)
+	if err != nil {
+		return nil, err
+	}
+	for _, account := range accounts {
+		if account.AccountNumber != managedAddr.InternalAccount() {
+			continue
+		}
+
+		resp.Account = account.AccountName
+		resp.WatchOnly = account.IsWatchOnly
+		break
+	}
+
+	return resp, nil
+}
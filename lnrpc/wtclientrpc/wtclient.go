@@ -41,6 +41,10 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/wtclientrpc.WatchtowerClient/MigrateTower": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/wtclientrpc.WatchtowerClient/ListTowers": {{
 			Entity: "offchain",
 			Action: "read",
@@ -57,6 +61,10 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/wtclientrpc.WatchtowerClient/ListTowerSessions": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 	}
 
 	// ErrWtclientNotActive signals that RPC calls cannot be processed
@@ -257,6 +265,75 @@ func (c *WatchtowerClient) RemoveTower(ctx context.Context,
 	return &RemoveTowerResponse{}, nil
 }
 
+// MigrateTower replaces a watchtower by a new one. The old watchtower is no
+// longer used for future session negotiations and backups, while the new
+// watchtower is preferred over any other registered watchtower. States that
+// were backed up before the migration remain with the old watchtower.
+func (c *WatchtowerClient) MigrateTower(ctx context.Context,
+	req *MigrateTowerRequest) (*MigrateTowerResponse, error) {
+
+	if err := c.isActive(); err != nil {
+		return nil, err
+	}
+
+	oldPubKey, err := btcec.ParsePubKey(req.OldPubkey, btcec.S256())
+	if err != nil {
+		return nil, fmt.Errorf("invalid old tower pubkey: %v", err)
+	}
+	newPubKey, err := btcec.ParsePubKey(req.NewPubkey, btcec.S256())
+	if err != nil {
+		return nil, fmt.Errorf("invalid new tower pubkey: %v", err)
+	}
+	addr, err := lncfg.ParseAddressString(
+		req.NewAddress, strconv.Itoa(watchtower.DefaultPeerPort),
+		c.cfg.Resolver,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address %v: %v",
+			req.NewAddress, err)
+	}
+
+	towerAddr := &lnwire.NetAddress{
+		IdentityKey: newPubKey,
+		Address:     addr,
+	}
+
+	// TODO(conner): make atomic via multiplexed client
+	err = c.cfg.Client.MigrateTower(oldPubKey, towerAddr)
+	if err != nil {
+		return nil, err
+	}
+	err = c.cfg.AnchorClient.MigrateTower(oldPubKey, towerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Both clients share the same database, so looking up the towers
+	// through either of them returns the sessions of both.
+	oldTower, err := c.cfg.Client.LookupTower(oldPubKey)
+	if err != nil {
+		return nil, err
+	}
+	newTower, err := c.cfg.Client.LookupTower(newPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var oldBackups, newBackups uint32
+	for _, session := range oldTower.Sessions {
+		oldBackups += uint32(len(session.AckedUpdates))
+	}
+	for _, session := range newTower.Sessions {
+		newBackups += uint32(len(session.AckedUpdates))
+	}
+
+	return &MigrateTowerResponse{
+		NewTowerBackups:  newBackups,
+		NewTowerSessions: uint32(len(newTower.Sessions)),
+		OldTowerBackups:  oldBackups,
+	}, nil
+}
+
 // ListTowers returns the list of watchtowers registered with the client.
 func (c *WatchtowerClient) ListTowers(ctx context.Context,
 	req *ListTowersRequest) (*ListTowersResponse, error) {
@@ -385,6 +462,125 @@ func (c *WatchtowerClient) Policy(ctx context.Context,
 	}, nil
 }
 
+// ListTowerSessions returns the sessions negotiated with each registered
+// watchtower, along with the number of backups stored in them and their
+// remaining capacity.
+func (c *WatchtowerClient) ListTowerSessions(ctx context.Context,
+	req *ListTowerSessionsRequest) (*ListTowerSessionsResponse, error) {
+
+	if err := c.isActive(); err != nil {
+		return nil, err
+	}
+
+	var towers []*wtclient.RegisteredTower
+	if len(req.Pubkey) > 0 {
+		pubKey, err := btcec.ParsePubKey(req.Pubkey, btcec.S256())
+		if err != nil {
+			return nil, err
+		}
+
+		tower, err := c.cfg.Client.LookupTower(pubKey)
+		if err == wtdb.ErrTowerNotFound {
+			tower, err = c.cfg.AnchorClient.LookupTower(pubKey)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// The tower is only a candidate for new sessions of one of
+		// the clients, so we need to ask both of them.
+		tower.ActiveSessionCandidate = c.isSessionCandidate(tower)
+		towers = append(towers, tower)
+	} else {
+		// Both clients share the same database, so the towers and
+		// sessions they return are the same.
+		registered, err := c.cfg.Client.RegisteredTowers()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tower := range registered {
+			tower.ActiveSessionCandidate = c.isSessionCandidate(
+				tower,
+			)
+			towers = append(towers, tower)
+		}
+	}
+
+	rpcTowers := make([]*TowerSessions, 0, len(towers))
+	for _, tower := range towers {
+		rpcTowers = append(rpcTowers, marshallTowerSessions(tower))
+	}
+
+	return &ListTowerSessionsResponse{Towers: rpcTowers}, nil
+}
+
+// isSessionCandidate returns true if the tower is a candidate for new sessions
+// of either the legacy or the anchor client.
+func (c *WatchtowerClient) isSessionCandidate(
+	tower *wtclient.RegisteredTower) bool {
+
+	if tower.ActiveSessionCandidate {
+		return true
+	}
+
+	anchorTower, err := c.cfg.AnchorClient.LookupTower(tower.IdentityKey)
+	if err != nil {
+		return false
+	}
+
+	return anchorTower.ActiveSessionCandidate
+}
+
+// marshallTowerSessions converts the sessions of a client registered
+// watchtower into their corresponding RPC type.
+func marshallTowerSessions(tower *wtclient.RegisteredTower) *TowerSessions {
+	rpcSessions := make([]*TowerSessionInfo, 0, len(tower.Sessions))
+	numExhausted := 0
+	for id, session := range tower.Sessions {
+		// The sequence number is only incremented when an update is
+		// committed to the session, so it counts the slots of the
+		// session that are used up.
+		maxBackups := session.Policy.MaxUpdates
+		var remaining uint16
+		if session.SeqNum < maxBackups {
+			remaining = maxBackups - session.SeqNum
+		}
+
+		exhausted := remaining == 0
+		if exhausted {
+			numExhausted++
+		}
+
+		policyType := PolicyType_LEGACY
+		if session.Policy.IsAnchorChannel() {
+			policyType = PolicyType_ANCHOR
+		}
+
+		sessionID := id
+		numPending := len(session.CommittedUpdates)
+		satPerVByte := session.Policy.SweepFeeRate.FeePerKVByte() / 1000
+		rpcSessions = append(rpcSessions, &TowerSessionInfo{
+			Id:                sessionID[:],
+			PolicyType:        policyType,
+			MaxBackups:        uint32(maxBackups),
+			SweepSatPerVbyte:  uint32(satPerVByte),
+			NumBackups:        uint32(len(session.AckedUpdates)),
+			NumPendingBackups: uint32(numPending),
+			RemainingBackups:  uint32(remaining),
+			Exhausted:         exhausted,
+		})
+	}
+
+	return &TowerSessions{
+		Pubkey:                 tower.IdentityKey.SerializeCompressed(),
+		ActiveSessionCandidate: tower.ActiveSessionCandidate,
+		Sessions:               rpcSessions,
+		SessionsExhausted: len(rpcSessions) > 0 &&
+			numExhausted == len(rpcSessions),
+	}
+}
+
 // marshallTower converts a client registered watchtower into its corresponding
 // RPC type.
 func marshallTower(tower *wtclient.RegisteredTower, includeSessions bool) *Tower {
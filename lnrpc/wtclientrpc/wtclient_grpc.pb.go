@@ -30,6 +30,14 @@ type WatchtowerClientClient interface {
 	//again. If an address is provided, then this RPC only serves as a way of
 	//removing the address from the watchtower instead.
 	RemoveTower(ctx context.Context, in *RemoveTowerRequest, opts ...grpc.CallOption) (*RemoveTowerResponse, error)
+	//
+	//MigrateTower replaces a watchtower by a new one. The old watchtower is no
+	//longer used for future session negotiations and backups, while the new
+	//watchtower is preferred over any other registered watchtower. States that
+	//were backed up before the migration remain with the old watchtower. The
+	//migration fails if the old watchtower has backups pending to be
+	//acknowledged.
+	MigrateTower(ctx context.Context, in *MigrateTowerRequest, opts ...grpc.CallOption) (*MigrateTowerResponse, error)
 	// ListTowers returns the list of watchtowers registered with the client.
 	ListTowers(ctx context.Context, in *ListTowersRequest, opts ...grpc.CallOption) (*ListTowersResponse, error)
 	// GetTowerInfo retrieves information for a registered watchtower.
@@ -38,6 +46,12 @@ type WatchtowerClientClient interface {
 	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error)
 	// Policy returns the active watchtower client policy configuration.
 	Policy(ctx context.Context, in *PolicyRequest, opts ...grpc.CallOption) (*PolicyResponse, error)
+	//
+	//ListTowerSessions returns the sessions negotiated with each registered
+	//watchtower, along with the number of backups stored in them and their
+	//remaining capacity. Watchtowers whose sessions are all exhausted are
+	//flagged.
+	ListTowerSessions(ctx context.Context, in *ListTowerSessionsRequest, opts ...grpc.CallOption) (*ListTowerSessionsResponse, error)
 }
 
 type watchtowerClientClient struct {
@@ -66,6 +80,15 @@ func (c *watchtowerClientClient) RemoveTower(ctx context.Context, in *RemoveTowe
 	return out, nil
 }
 
+func (c *watchtowerClientClient) MigrateTower(ctx context.Context, in *MigrateTowerRequest, opts ...grpc.CallOption) (*MigrateTowerResponse, error) {
+	out := new(MigrateTowerResponse)
+	err := c.cc.Invoke(ctx, "/wtclientrpc.WatchtowerClient/MigrateTower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *watchtowerClientClient) ListTowers(ctx context.Context, in *ListTowersRequest, opts ...grpc.CallOption) (*ListTowersResponse, error) {
 	out := new(ListTowersResponse)
 	err := c.cc.Invoke(ctx, "/wtclientrpc.WatchtowerClient/ListTowers", in, out, opts...)
@@ -102,6 +125,15 @@ func (c *watchtowerClientClient) Policy(ctx context.Context, in *PolicyRequest,
 	return out, nil
 }
 
+func (c *watchtowerClientClient) ListTowerSessions(ctx context.Context, in *ListTowerSessionsRequest, opts ...grpc.CallOption) (*ListTowerSessionsResponse, error) {
+	out := new(ListTowerSessionsResponse)
+	err := c.cc.Invoke(ctx, "/wtclientrpc.WatchtowerClient/ListTowerSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WatchtowerClientServer is the server API for WatchtowerClient service.
 // All implementations must embed UnimplementedWatchtowerClientServer
 // for forward compatibility
@@ -118,6 +150,14 @@ type WatchtowerClientServer interface {
 	//again. If an address is provided, then this RPC only serves as a way of
 	//removing the address from the watchtower instead.
 	RemoveTower(context.Context, *RemoveTowerRequest) (*RemoveTowerResponse, error)
+	//
+	//MigrateTower replaces a watchtower by a new one. The old watchtower is no
+	//longer used for future session negotiations and backups, while the new
+	//watchtower is preferred over any other registered watchtower. States that
+	//were backed up before the migration remain with the old watchtower. The
+	//migration fails if the old watchtower has backups pending to be
+	//acknowledged.
+	MigrateTower(context.Context, *MigrateTowerRequest) (*MigrateTowerResponse, error)
 	// ListTowers returns the list of watchtowers registered with the client.
 	ListTowers(context.Context, *ListTowersRequest) (*ListTowersResponse, error)
 	// GetTowerInfo retrieves information for a registered watchtower.
@@ -126,6 +166,12 @@ type WatchtowerClientServer interface {
 	Stats(context.Context, *StatsRequest) (*StatsResponse, error)
 	// Policy returns the active watchtower client policy configuration.
 	Policy(context.Context, *PolicyRequest) (*PolicyResponse, error)
+	//
+	//ListTowerSessions returns the sessions negotiated with each registered
+	//watchtower, along with the number of backups stored in them and their
+	//remaining capacity. Watchtowers whose sessions are all exhausted are
+	//flagged.
+	ListTowerSessions(context.Context, *ListTowerSessionsRequest) (*ListTowerSessionsResponse, error)
 	mustEmbedUnimplementedWatchtowerClientServer()
 }
 
@@ -139,6 +185,9 @@ func (UnimplementedWatchtowerClientServer) AddTower(context.Context, *AddTowerRe
 func (UnimplementedWatchtowerClientServer) RemoveTower(context.Context, *RemoveTowerRequest) (*RemoveTowerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveTower not implemented")
 }
+func (UnimplementedWatchtowerClientServer) MigrateTower(context.Context, *MigrateTowerRequest) (*MigrateTowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateTower not implemented")
+}
 func (UnimplementedWatchtowerClientServer) ListTowers(context.Context, *ListTowersRequest) (*ListTowersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTowers not implemented")
 }
@@ -151,6 +200,9 @@ func (UnimplementedWatchtowerClientServer) Stats(context.Context, *StatsRequest)
 func (UnimplementedWatchtowerClientServer) Policy(context.Context, *PolicyRequest) (*PolicyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Policy not implemented")
 }
+func (UnimplementedWatchtowerClientServer) ListTowerSessions(context.Context, *ListTowerSessionsRequest) (*ListTowerSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTowerSessions not implemented")
+}
 func (UnimplementedWatchtowerClientServer) mustEmbedUnimplementedWatchtowerClientServer() {}
 
 // UnsafeWatchtowerClientServer may be embedded to opt out of forward compatibility for this service.
@@ -200,6 +252,24 @@ func _WatchtowerClient_RemoveTower_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WatchtowerClient_MigrateTower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateTowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchtowerClientServer).MigrateTower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wtclientrpc.WatchtowerClient/MigrateTower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchtowerClientServer).MigrateTower(ctx, req.(*MigrateTowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WatchtowerClient_ListTowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListTowersRequest)
 	if err := dec(in); err != nil {
@@ -272,6 +342,24 @@ func _WatchtowerClient_Policy_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WatchtowerClient_ListTowerSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTowerSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchtowerClientServer).ListTowerSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wtclientrpc.WatchtowerClient/ListTowerSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchtowerClientServer).ListTowerSessions(ctx, req.(*ListTowerSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WatchtowerClient_ServiceDesc is the grpc.ServiceDesc for WatchtowerClient service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -287,6 +375,10 @@ var WatchtowerClient_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveTower",
 			Handler:    _WatchtowerClient_RemoveTower_Handler,
 		},
+		{
+			MethodName: "MigrateTower",
+			Handler:    _WatchtowerClient_MigrateTower_Handler,
+		},
 		{
 			MethodName: "ListTowers",
 			Handler:    _WatchtowerClient_ListTowers_Handler,
@@ -303,6 +395,10 @@ var WatchtowerClient_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Policy",
 			Handler:    _WatchtowerClient_Policy_Handler,
 		},
+		{
+			MethodName: "ListTowerSessions",
+			Handler:    _WatchtowerClient_ListTowerSessions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "wtclientrpc/wtclient.proto",
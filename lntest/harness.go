@@ -986,6 +986,10 @@ type OpenChannelParams struct {
 	// CommitmentType is the commitment type that should be used for the
 	// channel to be opened.
 	CommitmentType lnrpc.CommitmentType
+
+	// CloseAddress is an optional upfront shutdown address that the
+	// funds of the opening node are paid out to on a cooperative close.
+	CloseAddress string
 }
 
 // OpenChannel attempts to open a channel between srcNode and destNode with the
@@ -1030,6 +1034,7 @@ func (n *NetworkHarness) OpenChannel(srcNode, destNode *HarnessNode,
 		FundingShim:        p.FundingShim,
 		SatPerByte:         int64(p.SatPerVByte),
 		CommitmentType:     p.CommitmentType,
+		CloseAddress:       p.CloseAddress,
 	}
 
 	respStream, err := srcNode.OpenChannel(ctx, openReq)
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/integration/rpctest"
 	"github.com/btcsuite/btcd/rpcclient"
@@ -640,6 +641,64 @@ func getChannelBalance(t *harnessTest,
 	return resp
 }
 
+// assertChannelBalance asserts that the settled local and remote balances of
+// the given channel, as reported by the node's ListChannels, eventually match
+// the expected amounts. The balances of pending htlcs are reported separately
+// by ListChannels and are not part of the settled balances. The balances are
+// expected to include the channel reserves, which are reported alongside them
+// if the assertion fails.
+func assertChannelBalance(t *harnessTest, node *lntest.HarnessNode,
+	chanPoint *lnrpc.ChannelPoint, expectedLocal,
+	expectedRemote btcutil.Amount) {
+
+	t.t.Helper()
+
+	ctxb := context.Background()
+	chanPointStr := txStr(chanPoint)
+
+	err := wait.NoError(func() error {
+		ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+		defer cancel()
+		resp, err := node.ListChannels(
+			ctxt, &lnrpc.ListChannelsRequest{},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to list channels: %v", err)
+		}
+
+		for _, channel := range resp.Channels {
+			if channel.ChannelPoint != chanPointStr {
+				continue
+			}
+
+			local := btcutil.Amount(channel.LocalBalance)
+			remote := btcutil.Amount(channel.RemoteBalance)
+			if local == expectedLocal && remote == expectedRemote {
+				return nil
+			}
+
+			localReserve := btcutil.Amount(
+				channel.LocalConstraints.ChanReserveSat,
+			)
+			remoteReserve := btcutil.Amount(
+				channel.RemoteConstraints.ChanReserveSat,
+			)
+			unsettled := btcutil.Amount(channel.UnsettledBalance)
+
+			return fmt.Errorf("expected local=%v remote=%v, got "+
+				"local=%v (reserve %v) remote=%v (reserve "+
+				"%v) with %v in %v pending htlcs",
+				expectedLocal, expectedRemote, local,
+				localReserve, remote, remoteReserve, unsettled,
+				len(channel.PendingHtlcs))
+		}
+
+		return fmt.Errorf("channel %v not found", chanPointStr)
+	}, defaultTimeout)
+	require.NoErrorf(t.t, err, "%s has unexpected channel balance",
+		node.Name())
+}
+
 // expectedChanUpdate houses params we expect a ChannelUpdate to advertise.
 type expectedChanUpdate struct {
 	advertisingNode string
@@ -810,6 +869,69 @@ func getChannelPolicies(t *harnessTest, node *lntest.HarnessNode,
 	return policies
 }
 
+// assertChannelPruned asserts that the channel identified by chanPoint is
+// pruned from the node's graph once its funding output has been spent
+// on-chain and the spending transaction has been confirmed.
+func assertChannelPruned(t *harnessTest, net *lntest.NetworkHarness,
+	node *lntest.HarnessNode, chanPoint *lnrpc.ChannelPoint) {
+
+	t.t.Helper()
+
+	// Make sure the funding output is actually spent, otherwise the
+	// channel would only be pruned as a zombie.
+	txid, err := lnrpc.GetChanPointFundingTxid(chanPoint)
+	require.NoError(t.t, err, "unable to get txid")
+
+	txOut, err := net.Miner.Client.GetTxOut(
+		txid, chanPoint.OutputIndex, true,
+	)
+	require.NoError(t.t, err, "unable to query funding output")
+	require.Nil(
+		t.t, txOut, "funding output %v is unspent", txStr(chanPoint),
+	)
+
+	assertChannelPrunedWithin(t, node, chanPoint, defaultTimeout)
+}
+
+// assertChannelPrunedWithin asserts that the channel identified by chanPoint
+// no longer appears in the node's graph within the given timeout. Besides
+// channels whose funding output was spent, this can also be used to assert
+// that a zombie channel, which hasn't received any updates, is pruned by
+// choosing a timeout that covers the node's staleness window and graph prune
+// interval.
+func assertChannelPrunedWithin(t *harnessTest, node *lntest.HarnessNode,
+	chanPoint *lnrpc.ChannelPoint, timeout time.Duration) {
+
+	t.t.Helper()
+
+	ctxb := context.Background()
+	req := &lnrpc.ChannelGraphRequest{
+		IncludeUnannounced: true,
+	}
+
+	err := wait.NoError(func() error {
+		ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+		defer cancel()
+
+		chanGraph, err := node.DescribeGraph(ctxt, req)
+		if err != nil {
+			return fmt.Errorf("unable to query graph: %v", err)
+		}
+
+		for _, edge := range chanGraph.Edges {
+			if edge.ChanPoint == txStr(chanPoint) {
+				return fmt.Errorf("channel %v still in graph",
+					txStr(chanPoint))
+			}
+		}
+
+		return nil
+	}, timeout)
+	require.NoError(
+		t.t, err, "%s: channel not pruned from graph", node.Name(),
+	)
+}
+
 // assertChannelPolicy asserts that the passed node's known channel policy for
 // the passed chanPoint is consistent with the expected policy values.
 func assertChannelPolicy(t *harnessTest, node *lntest.HarnessNode,
@@ -1867,3 +1989,287 @@ func assertNumUTXOs(t *testing.T, node *lntest.HarnessNode, expectedUtxos int) {
 	}, defaultTimeout)
 	require.NoError(t, err, "wait for listunspent")
 }
+
+// assertChannelStablePostRestart restarts the given node and asserts that the
+// channel identified by chanPoint reappears as active with the same commitment
+// type, capacity and local balance it had before the restart. Any HTLCs that
+// were in flight before the restart must be recovered as well.
+func assertChannelStablePostRestart(t *harnessTest, net *lntest.NetworkHarness,
+	node *lntest.HarnessNode, chanPoint *lnrpc.ChannelPoint) {
+
+	t.t.Helper()
+
+	ctxb := context.Background()
+	chanPointStr := txStr(chanPoint)
+
+	// findChannel is a helper closure that looks up the channel among the
+	// node's open channels.
+	findChannel := func() (*lnrpc.Channel, error) {
+		ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+		defer cancel()
+
+		req := &lnrpc.ListChannelsRequest{}
+		resp, err := node.ListChannels(ctxt, req)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, channel := range resp.Channels {
+			if channel.ChannelPoint == chanPointStr {
+				return channel, nil
+			}
+		}
+
+		return nil, fmt.Errorf("channel %v not found", chanPointStr)
+	}
+
+	// htlcHashes returns the set of hex encoded payment hashes of the
+	// HTLCs that are in flight on the channel.
+	htlcHashes := func(channel *lnrpc.Channel) map[string]struct{} {
+		hashes := make(map[string]struct{}, len(channel.PendingHtlcs))
+		for _, htlc := range channel.PendingHtlcs {
+			hashes[hex.EncodeToString(htlc.HashLock)] = struct{}{}
+		}
+
+		return hashes
+	}
+
+	before, err := findChannel()
+	require.NoError(t.t, err, "unable to find channel before restart")
+
+	require.NoError(
+		t.t, net.RestartNode(node, nil), "unable to restart node",
+	)
+
+	// Once the node is back up, the channel must become active again and
+	// all its parameters must match the state before the restart.
+	err = wait.NoError(func() error {
+		after, err := findChannel()
+		if err != nil {
+			return err
+		}
+
+		if !after.Active {
+			return fmt.Errorf("channel %v not active", chanPointStr)
+		}
+
+		if after.CommitmentType != before.CommitmentType {
+			return fmt.Errorf("expected commitment type %v, got %v",
+				before.CommitmentType, after.CommitmentType)
+		}
+
+		if after.Capacity != before.Capacity {
+			return fmt.Errorf("expected capacity %v, got %v",
+				before.Capacity, after.Capacity)
+		}
+
+		if after.LocalBalance != before.LocalBalance {
+			return fmt.Errorf("expected local balance %v, got %v",
+				before.LocalBalance, after.LocalBalance)
+		}
+
+		// All HTLCs that were in flight must have been recovered.
+		beforeHashes := htlcHashes(before)
+		afterHashes := htlcHashes(after)
+		if len(beforeHashes) != len(afterHashes) {
+			return fmt.Errorf("expected %v htlcs in flight, got %v",
+				len(beforeHashes), len(afterHashes))
+		}
+		for hash := range beforeHashes {
+			if _, ok := afterHashes[hash]; !ok {
+				return fmt.Errorf("htlc %v not recovered", hash)
+			}
+		}
+
+		return nil
+	}, defaultTimeout)
+	require.NoError(t.t, err, "channel not stable after restart")
+}
+
+// assertChannelReserve asserts that the channel identified by chanPoint has
+// the expected local and remote channel reserves as reported by the
+// LocalConstraints and RemoteConstraints of ListChannels. For anchor channels
+// the initiator additionally pays for the two anchor outputs, which acts as an
+// implicit reserve on top of the explicit one. The effective local and remote
+// reserves, including any implicit anchor reserve, are returned.
+func assertChannelReserve(t *harnessTest, node *lntest.HarnessNode,
+	chanPoint *lnrpc.ChannelPoint, expectedLocal,
+	expectedRemote btcutil.Amount) (btcutil.Amount, btcutil.Amount) {
+
+	t.t.Helper()
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+
+	req := &lnrpc.ListChannelsRequest{}
+	resp, err := node.ListChannels(ctxt, req)
+	require.NoError(t.t, err, "unable to list channels")
+
+	chanPointStr := txStr(chanPoint)
+	for _, channel := range resp.Channels {
+		if channel.ChannelPoint != chanPointStr {
+			continue
+		}
+
+		require.NotNil(t.t, channel.LocalConstraints)
+		require.NotNil(t.t, channel.RemoteConstraints)
+
+		localReserve := btcutil.Amount(
+			channel.LocalConstraints.ChanReserveSat,
+		)
+		remoteReserve := btcutil.Amount(
+			channel.RemoteConstraints.ChanReserveSat,
+		)
+		require.Equal(
+			t.t, expectedLocal, localReserve,
+			"local reserve mismatch",
+		)
+		require.Equal(
+			t.t, expectedRemote, remoteReserve,
+			"remote reserve mismatch",
+		)
+
+		// The initiator of an anchor channel pays for both anchor
+		// outputs, which it can't spend while the channel is open.
+		if channel.CommitmentType == lnrpc.CommitmentType_ANCHORS {
+			implicitReserve := btcutil.Amount(2 * anchorSize)
+			if channel.Initiator {
+				localReserve += implicitReserve
+			} else {
+				remoteReserve += implicitReserve
+			}
+
+			t.Logf("anchor channel %v carries an implicit reserve "+
+				"of %v for the initiator", chanPointStr,
+				implicitReserve)
+		}
+
+		return localReserve, remoteReserve
+	}
+
+	t.Fatalf("channel %v not found", chanPointStr)
+	return 0, 0
+}
+
+const (
+	// coopCloseTargetConfs is the default confirmation target that is used
+	// by a node to estimate its ideal fee rate for a cooperative close.
+	coopCloseTargetConfs = 6
+
+	// coopCloseFeeRateTolerance is the relative deviation we allow between
+	// the fee rate of a closing transaction and the negotiated range, as
+	// both parties negotiate absolute fees based on weight estimates.
+	coopCloseFeeRateTolerance = 0.1
+)
+
+// coopCloseWithFeeRate initiates a cooperative close of the channel identified
+// by chanPoint from the passed node, using satPerVByte as the starting point of
+// the fee negotiation. If the peer's ideal fee rate differs from the target,
+// the negotiated fee rate ends up in between the two, so the fee rate of the
+// closing transaction is asserted to be within tolerance of that range. Once
+// the closing transaction is confirmed, its txid and the actual fee rate in
+// sat/vbyte are returned.
+func coopCloseWithFeeRate(t *harnessTest, net *lntest.NetworkHarness,
+	node *lntest.HarnessNode, chanPoint *lnrpc.ChannelPoint,
+	satPerVByte uint64) (*chainhash.Hash, float64) {
+
+	t.t.Helper()
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, channelCloseTimeout)
+	defer cancel()
+
+	// Look up the channel to find the peer and the value of the funding
+	// output that is spent by the closing transaction.
+	listResp, err := node.ListChannels(ctxt, &lnrpc.ListChannelsRequest{})
+	require.NoError(t.t, err, "unable to list channels")
+
+	var channel *lnrpc.Channel
+	chanPointStr := txStr(chanPoint)
+	for _, c := range listResp.Channels {
+		if c.ChannelPoint == chanPointStr {
+			channel = c
+			break
+		}
+	}
+	require.NotNil(t.t, channel, "channel %v not found", chanPointStr)
+
+	peer, err := net.LookUpNodeByPub(channel.RemotePubkey)
+	require.NoError(t.t, err, "unable to find peer")
+
+	// The peer counters our offer based on its own ideal fee rate, so the
+	// negotiated fee rate lies between our target and the peer's ideal.
+	feeResp, err := peer.WalletKitClient.EstimateFee(
+		ctxt, &walletrpc.EstimateFeeRequest{
+			ConfTarget: coopCloseTargetConfs,
+		},
+	)
+	require.NoError(t.t, err, "unable to estimate peer fee rate")
+
+	peerFeeRate := float64(feeResp.SatPerKw) * 4 / 1000
+	minFeeRate := math.Min(float64(satPerVByte), peerFeeRate)
+	maxFeeRate := math.Max(float64(satPerVByte), peerFeeRate)
+
+	closeUpdates, err := node.CloseChannel(
+		ctxt, &lnrpc.CloseChannelRequest{
+			ChannelPoint: chanPoint,
+			SatPerVbyte:  satPerVByte,
+		},
+	)
+	require.NoError(t.t, err, "unable to close channel")
+
+	// Wait for the negotiation to finish and the closing transaction to be
+	// broadcast.
+	update, err := closeUpdates.Recv()
+	require.NoError(t.t, err, "unable to receive close update")
+
+	pending, ok := update.Update.(*lnrpc.CloseStatusUpdate_ClosePending)
+	require.True(t.t, ok, "expected close pending update, got %v", update)
+
+	closingTxid, err := chainhash.NewHash(pending.ClosePending.Txid)
+	require.NoError(t.t, err, "invalid closing txid")
+
+	var closingTx *btcutil.Tx
+	err = wait.NoError(func() error {
+		closingTx, err = net.Miner.Client.GetRawTransaction(
+			closingTxid,
+		)
+		return err
+	}, minerMempoolTimeout)
+	require.NoError(t.t, err, "closing tx not found in mempool")
+
+	// The closing transaction spends the funding output, so its fee is the
+	// channel capacity minus the value of all outputs.
+	fee := channel.Capacity
+	for _, txOut := range closingTx.MsgTx().TxOut {
+		fee -= txOut.Value
+	}
+
+	weight := blockchain.GetTransactionWeight(closingTx)
+	vsize := (weight + blockchain.WitnessScaleFactor - 1) /
+		blockchain.WitnessScaleFactor
+	feeRate := float64(fee) / float64(vsize)
+
+	t.Logf("closing tx %v negotiated fee rate %.2f sat/vbyte (target=%v, "+
+		"peer_ideal=%.2f)", closingTxid, feeRate, satPerVByte,
+		peerFeeRate)
+
+	require.GreaterOrEqual(
+		t.t, feeRate, minFeeRate*(1-coopCloseFeeRateTolerance),
+		"closing fee rate below negotiation range",
+	)
+	require.LessOrEqual(
+		t.t, feeRate, maxFeeRate*(1+coopCloseFeeRateTolerance),
+		"closing fee rate above negotiation range",
+	)
+
+	// Finally, confirm the closing transaction and make sure the channel
+	// is cleaned up.
+	anchors := channel.CommitmentType == lnrpc.CommitmentType_ANCHORS
+	assertChannelClosed(
+		ctxt, t, net, node, chanPoint, anchors, closeUpdates,
+	)
+
+	return closingTxid, feeRate
+}
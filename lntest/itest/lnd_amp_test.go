@@ -1,6 +1,7 @@
 package itest
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
 	"sort"
@@ -296,7 +297,7 @@ func testSendPaymentAMP(net *lntest.NetworkHarness, t *harnessTest) {
 	require.Equal(t.t, int64(paymentAmt*1000), rpcInvoice.AmtPaidMsat)
 
 	// Finally, assert that the same set id is recorded for each htlc, and
-	// that the preimage hash pair is valid.
+	// that Bob reconstructed valid preimages from the shares of the set.
 	var setID []byte
 	require.Equal(t.t, succeeded, len(rpcInvoice.Htlcs))
 	for _, htlc := range rpcInvoice.Htlcs {
@@ -306,18 +307,8 @@ func testSendPaymentAMP(net *lntest.NetworkHarness, t *harnessTest) {
 			copy(setID, htlc.Amp.SetId)
 		}
 		require.Equal(t.t, setID, htlc.Amp.SetId)
-
-		// Parse the child hash and child preimage, and assert they are
-		// well-formed.
-		childHash, err := lntypes.MakeHash(htlc.Amp.Hash)
-		require.NoError(t.t, err)
-		childPreimage, err := lntypes.MakePreimage(htlc.Amp.Preimage)
-		require.NoError(t.t, err)
-
-		// Assert that the preimage actually matches the hashes.
-		validPreimage := childPreimage.Matches(childHash)
-		require.True(t.t, validPreimage)
 	}
+	assertAMPPreimageReconstructed(t, net, ctx.bob, setID)
 }
 
 func testSendToRouteAMP(net *lntest.NetworkHarness, t *harnessTest) {
@@ -599,3 +590,116 @@ func assertInvoiceEqual(t *testing.T, a, b *lnrpc.Invoice) {
 		require.Equal(t, htlcA.Amp, htlcB.Amp)
 	}
 }
+
+// assertAMPPreimageReconstructed asserts that the receiver reconstructed valid
+// preimages for the AMP set with the given set id. The root seed is recomputed
+// from the shares carried by the settled HTLCs of the set, and each settled
+// HTLC's preimage must derive from it and hash to the HTLC's payment hash. A
+// set that doesn't add up to the total amount is missing a shard and must not
+// settle, in which case the shares received must not reconstruct the root
+// seed either.
+func assertAMPPreimageReconstructed(t *harnessTest, net *lntest.NetworkHarness,
+	receiver *lntest.HarnessNode, setID []byte) {
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+
+	invoiceResp, err := receiver.ListInvoices(
+		ctxt, &lnrpc.ListInvoiceRequest{},
+	)
+	require.NoError(t.t, err)
+
+	// Collect the HTLCs of the set, which all carry a valid AMP record.
+	var setHtlcs []*lnrpc.InvoiceHTLC
+	for _, invoice := range invoiceResp.Invoices {
+		for _, htlc := range invoice.Htlcs {
+			if htlc.Amp == nil ||
+				!bytes.Equal(htlc.Amp.SetId, setID) {
+
+				continue
+			}
+
+			_, err := lntypes.MakeHash(htlc.Amp.Hash)
+			require.NoError(t.t, err, "invalid amp hash")
+			require.Len(t.t, htlc.Amp.RootShare, 32)
+
+			setHtlcs = append(setHtlcs, htlc)
+		}
+	}
+	require.NotEmpty(t.t, setHtlcs, "no htlcs for set id %x", setID)
+
+	// toChildDesc extracts the child descriptor of an AMP HTLC.
+	toChildDesc := func(htlc *lnrpc.InvoiceHTLC) amp.ChildDesc {
+		desc := amp.ChildDesc{
+			Index: htlc.Amp.ChildIndex,
+		}
+		copy(desc.Share[:], htlc.Amp.RootShare)
+
+		return desc
+	}
+
+	var (
+		settled     []*lnrpc.InvoiceHTLC
+		settledAmt  uint64
+		receivedAmt uint64
+		totalAmt    = setHtlcs[0].MppTotalAmtMsat
+	)
+	for _, htlc := range setHtlcs {
+		require.Equal(t.t, totalAmt, htlc.MppTotalAmtMsat)
+
+		receivedAmt += htlc.AmtMsat
+		if htlc.State != lnrpc.InvoiceHTLCState_SETTLED {
+			continue
+		}
+
+		settled = append(settled, htlc)
+		settledAmt += htlc.AmtMsat
+	}
+
+	// If the set is missing a shard, none of its HTLCs may settle, and the
+	// shares that were received must not reconstruct the root seed.
+	if receivedAmt < totalAmt {
+		require.Empty(t.t, settled, "incomplete amp set settled")
+
+		descs := make([]amp.ChildDesc, 0, len(setHtlcs))
+		for _, htlc := range setHtlcs {
+			descs = append(descs, toChildDesc(htlc))
+		}
+		children := amp.ReconstructChildren(descs...)
+		for i, child := range children {
+			require.NotEqual(
+				t.t, setHtlcs[i].Amp.Hash, child.Hash[:],
+				"incomplete amp set reconstructed",
+			)
+		}
+
+		return
+	}
+
+	require.GreaterOrEqual(
+		t.t, settledAmt, totalAmt, "amp set not fully settled",
+	)
+
+	// Reconstruct the root seed from the shares of the settled HTLCs and
+	// rederive the child preimages from it.
+	descs := make([]amp.ChildDesc, 0, len(settled))
+	for _, htlc := range settled {
+		descs = append(descs, toChildDesc(htlc))
+	}
+	children := amp.ReconstructChildren(descs...)
+
+	for i, htlc := range settled {
+		childHash, err := lntypes.MakeHash(htlc.Amp.Hash)
+		require.NoError(t.t, err)
+		childPreimage, err := lntypes.MakePreimage(htlc.Amp.Preimage)
+		require.NoError(t.t, err)
+
+		// The preimage the receiver settled with must be the one
+		// derived from the reconstructed root, and hash to the HTLC's
+		// payment hash.
+		require.Equal(t.t, children[i].Preimage, childPreimage)
+		require.Equal(t.t, children[i].Hash, childHash)
+		require.True(t.t, childPreimage.Matches(childHash))
+	}
+}
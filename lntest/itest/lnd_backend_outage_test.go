@@ -0,0 +1,165 @@
+package itest
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/stretchr/testify/require"
+)
+
+// testBackendOutageRecovery tests that a node keeps processing payments while
+// its chain backend is cut off from the network, and catches up on the chain
+// once the backend is reachable again.
+func testBackendOutageRecovery(net *lntest.NetworkHarness, t *harnessTest) {
+	// The neutrino backend can't be disconnected from the miner.
+	if net.BackendCfg.Name() == lntest.NeutrinoBackendName {
+		t.Skipf("skipping backend outage test for neutrino backend")
+	}
+
+	assertBackendOutageRecovery(t, net, net.Alice)
+}
+
+// assertBackendOutageRecovery simulates an outage of the chain backend by
+// disconnecting it from the miner, which leaves the node without any new
+// blocks. During the outage, the miner confirms the funding transaction of a
+// channel the node opens, and the node sends a payment over an existing
+// channel. It asserts that the node falls behind the chain and that the channel
+// stays pending, while the payment completes off-chain. Once the backend is
+// reconnected, the node must sync to the tip of the miner and open the channel,
+// without any HTLC being left behind. The node must have funds to open the
+// channels.
+func assertBackendOutageRecovery(t *harnessTest, net *lntest.NetworkHarness,
+	node *lntest.HarnessNode) {
+
+	t.t.Helper()
+
+	const (
+		chanAmt    = btcutil.Amount(500000)
+		paymentAmt = btcutil.Amount(10000)
+
+		// numOutageBlocks is the number of blocks mined during the
+		// outage, which is enough to open the channel.
+		numOutageBlocks = 6
+	)
+
+	ctxb := context.Background()
+
+	carol := net.NewNode(t.t, "Carol", nil)
+	defer shutdownAndAssert(net, t, carol)
+
+	net.ConnectNodes(t.t, node, carol)
+
+	chanPoint := openChannelAndAssert(
+		t, net, node, carol, lntest.OpenChannelParams{
+			Amt: chanAmt,
+		},
+	)
+	defer closeChannelAndAssert(t, net, node, chanPoint, false)
+
+	// Open another channel, whose funding transaction only confirms
+	// during the outage.
+	pendingUpdate, err := net.OpenPendingChannel(node, carol, chanAmt, 0)
+	require.NoError(t.t, err, "unable to open channel")
+
+	fundingTxID, err := chainhash.NewHash(pendingUpdate.Txid)
+	require.NoError(t.t, err, "invalid funding txid")
+
+	pendingChanPoint := &lnrpc.ChannelPoint{
+		FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+			FundingTxidBytes: pendingUpdate.Txid,
+		},
+		OutputIndex: pendingUpdate.OutputIndex,
+	}
+
+	_, err = waitForTxInMempool(net.Miner.Client, minerMempoolTimeout)
+	require.NoError(t.t, err, "funding tx not in mempool")
+
+	_, heightBefore, err := net.Miner.Client.GetBestBlock()
+	require.NoError(t.t, err, "unable to get best block")
+
+	err = waitForNodeBlockHeight(node, heightBefore)
+	require.NoError(t.t, err, "node not synced before outage")
+
+	// Cut the backend off from the miner. If we return early, we make sure
+	// to reconnect it, so the remaining tests aren't affected.
+	err = net.BackendCfg.DisconnectMiner()
+	require.NoError(t.t, err, "unable to disconnect backend")
+
+	backendConnected := false
+	defer func() {
+		if !backendConnected {
+			require.NoError(
+				t.t, net.BackendCfg.ConnectMiner(),
+				"unable to reconnect backend",
+			)
+		}
+	}()
+
+	block := mineBlocks(t, net, numOutageBlocks, 1)[0]
+	assertTxInBlock(t, block, fundingTxID)
+
+	// Payments don't depend on the chain, so they must still complete.
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	invoice, err := carol.AddInvoice(ctxt, &lnrpc.Invoice{
+		Value: int64(paymentAmt),
+	})
+	require.NoError(t.t, err, "unable to add invoice")
+
+	sendAndAssertSuccess(t, node, &routerrpc.SendPaymentRequest{
+		PaymentRequest: invoice.PaymentRequest,
+		TimeoutSeconds: 60,
+		FeeLimitMsat:   noFeeLimitMsat,
+	})
+
+	// The node can't know about the blocks mined during the outage, so it
+	// falls behind and the channel stays pending.
+	for _, n := range []*lntest.HarnessNode{node, carol} {
+		ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+		defer cancel()
+		info, err := n.GetInfo(ctxt, &lnrpc.GetInfoRequest{})
+		require.NoError(t.t, err, "unable to get info")
+		require.Equal(
+			t.t, uint32(heightBefore), info.BlockHeight,
+			"%v learned of blocks during outage", n.Name(),
+		)
+	}
+	assertNumOpenChannelsPending(t, node, carol, 1)
+
+	// Once the backend is reconnected, the node must catch up with the
+	// miner and reconcile the state of its channels.
+	err = net.BackendCfg.ConnectMiner()
+	require.NoError(t.t, err, "unable to reconnect backend")
+	backendConnected = true
+
+	_, heightAfter, err := net.Miner.Client.GetBestBlock()
+	require.NoError(t.t, err, "unable to get best block")
+
+	for _, n := range []*lntest.HarnessNode{node, carol} {
+		err = waitForNodeBlockHeight(n, heightAfter)
+		require.NoError(
+			t.t, err, "%v not synced after outage", n.Name(),
+		)
+	}
+
+	assertNumOpenChannelsPending(t, node, carol, 0)
+
+	ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	err = node.WaitForNetworkChannelOpen(ctxt, pendingChanPoint)
+	require.NoError(t.t, err, "channel not open after outage")
+
+	err = wait.NoError(func() error {
+		return assertNumActiveHtlcs(
+			[]*lntest.HarnessNode{node, carol}, 0,
+		)
+	}, defaultTimeout)
+	require.NoError(t.t, err, "htlcs left after outage")
+
+	closeChannelAndAssert(t, net, node, pendingChanPoint, false)
+}
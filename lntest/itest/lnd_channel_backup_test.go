@@ -783,6 +783,77 @@ func testExportChannelBackup(net *lntest.NetworkHarness, t *harnessTest) {
 	assertMultiBackupFound()(false, nil)
 }
 
+// testChannelRecoveryFromSeed tests that a node restored from its seed and a
+// multi channel backup recovers the funds of all channels whose peers are
+// online, while channels with an offline peer remain pending recovery.
+func testChannelRecoveryFromSeed(net *lntest.NetworkHarness, t *harnessTest) {
+	const chanAmt = btcutil.Amount(10000000)
+
+	password := []byte("El Psy Kongroo")
+	ctxb := context.Background()
+
+	nodeArgs := []string{
+		"--minbackoff=50ms",
+		"--maxbackoff=1s",
+	}
+
+	// Dave is the node that will lose its state and be recovered from
+	// its seed. He opens a channel to both Carol and Fred.
+	dave, mnemonic, _, err := net.NewNodeWithSeed(
+		"dave", nodeArgs, password, false,
+	)
+	require.NoError(t.t, err, "unable to create dave")
+
+	carol := net.NewNode(t.t, "carol", nodeArgs)
+	defer shutdownAndAssert(net, t, carol)
+
+	fred := net.NewNode(t.t, "fred", nodeArgs)
+	defer shutdownAndAssert(net, t, fred)
+
+	net.SendCoins(t.t, btcutil.SatoshiPerBitcoin, dave)
+
+	net.ConnectNodes(t.t, dave, carol)
+	net.ConnectNodes(t.t, dave, fred)
+
+	openChannelAndAssert(
+		t, net, dave, carol, lntest.OpenChannelParams{
+			Amt: chanAmt,
+		},
+	)
+	fredChanPoint := openChannelAndAssert(
+		t, net, dave, fred, lntest.OpenChannelParams{
+			Amt: chanAmt,
+		},
+	)
+
+	// Grab a backup of both channels before Dave loses his state.
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	chanBackup, err := dave.ExportAllChannelBackups(
+		ctxt, &lnrpc.ChanBackupExportRequest{},
+	)
+	require.NoError(t.t, err, "unable to export channel backups")
+	require.Len(t.t, chanBackup.MultiChanBackup.ChanPoints, 2)
+
+	shutdownAndAssert(net, t, dave)
+
+	// Fred goes offline, so the channel with him can't be recovered for
+	// now.
+	restartFred, err := net.SuspendNode(fred)
+	require.NoError(t.t, err, "unable to suspend fred")
+
+	restored, pendingRecovery := recoverFromSeed(
+		t, net, password, mnemonic, chanBackup.MultiChanBackup,
+	)
+	defer shutdownAndAssert(net, t, restored)
+
+	require.Equal(t.t, []string{txStr(fredChanPoint)}, pendingRecovery)
+	assertNodeNumChannels(t, carol, 0)
+
+	// Bring Fred back so that the harness can shut him down cleanly.
+	require.NoError(t.t, restartFred(), "unable to restart fred")
+}
+
 // nodeRestorer is a function closure that allows each chanRestoreTestCase to
 // control exactly *how* the prior node is restored. This might be using an
 // backup obtained over RPC, or the file system, etc.
@@ -1335,6 +1406,210 @@ func chanRestoreViaRPC(net *lntest.NetworkHarness, password []byte,
 	}, nil
 }
 
+// recoverFromSeed creates a fresh node from the given seed and imports the
+// given multi channel backup. It then asserts that all channels with peers that
+// are online are force closed by the remote party through the DLP protocol and
+// that the on-chain balance of the restored node increases by at most the sum
+// of its balances in those channels. Channels whose peer is offline must remain
+// pending recovery. The restored node and the channel points of the channels
+// that are still pending recovery are returned.
+func recoverFromSeed(t *harnessTest, net *lntest.NetworkHarness,
+	password []byte, seed []string,
+	backupChannels *lnrpc.MultiChanBackup) (*lntest.HarnessNode, []string) {
+
+	ctxb := context.Background()
+
+	// Increase the fee estimate so that the force close transactions of
+	// the peers will be cpfp'ed in case of anchor commitments.
+	net.SetFeeEstimate(30000)
+
+	restored, err := net.RestoreNodeWithSeed(
+		"recovered", nil, password, seed, "", 1000,
+		&lnrpc.ChanBackupSnapshot{
+			MultiChanBackup: backupChannels,
+		},
+	)
+	require.NoError(t.t, err, "unable to restore node")
+
+	// All backed up channels should show up as waiting close, since we
+	// can't use them anymore and wait for the remote party to close them.
+	numChans := len(backupChannels.ChanPoints)
+	assertNumPendingChannels(t, restored, numChans, 0)
+
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	pending, err := restored.PendingChannels(
+		ctxt, &lnrpc.PendingChannelsRequest{},
+	)
+	require.NoError(t.t, err, "unable to query pending channels")
+
+	// We'll now find out which of the restored channels have a peer that
+	// is online, and what balance the peer thinks we have in the channel.
+	// That's the most we can recover on-chain.
+	var (
+		onlineChans   = make(map[string]*lntest.HarnessNode)
+		offlineChans  []string
+		expectedSweep int64
+	)
+	for _, waitingClose := range pending.WaitingCloseChannels {
+		chanPoint := waitingClose.Channel.ChannelPoint
+		peerPub := waitingClose.Channel.RemoteNodePub
+
+		peer, err := net.LookUpNodeByPub(peerPub)
+		if err != nil {
+			offlineChans = append(offlineChans, chanPoint)
+			continue
+		}
+
+		balance, err := remoteChanBalance(peer, chanPoint)
+		if err != nil {
+			t.Logf("Unable to query peer %s of channel %v: %v",
+				peer.Name(), chanPoint, err)
+
+			offlineChans = append(offlineChans, chanPoint)
+			continue
+		}
+
+		onlineChans[chanPoint] = peer
+		expectedSweep += balance
+	}
+
+	// We'll record the on-chain balance recovered from the seed alone
+	// once the wallet rescan has completed.
+	balReq := &lnrpc.WalletBalanceRequest{}
+	var startingBalance int64
+	err = wait.NoError(func() error {
+		ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+		defer cancel()
+
+		balResp, err := restored.WalletBalance(ctxt, balReq)
+		if err != nil {
+			return err
+		}
+
+		startingBalance = balResp.ConfirmedBalance
+		if startingBalance <= 0 {
+			return fmt.Errorf("expected positive balance, had %v",
+				startingBalance)
+		}
+
+		return nil
+	}, defaultTimeout)
+	require.NoError(t.t, err, "on-chain balance not restored")
+
+	// Connecting to the online peers triggers the DLP protocol, which
+	// makes them force close their channel with us.
+	for _, peer := range onlineChans {
+		net.EnsureConnected(t.t, restored, peer)
+	}
+
+	// We'll now mine blocks until all channels with online peers are
+	// fully resolved and their funds have been swept into our wallet.
+	err = wait.NoError(func() error {
+		ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+		defer cancel()
+
+		pending, err := restored.PendingChannels(
+			ctxt, &lnrpc.PendingChannelsRequest{},
+		)
+		if err != nil {
+			return err
+		}
+
+		var unresolved []string
+		for _, c := range pending.WaitingCloseChannels {
+			unresolved = append(unresolved, c.Channel.ChannelPoint)
+		}
+		for _, c := range pending.PendingForceClosingChannels {
+			unresolved = append(unresolved, c.Channel.ChannelPoint)
+		}
+
+		for _, chanPoint := range unresolved {
+			if _, ok := onlineChans[chanPoint]; !ok {
+				continue
+			}
+
+			if _, err := net.Miner.Client.Generate(1); err != nil {
+				return err
+			}
+
+			return fmt.Errorf("channel %v not resolved", chanPoint)
+		}
+
+		if len(unresolved) != len(offlineChans) {
+			return fmt.Errorf("expected %d channels pending "+
+				"recovery, found %v", len(offlineChans),
+				unresolved)
+		}
+
+		balResp, err := restored.WalletBalance(ctxt, balReq)
+		if err != nil {
+			return err
+		}
+
+		recovered := balResp.ConfirmedBalance - startingBalance
+		if len(onlineChans) > 0 && recovered <= 0 {
+			return fmt.Errorf("balance not increased")
+		}
+		if recovered > expectedSweep {
+			return fmt.Errorf("recovered %v, expected at most %v",
+				recovered, expectedSweep)
+		}
+
+		return nil
+	}, defaultTimeout)
+	require.NoError(t.t, err)
+
+	// The channels with offline peers must still be waiting for the remote
+	// party to close them.
+	assertNumPendingChannels(t, restored, len(offlineChans), 0)
+	for _, chanPoint := range offlineChans {
+		t.Logf("Channel %v is still pending recovery", chanPoint)
+	}
+
+	return restored, offlineChans
+}
+
+// remoteChanBalance returns the balance of the remote party in the given
+// channel as seen by the node. The channel may either still be open or
+// already be in the process of being closed by the node.
+func remoteChanBalance(node *lntest.HarnessNode, chanPoint string) (int64,
+	error) {
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+
+	chans, err := node.ListChannels(ctxt, &lnrpc.ListChannelsRequest{})
+	if err != nil {
+		return 0, err
+	}
+	for _, channel := range chans.Channels {
+		if channel.ChannelPoint == chanPoint {
+			return channel.RemoteBalance, nil
+		}
+	}
+
+	pending, err := node.PendingChannels(
+		ctxt, &lnrpc.PendingChannelsRequest{},
+	)
+	if err != nil {
+		return 0, err
+	}
+	for _, c := range pending.WaitingCloseChannels {
+		if c.Channel.ChannelPoint == chanPoint {
+			return c.Channel.RemoteBalance, nil
+		}
+	}
+	for _, c := range pending.PendingForceClosingChannels {
+		if c.Channel.ChannelPoint == chanPoint {
+			return c.Channel.RemoteBalance, nil
+		}
+	}
+
+	return 0, fmt.Errorf("channel %v not found", chanPoint)
+}
+
 // copyPorts returns a node option function that copies the ports of an existing
 // node over to the newly created one.
 func copyPorts(oldNode *lntest.HarnessNode) lntest.NodeOption {
@@ -86,6 +86,14 @@ func testChannelBalance(net *lntest.NetworkHarness, t *harnessTest) {
 	// Ensure Bob currently has no available balance within the channel.
 	checkChannelBalance(net.Bob, 0, amount-calcStaticFee(cType, 0))
 
+	// The balances of the channel itself must match the totals as well.
+	assertChannelBalance(
+		t, net.Alice, chanPoint, amount-calcStaticFee(cType, 0), 0,
+	)
+	assertChannelBalance(
+		t, net.Bob, chanPoint, 0, amount-calcStaticFee(cType, 0),
+	)
+
 	// Finally close the channel between Alice and Bob, asserting that the
 	// channel has been properly closed on-chain.
 	closeChannelAndAssert(t, net, net.Alice, chanPoint, false)
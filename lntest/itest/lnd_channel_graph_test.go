@@ -391,6 +391,11 @@ func testUnannouncedChannels(net *lntest.NetworkHarness, t *harnessTest) {
 
 	// Close the channel used during the test.
 	closeChannelAndAssert(t, net, net.Alice, fundingChanPoint, false)
+
+	// With the funding output spent, the channel should be pruned from
+	// both nodes' graphs.
+	assertChannelPruned(t, net, net.Alice, fundingChanPoint)
+	assertChannelPruned(t, net, net.Bob, fundingChanPoint)
 }
 
 func testGraphTopologyNotifications(net *lntest.NetworkHarness, t *harnessTest) {
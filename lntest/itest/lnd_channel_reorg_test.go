@@ -0,0 +1,170 @@
+package itest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/integration/rpctest"
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/stretchr/testify/require"
+)
+
+// testReorgChannelState tests that a channel whose funding transaction is
+// reorged out before the channel is open remains pending, and only opens once
+// the funding transaction is confirmed again with the required depth.
+func testReorgChannelState(net *lntest.NetworkHarness, t *harnessTest) {
+	// Skip test for neutrino, as we cannot disconnect the miner at will.
+	if net.BackendCfg.Name() == lntest.NeutrinoBackendName {
+		t.Skipf("skipping reorg test for neutrino backend")
+	}
+
+	const (
+		chanAmt      = btcutil.Amount(1000000)
+		requiredConf = 3
+	)
+
+	ctxb := context.Background()
+
+	// Carol requires more confirmations than the depth of the reorg, so
+	// that the channel is still pending when its funding transaction is
+	// reorged out.
+	carol := net.NewNode(t.t, "Carol", []string{
+		fmt.Sprintf("--bitcoin.defaultchanconfs=%d", requiredConf),
+	})
+	defer shutdownAndAssert(net, t, carol)
+
+	net.ConnectNodes(t.t, net.Alice, carol)
+
+	pendingUpdate, err := net.OpenPendingChannel(
+		net.Alice, carol, chanAmt, 0,
+	)
+	require.NoError(t.t, err, "unable to open channel")
+
+	fundingTxID, err := chainhash.NewHash(pendingUpdate.Txid)
+	require.NoError(t.t, err, "invalid funding txid")
+
+	chanPoint := &lnrpc.ChannelPoint{
+		FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+			FundingTxidBytes: pendingUpdate.Txid,
+		},
+		OutputIndex: pendingUpdate.OutputIndex,
+	}
+
+	// Confirm the funding transaction once, which isn't enough for the
+	// channel to be open.
+	block := mineBlocks(t, net, 1, 1)[0]
+	assertTxInBlock(t, block, fundingTxID)
+	assertNumOpenChannelsPending(t, net.Alice, carol, 1)
+
+	// Reorg out the block that confirmed the funding transaction. The
+	// channel must remain pending, with its funding transaction back in
+	// the mempool.
+	simulateReorg(t, net, 1, net.Alice, carol)
+	assertNumOpenChannelsPending(t, net.Alice, carol, 1)
+
+	// Confirming the funding transaction again restarts the count of
+	// confirmations, so the channel only opens once the funding
+	// transaction has the required depth on the new chain.
+	block = mineBlocks(t, net, 1, 1)[0]
+	assertTxInBlock(t, block, fundingTxID)
+	assertNumOpenChannelsPending(t, net.Alice, carol, 1)
+
+	mineBlocks(t, net, requiredConf-1, 0)
+	assertNumOpenChannelsPending(t, net.Alice, carol, 0)
+
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	err = net.Alice.WaitForNetworkChannelOpen(ctxt, chanPoint)
+	require.NoError(t.t, err, "channel not open after reorg")
+
+	closeChannelAndAssert(t, net, net.Alice, chanPoint, false)
+}
+
+// simulateReorg replaces the last depth blocks of the miner's chain by a
+// longer chain of empty blocks. The transactions of the replaced blocks return
+// to the miner's mempool. The new chain is built by a temporary miner, which
+// is synced to the fork point by replaying the blocks of the miner. Once the
+// given nodes are synced to the new chain, its height is returned.
+func simulateReorg(t *harnessTest, net *lntest.NetworkHarness, depth int32,
+	nodes ...*lntest.HarnessNode) int32 {
+
+	t.t.Helper()
+
+	temp := "temp"
+
+	_, tipHeight, err := net.Miner.Client.GetBestBlock()
+	require.NoError(t.t, err, "unable to get best block")
+	require.Less(t.t, depth, tipHeight, "reorg deeper than chain")
+
+	tempLogDir := fmt.Sprintf("%s/.tempminerlogs", lntest.GetLogDir())
+	logFilename := "output-reorg-temp_miner.log"
+	tempMiner, tempMinerCleanUp, err := lntest.NewMiner(
+		tempLogDir, logFilename, harnessNetParams,
+		&rpcclient.NotificationHandlers{}, lntest.GetBtcdBinary(),
+	)
+	require.NoError(t.t, err, "failed to create temp miner")
+	defer func() {
+		require.NoError(
+			t.t, tempMinerCleanUp(),
+			"failed to clean up temp miner",
+		)
+	}()
+
+	require.NoError(
+		t.t, tempMiner.SetUp(false, 0), "unable to set up mining node",
+	)
+
+	// Replay the miner's chain up to the fork point, so that the temp
+	// miner doesn't know about the blocks that will be reorged out.
+	forkHeight := tipHeight - depth
+	for height := int32(1); height <= forkHeight; height++ {
+		hash, err := net.Miner.Client.GetBlockHash(int64(height))
+		require.NoError(t.t, err, "unable to get block hash")
+
+		block, err := net.Miner.Client.GetBlock(hash)
+		require.NoError(t.t, err, "unable to get block")
+
+		err = tempMiner.Client.SubmitBlock(btcutil.NewBlock(block), nil)
+		require.NoError(t.t, err, "unable to submit block %v", height)
+	}
+
+	// Mine a competing chain that is one block longer than the one that
+	// is replaced.
+	_, err = tempMiner.Client.Generate(uint32(depth) + 1)
+	require.NoError(t.t, err, "unable to generate blocks")
+	assertMinerBlockHeightDelta(t, net.Miner, tempMiner, 1)
+
+	// Connecting the miners makes the miner switch to the longer chain.
+	// We disconnect them again afterwards, so that the temp miner can be
+	// shut down.
+	err = net.Miner.Client.Node(
+		btcjson.NConnect, tempMiner.P2PAddress(), &temp,
+	)
+	require.NoError(t.t, err, "unable to connect miners")
+
+	miners := []*rpctest.Harness{tempMiner, net.Miner}
+	err = rpctest.JoinNodes(miners, rpctest.Blocks)
+	require.NoError(t.t, err, "unable to join miners on blocks")
+	assertMinerBlockHeightDelta(t, net.Miner, tempMiner, 0)
+
+	err = net.Miner.Client.Node(
+		btcjson.NDisconnect, tempMiner.P2PAddress(), &temp,
+	)
+	require.NoError(t.t, err, "unable to disconnect miners")
+
+	_, newHeight, err := net.Miner.Client.GetBestBlock()
+	require.NoError(t.t, err, "unable to get best block")
+
+	for _, node := range nodes {
+		err := waitForNodeBlockHeight(node, newHeight)
+		require.NoError(t.t, err, "%s didn't sync to new chain",
+			node.Name())
+	}
+
+	return newHeight
+}
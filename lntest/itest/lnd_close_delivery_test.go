@@ -0,0 +1,196 @@
+package itest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// testCloseDeliveryAddress tests that a cooperative close pays the local
+// balance to the requested delivery address, unless an upfront shutdown
+// address was committed to at channel open, which takes precedence.
+func testCloseDeliveryAddress(net *lntest.NetworkHarness, t *harnessTest) {
+	const chanAmt = btcutil.Amount(1000000)
+
+	ctxb := context.Background()
+
+	newAddress := func() string {
+		ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+		defer cancel()
+
+		resp, err := net.Alice.NewAddress(ctxt, &lnrpc.NewAddressRequest{
+			Type: lnrpc.AddressType_WITNESS_PUBKEY_HASH,
+		})
+		require.NoError(t.t, err, "unable to get new address")
+
+		return resp.Address
+	}
+
+	// Without an upfront shutdown address, the funds are delivered to the
+	// address of the close request.
+	chanPoint := openChannelAndAssert(
+		t, net, net.Alice, net.Bob,
+		lntest.OpenChannelParams{
+			Amt: chanAmt,
+		},
+	)
+
+	deliveryAddr := newAddress()
+	assertCloseDeliveredTo(
+		t, net, net.Alice, chanPoint, deliveryAddr,
+		&lnrpc.CloseChannelRequest{
+			DeliveryAddress: deliveryAddr,
+		},
+	)
+
+	// With an upfront shutdown address, the funds are delivered there,
+	// even if the close request asks for a fresh address of another type.
+	upfrontAddr := newAddress()
+	chanPoint = openChannelAndAssert(
+		t, net, net.Alice, net.Bob,
+		lntest.OpenChannelParams{
+			Amt:          chanAmt,
+			CloseAddress: upfrontAddr,
+		},
+	)
+
+	assertCloseDeliveredTo(
+		t, net, net.Alice, chanPoint, upfrontAddr,
+		&lnrpc.CloseChannelRequest{
+			DeliveryAddressType: lnrpc.AddressType_NESTED_PUBKEY_HASH,
+		},
+	)
+}
+
+// assertCloseDeliveredTo cooperatively closes the channel from the node's side
+// using the given close request, and asserts that the closing transaction pays
+// the node's balance to the expected address. If the node opened the channel,
+// its balance is reduced by the closing fee.
+func assertCloseDeliveredTo(t *harnessTest, net *lntest.NetworkHarness,
+	node *lntest.HarnessNode, chanPoint *lnrpc.ChannelPoint, address string,
+	req *lnrpc.CloseChannelRequest) {
+
+	t.t.Helper()
+
+	ctxb := context.Background()
+
+	addr, err := btcutil.DecodeAddress(address, harnessNetParams)
+	require.NoError(t.t, err, "unable to decode address")
+	pkScript, err := txscript.PayToAddrScript(addr)
+	require.NoError(t.t, err, "unable to create script")
+
+	// activeChannel returns the channel as known by the given node if it
+	// is active.
+	activeChannel := func(n *lntest.HarnessNode) (*lnrpc.Channel, error) {
+		ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+		defer cancel()
+
+		resp, err := n.ListChannels(ctxt, &lnrpc.ListChannelsRequest{})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, channel := range resp.Channels {
+			if channel.ChannelPoint != txStr(chanPoint) {
+				continue
+			}
+
+			if !channel.Active {
+				return nil, fmt.Errorf("channel of %s not "+
+					"active", n.Name())
+			}
+
+			return channel, nil
+		}
+
+		return nil, fmt.Errorf("%s has no channel %v", n.Name(),
+			txStr(chanPoint))
+	}
+
+	// Wait for the channel to be active on both sides, as it can't be
+	// closed cooperatively otherwise, and capture the balances it is
+	// closed with.
+	var channel *lnrpc.Channel
+	err = wait.NoError(func() error {
+		var err error
+		channel, err = activeChannel(node)
+		return err
+	}, defaultTimeout)
+	require.NoError(t.t, err)
+
+	peer, err := net.LookUpNodeByPub(channel.RemotePubkey)
+	require.NoError(t.t, err, "unable to find peer")
+	err = wait.NoError(func() error {
+		_, err := activeChannel(peer)
+		return err
+	}, defaultTimeout)
+	require.NoError(t.t, err)
+
+	ctxt, cancel := context.WithTimeout(ctxb, channelCloseTimeout)
+	defer cancel()
+
+	closeReq := proto.Clone(req).(*lnrpc.CloseChannelRequest)
+	closeReq.ChannelPoint = chanPoint
+	closeReq.Force = false
+	stream, err := node.CloseChannel(ctxt, closeReq)
+	require.NoError(t.t, err, "unable to close channel")
+
+	update, err := stream.Recv()
+	require.NoError(t.t, err, "unable to receive close update")
+	pending, ok := update.Update.(*lnrpc.CloseStatusUpdate_ClosePending)
+	require.True(t.t, ok, "expected close pending update, got %v", update)
+
+	closeTxid, err := chainhash.NewHash(pending.ClosePending.Txid)
+	require.NoError(t.t, err, "invalid closing txid")
+
+	block := mineBlocks(t, net, 1, 1)[0]
+	assertTxInBlock(t, block, closeTxid)
+
+	_, err = net.WaitForChannelClose(stream)
+	require.NoError(t.t, err, "channel not closed")
+
+	var closeTx *wire.MsgTx
+	for _, tx := range block.Transactions {
+		if tx.TxHash() == *closeTxid {
+			closeTx = tx
+		}
+	}
+	require.NotNil(t.t, closeTx, "closing tx not found")
+
+	// The closing fee is whatever the outputs leave of the capacity.
+	var (
+		delivered *wire.TxOut
+		totalOut  int64
+	)
+	for _, txOut := range closeTx.TxOut {
+		totalOut += txOut.Value
+		if bytes.Equal(txOut.PkScript, pkScript) {
+			delivered = txOut
+		}
+	}
+	require.NotNil(t.t, delivered, "no output pays to %v", address)
+
+	// The initiator pays the closing fee, but gets back the commitment
+	// fee and the anchors, which aren't part of its local balance.
+	expected := channel.LocalBalance
+	if channel.Initiator {
+		closeFee := channel.Capacity - totalOut
+		expected += channel.CommitFee - closeFee
+
+		if channel.CommitmentType == lnrpc.CommitmentType_ANCHORS {
+			expected += 2 * anchorSize
+		}
+	}
+	require.Equal(t.t, expected, delivered.Value,
+		"unexpected amount delivered to %v", address)
+}
@@ -0,0 +1,253 @@
+package itest
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"fmt"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/chainreg"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// testDuplicateHtlc tests that multiple HTLCs with the same payment hash on
+// the same channel are each resolved exactly once, both when they are shards
+// of a legitimate MPP payment and when each of them pays the full invoice.
+func testDuplicateHtlc(net *lntest.NetworkHarness, t *harnessTest) {
+	const chanAmt = btcutil.Amount(500000)
+
+	chanPoint := openChannelAndAssert(
+		t, net, net.Alice, net.Bob, lntest.OpenChannelParams{
+			Amt: chanAmt,
+		},
+	)
+	defer closeChannelAndAssert(t, net, net.Alice, chanPoint, false)
+
+	assertDuplicateHtlcHandled(t, net, net.Alice, net.Bob)
+}
+
+// assertDuplicateHtlcHandled sends two HTLCs with the same payment hash over
+// the direct channel between the sender and the receiver, first as shards
+// that together pay an invoice, and then as shards that each pay the full
+// invoice. It asserts that in both cases the HTLCs are held together, and
+// that settling the invoice settles each of them exactly once.
+func assertDuplicateHtlcHandled(t *harnessTest, net *lntest.NetworkHarness,
+	sender, receiver *lntest.HarnessNode) {
+
+	t.t.Helper()
+
+	const shardAmt = btcutil.Amount(10000)
+
+	// A legitimate MPP payment, whose shards share the payment hash, must
+	// still work.
+	assertSameHashShardsSettled(t, sender, receiver, 2*shardAmt, shardAmt)
+
+	// The second shard duplicates the first, as each of them pays the
+	// full invoice.
+	assertSameHashShardsSettled(t, sender, receiver, shardAmt, shardAmt)
+}
+
+// assertSameHashShardsSettled sends two shards of the given amount to a hold
+// invoice of the given value over the direct channel between the sender and
+// the receiver. The shards share the payment hash and together form the
+// complete MPP set. It asserts that both HTLCs are accepted and active at the
+// same time, and that settling the invoice settles each of them once.
+func assertSameHashShardsSettled(t *harnessTest, sender,
+	receiver *lntest.HarnessNode, invoiceAmt, shardAmt btcutil.Amount) {
+
+	t.t.Helper()
+
+	const numShards = 2
+
+	ctxb := context.Background()
+	totalAmt := numShards * shardAmt
+
+	var preimage lntypes.Preimage
+	_, err := rand.Read(preimage[:])
+	require.NoError(t.t, err, "unable to generate preimage")
+	payHash := preimage.Hash()
+
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	invoice, err := receiver.AddHoldInvoice(
+		ctxt, &invoicesrpc.AddHoldInvoiceRequest{
+			Memo:  "duplicate htlc",
+			Value: int64(invoiceAmt),
+			Hash:  payHash[:],
+		},
+	)
+	require.NoError(t.t, err, "unable to add hold invoice")
+
+	invoiceCtx, cancelInvoice := context.WithCancel(ctxb)
+	defer cancelInvoice()
+	invoiceStream, err := receiver.SubscribeSingleInvoice(
+		invoiceCtx, &invoicesrpc.SubscribeSingleInvoiceRequest{
+			RHash: payHash[:],
+		},
+	)
+	require.NoError(t.t, err, "unable to subscribe to invoice")
+
+	waitForInvoiceState := func(state lnrpc.Invoice_InvoiceState) {
+		for {
+			update, err := invoiceStream.Recv()
+			require.NoError(t.t, err)
+
+			if update.State == state {
+				return
+			}
+		}
+	}
+
+	// Both shards take the same route, so they are added to the same
+	// channel.
+	routeResp, err := sender.RouterClient.BuildRoute(
+		ctxt, &routerrpc.BuildRouteRequest{
+			AmtMsat:        int64(shardAmt * 1000),
+			FinalCltvDelta: chainreg.DefaultBitcoinTimeLockDelta,
+			HopPubkeys:     [][]byte{receiver.PubKey[:]},
+			PaymentAddr:    invoice.PaymentAddr,
+		},
+	)
+	require.NoError(t.t, err, "unable to build route")
+
+	route := routeResp.Route
+	route.Hops[len(route.Hops)-1].MppRecord.TotalAmtMsat =
+		int64(totalAmt * 1000)
+
+	// We send all shards in their own goroutine, since SendToRoute blocks
+	// as long as the HTLC is held by the receiver.
+	type sendResult struct {
+		attempt *lnrpc.HTLCAttempt
+		err     error
+	}
+	results := make(chan sendResult, numShards)
+	for i := 0; i < numShards; i++ {
+		go func() {
+			ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+			defer cancel()
+
+			attempt, err := sender.RouterClient.SendToRouteV2(
+				ctxt, &routerrpc.SendToRouteRequest{
+					PaymentHash: payHash[:],
+					Route:       route,
+				},
+			)
+			results <- sendResult{attempt: attempt, err: err}
+		}()
+	}
+
+	// Only the complete set moves the invoice to the accepted state, at
+	// which point both HTLCs are active on the channel.
+	waitForInvoiceState(lnrpc.Invoice_ACCEPTED)
+
+	numActiveShards := func(node, peer *lntest.HarnessNode) (int, error) {
+		ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+		defer cancel()
+		resp, err := node.ListChannels(
+			ctxt, &lnrpc.ListChannelsRequest{},
+		)
+		if err != nil {
+			return 0, err
+		}
+
+		var numActive int
+		for _, channel := range resp.Channels {
+			if channel.RemotePubkey != peer.PubKeyStr {
+				continue
+			}
+
+			for _, htlc := range channel.PendingHtlcs {
+				if bytes.Equal(htlc.HashLock, payHash[:]) {
+					numActive++
+				}
+			}
+		}
+
+		return numActive, nil
+	}
+
+	err = wait.NoError(func() error {
+		for _, nodes := range [][2]*lntest.HarnessNode{
+			{sender, receiver}, {receiver, sender},
+		} {
+			numActive, err := numActiveShards(nodes[0], nodes[1])
+			if err != nil {
+				return err
+			}
+
+			if numActive != numShards {
+				return fmt.Errorf("%v has %v active htlcs, "+
+					"expected %v", nodes[0].Name(),
+					numActive, numShards)
+			}
+		}
+
+		return nil
+	}, defaultTimeout)
+	require.NoError(t.t, err, "shards not active")
+
+	ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	_, err = receiver.SettleInvoice(ctxt, &invoicesrpc.SettleInvoiceMsg{
+		Preimage: preimage[:],
+	})
+	require.NoError(t.t, err, "unable to settle invoice")
+
+	waitForInvoiceState(lnrpc.Invoice_SETTLED)
+
+	for i := 0; i < numShards; i++ {
+		result := <-results
+		require.NoError(t.t, result.err, "unable to send shard")
+		require.Nil(t.t, result.attempt.Failure, "shard failed")
+		require.Equal(t.t, preimage[:], result.attempt.Preimage)
+	}
+
+	// The invoice is settled once, with each HTLC settled and counted
+	// exactly once.
+	ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	settled, err := receiver.LookupInvoice(ctxt, &lnrpc.PaymentHash{
+		RHash: payHash[:],
+	})
+	require.NoError(t.t, err, "unable to lookup invoice")
+	require.Equal(t.t, lnrpc.Invoice_SETTLED, settled.State)
+	require.Equal(t.t, int64(totalAmt*1000), settled.AmtPaidMsat)
+	require.Len(t.t, settled.Htlcs, numShards)
+	for _, htlc := range settled.Htlcs {
+		require.Equal(t.t, lnrpc.InvoiceHTLCState_SETTLED, htlc.State)
+		require.Equal(t.t, uint64(shardAmt*1000), htlc.AmtMsat)
+	}
+
+	// The sender records a single payment with both shards succeeded.
+	resp, err := sender.ListPayments(ctxt, &lnrpc.ListPaymentsRequest{
+		IncludeIncomplete: true,
+	})
+	require.NoError(t.t, err, "unable to list payments")
+
+	var payments []*lnrpc.Payment
+	for _, p := range resp.Payments {
+		if p.PaymentHash == payHash.String() {
+			payments = append(payments, p)
+		}
+	}
+	require.Len(t.t, payments, 1, "duplicate payment for hash")
+	require.Equal(t.t, lnrpc.Payment_SUCCEEDED, payments[0].Status)
+	require.Len(t.t, payments[0].Htlcs, numShards)
+	for _, htlc := range payments[0].Htlcs {
+		require.Equal(t.t, lnrpc.HTLCAttempt_SUCCEEDED, htlc.Status)
+	}
+
+	err = wait.NoError(func() error {
+		return assertNumActiveHtlcs(
+			[]*lntest.HarnessNode{sender, receiver}, 0,
+		)
+	}, defaultTimeout)
+	require.NoError(t.t, err, "htlcs still active")
+}
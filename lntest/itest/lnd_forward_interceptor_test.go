@@ -1,7 +1,9 @@
 package itest
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 	"sync"
@@ -230,33 +232,22 @@ func testForwardInterceptor(net *lntest.NetworkHarness, t *harnessTest) {
 	restartAlice, err := net.SuspendNode(alice)
 	require.NoError(t.t, err, "failed to suspend alice")
 
-	ctxt, cancelInterceptor = context.WithTimeout(ctxb, defaultTimeout)
-	defer cancelInterceptor()
-	interceptor, err = testContext.bob.RouterClient.HtlcInterceptor(ctxt)
-	require.NoError(t.t, err, "failed to create HtlcInterceptor")
+	stopInterceptor := runHtlcInterceptor(t, testContext.bob,
+		func(request *routerrpc.ForwardHtlcInterceptRequest) (
+			routerrpc.ResolveHoldForwardAction, []byte) {
 
-	err = restartAlice()
-	require.NoError(t.t, err, "failed to restart alice")
+			t.t.Errorf("no more intercepts should arrive, got %v",
+				request.IncomingCircuitKey)
 
-	go func() {
-		request, err := interceptor.Recv()
-		if err != nil {
-			// If it is  just the error result of the context cancellation
-			// the we exit silently.
-			status, ok := status.FromError(err)
-			if ok && status.Code() == codes.Canceled {
-				return
-			}
-			// Otherwise it an unexpected error, we fail the test.
-			require.NoError(
-				t.t, err, "unexpected error in interceptor.Recv()",
-			)
-			return
-		}
+			return routerrpc.ResolveHoldForwardAction_FAIL, nil
+		},
+	)
 
-		require.Nil(t.t, request, "no more intercepts should arrive")
-	}()
+	err = restartAlice()
+	require.NoError(t.t, err, "failed to restart alice")
 
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
 	err = wait.Predicate(func() bool {
 		channels, err := bob.ListChannels(ctxt, &lnrpc.ListChannelsRequest{
 			ActiveOnly: true, Peer: alice.PubKey[:],
@@ -265,6 +256,186 @@ func testForwardInterceptor(net *lntest.NetworkHarness, t *harnessTest) {
 	}, defaultTimeout)
 	require.NoError(t.t, err, "alice <> bob channel didnt re-activate")
 
+	stopInterceptor()
+
+	// Finally, settle new payments at Bob through the interceptor. Using
+	// the preimage of another invoice must fail the payment, while using
+	// the preimage of Carol's invoice should result in a successful
+	// payment for Alice.
+	cases := testContext.prepareTestCases()
+	settleCase := cases[2]
+	assertInterceptSettle(
+		t, testContext, testContext.bob, cases[0],
+		settleCase.invoice.RPreimage,
+	)
+	assertInterceptSettle(
+		t, testContext, testContext.bob, settleCase,
+		settleCase.invoice.RPreimage,
+	)
+}
+
+// assertInterceptSettle intercepts the HTLC of a payment from Alice to the
+// invoice of the test case at the given node, and settles it with the given
+// preimage. If the preimage matches the invoice's payment hash, the payment
+// must succeed with that preimage. Otherwise the node must reject the settle
+// and fail the HTLC back, so that the payment fails and the invoice remains
+// open.
+func assertInterceptSettle(t *harnessTest, c *interceptorTestContext,
+	node *lntest.HarnessNode, testCase *interceptorTestCase,
+	preimage []byte) {
+
+	t.t.Helper()
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+
+	interceptor, err := node.RouterClient.HtlcInterceptor(ctxt)
+	require.NoError(t.t, err, "failed to create HtlcInterceptor")
+
+	// The payment only completes once the intercepted HTLC is resolved,
+	// so we send it in the background.
+	type paymentResult struct {
+		attempt *lnrpc.HTLCAttempt
+		err     error
+	}
+	resultChan := make(chan paymentResult, 1)
+	go func() {
+		attempt, err := c.sendAliceToCarolPayment(
+			ctxt, testCase.invoice.ValueMsat,
+			testCase.invoice.RHash, testCase.payAddr,
+		)
+		resultChan <- paymentResult{attempt: attempt, err: err}
+	}()
+
+	request, err := interceptor.Recv()
+	require.NoError(t.t, err, "unable to receive intercepted htlc")
+	require.Equal(t.t, testCase.invoice.RHash, request.PaymentHash)
+
+	err = interceptor.Send(&routerrpc.ForwardHtlcInterceptResponse{
+		IncomingCircuitKey: request.IncomingCircuitKey,
+		Action:             routerrpc.ResolveHoldForwardAction_SETTLE,
+		Preimage:           preimage,
+	})
+	require.NoError(t.t, err, "unable to send interceptor response")
+
+	var result paymentResult
+	select {
+	case result = <-resultChan:
+	case <-time.After(defaultTimeout):
+		t.Fatalf("intercepted htlc wasn't resolved")
+	}
+	require.NoError(t.t, result.err, "failed to send payment")
+	attempt := result.attempt
+
+	hash := sha256.Sum256(preimage)
+	if bytes.Equal(hash[:], testCase.invoice.RHash) {
+		require.Equal(
+			t.t, lnrpc.HTLCAttempt_SUCCEEDED, attempt.Status,
+			"expected intercepted settle to succeed",
+		)
+		require.Equal(t.t, preimage, attempt.Preimage)
+
+		return
+	}
+
+	// The failure source index counts Alice as hop zero, so the
+	// intercepting node is at index one.
+	require.Equal(
+		t.t, lnrpc.HTLCAttempt_FAILED, attempt.Status,
+		"expected settle with invalid preimage to fail",
+	)
+	require.NotNil(t.t, attempt.Failure)
+	require.Equal(
+		t.t, lnrpc.Failure_TEMPORARY_CHANNEL_FAILURE,
+		attempt.Failure.Code,
+	)
+	require.EqualValues(t.t, 1, attempt.Failure.FailureSourceIndex)
+
+	invoice, err := c.carol.LookupInvoice(ctxt, &lnrpc.PaymentHash{
+		RHash: testCase.invoice.RHash,
+	})
+	require.NoError(t.t, err, "unable to lookup invoice")
+	require.Equal(t.t, lnrpc.Invoice_OPEN, invoice.State)
+}
+
+// runHtlcInterceptor registers an HTLC interceptor on the given node and calls
+// decide for each intercepted HTLC. The returned action is sent back to the
+// node, together with the returned preimage if the action is to settle. The
+// preimage is asserted to match the HTLC's payment hash, so that an
+// intercepted settle results in a valid forward. The returned function stops
+// the interceptor and waits for it to exit, which releases any HTLCs that are
+// still held.
+func runHtlcInterceptor(t *harnessTest, node *lntest.HarnessNode,
+	decide func(*routerrpc.ForwardHtlcInterceptRequest) (
+		routerrpc.ResolveHoldForwardAction, []byte)) func() {
+
+	ctxb := context.Background()
+	ctx, cancel := context.WithCancel(ctxb)
+
+	interceptor, err := node.RouterClient.HtlcInterceptor(ctx)
+	if err != nil {
+		cancel()
+		require.NoError(t.t, err, "failed to create HtlcInterceptor")
+	}
+
+	// isCanceled returns true if the error is the result of the stream's
+	// context being canceled when the interceptor is stopped.
+	isCanceled := func(err error) bool {
+		status, ok := status.FromError(err)
+		return ok && status.Code() == codes.Canceled
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for {
+			request, err := interceptor.Recv()
+			switch {
+			case isCanceled(err):
+				return
+
+			case err != nil:
+				t.t.Errorf("unexpected error in "+
+					"interceptor.Recv(): %v", err)
+				return
+			}
+
+			action, preimage := decide(request)
+
+			if action == routerrpc.ResolveHoldForwardAction_SETTLE {
+				hash := sha256.Sum256(preimage)
+				if !bytes.Equal(hash[:], request.PaymentHash) {
+					t.t.Errorf("preimage %x doesn't match "+
+						"payment hash %x", preimage,
+						request.PaymentHash)
+				}
+			}
+
+			resp := &routerrpc.ForwardHtlcInterceptResponse{
+				IncomingCircuitKey: request.IncomingCircuitKey,
+				Action:             action,
+				Preimage:           preimage,
+			}
+			err = interceptor.Send(resp)
+			switch {
+			case isCanceled(err):
+				return
+
+			case err != nil:
+				t.t.Errorf("unable to send interceptor "+
+					"response: %v", err)
+				return
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		wg.Wait()
+	}
 }
 
 // interceptorTestContext is a helper struct to hold the test context and
@@ -15,6 +15,7 @@ import (
 	"github.com/lightningnetwork/lnd/labels"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/signrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/lightningnetwork/lnd/lntest"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/stretchr/testify/require"
@@ -229,6 +230,19 @@ func basicChannelFundingTest(t *harnessTest, net *lntest.NetworkHarness,
 		bob, bobChannelBalance, pushAmt, aliceLocalBalance,
 	)
 
+	// Both sides use the default reserve of 1% of the channel capacity.
+	defaultReserve := chanAmt / 100
+	assertChannelReserve(
+		t, alice, chanPoint, defaultReserve, defaultReserve,
+	)
+	assertChannelReserve(
+		t, bob, chanPoint, defaultReserve, defaultReserve,
+	)
+
+	// The negotiated commitment type and channel parameters must survive
+	// a restart of the initiator.
+	assertChannelStablePostRestart(t, net, alice, chanPoint)
+
 	req := &lnrpc.ListChannelsRequest{}
 	aliceChannel, err := alice.ListChannels(context.Background(), req)
 	require.NoError(t.t, err, "unable to obtain chan")
@@ -362,8 +376,10 @@ func testUnconfirmedChannelFunding(net *lntest.NetworkHarness, t *harnessTest) {
 	checkChannelBalance(carol, carolLocalBalance, pushAmt, 0, 0)
 	checkChannelBalance(net.Alice, pushAmt, carolLocalBalance, 0, 0)
 
-	// Now that we're done with the test, the channel can be closed.
-	closeChannelAndAssert(t, net, carol, chanPoint, false)
+	// Now that we're done with the test, the channel can be closed. We do
+	// so at a custom fee rate to cover the cooperative close fee
+	// negotiation.
+	coopCloseWithFeeRate(t, net, carol, chanPoint, 20)
 }
 
 // testexternalfundingchanpoint tests that we're able to carry out a normal
@@ -744,3 +760,182 @@ func deriveFundingShim(net *lntest.NetworkHarness, t *harnessTest,
 
 	return fundingShim, chanPoint, txid
 }
+
+// testChannelFundingWithKey tests that a channel can be funded with a funding
+// key that is provided externally through a funding shim, and that a funding
+// key that doesn't match its key locator or can't be parsed causes the
+// funding to fail.
+func testChannelFundingWithKey(net *lntest.NetworkHarness, t *harnessTest) {
+	ctxb := context.Background()
+
+	carol := net.NewNode(t.t, "carol", nil)
+	defer shutdownAndAssert(net, t, carol)
+
+	dave := net.NewNode(t.t, "dave", nil)
+	defer shutdownAndAssert(net, t, dave)
+
+	net.SendCoins(t.t, btcutil.SatoshiPerBitcoin, carol)
+	net.EnsureConnected(t.t, carol, dave)
+
+	deriveKey := func(index int32) *signrpc.KeyDescriptor {
+		ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+		defer cancel()
+
+		key, err := carol.WalletKitClient.DeriveKey(
+			ctxt, &signrpc.KeyLocator{
+				KeyFamily: 9999,
+				KeyIndex:  index,
+			},
+		)
+		require.NoError(t.t, err)
+
+		return key
+	}
+
+	const chanSize = funding.MaxBtcFundingAmount
+	params := lntest.OpenChannelParams{
+		Amt: chanSize,
+	}
+
+	// A funding key that matches its key locator results in an open
+	// channel.
+	chanPoint, err := openChannelWithFundingKey(
+		t, net, carol, dave, deriveKey(1), params,
+	)
+	require.NoError(t.t, err)
+	closeChannelAndAssert(t, net, carol, chanPoint, false)
+
+	// If the raw key doesn't match the key locator, Carol signs with a
+	// different key than the one in the funding output, so Dave rejects
+	// her signature.
+	mismatchedKey := deriveKey(2)
+	mismatchedKey.RawKeyBytes = deriveKey(3).RawKeyBytes
+	_, err = openChannelWithFundingKey(
+		t, net, carol, dave, mismatchedKey, params,
+	)
+	require.Error(t.t, err, "funding with mismatched key succeeded")
+
+	// A funding key that isn't a valid public key can't be used at all.
+	invalidKey := deriveKey(4)
+	invalidKey.RawKeyBytes = invalidKey.RawKeyBytes[1:]
+	_, err = openChannelWithFundingKey(
+		t, net, carol, dave, invalidKey, params,
+	)
+	require.Error(t.t, err, "funding with invalid key succeeded")
+}
+
+// openChannelWithFundingKey opens a channel from the funder to the peer whose
+// funding output uses the given funding key of the funder. The funding
+// transaction is published by the miner and registered with both nodes as a
+// funding shim. Once the channel is open, the funding output is asserted to
+// pay to the multisig script of the given key and the peer's key. Errors of
+// the funding flow, such as those caused by a funding key that is invalid or
+// doesn't match its key locator, are returned.
+func openChannelWithFundingKey(t *harnessTest, net *lntest.NetworkHarness,
+	funder, peer *lntest.HarnessNode, key *signrpc.KeyDescriptor,
+	p lntest.OpenChannelParams) (*lnrpc.ChannelPoint, error) {
+
+	t.t.Helper()
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+
+	peerKey, err := peer.WalletKitClient.DeriveNextKey(
+		ctxt, &walletrpc.KeyReq{
+			KeyFamily: 9999,
+		},
+	)
+	require.NoError(t.t, err)
+
+	// Construct the funding output from both funding keys. This fails
+	// early for keys that can't be used in the multisig script.
+	_, fundingOutput, err := input.GenFundingPkScript(
+		key.RawKeyBytes, peerKey.RawKeyBytes, int64(p.Amt),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid funding key: %v", err)
+	}
+
+	txid, err := net.Miner.SendOutputsWithoutChange(
+		[]*wire.TxOut{fundingOutput}, 5,
+	)
+	require.NoError(t.t, err)
+
+	var pendingChanID [32]byte
+	_, err = rand.Read(pendingChanID[:])
+	require.NoError(t.t, err)
+
+	chanPoint := &lnrpc.ChannelPoint{
+		FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+			FundingTxidBytes: txid[:],
+		},
+	}
+
+	// The peer registers the shim with its own key as the local key
+	// first, then the funder uses the same shim with the keys swapped.
+	newShim := func(localKey *signrpc.KeyDescriptor,
+		remoteKey []byte) *lnrpc.FundingShim {
+
+		chanPointShim := &lnrpc.ChanPointShim{
+			Amt:       int64(p.Amt),
+			ChanPoint: chanPoint,
+			LocalKey: &lnrpc.KeyDescriptor{
+				RawKeyBytes: localKey.RawKeyBytes,
+				KeyLoc: &lnrpc.KeyLocator{
+					KeyFamily: localKey.KeyLoc.KeyFamily,
+					KeyIndex:  localKey.KeyLoc.KeyIndex,
+				},
+			},
+			RemoteKey:     remoteKey,
+			PendingChanId: pendingChanID[:],
+		}
+
+		return &lnrpc.FundingShim{
+			Shim: &lnrpc.FundingShim_ChanPointShim{
+				ChanPointShim: chanPointShim,
+			},
+		}
+	}
+
+	_, err = peer.FundingStateStep(ctxt, &lnrpc.FundingTransitionMsg{
+		Trigger: &lnrpc.FundingTransitionMsg_ShimRegister{
+			ShimRegister: newShim(peerKey, key.RawKeyBytes),
+		},
+	})
+	require.NoError(t.t, err)
+
+	p.FundingShim = newShim(key, peerKey.RawKeyBytes)
+	stream, err := net.OpenChannel(funder, peer, p)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open channel: %v", err)
+	}
+
+	block := mineBlocks(t, net, 6, 1)[0]
+	assertTxInBlock(t, block, txid)
+
+	openChanPoint, err := net.WaitForChannelOpen(stream)
+	if err != nil {
+		return nil, fmt.Errorf("channel didn't open: %v", err)
+	}
+
+	// The channel must be the one funded by the output we created, which
+	// pays to the multisig script of the given funding key.
+	fundingTx, err := net.Miner.Client.GetRawTransaction(txid)
+	require.NoError(t.t, err)
+
+	outputs := fundingTx.MsgTx().TxOut
+	require.Less(t.t, int(openChanPoint.OutputIndex), len(outputs))
+
+	openTxid, err := lnrpc.GetChanPointFundingTxid(openChanPoint)
+	require.NoError(t.t, err)
+	require.Equal(t.t, *txid, *openTxid, "unexpected funding txid")
+
+	require.Equal(
+		t.t, fundingOutput.PkScript,
+		outputs[openChanPoint.OutputIndex].PkScript,
+		"channel doesn't use the given funding key",
+	)
+
+	return openChanPoint, nil
+}
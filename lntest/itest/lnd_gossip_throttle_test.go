@@ -0,0 +1,199 @@
+package itest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// throttleTestBurst is the maximum number of channel updates per
+	// channel and direction that the victim accepts in the tests.
+	throttleTestBurst = 3
+
+	// throttleCheckTimeout is the duration for which the victim's view of
+	// a throttled channel policy must remain unchanged.
+	throttleCheckTimeout = 5 * time.Second
+)
+
+// testGossipThrottle tests that a node rate limits the channel updates of a
+// peer that exceed the configured burst, without disconnecting the peer.
+func testGossipThrottle(net *lntest.NetworkHarness, t *harnessTest) {
+	const chanAmt = btcutil.Amount(1000000)
+
+	// Carol refills the update allowance of a channel only once per hour,
+	// so that no update beyond the burst can be accepted during the test.
+	carol := net.NewNode(t.t, "Carol", []string{
+		fmt.Sprintf("--gossip.max-channel-update-burst=%d",
+			throttleTestBurst),
+		"--gossip.channel-update-interval=1h",
+	})
+	defer shutdownAndAssert(net, t, carol)
+
+	net.ConnectNodes(t.t, net.Alice, carol)
+
+	chanPoint := openChannelAndAssert(
+		t, net, net.Alice, carol,
+		lntest.OpenChannelParams{
+			Amt: chanAmt,
+		},
+	)
+	defer closeChannelAndAssert(t, net, net.Alice, chanPoint, false)
+
+	assertGossipThrottled(
+		t, net, net.Alice, carol, chanPoint, throttleTestBurst,
+	)
+}
+
+// assertGossipThrottled has the spammer emit more updates for its side of the
+// given channel than the victim accepts within its channel update interval.
+// It asserts that the victim accepts all updates within the burst, drops the
+// excess updates and stays connected to the spammer. The victim must already
+// know the spammer's policy, and the policy must not have been changed since.
+func assertGossipThrottled(t *harnessTest, net *lntest.NetworkHarness,
+	spammer, victim *lntest.HarnessNode, chanPoint *lnrpc.ChannelPoint,
+	burst int) {
+
+	t.t.Helper()
+
+	ctxb := context.Background()
+
+	policy, err := advertisedPolicy(spammer, spammer, chanPoint)
+	require.NoError(t.t, err, "unable to get spammer's policy")
+
+	updateBaseFee := func(baseFee int64) {
+		ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+		defer cancel()
+
+		_, err := spammer.UpdateChannelPolicy(
+			ctxt, &lnrpc.PolicyUpdateRequest{
+				BaseFeeMsat:   baseFee,
+				FeeRate:       0.0001,
+				TimeLockDelta: policy.TimeLockDelta,
+				Scope: &lnrpc.PolicyUpdateRequest_ChanPoint{
+					ChanPoint: chanPoint,
+				},
+			},
+		)
+		require.NoError(t.t, err, "unable to update policy")
+	}
+
+	// assertBaseFee waits until the node knows the spammer's policy with
+	// the given base fee.
+	assertBaseFee := func(node *lntest.HarnessNode, baseFee int64) error {
+		return wait.NoError(func() error {
+			policy, err := advertisedPolicy(node, spammer, chanPoint)
+			if err != nil {
+				return err
+			}
+
+			if policy.FeeBaseMsat != baseFee {
+				return fmt.Errorf("expected base fee %v, got %v",
+					baseFee, policy.FeeBaseMsat)
+			}
+
+			return nil
+		}, defaultTimeout)
+	}
+
+	// All updates within the burst must be accepted. We wait for each of
+	// them to arrive, so that they aren't merged into a single update by
+	// the spammer's gossip batching.
+	baseFee := policy.FeeBaseMsat
+	for i := 0; i < burst; i++ {
+		baseFee++
+		updateBaseFee(baseFee)
+
+		err := assertBaseFee(victim, baseFee)
+		require.NoError(t.t, err, "update %d within burst not accepted",
+			i+1)
+	}
+	acceptedFee := baseFee
+
+	// Now the spammer emits another burst of updates, which exceed the
+	// victim's allowance.
+	for i := 0; i < burst; i++ {
+		baseFee++
+		updateBaseFee(baseFee)
+	}
+
+	err = assertBaseFee(spammer, baseFee)
+	require.NoError(t.t, err, "spammer didn't apply its updates")
+
+	// The victim must keep the last accepted policy, as the excess updates
+	// are dropped.
+	err = wait.InvariantNoError(func() error {
+		policy, err := advertisedPolicy(victim, spammer, chanPoint)
+		if err != nil {
+			return err
+		}
+
+		if policy.FeeBaseMsat != acceptedFee {
+			return fmt.Errorf("excess update with base fee %v "+
+				"accepted", policy.FeeBaseMsat)
+		}
+
+		return nil
+	}, throttleCheckTimeout)
+	require.NoError(t.t, err)
+
+	// Throttling must not cause the victim to disconnect the spammer.
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	peers, err := victim.ListPeers(ctxt, &lnrpc.ListPeersRequest{})
+	require.NoError(t.t, err, "unable to list peers")
+
+	var connected bool
+	for _, peer := range peers.Peers {
+		if peer.PubKey == spammer.PubKeyStr {
+			connected = true
+		}
+	}
+	require.True(t.t, connected, "victim disconnected the spammer")
+}
+
+// advertisedPolicy returns the policy that the advertiser has announced for
+// its side of the channel, as known by the given node.
+func advertisedPolicy(node, advertiser *lntest.HarnessNode,
+	chanPoint *lnrpc.ChannelPoint) (*lnrpc.RoutingPolicy, error) {
+
+	ctxt, cancel := context.WithTimeout(
+		context.Background(), defaultTimeout,
+	)
+	defer cancel()
+
+	graph, err := node.DescribeGraph(ctxt, &lnrpc.ChannelGraphRequest{
+		IncludeUnannounced: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, edge := range graph.Edges {
+		if edge.ChanPoint != txStr(chanPoint) {
+			continue
+		}
+
+		policy := edge.Node2Policy
+		if edge.Node1Pub == advertiser.PubKeyStr {
+			policy = edge.Node1Policy
+		}
+
+		if policy == nil {
+			return nil, fmt.Errorf("%s has no policy of %s for "+
+				"channel %v", node.Name(), advertiser.Name(),
+				txStr(chanPoint))
+		}
+
+		return policy, nil
+	}
+
+	return nil, fmt.Errorf("%s doesn't know channel %v", node.Name(),
+		txStr(chanPoint))
+}
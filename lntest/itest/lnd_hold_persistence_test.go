@@ -3,6 +3,7 @@ package itest
 import (
 	"context"
 	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"sync"
@@ -405,3 +406,208 @@ func testHoldInvoicePersistence(net *lntest.NetworkHarness, t *harnessTest) {
 		require.Equal(t.t, io.EOF, err)
 	}
 }
+
+// testPaymentRestartPersistence tests that a payment that is in flight while
+// the sender restarts is resumed and settled afterwards.
+func testPaymentRestartPersistence(net *lntest.NetworkHarness,
+	t *harnessTest) {
+
+	const chanAmt = btcutil.Amount(1000000)
+
+	// Open a channel between Alice and Bob over which the payment is made.
+	chanPoint := openChannelAndAssert(
+		t, net, net.Alice, net.Bob,
+		lntest.OpenChannelParams{
+			Amt: chanAmt,
+		},
+	)
+	defer closeChannelAndAssert(t, net, net.Alice, chanPoint, false)
+
+	assertPaymentSurvivesRestart(
+		t, net, net.Alice, &routerrpc.SendPaymentRequest{
+			Dest:           net.Bob.PubKey[:],
+			Amt:            1000,
+			TimeoutSeconds: 60,
+			FeeLimitSat:    1000000,
+		},
+	)
+}
+
+// assertPaymentSurvivesRestart makes a payment from the sender to the
+// destination of the given request and restarts the sender while the payment
+// is in flight. The destination must be a node of the harness, which creates a
+// hold invoice for the request's amount to keep the payment in flight. The
+// destination and amount of the request are replaced by the payment request of
+// that invoice. Once the sender is back up, the hold invoice is settled and
+// the payment is asserted to succeed using the HTLCs that were recovered from
+// the payment database rather than new ones.
+func assertPaymentSurvivesRestart(t *harnessTest, net *lntest.NetworkHarness,
+	sender *lntest.HarnessNode, req *routerrpc.SendPaymentRequest) {
+
+	ctxb := context.Background()
+
+	receiver, err := net.LookUpNodeByPub(hex.EncodeToString(req.Dest))
+	require.NoError(t.t, err, "destination is not a harness node")
+
+	// Let the receiver create a hold invoice for the payment and subscribe
+	// to its updates.
+	var preimage lntypes.Preimage
+	_, err = rand.Read(preimage[:])
+	require.NoError(t.t, err)
+	payHash := preimage.Hash()
+
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	invoice, err := receiver.AddHoldInvoice(
+		ctxt, &invoicesrpc.AddHoldInvoiceRequest{
+			Memo:  "restart",
+			Value: req.Amt,
+			Hash:  payHash[:],
+		},
+	)
+	require.NoError(t.t, err, "unable to add hold invoice")
+
+	invoiceCtx, cancelInvoice := context.WithCancel(ctxb)
+	defer cancelInvoice()
+	invoiceStream, err := receiver.SubscribeSingleInvoice(
+		invoiceCtx, &invoicesrpc.SubscribeSingleInvoiceRequest{
+			RHash: payHash[:],
+		},
+	)
+	require.NoError(t.t, err, "unable to subscribe to invoice")
+
+	waitForInvoiceState := func(state lnrpc.Invoice_InvoiceState) {
+		for {
+			update, err := invoiceStream.Recv()
+			require.NoError(t.t, err)
+
+			if update.State == state {
+				return
+			}
+		}
+	}
+	waitForInvoiceState(lnrpc.Invoice_OPEN)
+
+	// Start the payment and wait for the receiver to accept its HTLCs.
+	req.Dest = nil
+	req.Amt = 0
+	req.PaymentRequest = invoice.PaymentRequest
+
+	payCtx, cancelPay := context.WithCancel(ctxb)
+	defer cancelPay()
+	payStream, err := sender.RouterClient.SendPaymentV2(payCtx, req)
+	require.NoError(t.t, err, "unable to send payment")
+
+	payment, err := payStream.Recv()
+	require.NoError(t.t, err, "unable to receive payment update")
+	require.Equal(t.t, lnrpc.Payment_IN_FLIGHT, payment.Status)
+
+	waitForInvoiceState(lnrpc.Invoice_ACCEPTED)
+
+	// fetchAttempts returns the status of the HTLC attempts that the
+	// sender has recorded for the payment, keyed by attempt ID.
+	type attemptStatuses map[uint64]lnrpc.HTLCAttempt_HTLCStatus
+	fetchAttempts := func() (attemptStatuses, error) {
+
+		ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+		defer cancel()
+		resp, err := sender.ListPayments(
+			ctxt, &lnrpc.ListPaymentsRequest{
+				IncludeIncomplete: true,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range resp.Payments {
+			if p.PaymentHash != payHash.String() {
+				continue
+			}
+
+			attempts := make(attemptStatuses)
+			for _, htlc := range p.Htlcs {
+				attempts[htlc.AttemptId] = htlc.Status
+			}
+
+			return attempts, nil
+		}
+
+		return nil, fmt.Errorf("payment %v not found", payHash)
+	}
+
+	// Record the attempts that are in flight before the restart.
+	var inFlight attemptStatuses
+	err = wait.NoError(func() error {
+		inFlight, err = fetchAttempts()
+		if err != nil {
+			return err
+		}
+
+		if len(inFlight) == 0 {
+			return fmt.Errorf("no attempt recorded")
+		}
+
+		for id, status := range inFlight {
+			if status != lnrpc.HTLCAttempt_IN_FLIGHT {
+				return fmt.Errorf("attempt %v not in flight: "+
+					"%v", id, status)
+			}
+		}
+
+		return nil
+	}, defaultTimeout)
+	require.NoError(t.t, err, "attempts not in flight")
+
+	// Restart the sender while the payment is in flight. The payment must
+	// be recovered from the payment database with the same attempts.
+	require.NoError(t.t, net.RestartNode(sender, nil), "restart failed")
+
+	recovered, err := fetchAttempts()
+	require.NoError(t.t, err)
+	require.Equal(t.t, inFlight, recovered, "attempts changed on restart")
+
+	trackCtx, cancelTrack := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancelTrack()
+	trackStream, err := sender.RouterClient.TrackPaymentV2(
+		trackCtx, &routerrpc.TrackPaymentRequest{
+			PaymentHash: payHash[:],
+		},
+	)
+	require.NoError(t.t, err, "unable to track payment")
+
+	// Release the hold invoice and wait for the payment to settle.
+	ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	_, err = receiver.SettleInvoice(
+		ctxt, &invoicesrpc.SettleInvoiceMsg{
+			Preimage: preimage[:],
+		},
+	)
+	require.NoError(t.t, err, "unable to settle invoice")
+	waitForInvoiceState(lnrpc.Invoice_SETTLED)
+
+	for payment.Status == lnrpc.Payment_IN_FLIGHT {
+		payment, err = trackStream.Recv()
+		require.NoError(t.t, err, "unable to receive payment update")
+	}
+	require.Equal(t.t, lnrpc.Payment_SUCCEEDED, payment.Status)
+	require.Equal(t.t, preimage.String(), payment.PaymentPreimage)
+
+	// The payment must have settled with exactly the attempts that were
+	// in flight before the restart, and the receiver must not have seen
+	// any duplicate HTLCs.
+	require.Len(t.t, payment.Htlcs, len(inFlight))
+	for _, htlc := range payment.Htlcs {
+		require.Contains(t.t, inFlight, htlc.AttemptId)
+		require.Equal(t.t, lnrpc.HTLCAttempt_SUCCEEDED, htlc.Status)
+	}
+
+	ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	settled, err := receiver.LookupInvoice(
+		ctxt, &lnrpc.PaymentHash{RHash: payHash[:]},
+	)
+	require.NoError(t.t, err, "unable to look up invoice")
+	require.Len(t.t, settled.Htlcs, len(inFlight))
+}
@@ -0,0 +1,158 @@
+package itest
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// testExpiredHtlcRejected tests that HTLCs whose expiry is too close to the
+// current height are rejected, both by an intermediate hop and by the final
+// hop.
+func testExpiredHtlcRejected(net *lntest.NetworkHarness, t *harnessTest) {
+	const chanAmt = btcutil.Amount(1000000)
+
+	ctxb := context.Background()
+
+	carol := net.NewNode(t.t, "Carol", nil)
+	defer shutdownAndAssert(net, t, carol)
+
+	net.ConnectNodes(t.t, net.Bob, carol)
+
+	// We'll create the route Alice -> Bob -> Carol.
+	chanPointAlice := openChannelAndAssert(
+		t, net, net.Alice, net.Bob,
+		lntest.OpenChannelParams{
+			Amt: chanAmt,
+		},
+	)
+	defer closeChannelAndAssert(t, net, net.Alice, chanPointAlice, false)
+
+	chanPointBob := openChannelAndAssert(
+		t, net, net.Bob, carol,
+		lntest.OpenChannelParams{
+			Amt: chanAmt,
+		},
+	)
+	defer closeChannelAndAssert(t, net, net.Bob, chanPointBob, false)
+
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	err := net.Alice.WaitForNetworkChannelOpen(ctxt, chanPointBob)
+	require.NoError(t.t, err, "alice didn't see the bob->carol channel")
+
+	// A payment to Bob is rejected by Bob as the final hop.
+	assertExpiredHtlcRejected(t, net, net.Alice, net.Bob)
+
+	// A payment to Carol is rejected by Bob as an intermediate hop.
+	assertExpiredHtlcRejected(t, net, net.Alice, carol)
+}
+
+// assertExpiredHtlcRejected sends a payment from the sender to an invoice of
+// the receiver over a route whose time locks are shifted to expire too soon,
+// and asserts that it is rejected. If the route has intermediate hops, the
+// first one must reject the HTLC with expiry_too_soon. Otherwise the receiver
+// must reject it as the final hop. As lnd doesn't leak the reason why an
+// invoice can't be paid, incorrect_or_unknown_payment_details is accepted in
+// addition to final_expiry_too_soon in that case.
+func assertExpiredHtlcRejected(t *harnessTest, net *lntest.NetworkHarness,
+	sender, receiver *lntest.HarnessNode) {
+
+	t.t.Helper()
+
+	const paymentAmt = btcutil.Amount(1000)
+
+	ctxb := context.Background()
+
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	invoice, err := receiver.AddInvoice(ctxt, &lnrpc.Invoice{
+		Value: int64(paymentAmt),
+	})
+	require.NoError(t.t, err, "unable to add invoice")
+
+	routes, err := sender.QueryRoutes(ctxt, &lnrpc.QueryRoutesRequest{
+		PubKey: receiver.PubKeyStr,
+		Amt:    int64(paymentAmt),
+	})
+	require.NoError(t.t, err, "unable to query routes")
+	require.NotEmpty(t.t, routes.Routes)
+
+	info, err := sender.GetInfo(ctxt, &lnrpc.GetInfoRequest{})
+	require.NoError(t.t, err, "unable to get info")
+	height := info.BlockHeight
+
+	// We'll pick the expiry of the HTLC that the rejecting node must
+	// forward or accept. An intermediate hop rejects HTLCs that it would
+	// need to forward with an expiry within its outgoing reject delta. The
+	// final hop rejects HTLCs that expire within its final reject delta,
+	// which is larger than the outgoing reject delta that the sender
+	// applies to its own HTLC.
+	route := routes.Routes[0]
+	finalHop := len(route.Hops) == 1
+	targetExpiry := height + lncfg.DefaultOutgoingCltvRejectDelta - 1
+	if finalHop {
+		targetExpiry = height + lncfg.DefaultOutgoingCltvRejectDelta + 1
+		require.Less(
+			t.t, targetExpiry-height,
+			uint32(lncfg.DefaultFinalCltvRejectDelta),
+		)
+	}
+
+	// Shift the time locks of all hops down by the same amount, so that
+	// the time lock deltas of the hops are still satisfied.
+	shift := route.Hops[0].Expiry - targetExpiry
+	route.TotalTimeLock -= shift
+	for _, hop := range route.Hops {
+		hop.Expiry -= shift
+	}
+
+	lastHop := route.Hops[len(route.Hops)-1]
+	lastHop.TlvPayload = true
+	lastHop.MppRecord = &lnrpc.MPPRecord{
+		PaymentAddr:  invoice.PaymentAddr,
+		TotalAmtMsat: int64(lnwire.NewMSatFromSatoshis(paymentAmt)),
+	}
+
+	resp, err := sender.RouterClient.SendToRouteV2(
+		ctxt, &routerrpc.SendToRouteRequest{
+			PaymentHash: invoice.RHash,
+			Route:       route,
+		},
+	)
+	require.NoError(t.t, err, "unable to send to route")
+	require.NotNil(t.t, resp.Failure, "expired htlc wasn't rejected")
+
+	// The failure source index counts the sender as hop zero, so the
+	// first hop of the route is at index one.
+	require.EqualValues(
+		t.t, 1, resp.Failure.FailureSourceIndex,
+		"htlc rejected by unexpected hop",
+	)
+
+	if !finalHop {
+		require.Equal(
+			t.t, lnrpc.Failure_EXPIRY_TOO_SOON, resp.Failure.Code,
+		)
+
+		return
+	}
+
+	require.Contains(t.t, []lnrpc.Failure_FailureCode{
+		lnrpc.Failure_FINAL_EXPIRY_TOO_SOON,
+		lnrpc.Failure_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS,
+	}, resp.Failure.Code)
+
+	// The invoice must still be open, as the HTLC was rejected.
+	dbInvoice, err := receiver.LookupInvoice(ctxt, &lnrpc.PaymentHash{
+		RHash: invoice.RHash,
+	})
+	require.NoError(t.t, err, "unable to lookup invoice")
+	require.Equal(t.t, lnrpc.Invoice_OPEN, dbInvoice.State)
+}
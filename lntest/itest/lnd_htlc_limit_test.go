@@ -0,0 +1,208 @@
+package itest
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/chainreg"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// testHtlcLimit tests that the number of htlcs a node may add to a channel is
+// limited to the max_accepted_htlcs of its peer, in both directions of the
+// channel.
+func testHtlcLimit(net *lntest.NetworkHarness, t *harnessTest) {
+	const (
+		chanAmt  = btcutil.Amount(1000000)
+		maxHtlcs = 5
+	)
+
+	// Carol only accepts a few htlcs from Alice, and Alice only accepts a
+	// few htlcs from Carol on the channel she opens, so that we don't
+	// need to fill hundreds of slots.
+	carol := net.NewNode(t.t, "Carol", []string{
+		fmt.Sprintf("--default-remote-max-htlcs=%v", maxHtlcs),
+	})
+	defer shutdownAndAssert(net, t, carol)
+
+	net.ConnectNodes(t.t, net.Alice, carol)
+
+	chanPoint := openChannelAndAssert(
+		t, net, net.Alice, carol, lntest.OpenChannelParams{
+			Amt:            chanAmt,
+			PushAmt:        chanAmt / 2,
+			RemoteMaxHtlcs: maxHtlcs,
+		},
+	)
+	defer closeChannelAndAssert(t, net, net.Alice, chanPoint, false)
+
+	assertHtlcLimitEnforced(t, net, net.Alice, carol)
+	assertHtlcLimitEnforced(t, net, carol, net.Alice)
+}
+
+// assertHtlcLimitEnforced fills the channel between the sender and the
+// receiver with htlcs of hold invoices, up to the number of htlcs the receiver
+// accepts from the sender. It asserts that the sender fails the next htlc with
+// temporary_channel_failure, and that the htlc succeeds once one of the hold
+// invoices is settled. The remaining hold invoices are canceled again. The
+// nodes must share a single channel, to which the sender hasn't added any
+// htlcs yet.
+func assertHtlcLimitEnforced(t *harnessTest, net *lntest.NetworkHarness,
+	sender, receiver *lntest.HarnessNode) {
+
+	t.t.Helper()
+
+	// The htlcs are below the dust limit, so that they don't add outputs
+	// to the commitments, which would make the commitment fee grow with
+	// the number of htlcs.
+	const htlcAmtMsat = 10000
+
+	ctxb := context.Background()
+
+	// fetchChannel returns the channel of the sender with the receiver.
+	fetchChannel := func() (*lnrpc.Channel, error) {
+		ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+		defer cancel()
+		resp, err := sender.ListChannels(ctxt, &lnrpc.ListChannelsRequest{
+			ActiveOnly: true,
+			Peer:       receiver.PubKey[:],
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(resp.Channels) != 1 {
+			return nil, fmt.Errorf("expected one channel with %v, "+
+				"got %v", receiver.Name(), len(resp.Channels))
+		}
+
+		return resp.Channels[0], nil
+	}
+
+	// assertNumHtlcs asserts that the sender has the given number of
+	// htlcs on the channel. As the receiver doesn't add htlcs, all of them
+	// are added by the sender.
+	assertNumHtlcs := func(numHtlcs int) {
+		err := wait.NoError(func() error {
+			channel, err := fetchChannel()
+			if err != nil {
+				return err
+			}
+
+			if len(channel.PendingHtlcs) != numHtlcs {
+				return fmt.Errorf("expected %v htlcs, got %v",
+					numHtlcs, len(channel.PendingHtlcs))
+			}
+
+			return nil
+		}, defaultTimeout)
+		require.NoError(t.t, err, "%v htlcs", sender.Name())
+	}
+
+	channel, err := fetchChannel()
+	require.NoError(t.t, err, "unable to find channel")
+	require.Empty(t.t, channel.PendingHtlcs, "channel has htlcs")
+
+	// The number of htlcs the receiver accepts from the sender is part of
+	// the constraints that apply to the updates of the sender.
+	maxHtlcs := int(channel.LocalConstraints.MaxAcceptedHtlcs)
+	require.NotZero(t.t, maxHtlcs, "no htlc limit")
+
+	// We query the route of the htlc that exceeds the limit up front, as
+	// path finding doesn't consider the channel once it is full.
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	invoice, err := receiver.AddInvoice(ctxt, &lnrpc.Invoice{
+		ValueMsat:  htlcAmtMsat,
+		CltvExpiry: chainreg.DefaultBitcoinTimeLockDelta,
+	})
+	require.NoError(t.t, err, "unable to add invoice")
+
+	routes, err := sender.QueryRoutes(ctxt, &lnrpc.QueryRoutesRequest{
+		PubKey:         receiver.PubKeyStr,
+		AmtMsat:        htlcAmtMsat,
+		OutgoingChanId: channel.ChanId,
+		FinalCltvDelta: chainreg.DefaultBitcoinTimeLockDelta,
+	})
+	require.NoError(t.t, err, "unable to query routes")
+	require.NotEmpty(t.t, routes.Routes)
+
+	route := routes.Routes[0]
+	require.Len(t.t, route.Hops, 1, "expected direct route")
+	route.Hops[0].TlvPayload = true
+	route.Hops[0].MppRecord = &lnrpc.MPPRecord{
+		PaymentAddr:  invoice.PaymentAddr,
+		TotalAmtMsat: htlcAmtMsat,
+	}
+
+	sendReq := &routerrpc.SendToRouteRequest{
+		PaymentHash: invoice.RHash,
+		Route:       route,
+	}
+
+	// Fill all htlc slots with htlcs of hold invoices, which remain on the
+	// channel until the invoices are settled or canceled.
+	preimages := make([]lntypes.Preimage, maxHtlcs)
+	subscriptions := make([]*holdSubscription, maxHtlcs)
+	for i := 0; i < maxHtlcs; i++ {
+		_, err := rand.Read(preimages[i][:])
+		require.NoError(t.t, err, "unable to generate preimage")
+
+		subCtx, cancel := context.WithTimeout(ctxb, defaultTimeout)
+		defer cancel()
+
+		subscriptions[i] = acceptHoldInvoiceHash(
+			subCtx, t.t, preimages[i].Hash(), channel.ChanId,
+			sender, receiver,
+		)
+	}
+	assertNumHtlcs(maxHtlcs)
+
+	// The channel is full, so the sender must fail the next htlc itself.
+	// The failure source index counts the sender as hop zero.
+	ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	attempt, err := sender.RouterClient.SendToRouteV2(ctxt, sendReq)
+	require.NoError(t.t, err, "unable to send to route")
+	require.Equal(t.t, lnrpc.HTLCAttempt_FAILED, attempt.Status)
+	require.NotNil(t.t, attempt.Failure, "htlc exceeding limit accepted")
+	require.Equal(
+		t.t, lnrpc.Failure_TEMPORARY_CHANNEL_FAILURE,
+		attempt.Failure.Code,
+	)
+	require.Zero(t.t, attempt.Failure.FailureSourceIndex)
+	assertNumHtlcs(maxHtlcs)
+
+	// Settling one of the hold invoices frees up a slot, which allows the
+	// htlc to succeed.
+	ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	subscriptions[0].settle(ctxt, t.t, preimages[0])
+	assertNumHtlcs(maxHtlcs - 1)
+
+	ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	attempt, err = sender.RouterClient.SendToRouteV2(ctxt, sendReq)
+	require.NoError(t.t, err, "unable to send to route")
+	require.Equal(t.t, lnrpc.HTLCAttempt_SUCCEEDED, attempt.Status)
+
+	dbInvoice, err := receiver.LookupInvoice(ctxt, &lnrpc.PaymentHash{
+		RHash: invoice.RHash,
+	})
+	require.NoError(t.t, err, "unable to lookup invoice")
+	require.Equal(t.t, lnrpc.Invoice_SETTLED, dbInvoice.State)
+
+	// Cancel the remaining hold invoices to free up the channel again.
+	for _, sub := range subscriptions[1:] {
+		ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+		sub.cancel(ctxt, t.t)
+		cancel()
+	}
+	assertNumHtlcs(0)
+}
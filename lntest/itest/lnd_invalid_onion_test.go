@@ -0,0 +1,136 @@
+package itest
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// testInvalidOnionRejected tests that HTLCs with a corrupted onion are failed
+// back as malformed by the node that receives them, both if the HTLC was
+// forwarded and if it was sent by the peer directly.
+func testInvalidOnionRejected(net *lntest.NetworkHarness, t *harnessTest) {
+	const chanAmt = btcutil.Amount(1000000)
+
+	ctxb := context.Background()
+
+	// Carol corrupts the onion of every HTLC she adds to her channels.
+	carol := net.NewNode(t.t, "Carol", []string{"--hodl.bogus-onion"})
+	defer shutdownAndAssert(net, t, carol)
+
+	dave := net.NewNode(t.t, "Dave", nil)
+	defer shutdownAndAssert(net, t, dave)
+
+	net.ConnectNodes(t.t, net.Alice, carol)
+	net.ConnectNodes(t.t, carol, dave)
+
+	// We'll create the route Alice -> Carol -> Dave.
+	chanPointAlice := openChannelAndAssert(
+		t, net, net.Alice, carol,
+		lntest.OpenChannelParams{
+			Amt: chanAmt,
+		},
+	)
+	defer closeChannelAndAssert(t, net, net.Alice, chanPointAlice, false)
+
+	chanPointCarol := openChannelAndAssert(
+		t, net, carol, dave,
+		lntest.OpenChannelParams{
+			Amt: chanAmt,
+		},
+	)
+	defer closeChannelAndAssert(t, net, carol, chanPointCarol, false)
+
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	err := net.Alice.WaitForNetworkChannelOpen(ctxt, chanPointCarol)
+	require.NoError(t.t, err, "alice didn't see the carol->dave channel")
+
+	// A payment that Carol forwards to Dave is rejected by Dave, and the
+	// failure must travel back to Alice through Carol.
+	assertInvalidOnionRejected(t, net, net.Alice, dave)
+
+	// A payment that Carol sends to Dave herself is rejected by Dave.
+	assertInvalidOnionRejected(t, net, carol, dave)
+}
+
+// assertInvalidOnionRejected sends a payment from the sender to an invoice of
+// the receiver and asserts that the receiver rejects it because of an invalid
+// onion. The node that hands the HTLC to the receiver, which may be the sender
+// itself, must run with --hodl.bogus-onion, so that the onion it adds is
+// corrupted. The receiver fails the HTLC back as malformed, which that node
+// must turn into a regular failure that is propagated back to the sender.
+func assertInvalidOnionRejected(t *harnessTest, net *lntest.NetworkHarness,
+	sender, receiver *lntest.HarnessNode) {
+
+	t.t.Helper()
+
+	const paymentAmt = btcutil.Amount(1000)
+
+	ctxb := context.Background()
+
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	invoice, err := receiver.AddInvoice(ctxt, &lnrpc.Invoice{
+		Value: int64(paymentAmt),
+	})
+	require.NoError(t.t, err, "unable to add invoice")
+
+	routes, err := sender.QueryRoutes(ctxt, &lnrpc.QueryRoutesRequest{
+		PubKey: receiver.PubKeyStr,
+		Amt:    int64(paymentAmt),
+	})
+	require.NoError(t.t, err, "unable to query routes")
+	require.NotEmpty(t.t, routes.Routes)
+
+	route := routes.Routes[0]
+	lastHop := route.Hops[len(route.Hops)-1]
+	lastHop.TlvPayload = true
+	lastHop.MppRecord = &lnrpc.MPPRecord{
+		PaymentAddr:  invoice.PaymentAddr,
+		TotalAmtMsat: int64(lnwire.NewMSatFromSatoshis(paymentAmt)),
+	}
+
+	resp, err := sender.RouterClient.SendToRouteV2(
+		ctxt, &routerrpc.SendToRouteRequest{
+			PaymentHash: invoice.RHash,
+			Route:       route,
+		},
+	)
+	require.NoError(t.t, err, "unable to send to route")
+	require.NotNil(t.t, resp.Failure, "invalid onion wasn't rejected")
+
+	require.Contains(t.t, []lnrpc.Failure_FailureCode{
+		lnrpc.Failure_INVALID_ONION_HMAC,
+		lnrpc.Failure_INVALID_ONION_VERSION,
+	}, resp.Failure.Code)
+
+	// As the malformed failure of the receiver isn't encrypted, the node
+	// that added the HTLC to the receiver reports it as its own. The
+	// failure source index counts the sender as hop zero, so this is the
+	// hop right before the receiver.
+	require.EqualValues(
+		t.t, len(route.Hops)-1, resp.Failure.FailureSourceIndex,
+		"failure reported by unexpected hop",
+	)
+
+	// The HTLC must be removed from all channels of the sender and the
+	// receiver, and the invoice must still be open.
+	nodes := []*lntest.HarnessNode{sender, receiver}
+	err = wait.NoError(func() error {
+		return assertNumActiveHtlcs(nodes, 0)
+	}, defaultTimeout)
+	require.NoError(t.t, err, "htlc not removed after rejection")
+
+	dbInvoice, err := receiver.LookupInvoice(ctxt, &lnrpc.PaymentHash{
+		RHash: invoice.RHash,
+	})
+	require.NoError(t.t, err, "unable to lookup invoice")
+	require.Equal(t.t, lnrpc.Invoice_OPEN, dbInvoice.State)
+}
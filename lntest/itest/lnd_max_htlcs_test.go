@@ -163,6 +163,38 @@ func (h *holdSubscription) cancel(ctx context.Context, t *testing.T) {
 		payUpdate.FailureReason, "expected unknown details")
 }
 
+// settle settles the hold invoice from the recipient with the given preimage
+// and consumes updates from the payer until the payment has succeeded.
+func (h *holdSubscription) settle(ctx context.Context, t *testing.T,
+	preimage lntypes.Preimage) {
+
+	_, err := h.recipient.SettleInvoice(ctx, &invoicesrpc.SettleInvoiceMsg{
+		Preimage: preimage[:],
+	})
+	require.NoError(t, err, "invoice settle failed")
+
+	invUpdate, err := h.invSubscription.Recv()
+	require.NoError(t, err, "settle invoice subscribe failed")
+	require.Equal(t, lnrpc.Invoice_SETTLED, invUpdate.State,
+		"expected invoice settled")
+
+	// The payment may remain in flight until the settle of the htlc is
+	// processed, so we skip updates until it reaches a final state.
+	for {
+		payUpdate, err := h.paymentSubscription.Recv()
+		require.NoError(t, err, "settle payment subscribe failed")
+
+		if payUpdate.Status == lnrpc.Payment_IN_FLIGHT {
+			continue
+		}
+
+		require.Equal(t, lnrpc.Payment_SUCCEEDED, payUpdate.Status,
+			"expected payment succeeded")
+
+		return
+	}
+}
+
 // acceptHoldInvoice adds a hold invoice to the recipient node, pays it from
 // the sender and asserts that we have reached the accepted state where htlcs
 // are locked in for the payment.
@@ -171,6 +203,22 @@ func acceptHoldInvoice(ctx context.Context, t *testing.T, idx int, sender,
 
 	hash := [lntypes.HashSize]byte{byte(idx + 1)}
 
+	return acceptHoldInvoiceHash(ctx, t, hash, 0, sender, receiver)
+}
+
+// acceptHoldInvoiceHash adds a hold invoice for the given hash to the
+// recipient node, pays it from the sender and asserts that the htlc of the
+// payment is accepted. If outgoingChanID is non-zero, the sender must use that
+// channel for the payment.
+func acceptHoldInvoiceHash(ctx context.Context, t *testing.T,
+	hash lntypes.Hash, outgoingChanID uint64, sender,
+	receiver *lntest.HarnessNode) *holdSubscription {
+
+	var outgoingChanIDs []uint64
+	if outgoingChanID != 0 {
+		outgoingChanIDs = []uint64{outgoingChanID}
+	}
+
 	invoice, err := receiver.AddHoldInvoice(
 		ctx, &invoicesrpc.AddHoldInvoiceRequest{
 			ValueMsat: 10000,
@@ -193,9 +241,10 @@ func acceptHoldInvoice(ctx context.Context, t *testing.T, idx int, sender,
 
 	payStream, err := sender.RouterClient.SendPaymentV2(
 		ctx, &routerrpc.SendPaymentRequest{
-			PaymentRequest: invoice.PaymentRequest,
-			TimeoutSeconds: 60,
-			FeeLimitSat:    1000000,
+			PaymentRequest:  invoice.PaymentRequest,
+			TimeoutSeconds:  60,
+			FeeLimitSat:     1000000,
+			OutgoingChanIds: outgoingChanIDs,
 		},
 	)
 	require.NoError(t, err, "send payment failed")
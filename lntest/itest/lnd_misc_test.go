@@ -1576,7 +1576,8 @@ func testAbandonChannel(net *lntest.NetworkHarness, t *harnessTest) {
 
 	// Send request to abandon channel.
 	abandonChannelRequest := &lnrpc.AbandonChannelRequest{
-		ChannelPoint: chanPoint,
+		ChannelPoint:      chanPoint,
+		IKnowWhatIAmDoing: true,
 	}
 
 	ctxt, _ = context.WithTimeout(ctxb, defaultTimeout)
@@ -1839,6 +1840,39 @@ func testSweepAllCoins(net *lntest.NetworkHarness, t *harnessTest) {
 
 	assertTxLabel(t, ainz, sweepTxStr, newLabel)
 
+	// The new label must also be returned when looking up the transaction
+	// on its own.
+	ctxt, _ = context.WithTimeout(ctxb, defaultTimeout)
+	tx, err := ainz.WalletKitClient.GetTransaction(
+		ctxt, &walletrpc.GetTransactionRequest{
+			Txid: sweepHash[:],
+		},
+	)
+	require.NoError(t.t, err, "unable to get transaction")
+	require.Equal(t.t, sweepTxStr, tx.TxHash)
+	require.Equal(t.t, newLabel, tx.Label)
+
+	// Transactions that the wallet received can be labelled as well.
+	receivedHash := sweepTx.TxIn[0].PreviousOutPoint.Hash
+	receivedLabel := "received coins"
+	ctxt, _ = context.WithTimeout(ctxb, defaultTimeout)
+	_, err = ainz.WalletKitClient.LabelTransaction(
+		ctxt, &walletrpc.LabelTransactionRequest{
+			Txid:  receivedHash[:],
+			Label: receivedLabel,
+		},
+	)
+	require.NoError(t.t, err, "unable to label received tx")
+
+	ctxt, _ = context.WithTimeout(ctxb, defaultTimeout)
+	tx, err = ainz.WalletKitClient.GetTransaction(
+		ctxt, &walletrpc.GetTransactionRequest{
+			Txid: receivedHash[:],
+		},
+	)
+	require.NoError(t.t, err, "unable to get received transaction")
+	require.Equal(t.t, receivedLabel, tx.Label)
+
 	// Finally, Ainz should now have no coins at all within his wallet.
 	balReq := &lnrpc.WalletBalanceRequest{}
 	resp, err := ainz.WalletBalance(ctxt, balReq)
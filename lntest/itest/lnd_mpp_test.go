@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/btcsuite/btcd/wire"
@@ -12,7 +13,9 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
 	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
 )
 
 // testSendToRouteMultiPath tests that we are able to successfully route a
@@ -137,8 +140,10 @@ func testSendToRouteMultiPath(net *lntest.NetworkHarness, t *harnessTest) {
 	}
 
 	// assertNumHtlcs is a helper that checks the node's latest payment,
-	// and asserts it was split into num shards.
-	assertNumHtlcs := func(node *lntest.HarnessNode, num int) {
+	// and asserts it was split into num shards. It returns the payment.
+	assertNumHtlcs := func(node *lntest.HarnessNode,
+		num int) *lnrpc.Payment {
+
 		req := &lnrpc.ListPaymentsRequest{
 			IncludeIncomplete: true,
 		}
@@ -171,6 +176,8 @@ func testSendToRouteMultiPath(net *lntest.NetworkHarness, t *harnessTest) {
 			t.Fatalf("expected %v succussful HTLCs, got %v", num,
 				succeeded)
 		}
+
+		return payment
 	}
 
 	// assertSettledInvoice checks that the invoice for the given payment
@@ -234,10 +241,384 @@ func testSendToRouteMultiPath(net *lntest.NetworkHarness, t *harnessTest) {
 
 	// Finally check that the payment shows up with three settled HTLCs in
 	// Alice's list of payments...
-	assertNumHtlcs(ctx.alice, 3)
+	payment := assertNumHtlcs(ctx.alice, 3)
+
+	// ...each of which paid the default fee to every node it was forwarded
+	// by. The third shard is forwarded by Eve and then by Carol, who also
+	// forwards Eve's fee.
+	defaultFee := func(amt lnwire.MilliSatoshi) lnwire.MilliSatoshi {
+		return chainreg.DefaultBitcoinBaseFeeMSat +
+			amt*chainreg.DefaultBitcoinFeeRate/1000000
+	}
+	shardAmtMsat := lnwire.NewMSatFromSatoshis(shardAmt)
+	eveFee := defaultFee(shardAmtMsat)
+	expectedFee := 3*defaultFee(shardAmtMsat) +
+		defaultFee(shardAmtMsat+eveFee)
+	assertMPPFees(t, ctx.alice, payment, int64(expectedFee))
 
 	// ...and in Bob's list of paid invoices.
 	assertSettledInvoice(ctx.bob, rHash, 3)
+
+	// Alice should also be able to split a payment across parallel
+	// channels that she opens directly to Bob.
+	assertMPPAcrossParallelChannels(t, net, ctx.alice, ctx.bob)
+
+	// If a shard fails on the way, Alice must retry its amount over
+	// another route without paying more than the invoice amount.
+	assertMPPShardRetry(t, net, ctx.alice, ctx.bob)
+}
+
+// assertMPPAcrossParallelChannels opens two parallel channels from the sender
+// to the receiver and asserts that a payment exceeding the capacity of each
+// of them is split across both channels. The receiver must settle all shards
+// in a single invoice. The channels are closed again before returning.
+func assertMPPAcrossParallelChannels(t *harnessTest,
+	net *lntest.NetworkHarness, sender, receiver *lntest.HarnessNode) {
+
+	ctxb := context.Background()
+
+	const (
+		chanAmt    = btcutil.Amount(500000)
+		paymentAmt = chanAmt * 7 / 5
+	)
+
+	net.SendCoins(t.t, btcutil.SatoshiPerBitcoin, sender)
+	net.EnsureConnected(t.t, sender, receiver)
+
+	chanPoint1 := openChannelAndAssert(
+		t, net, sender, receiver, lntest.OpenChannelParams{
+			Amt: chanAmt,
+		},
+	)
+	defer closeChannelAndAssert(t, net, sender, chanPoint1, false)
+
+	chanPoint2 := openChannelAndAssert(
+		t, net, sender, receiver, lntest.OpenChannelParams{
+			Amt: chanAmt,
+		},
+	)
+	defer closeChannelAndAssert(t, net, sender, chanPoint2, false)
+
+	// Restrict the payment to the two parallel channels so that no
+	// other path between the nodes is used.
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	chans, err := sender.ListChannels(ctxt, &lnrpc.ListChannelsRequest{
+		ActiveOnly: true,
+		Peer:       receiver.PubKey[:],
+	})
+	require.NoError(t.t, err)
+	require.Len(t.t, chans.Channels, 2)
+
+	parallelChans := make(map[uint64]struct{}, len(chans.Channels))
+	outgoingChanIDs := make([]uint64, 0, len(chans.Channels))
+	for _, c := range chans.Channels {
+		parallelChans[c.ChanId] = struct{}{}
+		outgoingChanIDs = append(outgoingChanIDs, c.ChanId)
+	}
+
+	ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	invoice, err := receiver.AddInvoice(ctxt, &lnrpc.Invoice{
+		Memo:  "parallel mpp",
+		Value: int64(paymentAmt),
+	})
+	require.NoError(t.t, err)
+
+	payment := sendAndAssertSuccess(
+		t, sender, &routerrpc.SendPaymentRequest{
+			PaymentRequest:  invoice.PaymentRequest,
+			OutgoingChanIds: outgoingChanIDs,
+			TimeoutSeconds:  60,
+			FeeLimitMsat:    noFeeLimitMsat,
+			MaxParts:        10,
+		},
+	)
+
+	// All successful shards must have been sent directly to the receiver
+	// and together they must have used both channels.
+	usedChans := make(map[uint64]struct{})
+	var numShards int
+	for _, htlc := range payment.Htlcs {
+		if htlc.Status != lnrpc.HTLCAttempt_SUCCEEDED {
+			continue
+		}
+
+		require.Len(t.t, htlc.Route.Hops, 1)
+		chanID := htlc.Route.Hops[0].ChanId
+		require.Contains(t.t, parallelChans, chanID)
+
+		usedChans[chanID] = struct{}{}
+		numShards++
+	}
+	require.Len(t.t, usedChans, 2, "payment not split across both "+
+		"parallel channels")
+
+	// The receiver must have aggregated all shards into a single settled
+	// invoice paying the full amount.
+	ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	dbInvoice, err := receiver.LookupInvoice(ctxt, &lnrpc.PaymentHash{
+		RHash: invoice.RHash,
+	})
+	require.NoError(t.t, err)
+	require.Equal(t.t, lnrpc.Invoice_SETTLED, dbInvoice.State)
+	require.Equal(t.t, int64(paymentAmt), dbInvoice.AmtPaidSat)
+
+	var numSettled int
+	for _, htlc := range dbInvoice.Htlcs {
+		if htlc.State == lnrpc.InvoiceHTLCState_SETTLED {
+			numSettled++
+		}
+	}
+	require.Equal(t.t, numShards, numSettled)
+}
+
+// assertMPPShardRetry sets up two routes from the sender to the receiver, each
+// through a new intermediate node, and pays an invoice of the receiver in
+// multiple shards over them. The intermediate nodes intercept the shards and
+// fail the first one they see, as if it had timed out. It asserts that the
+// sender retries the failed amount over the other route and that the payment
+// settles with exactly the invoice amount. The routes are torn down again
+// before returning.
+func assertMPPShardRetry(t *harnessTest, net *lntest.NetworkHarness,
+	sender, receiver *lntest.HarnessNode) {
+
+	ctxb := context.Background()
+
+	const (
+		chanAmt    = btcutil.Amount(500000)
+		shardAmt   = btcutil.Amount(150000)
+		paymentAmt = 2 * shardAmt
+	)
+
+	net.SendCoins(t.t, btcutil.SatoshiPerBitcoin, sender)
+
+	// Each route can carry the full payment, so that the failed shard can
+	// be retried over the route that wasn't affected by the failure.
+	var outgoingChanIDs []uint64
+	hops := make([]*lntest.HarnessNode, 0, 2)
+	for _, name := range []string{"Frank", "Grace"} {
+		hop := net.NewNode(t.t, name, nil)
+		defer shutdownAndAssert(net, t, hop)
+		hops = append(hops, hop)
+
+		net.SendCoins(t.t, btcutil.SatoshiPerBitcoin, hop)
+		net.EnsureConnected(t.t, sender, hop)
+		net.EnsureConnected(t.t, hop, receiver)
+
+		chanPointIn := openChannelAndAssert(
+			t, net, sender, hop, lntest.OpenChannelParams{
+				Amt: chanAmt,
+			},
+		)
+		defer closeChannelAndAssert(t, net, sender, chanPointIn, false)
+
+		chanPointOut := openChannelAndAssert(
+			t, net, hop, receiver, lntest.OpenChannelParams{
+				Amt: chanAmt,
+			},
+		)
+		defer closeChannelAndAssert(t, net, hop, chanPointOut, false)
+
+		ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+		err := sender.WaitForNetworkChannelOpen(ctxt, chanPointOut)
+		cancel()
+		require.NoError(t.t, err, "sender didn't see channel of %s",
+			name)
+
+		ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+		chans, err := sender.ListChannels(
+			ctxt, &lnrpc.ListChannelsRequest{
+				ActiveOnly: true,
+				Peer:       hop.PubKey[:],
+			},
+		)
+		cancel()
+		require.NoError(t.t, err)
+		require.Len(t.t, chans.Channels, 1)
+
+		outgoingChanIDs = append(
+			outgoingChanIDs, chans.Channels[0].ChanId,
+		)
+	}
+
+	// Both intermediate nodes share the decision, so that only the first
+	// shard that reaches either of them is failed.
+	var failed int32
+	decide := func(*routerrpc.ForwardHtlcInterceptRequest) (
+		routerrpc.ResolveHoldForwardAction, []byte) {
+
+		if atomic.CompareAndSwapInt32(&failed, 0, 1) {
+			return routerrpc.ResolveHoldForwardAction_FAIL, nil
+		}
+
+		return routerrpc.ResolveHoldForwardAction_RESUME, nil
+	}
+	for _, hop := range hops {
+		stopInterceptor := runHtlcInterceptor(t, hop, decide)
+		defer stopInterceptor()
+	}
+
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	invoice, err := receiver.AddInvoice(ctxt, &lnrpc.Invoice{
+		Memo:  "mpp shard retry",
+		Value: int64(paymentAmt),
+	})
+	require.NoError(t.t, err)
+
+	shardAmtMsat := lnwire.NewMSatFromSatoshis(shardAmt)
+	payment := sendAndAssertSuccess(
+		t, sender, &routerrpc.SendPaymentRequest{
+			PaymentRequest:   invoice.PaymentRequest,
+			OutgoingChanIds:  outgoingChanIDs,
+			TimeoutSeconds:   60,
+			FeeLimitMsat:     noFeeLimitMsat,
+			MaxParts:         10,
+			MaxShardSizeMsat: uint64(shardAmtMsat),
+		},
+	)
+
+	// Exactly one shard must have failed, at the intermediate node that
+	// intercepted it.
+	var failedShard *lnrpc.HTLCAttempt
+	for _, htlc := range payment.Htlcs {
+		if htlc.Status != lnrpc.HTLCAttempt_FAILED {
+			continue
+		}
+
+		require.Nil(t.t, failedShard, "more than one shard failed")
+		failedShard = htlc
+	}
+	require.NotNil(t.t, failedShard, "no shard failed")
+	require.Equal(
+		t.t, lnrpc.Failure_TEMPORARY_CHANNEL_FAILURE,
+		failedShard.Failure.Code,
+	)
+	require.EqualValues(t.t, 1, failedShard.Failure.FailureSourceIndex)
+	failedHop := failedShard.Route.Hops[0].PubKey
+
+	// The amount of the failed shard must have been retried over the other
+	// route. The successful shards together must deliver exactly the
+	// invoice amount.
+	var (
+		retried       bool
+		deliveredMsat int64
+	)
+	for _, htlc := range payment.Htlcs {
+		if htlc.Status != lnrpc.HTLCAttempt_SUCCEEDED {
+			continue
+		}
+
+		routeHops := htlc.Route.Hops
+		deliveredMsat += routeHops[len(routeHops)-1].AmtToForwardMsat
+
+		if htlc.AttemptTimeNs >= failedShard.ResolveTimeNs &&
+			routeHops[0].PubKey != failedHop {
+
+			retried = true
+		}
+	}
+	require.True(t.t, retried, "failed shard not retried over other route")
+
+	paymentAmtMsat := int64(lnwire.NewMSatFromSatoshis(paymentAmt))
+	require.Equal(t.t, paymentAmtMsat, deliveredMsat)
+	require.Equal(t.t, paymentAmtMsat, payment.ValueMsat)
+
+	// Only the two shards that succeeded pay the default fee of their
+	// intermediate node, while the failed shard must not be counted.
+	hopFee := chainreg.DefaultBitcoinBaseFeeMSat +
+		shardAmtMsat*chainreg.DefaultBitcoinFeeRate/1000000
+	assertMPPFees(t, sender, payment, int64(2*hopFee))
+
+	// The receiver must have settled the invoice once, with the amount
+	// of all settled shards matching the invoice amount.
+	dbInvoice, err := receiver.LookupInvoice(ctxt, &lnrpc.PaymentHash{
+		RHash: invoice.RHash,
+	})
+	require.NoError(t.t, err)
+	require.Equal(t.t, lnrpc.Invoice_SETTLED, dbInvoice.State)
+	require.Equal(t.t, paymentAmtMsat, dbInvoice.AmtPaidMsat)
+
+	var settledMsat int64
+	for _, htlc := range dbInvoice.Htlcs {
+		if htlc.State == lnrpc.InvoiceHTLCState_SETTLED {
+			settledMsat += int64(htlc.AmtMsat)
+		}
+	}
+	require.Equal(t.t, paymentAmtMsat, settledMsat)
+}
+
+// assertMPPFees asserts that the fees of the successful shards of the payment
+// add up to the expected total, which must also be the fee the payment
+// reports. Failed shards, such as those that were retried, didn't pay any
+// fees and must not be counted. The fee each hop of a shard charged must match
+// the policy of the channel the hop forwarded the shard over, as known to the
+// given node.
+func assertMPPFees(t *harnessTest, node *lntest.HarnessNode,
+	payment *lnrpc.Payment, expectedTotalFeeMsat int64) {
+
+	t.t.Helper()
+
+	ctxb := context.Background()
+
+	var totalFeeMsat int64
+	for _, htlc := range payment.Htlcs {
+		if htlc.Status != lnrpc.HTLCAttempt_SUCCEEDED {
+			continue
+		}
+
+		hops := htlc.Route.Hops
+		finalHop := hops[len(hops)-1]
+
+		// The final hop receives the shard, so it doesn't charge a
+		// fee.
+		require.Zero(t.t, finalHop.FeeMsat, "fee charged by final hop")
+
+		var routeFeeMsat int64
+		for i, hop := range hops[:len(hops)-1] {
+			req := &lnrpc.ChanInfoRequest{ChanId: hops[i+1].ChanId}
+			ctxt, cancel := context.WithTimeout(
+				ctxb, defaultTimeout,
+			)
+			edge, err := node.GetChanInfo(ctxt, req)
+			cancel()
+			require.NoError(t.t, err, "unable to get channel info")
+
+			policy := edge.Node1Policy
+			if edge.Node2Pub == hop.PubKey {
+				policy = edge.Node2Policy
+			}
+
+			expectedFeeMsat := policy.FeeBaseMsat +
+				hop.AmtToForwardMsat*policy.FeeRateMilliMsat/
+					1000000
+			require.Equal(
+				t.t, expectedFeeMsat, hop.FeeMsat,
+				"unexpected fee charged by hop %d", i,
+			)
+
+			routeFeeMsat += hop.FeeMsat
+		}
+
+		require.Equal(t.t, routeFeeMsat, htlc.Route.TotalFeesMsat)
+		require.Equal(
+			t.t, htlc.Route.TotalAmtMsat-finalHop.AmtToForwardMsat,
+			htlc.Route.TotalFeesMsat,
+		)
+
+		totalFeeMsat += htlc.Route.TotalFeesMsat
+	}
+
+	require.Equal(
+		t.t, expectedTotalFeeMsat, totalFeeMsat,
+		"unexpected total fee of successful shards",
+	)
+	require.Equal(
+		t.t, expectedTotalFeeMsat, payment.FeeMsat,
+		"unexpected fee reported for payment",
+	)
 }
 
 type mppTestContext struct {
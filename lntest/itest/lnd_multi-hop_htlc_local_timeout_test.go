@@ -226,6 +226,11 @@ func testMultiHopHtlcLocalTimeout(net *lntest.NetworkHarness, t *harnessTest,
 	err = waitForNumChannelPendingForceClose(bob, 0, nil)
 	require.NoError(t.t, err)
 
+	// The HTLC output on Bob's commitment should have been resolved via
+	// the timeout path, including the sweep of the second-level timeout
+	// transaction.
+	assertHtlcOutputResolved(t, net, bob, bobChanPoint, payHash, false)
+
 	// Coop close channel, expect no anchors.
 	closeChannelAndAssertType(t, net, alice, aliceChanPoint, false, false)
 }
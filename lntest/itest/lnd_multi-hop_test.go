@@ -1,17 +1,24 @@
 package itest
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
 	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/lightningnetwork/lnd/lntest/wait"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
 )
 
 func testMultiHopHtlcClaims(net *lntest.NetworkHarness, t *harnessTest) {
@@ -300,3 +307,160 @@ func assertAllTxesSpendFrom(t *harnessTest, txes []*wire.MsgTx,
 		}
 	}
 }
+
+// assertHtlcOutputResolved asserts that the output of the HTLC with the given
+// payment hash on the confirmed commitment of the force closed channel is
+// resolved on-chain via the success path if success is set, or via the
+// timeout path otherwise. Blocks are mined until the HTLC output is spent. If
+// the HTLC output is spent by a second-level HTLC transaction, blocks are
+// mined until the output of the second-level transaction is swept as well.
+// Second-level transactions of anchor channels may be aggregated with other
+// inputs, so the HTLC output is located through its witness script rather than
+// its position in the transaction.
+func assertHtlcOutputResolved(t *harnessTest, net *lntest.NetworkHarness,
+	node *lntest.HarnessNode, chanPoint *lnrpc.ChannelPoint,
+	htlcHash []byte, success bool) {
+
+	t.t.Helper()
+
+	// The number of blocks we mine at most while waiting for an output to
+	// be spent, which needs to cover the expiry of the HTLC.
+	const maxBlocks = 200
+
+	miner := net.Miner.Client
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+
+	// Look up the commitment transaction that closed the channel, which is
+	// either still pending resolution or already fully resolved.
+	var closeTxidStr string
+	pending, err := node.PendingChannels(
+		ctxt, &lnrpc.PendingChannelsRequest{},
+	)
+	require.NoError(t.t, err, "unable to query pending channels")
+	for _, c := range pending.PendingForceClosingChannels {
+		if c.Channel.ChannelPoint == txStr(chanPoint) {
+			closeTxidStr = c.ClosingTxid
+		}
+	}
+
+	if closeTxidStr == "" {
+		closed, err := node.ClosedChannels(
+			ctxt, &lnrpc.ClosedChannelsRequest{},
+		)
+		require.NoError(t.t, err, "unable to query closed channels")
+		for _, c := range closed.Channels {
+			if c.ChannelPoint == txStr(chanPoint) {
+				closeTxidStr = c.ClosingTxHash
+			}
+		}
+	}
+	require.NotEmpty(t.t, closeTxidStr, "channel %v not force closed",
+		txStr(chanPoint))
+
+	closeTxid, err := chainhash.NewHashFromStr(closeTxidStr)
+	require.NoError(t.t, err)
+
+	closeTx, err := miner.GetRawTransactionVerbose(closeTxid)
+	require.NoError(t.t, err, "unable to find closing tx")
+	require.NotEmpty(t.t, closeTx.BlockHash, "closing tx not confirmed")
+
+	blockHash, err := chainhash.NewHashFromStr(closeTx.BlockHash)
+	require.NoError(t.t, err)
+	header, err := miner.GetBlockHeaderVerbose(blockHash)
+	require.NoError(t.t, err, "unable to get closing block")
+
+	// waitForSpend scans the blocks starting at the given height for an
+	// input matching the predicate, mining blocks until it is found. It
+	// returns the spending transaction, the index of the matching input
+	// and the height to continue scanning from.
+	waitForSpend := func(height int64,
+		match func(*wire.TxIn) bool) (*wire.MsgTx, int, int64) {
+
+		for i := 0; i <= maxBlocks; i++ {
+			tip, err := miner.GetBlockCount()
+			require.NoError(t.t, err, "unable to get block count")
+
+			for ; height <= tip; height++ {
+				hash, err := miner.GetBlockHash(height)
+				require.NoError(t.t, err)
+				block, err := miner.GetBlock(hash)
+				require.NoError(t.t, err)
+
+				for _, tx := range block.Transactions {
+					for idx, txIn := range tx.TxIn {
+						if match(txIn) {
+							return tx, idx, height
+						}
+					}
+				}
+			}
+
+			// Give the nodes a moment to broadcast their sweeps
+			// before mining the next block.
+			_ = wait.Predicate(func() bool {
+				mempool, err := miner.GetRawMempool()
+				return err == nil && len(mempool) > 0
+			}, time.Second)
+
+			mineBlocks(t, net, 1, 0)
+		}
+
+		t.Fatalf("output not spent within %v blocks", maxBlocks)
+		return nil, 0, 0
+	}
+
+	// The witness script of both the success and the timeout path commit
+	// to the payment hash, which allows us to locate the HTLC output.
+	hashCommitment := input.Ripemd160H(htlcHash)
+	spendTx, inputIdx, height := waitForSpend(
+		int64(header.Height), func(txIn *wire.TxIn) bool {
+			if txIn.PreviousOutPoint.Hash != *closeTxid {
+				return false
+			}
+
+			witness := txIn.Witness
+			if len(witness) == 0 {
+				return false
+			}
+
+			return bytes.Contains(
+				witness[len(witness)-1], hashCommitment,
+			)
+		},
+	)
+
+	// Only the success path reveals the preimage of the payment hash.
+	var preimageRevealed bool
+	for _, item := range spendTx.TxIn[inputIdx].Witness {
+		preimageHash := sha256.Sum256(item)
+		if bytes.Equal(preimageHash[:], htlcHash) {
+			preimageRevealed = true
+		}
+	}
+	require.Equal(
+		t.t, success, preimageRevealed, "htlc resolved via unexpected "+
+			"path",
+	)
+
+	// A second-level HTLC transaction pays to a P2WSH output at the same
+	// index as the HTLC input, while sweeps pay directly to the wallet.
+	// Anchor channels sign second-level transactions with
+	// SIGHASH_SINGLE, so this holds even if they are aggregated.
+	if inputIdx >= len(spendTx.TxOut) {
+		return
+	}
+	pkScript := spendTx.TxOut[inputIdx].PkScript
+	if !txscript.IsPayToWitnessScriptHash(pkScript) {
+		return
+	}
+
+	secondLevelOutpoint := wire.OutPoint{
+		Hash:  spendTx.TxHash(),
+		Index: uint32(inputIdx),
+	}
+	waitForSpend(height, func(txIn *wire.TxIn) bool {
+		return txIn.PreviousOutPoint == secondLevelOutpoint
+	})
+}
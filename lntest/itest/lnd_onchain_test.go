@@ -13,6 +13,7 @@ import (
 	"github.com/lightningnetwork/lnd/lntest"
 	"github.com/lightningnetwork/lnd/lntest/wait"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/sweep"
 	"github.com/stretchr/testify/require"
 )
@@ -162,6 +163,54 @@ func testCPFP(net *lntest.NetworkHarness, t *harnessTest) {
 	}
 }
 
+// testAnchorCPFP tests that a node force closing an anchor channel bumps the
+// fee of its commitment transaction through the local anchor if the current
+// fee estimate exceeds the commitment fee rate, and leaves the anchor alone if
+// sweeping it is uneconomical.
+func testAnchorCPFP(net *lntest.NetworkHarness, t *harnessTest) {
+	args := nodeArgsForCommitType(lnrpc.CommitmentType_ANCHORS)
+	alice := net.NewNode(t.t, "Alice", args)
+	defer shutdownAndAssert(net, t, alice)
+
+	bob := net.NewNode(t.t, "Bob", args)
+	defer shutdownAndAssert(net, t, bob)
+
+	net.ConnectNodes(t.t, alice, bob)
+
+	// Alice needs a wallet input to add fees to her anchor.
+	net.SendCoins(t.t, btcutil.SatoshiPerBitcoin, alice)
+
+	// cpfpAtFeeRate opens a channel from Alice to Bob and asserts the
+	// anchor fee bump once Alice force closes it at a fee rate that is
+	// derived from the fee rate of the commitment transaction.
+	cpfpAtFeeRate := func(feeRateFactor float64) {
+		chanPoint := openChannelAndAssert(
+			t, net, alice, bob, lntest.OpenChannelParams{
+				Amt:     1e6,
+				PushAmt: 5e5,
+			},
+		)
+
+		chanInfo, err := getChanInfo(alice)
+		require.NoError(t.t, err)
+
+		commitFeeRate := chainfee.SatPerKWeight(chanInfo.FeePerKw)
+		targetFeeRate := chainfee.SatPerKWeight(
+			float64(commitFeeRate) * feeRateFactor,
+		)
+
+		assertAnchorCPFP(t, net, alice, chanPoint, targetFeeRate)
+	}
+
+	// A fee estimate below the commitment fee rate doesn't justify a
+	// fee bump.
+	cpfpAtFeeRate(0.5)
+
+	// A fee estimate above the commitment fee rate is met by sweeping the
+	// anchor.
+	cpfpAtFeeRate(4)
+}
+
 // testAnchorReservedValue tests that we won't allow sending transactions when
 // that would take the value we reserve for anchor fee bumping out of our
 // wallet.
@@ -371,3 +420,119 @@ func testAnchorReservedValue(net *lntest.NetworkHarness, t *harnessTest) {
 		t.Fatalf("expected 1 output instead have %v", len(sweepTx.TxOut))
 	}
 }
+
+// assertAnchorCPFP sets the fee estimate to the target fee rate, force closes
+// the given anchor channel from the node's side and asserts the fee bump of
+// the commitment transaction through the node's anchor. If the target fee
+// rate exceeds the fee rate of the commitment transaction, the anchor must be
+// swept by a child that lifts the fee rate of the package to the target.
+// Otherwise sweeping the anchor is uneconomical, and the commitment
+// transaction must remain alone in the mempool. The broadcast transactions are
+// mined before returning.
+func assertAnchorCPFP(t *harnessTest, net *lntest.NetworkHarness,
+	node *lntest.HarnessNode, chanPoint *lnrpc.ChannelPoint,
+	targetFeeRate chainfee.SatPerKWeight) {
+
+	ctxb := context.Background()
+	miner := net.Miner.Client
+
+	// Look up the fee rate of the commitment transaction we are about to
+	// broadcast.
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	chans, err := node.ListChannels(ctxt, &lnrpc.ListChannelsRequest{})
+	require.NoError(t.t, err)
+
+	var commitFeeRate chainfee.SatPerKWeight
+	for _, channel := range chans.Channels {
+		if channel.ChannelPoint == txStr(chanPoint) {
+			commitFeeRate = chainfee.SatPerKWeight(channel.FeePerKw)
+		}
+	}
+	require.NotZero(t.t, commitFeeRate, "channel %v not found",
+		txStr(chanPoint))
+
+	net.SetFeeEstimate(targetFeeRate)
+
+	_, closingTxID, err := net.CloseChannel(node, chanPoint, true)
+	require.NoError(t.t, err, "unable to force close channel")
+
+	// Wait for the node to hand its anchor to the sweeper.
+	var anchor *walletrpc.PendingSweep
+	err = wait.NoError(func() error {
+		ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+		defer cancel()
+
+		resp, err := node.WalletKitClient.PendingSweeps(
+			ctxt, &walletrpc.PendingSweepsRequest{},
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, pending := range resp.PendingSweeps {
+			isAnchor := pending.WitnessType ==
+				walletrpc.WitnessType_COMMITMENT_ANCHOR
+			if isAnchor && bytes.Equal(
+				pending.Outpoint.TxidBytes, closingTxID[:],
+			) {
+
+				anchor = pending
+				return nil
+			}
+		}
+
+		return fmt.Errorf("anchor of %v not pending sweep",
+			closingTxID)
+	}, defaultTimeout)
+	require.NoError(t.t, err)
+
+	// If the commitment transaction already pays the target fee rate,
+	// sweeping the anchor is uneconomical. The commitment transaction must
+	// then stay alone in the mempool for longer than a sweep batch window.
+	if targetFeeRate <= commitFeeRate {
+		err = wait.InvariantNoError(func() error {
+			mempool, err := miner.GetRawMempool()
+			if err != nil {
+				return err
+			}
+
+			if len(mempool) != 1 || *mempool[0] != *closingTxID {
+				return fmt.Errorf("expected only commitment "+
+					"tx %v in mempool, got %v",
+					closingTxID, mempool)
+			}
+
+			return nil
+		}, 2*sweep.DefaultBatchWindowDuration)
+		require.NoError(t.t, err, "uneconomical anchor swept")
+
+		mineBlocks(t, net, 1, 1)
+		return
+	}
+
+	// Otherwise the anchor must be swept by a child of the commitment
+	// transaction.
+	txns, err := getNTxsFromMempool(miner, 2, minerMempoolTimeout)
+	require.NoError(t.t, err, "commitment and anchor sweep not found")
+
+	_, anchorSweep := findCommitAndAnchor(
+		t, net, txns, closingTxID.String(),
+	)
+	require.NotNil(t.t, anchorSweep, "anchor sweep not found")
+	require.EqualValues(
+		t.t, anchor.Outpoint.OutputIndex, anchorSweep.OutPoint.Index,
+	)
+
+	// The package of the commitment transaction and its child must meet
+	// the target fee rate. Allow some deviation because weight estimates
+	// during tx generation are estimates.
+	feeRate := calculateTxnsFeeRate(t.t, net.Miner, txns)
+	require.GreaterOrEqualf(
+		t.t, float64(feeRate), float64(targetFeeRate)*0.99,
+		"expected package fee rate of at least %v, got %v",
+		targetFeeRate, feeRate,
+	)
+
+	mineBlocks(t, net, 1, 2)
+}
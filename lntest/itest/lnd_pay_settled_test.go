@@ -0,0 +1,170 @@
+package itest
+
+import (
+	"context"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testPayAlreadySettled tests that paying an invoice that was already paid is
+// rejected, and that the rejection reports the expiry of the invoice instead
+// if it expired in the meantime.
+func testPayAlreadySettled(net *lntest.NetworkHarness, t *harnessTest) {
+	const (
+		chanAmt    = btcutil.Amount(500000)
+		paymentAmt = btcutil.Amount(10000)
+
+		// shortExpiry is the expiry of the invoice that expires
+		// between both payment attempts. It must be long enough for
+		// the first attempt to complete.
+		shortExpiry = 5 * time.Second
+	)
+
+	ctxb := context.Background()
+
+	chanPoint := openChannelAndAssert(
+		t, net, net.Alice, net.Bob, lntest.OpenChannelParams{
+			Amt: chanAmt,
+		},
+	)
+	defer closeChannelAndAssert(t, net, net.Alice, chanPoint, false)
+
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	invoice, err := net.Bob.AddInvoice(ctxt, &lnrpc.Invoice{
+		Memo:  "pay already settled",
+		Value: int64(paymentAmt),
+	})
+	require.NoError(t.t, err, "unable to add invoice")
+
+	assertPayAlreadySettled(t, net, net.Alice, invoice.PaymentRequest)
+
+	// Pay an invoice with a short expiry, and only attempt to pay it again
+	// once it expired.
+	expiringInvoice, err := net.Bob.AddInvoice(ctxt, &lnrpc.Invoice{
+		Memo:   "pay already settled and expired",
+		Value:  int64(paymentAmt),
+		Expiry: int64(shortExpiry.Seconds()),
+	})
+	require.NoError(t.t, err, "unable to add invoice")
+
+	payment := sendAndAssertSuccess(
+		t, net.Alice, &routerrpc.SendPaymentRequest{
+			PaymentRequest: expiringInvoice.PaymentRequest,
+			TimeoutSeconds: 60,
+			FeeLimitMsat:   noFeeLimitMsat,
+		},
+	)
+
+	time.Sleep(shortExpiry)
+
+	assertRepayRejected(
+		t, net.Alice, expiringInvoice.PaymentRequest, payment,
+	)
+}
+
+// assertPayAlreadySettled pays the invoice and then attempts to pay it again.
+// It asserts that the second attempt is rejected because the invoice is
+// already paid, without any HTLC being sent for it.
+func assertPayAlreadySettled(t *harnessTest, net *lntest.NetworkHarness,
+	sender *lntest.HarnessNode, payReq string) {
+
+	t.t.Helper()
+
+	payment := sendAndAssertSuccess(
+		t, sender, &routerrpc.SendPaymentRequest{
+			PaymentRequest: payReq,
+			TimeoutSeconds: 60,
+			FeeLimitMsat:   noFeeLimitMsat,
+		},
+	)
+
+	assertRepayRejected(t, sender, payReq, payment)
+}
+
+// assertRepayRejected attempts to pay the invoice, which the sender already
+// paid in the given payment, once more. It asserts that the attempt is
+// rejected before any HTLC is sent. The rejection must report that the
+// invoice is already paid, or that it expired if it did so since it was
+// paid.
+func assertRepayRejected(t *harnessTest, sender *lntest.HarnessNode,
+	payReq string, payment *lnrpc.Payment) {
+
+	t.t.Helper()
+
+	ctxb := context.Background()
+
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	decoded, err := sender.DecodePayReq(ctxt, &lnrpc.PayReqString{
+		PayReq: payReq,
+	})
+	require.NoError(t.t, err, "unable to decode invoice")
+
+	// If the invoice is about to expire, we wait for it to do so, as we
+	// can't tell which error to expect otherwise.
+	validUntil := time.Unix(decoded.Timestamp+decoded.Expiry, 0)
+	if time.Until(validUntil) < time.Second {
+		time.Sleep(time.Until(validUntil) + time.Second)
+	}
+	expired := time.Now().After(validUntil)
+
+	stream, err := sender.RouterClient.SendPaymentV2(
+		ctxt, &routerrpc.SendPaymentRequest{
+			PaymentRequest: payReq,
+			TimeoutSeconds: 60,
+			FeeLimitMsat:   noFeeLimitMsat,
+		},
+	)
+	require.NoError(t.t, err, "unable to send payment")
+
+	_, err = getPaymentResult(stream)
+	require.Error(t.t, err, "invoice was paid twice")
+
+	// The expiry of the invoice is checked before we look for an earlier
+	// payment of it.
+	if expired {
+		require.Contains(t.t, err.Error(), "invoice expired")
+	} else {
+		require.Equal(t.t, codes.AlreadyExists, status.Code(err))
+		require.Contains(
+			t.t, err.Error(), channeldb.ErrAlreadyPaid.Error(),
+		)
+	}
+
+	// The earlier payment must be the only one for the invoice, and no
+	// HTLC must have been added to it.
+	ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	resp, err := sender.ListPayments(ctxt, &lnrpc.ListPaymentsRequest{
+		IncludeIncomplete: true,
+	})
+	require.NoError(t.t, err, "unable to list payments")
+
+	var payments []*lnrpc.Payment
+	for _, p := range resp.Payments {
+		if p.PaymentHash == decoded.PaymentHash {
+			payments = append(payments, p)
+		}
+	}
+	require.Len(t.t, payments, 1, "duplicate payment for invoice")
+	require.Equal(t.t, lnrpc.Payment_SUCCEEDED, payments[0].Status)
+	require.Len(
+		t.t, payments[0].Htlcs, len(payment.Htlcs),
+		"duplicate htlc for invoice",
+	)
+
+	err = wait.NoError(func() error {
+		return assertNumActiveHtlcs([]*lntest.HarnessNode{sender}, 0)
+	}, defaultTimeout)
+	require.NoError(t.t, err, "htlc sent for paid invoice")
+}
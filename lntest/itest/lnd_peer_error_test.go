@@ -0,0 +1,389 @@
+package itest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/brontide"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/signrpc"
+	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tor"
+	"github.com/stretchr/testify/require"
+)
+
+// testPeerErrorHandled tests that errors sent by a peer for a channel fail the
+// channel without closing it.
+func testPeerErrorHandled(net *lntest.NetworkHarness, t *harnessTest) {
+	const chanAmt = btcutil.Amount(500000)
+
+	// Carol doesn't listen for connections and waits long before she
+	// reconnects, so that the connection to Alice can be taken over by a
+	// raw peer that impersonates her.
+	carol := net.NewNode(t.t, "Carol", []string{
+		"--nolisten", "--minbackoff=1h", "--maxbackoff=1h",
+	})
+	defer shutdownAndAssert(net, t, carol)
+
+	net.ConnectNodes(t.t, carol, net.Alice)
+
+	chanPoint := openChannelAndAssert(
+		t, net, net.Alice, carol, lntest.OpenChannelParams{
+			Amt: chanAmt,
+		},
+	)
+	defer closeChannelAndAssert(t, net, net.Alice, chanPoint, false)
+
+	assertPeerErrorHandled(t, net, net.Alice, chanPoint)
+}
+
+// assertPeerErrorHandled has the peer of the given channel send an error for
+// that channel, followed by a connection-wide error, which uses an all-zero
+// channel id. It asserts that the node fails the channel and reports the error
+// back, but only records the error as a warning and doesn't force close the
+// channel, which becomes active again once the peer reconnects.
+//
+// The errors are sent over a raw connection that impersonates the peer using
+// the identity key of its signer. The peer must not listen for connections,
+// and must connect to the node, so that the raw connection takes over its
+// connection. It also must not reconnect by itself while the errors are sent,
+// for example by using a long min backoff.
+func assertPeerErrorHandled(t *harnessTest, net *lntest.NetworkHarness,
+	node *lntest.HarnessNode, chanPoint *lnrpc.ChannelPoint) {
+
+	t.t.Helper()
+
+	channel := assertChannelActive(t, node, chanPoint)
+	peer, err := net.LookUpNodeByPub(channel.RemotePubkey)
+	require.NoError(t.t, err, "unable to find peer")
+
+	fundingTxID, err := lnrpc.GetChanPointFundingTxid(chanPoint)
+	require.NoError(t.t, err, "unable to get txid")
+	chanID := lnwire.NewChanIDFromOutPoint(&wire.OutPoint{
+		Hash:  *fundingTxID,
+		Index: chanPoint.OutputIndex,
+	})
+
+	conn := connectRawPeer(t, node, newNodeKeyECDH(t, peer))
+	defer conn.Close()
+
+	// The node fails the channel upon an error for it, and tells the peer
+	// that it did so with an error of its own.
+	const chanErrData = "channel test error"
+	writeRawMessage(t, conn, &lnwire.Error{
+		ChanID: chanID,
+		Data:   lnwire.ErrorData(chanErrData),
+	})
+	readRawError(t, conn, chanID)
+
+	// The error is only recorded for the peer, and the channel stays open
+	// without being force closed.
+	assertPeerError(t, node, channel.RemotePubkey, chanErrData)
+	assertChannelInactive(t, node, chanPoint)
+	assertNumPendingChannels(t, node, 0, 0)
+
+	// A connection-wide error doesn't close the channel either.
+	writeRawMessage(t, conn, &lnwire.Error{
+		ChanID: lnwire.ConnectionWideID,
+		Data:   lnwire.ErrorData("connection-wide test error"),
+	})
+	assertChannelInactive(t, node, chanPoint)
+	assertNumPendingChannels(t, node, 0, 0)
+
+	// Once the real peer reconnects, the channel is re-established.
+	require.NoError(t.t, conn.Close(), "unable to close raw connection")
+	assertPeerConnection(t, node, channel.RemotePubkey, false)
+
+	net.ConnectNodes(t.t, peer, node)
+	assertChannelActive(t, node, chanPoint)
+}
+
+// assertChannelActive asserts that the given channel of the node is active,
+// and returns it.
+func assertChannelActive(t *harnessTest, node *lntest.HarnessNode,
+	chanPoint *lnrpc.ChannelPoint) *lnrpc.Channel {
+
+	t.t.Helper()
+
+	return waitForListedChannel(
+		t, node, chanPoint, &lnrpc.ListChannelsRequest{
+			ActiveOnly: true,
+		},
+	)
+}
+
+// assertChannelInactive asserts that the given channel of the node is open,
+// but inactive.
+func assertChannelInactive(t *harnessTest, node *lntest.HarnessNode,
+	chanPoint *lnrpc.ChannelPoint) {
+
+	t.t.Helper()
+
+	waitForListedChannel(
+		t, node, chanPoint, &lnrpc.ListChannelsRequest{
+			InactiveOnly: true,
+		},
+	)
+}
+
+// waitForListedChannel waits until the given channel of the node is returned
+// by ListChannels for the given request, and returns it.
+func waitForListedChannel(t *harnessTest, node *lntest.HarnessNode,
+	chanPoint *lnrpc.ChannelPoint,
+	req *lnrpc.ListChannelsRequest) *lnrpc.Channel {
+
+	t.t.Helper()
+
+	ctxb := context.Background()
+	chanPointStr := txStr(chanPoint)
+
+	var channel *lnrpc.Channel
+	err := wait.NoError(func() error {
+		ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+		defer cancel()
+		resp, err := node.ListChannels(ctxt, req)
+		if err != nil {
+			return err
+		}
+
+		for _, c := range resp.Channels {
+			if c.ChannelPoint == chanPointStr {
+				channel = c
+				return nil
+			}
+		}
+
+		return fmt.Errorf("channel %v not found", chanPointStr)
+	}, defaultTimeout)
+	require.NoError(t.t, err, "%v channel not listed", node.Name())
+
+	return channel
+}
+
+// assertPeerConnection asserts that the node is connected to the peer with
+// the given public key, or that it isn't if connected is false.
+func assertPeerConnection(t *harnessTest, node *lntest.HarnessNode,
+	pubKeyStr string, connected bool) {
+
+	t.t.Helper()
+
+	ctxb := context.Background()
+
+	err := wait.NoError(func() error {
+		ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+		defer cancel()
+		resp, err := node.ListPeers(ctxt, &lnrpc.ListPeersRequest{})
+		if err != nil {
+			return err
+		}
+
+		var found bool
+		for _, p := range resp.Peers {
+			if p.PubKey == pubKeyStr {
+				found = true
+				break
+			}
+		}
+
+		if found != connected {
+			return fmt.Errorf("expected connected=%v to %v, got "+
+				"%v", connected, pubKeyStr, found)
+		}
+
+		return nil
+	}, defaultTimeout)
+	require.NoError(t.t, err, "%v peer connection", node.Name())
+}
+
+// assertPeerError asserts that the node recorded an error containing the
+// given data for the peer with the given public key.
+func assertPeerError(t *harnessTest, node *lntest.HarnessNode,
+	pubKeyStr, data string) {
+
+	t.t.Helper()
+
+	ctxb := context.Background()
+
+	err := wait.NoError(func() error {
+		ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+		defer cancel()
+		resp, err := node.ListPeers(ctxt, &lnrpc.ListPeersRequest{})
+		if err != nil {
+			return err
+		}
+
+		for _, p := range resp.Peers {
+			if p.PubKey != pubKeyStr {
+				continue
+			}
+
+			for _, peerErr := range p.Errors {
+				if strings.Contains(peerErr.Error, data) {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("error %q not recorded for %v", data,
+			pubKeyStr)
+	}, defaultTimeout)
+	require.NoError(t.t, err, "%v peer error", node.Name())
+}
+
+// nodeKeyECDH performs ECDH operations with the identity key of a node through
+// its signer, which allows a raw connection to impersonate the node.
+type nodeKeyECDH struct {
+	node   *lntest.HarnessNode
+	pubKey *btcec.PublicKey
+}
+
+// A compile time check to ensure nodeKeyECDH implements the SingleKeyECDH
+// interface.
+var _ keychain.SingleKeyECDH = (*nodeKeyECDH)(nil)
+
+// newNodeKeyECDH returns a nodeKeyECDH for the identity key of the node.
+func newNodeKeyECDH(t *harnessTest, node *lntest.HarnessNode) *nodeKeyECDH {
+	t.t.Helper()
+
+	pubKey, err := btcec.ParsePubKey(node.PubKey[:], btcec.S256())
+	require.NoError(t.t, err, "unable to parse node key")
+
+	return &nodeKeyECDH{
+		node:   node,
+		pubKey: pubKey,
+	}
+}
+
+// PubKey returns the identity public key of the node.
+//
+// NOTE: This is part of the keychain.SingleKeyECDH interface.
+func (k *nodeKeyECDH) PubKey() *btcec.PublicKey {
+	return k.pubKey
+}
+
+// ECDH derives the shared key between the identity key of the node and the
+// given public key.
+//
+// NOTE: This is part of the keychain.SingleKeyECDH interface.
+func (k *nodeKeyECDH) ECDH(pubKey *btcec.PublicKey) ([32]byte, error) {
+	var sharedKey [32]byte
+
+	ctxt, cancel := context.WithTimeout(
+		context.Background(), defaultTimeout,
+	)
+	defer cancel()
+
+	// Without a key locator, the signer uses the identity key.
+	resp, err := k.node.SignerClient.DeriveSharedKey(
+		ctxt, &signrpc.SharedKeyRequest{
+			EphemeralPubkey: pubKey.SerializeCompressed(),
+		},
+	)
+	if err != nil {
+		return sharedKey, err
+	}
+
+	copy(sharedKey[:], resp.SharedKey)
+
+	return sharedKey, nil
+}
+
+// connectRawPeer connects to the node as a peer with the given identity key
+// that only speaks the wire protocol, which allows sending messages lnd itself
+// wouldn't send. It completes the exchange of init messages, and returns the
+// connection.
+func connectRawPeer(t *harnessTest, node *lntest.HarnessNode,
+	localKey keychain.SingleKeyECDH) *brontide.Conn {
+
+	t.t.Helper()
+
+	nodePub, err := btcec.ParsePubKey(node.PubKey[:], btcec.S256())
+	require.NoError(t.t, err, "unable to parse node key")
+
+	clearNet := &tor.ClearNet{}
+	addr, err := clearNet.ResolveTCPAddr("tcp", node.Cfg.P2PAddr())
+	require.NoError(t.t, err, "unable to resolve node address")
+
+	conn, err := brontide.Dial(
+		localKey, &lnwire.NetAddress{
+			IdentityKey: nodePub,
+			Address:     addr,
+		}, defaultTimeout, clearNet.Dial,
+	)
+	require.NoError(t.t, err, "unable to connect to node")
+
+	// The node sends its init message first. We reply with the same
+	// features, so that the node considers us compatible.
+	nodeInit, ok := readRawMessage(t, conn).(*lnwire.Init)
+	require.True(t.t, ok, "expected init message")
+
+	writeRawMessage(t, conn, lnwire.NewInitMessage(
+		nodeInit.GlobalFeatures, nodeInit.Features,
+	))
+
+	return conn
+}
+
+// readRawMessage reads the next message the node sends over the raw
+// connection. Messages of unknown types are returned as nil.
+func readRawMessage(t *harnessTest, conn *brontide.Conn) lnwire.Message {
+	t.t.Helper()
+
+	err := conn.SetReadDeadline(time.Now().Add(defaultTimeout))
+	require.NoError(t.t, err, "unable to set read deadline")
+
+	rawMsg, err := conn.ReadNextMessage()
+	require.NoError(t.t, err, "unable to read message")
+
+	msg, err := lnwire.ReadMessage(bytes.NewReader(rawMsg), 0)
+	if _, ok := err.(*lnwire.UnknownMessage); ok {
+		return nil
+	}
+	require.NoError(t.t, err, "unable to parse message")
+
+	return msg
+}
+
+// readRawError reads messages the node sends over the raw connection until it
+// sends an error for the given channel.
+func readRawError(t *harnessTest, conn *brontide.Conn, chanID lnwire.ChannelID) {
+	t.t.Helper()
+
+	timeout := time.After(defaultTimeout)
+	for {
+		select {
+		case <-timeout:
+			t.Fatalf("no error received for channel %v", chanID)
+
+		default:
+		}
+
+		msg, ok := readRawMessage(t, conn).(*lnwire.Error)
+		if ok && msg.ChanID == chanID {
+			return
+		}
+	}
+}
+
+// writeRawMessage sends the message to the node over the raw connection.
+func writeRawMessage(t *harnessTest, conn *brontide.Conn, msg lnwire.Message) {
+	t.t.Helper()
+
+	var b bytes.Buffer
+	_, err := lnwire.WriteMessage(&b, msg, 0)
+	require.NoError(t.t, err, "unable to encode %T", msg)
+
+	require.NoError(t.t, conn.WriteMessage(b.Bytes()))
+
+	_, err = conn.Flush()
+	require.NoError(t.t, err, "unable to send %T", msg)
+}
@@ -0,0 +1,150 @@
+package itest
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/stretchr/testify/require"
+)
+
+// testProbePayment tests that a probe payment to a random hash reaches the
+// destination if the route has enough liquidity, and that a probe which
+// exceeds the liquidity of an intermediate hop reports that hop.
+func testProbePayment(net *lntest.NetworkHarness, t *harnessTest) {
+	const (
+		chanAmt = btcutil.Amount(100000)
+		pushAmt = btcutil.Amount(50000)
+	)
+
+	ctxb := context.Background()
+
+	carol := net.NewNode(t.t, "Carol", nil)
+	defer shutdownAndAssert(net, t, carol)
+
+	net.ConnectNodes(t.t, net.Bob, carol)
+
+	// We'll create the route Alice -> Bob -> Carol. Bob pushes half of
+	// the channel to Carol, so he can't forward more than that.
+	chanPointAlice := openChannelAndAssert(
+		t, net, net.Alice, net.Bob,
+		lntest.OpenChannelParams{
+			Amt: chanAmt * 10,
+		},
+	)
+	defer closeChannelAndAssert(t, net, net.Alice, chanPointAlice, false)
+
+	chanPointBob := openChannelAndAssert(
+		t, net, net.Bob, carol,
+		lntest.OpenChannelParams{
+			Amt:     chanAmt,
+			PushAmt: pushAmt,
+		},
+	)
+	defer closeChannelAndAssert(t, net, net.Bob, chanPointBob, false)
+
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	err := net.Alice.WaitForNetworkChannelOpen(ctxt, chanPointBob)
+	require.NoError(t.t, err, "alice didn't see the bob->carol channel")
+
+	// A small probe must make it all the way to Carol.
+	probeRoute := sendProbe(t, net, net.Alice, carol, 1000)
+	require.Len(t.t, probeRoute.Hops, 2)
+
+	// A probe that exceeds Bob's balance in the channel with Carol, but
+	// not its capacity, must fail at Bob.
+	_, err = probe(net.Alice, carol, chanAmt-pushAmt/2)
+	require.Error(t.t, err)
+	require.Contains(t.t, err.Error(), net.Bob.PubKeyStr)
+}
+
+// sendProbe sends a payment to a random hash from the sender to the
+// destination and asserts that it fails at the final hop with
+// incorrect_payment_details, which proves that the route reached the
+// destination. If the probe fails earlier, the failing hop is reported. The
+// route that reached the destination is returned.
+func sendProbe(t *harnessTest, net *lntest.NetworkHarness,
+	sender, dest *lntest.HarnessNode, amt btcutil.Amount) *lnrpc.Route {
+
+	t.t.Helper()
+
+	route, err := probe(sender, dest, amt)
+	require.NoError(t.t, err, "probe didn't reach the destination")
+
+	return route
+}
+
+// probe sends a payment to a random hash from the sender to the destination.
+// If the last attempt of the payment reached the destination, its route is
+// returned. Otherwise an error that names the failing hop is returned.
+func probe(sender, dest *lntest.HarnessNode,
+	amt btcutil.Amount) (*lnrpc.Route, error) {
+
+	payHash := make([]byte, 32)
+	if _, err := rand.Read(payHash); err != nil {
+		return nil, err
+	}
+
+	ctxt, cancel := context.WithTimeout(
+		context.Background(), defaultTimeout,
+	)
+	defer cancel()
+
+	stream, err := sender.RouterClient.SendPaymentV2(
+		ctxt, &routerrpc.SendPaymentRequest{
+			Dest:           dest.PubKey[:],
+			Amt:            int64(amt),
+			PaymentHash:    payHash,
+			TimeoutSeconds: 60,
+			FeeLimitMsat:   noFeeLimitMsat,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	payment, err := getPaymentResult(stream)
+	if err != nil {
+		return nil, err
+	}
+
+	if payment.Status != lnrpc.Payment_FAILED {
+		return nil, fmt.Errorf("probe unexpectedly ended in state %v",
+			payment.Status)
+	}
+
+	if len(payment.Htlcs) == 0 {
+		return nil, fmt.Errorf("probe failed without attempting a "+
+			"route: %v", payment.FailureReason)
+	}
+
+	attempt := payment.Htlcs[len(payment.Htlcs)-1]
+	if attempt.Failure == nil {
+		return nil, fmt.Errorf("probe attempt %v has no failure",
+			attempt.AttemptId)
+	}
+
+	code := attempt.Failure.Code
+	hops := attempt.Route.Hops
+	sourceIdx := attempt.Failure.FailureSourceIndex
+
+	if code == lnrpc.Failure_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS &&
+		sourceIdx == uint32(len(hops)) {
+
+		return attempt.Route, nil
+	}
+
+	// The failure source index counts the sender as hop zero.
+	failingNode := sender.PubKeyStr
+	if sourceIdx > 0 && int(sourceIdx) <= len(hops) {
+		failingNode = hops[sourceIdx-1].PubKey
+	}
+
+	return nil, fmt.Errorf("probe failed at hop %d (%v) with %v",
+		sourceIdx, failingNode, code)
+}
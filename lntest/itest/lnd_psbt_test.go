@@ -243,6 +243,138 @@ func testPsbtChanFunding(net *lntest.NetworkHarness, t *harnessTest) {
 	// will additionally assert the relevant channel closing post
 	// conditions.
 	closeChannelAndAssert(t, net, carol, chanPoint, false)
+
+	// Finally, make sure a conflicting concurrent open between the two
+	// nodes is rejected while additional channels are still allowed.
+	assertDuplicateOpenRejected(t, net, dave, carol)
+}
+
+// assertDuplicateOpenRejected asserts that the funder rejects a second channel
+// open to the peer that conflicts with the pending reservation of a first one,
+// without affecting the first open. A PSBT shim is used for the first open to
+// keep its reservation pending while the conflicting open is attempted. To
+// make sure that only the conflict is rejected, another regular channel to the
+// same peer is opened afterwards. The funder needs enough on-chain funds to
+// fund both channels.
+func assertDuplicateOpenRejected(t *harnessTest, net *lntest.NetworkHarness,
+	funder, peer *lntest.HarnessNode) {
+
+	ctxb := context.Background()
+	const chanSize = btcutil.Amount(500_000)
+
+	var pendingChanID [32]byte
+	_, err := rand.Read(pendingChanID[:])
+	require.NoError(t.t, err)
+
+	openParams := lntest.OpenChannelParams{
+		Amt: chanSize,
+		FundingShim: &lnrpc.FundingShim{
+			Shim: &lnrpc.FundingShim_PsbtShim{
+				PsbtShim: &lnrpc.PsbtShim{
+					PendingChanId: pendingChanID[:],
+				},
+			},
+		},
+	}
+
+	// Start the first open. Its reservation stays pending until the PSBT
+	// is finalized.
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	chanUpdates, psbtBytes, err := openChannelPsbt(
+		ctxt, funder, peer, openParams,
+	)
+	require.NoError(t.t, err)
+
+	// A second open with the same pending channel ID conflicts with the
+	// pending reservation and must be rejected with a descriptive error.
+	ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	_, _, err = openChannelPsbt(ctxt, funder, peer, openParams)
+	require.Error(t.t, err, "conflicting channel open not rejected")
+	require.Contains(t.t, err.Error(), "already present")
+
+	// The first open must not be affected by the rejected one, so we now
+	// fund, sign and publish its funding transaction.
+	ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	fundResp, err := funder.WalletKitClient.FundPsbt(
+		ctxt, &walletrpc.FundPsbtRequest{
+			Template: &walletrpc.FundPsbtRequest_Psbt{
+				Psbt: psbtBytes,
+			},
+			Fees: &walletrpc.FundPsbtRequest_SatPerVbyte{
+				SatPerVbyte: 2,
+			},
+		},
+	)
+	require.NoError(t.t, err)
+
+	_, err = funder.FundingStateStep(ctxb, &lnrpc.FundingTransitionMsg{
+		Trigger: &lnrpc.FundingTransitionMsg_PsbtVerify{
+			PsbtVerify: &lnrpc.FundingPsbtVerify{
+				PendingChanId: pendingChanID[:],
+				FundedPsbt:    fundResp.FundedPsbt,
+			},
+		},
+	})
+	require.NoError(t.t, err)
+
+	ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	finalizeRes, err := funder.WalletKitClient.FinalizePsbt(
+		ctxt, &walletrpc.FinalizePsbtRequest{
+			FundedPsbt: fundResp.FundedPsbt,
+		},
+	)
+	require.NoError(t.t, err)
+
+	_, err = funder.FundingStateStep(ctxb, &lnrpc.FundingTransitionMsg{
+		Trigger: &lnrpc.FundingTransitionMsg_PsbtFinalize{
+			PsbtFinalize: &lnrpc.FundingPsbtFinalize{
+				PendingChanId: pendingChanID[:],
+				FinalRawTx:    finalizeRes.RawFinalTx,
+			},
+		},
+	})
+	require.NoError(t.t, err)
+
+	ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	updateResp, err := receiveChanUpdate(ctxt, chanUpdates)
+	require.NoError(t.t, err)
+	upd, ok := updateResp.Update.(*lnrpc.OpenStatusUpdate_ChanPending)
+	require.True(t.t, ok)
+	chanPoint := &lnrpc.ChannelPoint{
+		FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+			FundingTxidBytes: upd.ChanPending.Txid,
+		},
+		OutputIndex: upd.ChanPending.OutputIndex,
+	}
+
+	var finalTx wire.MsgTx
+	err = finalTx.Deserialize(bytes.NewReader(finalizeRes.RawFinalTx))
+	require.NoError(t.t, err)
+
+	txHash := finalTx.TxHash()
+	block := mineBlocks(t, net, 6, 1)[0]
+	assertTxInBlock(t, block, &txHash)
+	ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	err = funder.WaitForNetworkChannelOpen(ctxt, chanPoint)
+	require.NoError(t.t, err)
+
+	// Opening another channel to the same peer that doesn't conflict with
+	// a pending reservation must still be allowed.
+	chanPoint2 := openChannelAndAssert(
+		t, net, funder, peer, lntest.OpenChannelParams{
+			Amt: chanSize,
+		},
+	)
+	assertNodeNumChannels(t, funder, 2)
+
+	closeChannelAndAssert(t, net, funder, chanPoint, false)
+	closeChannelAndAssert(t, net, funder, chanPoint2, false)
 }
 
 // openChannelPsbt attempts to open a channel between srcNode and destNode with
@@ -0,0 +1,287 @@
+package itest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/stretchr/testify/require"
+)
+
+// testReroutesOnDisable tests that a payment whose route is disabled while
+// its HTLC is in flight is retried over an alternative route.
+func testReroutesOnDisable(net *lntest.NetworkHarness, t *harnessTest) {
+	dave := net.NewNode(t.t, "Dave", nil)
+	defer shutdownAndAssert(net, t, dave)
+
+	assertReroutesOnDisable(t, net, net.Alice, dave)
+}
+
+// assertReroutesOnDisable sets up two routes from the sender to the receiver,
+// over Frank and over Grace, where the one over Frank is cheaper. It starts a
+// payment, which takes the route over Frank, and holds its HTLC at Frank. While
+// the HTLC is held, Frank disables his channel to the receiver. The channel is
+// disabled through the UpdateChanStatus RPC, as UpdateChannelPolicy can't
+// disable channels. The held HTLC must still be resolved, which fails it back
+// to the sender. The payment must then either complete over the route via
+// Grace or fail, but no HTLC may remain stuck. The routes are torn down again
+// before returning.
+func assertReroutesOnDisable(t *harnessTest, net *lntest.NetworkHarness,
+	sender, receiver *lntest.HarnessNode) {
+
+	t.t.Helper()
+
+	ctxb := context.Background()
+
+	const (
+		chanAmt    = btcutil.Amount(500000)
+		paymentAmt = btcutil.Amount(100000)
+
+		// expensiveBaseFee is the base fee of the route via Grace,
+		// which makes the route via Frank the preferred one.
+		expensiveBaseFee = 10000
+	)
+
+	net.SendCoins(t.t, btcutil.SatoshiPerBitcoin, sender)
+
+	frank := net.NewNode(t.t, "Frank", nil)
+	defer shutdownAndAssert(net, t, frank)
+	grace := net.NewNode(t.t, "Grace", nil)
+	defer shutdownAndAssert(net, t, grace)
+
+	// openRoute opens the channels from the sender to the hop and from the
+	// hop to the receiver, and returns both.
+	openRoute := func(hop *lntest.HarnessNode) (*lnrpc.ChannelPoint,
+		*lnrpc.ChannelPoint) {
+
+		net.SendCoins(t.t, btcutil.SatoshiPerBitcoin, hop)
+		net.EnsureConnected(t.t, sender, hop)
+		net.EnsureConnected(t.t, hop, receiver)
+
+		chanPointIn := openChannelAndAssert(
+			t, net, sender, hop, lntest.OpenChannelParams{
+				Amt: chanAmt,
+			},
+		)
+
+		chanPointOut := openChannelAndAssert(
+			t, net, hop, receiver, lntest.OpenChannelParams{
+				Amt: chanAmt,
+			},
+		)
+
+		ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+		defer cancel()
+		err := sender.WaitForNetworkChannelOpen(ctxt, chanPointOut)
+		require.NoError(t.t, err, "sender didn't see channel of %s",
+			hop.Name())
+
+		return chanPointIn, chanPointOut
+	}
+
+	senderFrankChanPoint, frankChanPoint := openRoute(frank)
+	defer closeChannelAndAssert(t, net, sender, senderFrankChanPoint, false)
+	defer closeChannelAndAssert(t, net, frank, frankChanPoint, false)
+
+	senderGraceChanPoint, graceChanPoint := openRoute(grace)
+	defer closeChannelAndAssert(t, net, sender, senderGraceChanPoint, false)
+	defer closeChannelAndAssert(t, net, grace, graceChanPoint, false)
+
+	// Make the route via Grace more expensive and wait for the sender to
+	// learn about it, so that the first attempt takes the route via
+	// Frank.
+	policy, err := advertisedPolicy(grace, grace, graceChanPoint)
+	require.NoError(t.t, err, "unable to get grace's policy")
+
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	_, err = grace.UpdateChannelPolicy(ctxt, &lnrpc.PolicyUpdateRequest{
+		BaseFeeMsat:   expensiveBaseFee,
+		FeeRate:       0.000001,
+		TimeLockDelta: policy.TimeLockDelta,
+		Scope: &lnrpc.PolicyUpdateRequest_ChanPoint{
+			ChanPoint: graceChanPoint,
+		},
+	})
+	require.NoError(t.t, err, "unable to update grace's policy")
+
+	err = wait.NoError(func() error {
+		policy, err := advertisedPolicy(sender, grace, graceChanPoint)
+		if err != nil {
+			return err
+		}
+
+		if policy.FeeBaseMsat != expensiveBaseFee {
+			return fmt.Errorf("sender doesn't know grace's fee yet")
+		}
+
+		return nil
+	}, defaultTimeout)
+	require.NoError(t.t, err)
+
+	// Frank holds the first HTLC until we release it, all later HTLCs are
+	// resumed right away.
+	var (
+		held    = make(chan struct{})
+		release = make(chan routerrpc.ResolveHoldForwardAction)
+		done    = make(chan struct{})
+		numHeld int
+	)
+	decide := func(*routerrpc.ForwardHtlcInterceptRequest) (
+		routerrpc.ResolveHoldForwardAction, []byte) {
+
+		numHeld++
+		if numHeld > 1 {
+			return routerrpc.ResolveHoldForwardAction_RESUME, nil
+		}
+
+		close(held)
+		select {
+		case action := <-release:
+			return action, nil
+
+		case <-done:
+			return routerrpc.ResolveHoldForwardAction_RESUME, nil
+		}
+	}
+	stopInterceptor := runHtlcInterceptor(t, frank, decide)
+	defer stopInterceptor()
+	defer close(done)
+
+	ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	invoice, err := receiver.AddInvoice(ctxt, &lnrpc.Invoice{
+		Memo:  "reroute on disable",
+		Value: int64(paymentAmt),
+	})
+	require.NoError(t.t, err)
+
+	ctxp, cancelPayment := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancelPayment()
+	stream, err := sender.RouterClient.SendPaymentV2(
+		ctxp, &routerrpc.SendPaymentRequest{
+			PaymentRequest: invoice.PaymentRequest,
+			TimeoutSeconds: 60,
+			FeeLimitMsat:   noFeeLimitMsat,
+		},
+	)
+	require.NoError(t.t, err, "unable to send payment")
+
+	select {
+	case <-held:
+	case <-time.After(defaultTimeout):
+		t.Fatalf("payment didn't take the route via frank")
+	}
+
+	// The HTLC is now in flight on the channel from the sender to Frank.
+	err = wait.NoError(func() error {
+		return assertNumActiveHtlcs(
+			[]*lntest.HarnessNode{sender, frank}, 1,
+		)
+	}, defaultTimeout)
+	require.NoError(t.t, err, "htlc not in flight")
+
+	// Frank disables his channel to the receiver, and we wait for the
+	// sender to learn about it.
+	ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	_, err = frank.RouterClient.UpdateChanStatus(
+		ctxt, &routerrpc.UpdateChanStatusRequest{
+			ChanPoint: frankChanPoint,
+			Action:    routerrpc.ChanStatusAction_DISABLE,
+		},
+	)
+	require.NoError(t.t, err, "unable to disable frank's channel")
+
+	err = wait.NoError(func() error {
+		policy, err := advertisedPolicy(sender, frank, frankChanPoint)
+		if err != nil {
+			return err
+		}
+
+		if !policy.Disabled {
+			return fmt.Errorf("sender doesn't know that frank's " +
+				"channel is disabled")
+		}
+
+		return nil
+	}, defaultTimeout)
+	require.NoError(t.t, err)
+
+	// The HTLC that is in flight on the disabled route must still be
+	// resolved. Frank fails it back, which makes the sender retry.
+	select {
+	case release <- routerrpc.ResolveHoldForwardAction_FAIL:
+	case <-time.After(defaultTimeout):
+		t.Fatalf("unable to release held htlc")
+	}
+
+	payment, err := getPaymentResult(stream)
+	require.NoError(t.t, err, "unable to get payment result")
+
+	switch payment.Status {
+	// If the payment succeeded, it must have been delivered via Grace,
+	// after the attempt via Frank failed.
+	case lnrpc.Payment_SUCCEEDED:
+		var failedViaFrank, settledViaGrace bool
+		for _, htlc := range payment.Htlcs {
+			firstHop := htlc.Route.Hops[0].PubKey
+			switch {
+			case htlc.Status == lnrpc.HTLCAttempt_FAILED &&
+				firstHop == frank.PubKeyStr:
+
+				failedViaFrank = true
+
+			case htlc.Status == lnrpc.HTLCAttempt_SUCCEEDED:
+				require.Equal(
+					t.t, grace.PubKeyStr, firstHop,
+					"payment not rerouted via grace",
+				)
+				settledViaGrace = true
+			}
+		}
+		require.True(t.t, failedViaFrank, "no failed attempt via frank")
+		require.True(t.t, settledViaGrace, "no attempt settled")
+
+		ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+		defer cancel()
+		dbInvoice, err := receiver.LookupInvoice(
+			ctxt, &lnrpc.PaymentHash{RHash: invoice.RHash},
+		)
+		require.NoError(t.t, err, "unable to lookup invoice")
+		require.Equal(t.t, lnrpc.Invoice_SETTLED, dbInvoice.State)
+
+	// Otherwise, the payment must have failed for a reason.
+	case lnrpc.Payment_FAILED:
+		require.NotEqual(
+			t.t, lnrpc.PaymentFailureReason_FAILURE_REASON_NONE,
+			payment.FailureReason, "payment failed without reason",
+		)
+
+	default:
+		t.Fatalf("payment not final: %v", payment.Status)
+	}
+
+	// No HTLC may remain on any of the channels.
+	nodes := []*lntest.HarnessNode{sender, frank, grace, receiver}
+	err = wait.NoError(func() error {
+		return assertNumActiveHtlcs(nodes, 0)
+	}, defaultTimeout)
+	require.NoError(t.t, err, "htlc stuck after disable")
+
+	// Re-enable Frank's channel, so that it can be closed like any other.
+	ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	_, err = frank.RouterClient.UpdateChanStatus(
+		ctxt, &routerrpc.UpdateChanStatusRequest{
+			ChanPoint: frankChanPoint,
+			Action:    routerrpc.ChanStatusAction_AUTO,
+		},
+	)
+	require.NoError(t.t, err, "unable to re-enable frank's channel")
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc/wtclientrpc"
 	"github.com/lightningnetwork/lnd/lntest"
 	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/stretchr/testify/require"
 )
 
 // testRevokedCloseRetribution tests that Carol is able carry out
@@ -1045,11 +1046,6 @@ func testRevokedCloseRetributionAltruistWatchtowerCase(
 			carolChan.LocalBalance, chanAmt/4)
 	}
 
-	// Grab Carol's current commitment height (update number), we'll later
-	// revert her to this state after additional updates to force him to
-	// broadcast this soon to be revoked state.
-	carolStateNumPreCopy := carolChan.NumUpdates
-
 	// With the temporary file created, copy Carol's current state into the
 	// temporary file we created above. Later after more updates, we'll
 	// restore this state.
@@ -1075,140 +1071,10 @@ func testRevokedCloseRetributionAltruistWatchtowerCase(
 
 	davePreSweepBalance := daveBalResp.ConfirmedBalance
 
-	// Wait until the backup has been accepted by the watchtower before
-	// shutting down Dave.
-	err = wait.NoError(func() error {
-		ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
-		defer cancel()
-		bkpStats, err := dave.WatchtowerClient.Stats(ctxt,
-			&wtclientrpc.StatsRequest{},
-		)
-		if err != nil {
-			return err
-
-		}
-		if bkpStats == nil {
-			return errors.New("no active backup sessions")
-		}
-		if bkpStats.NumBackups == 0 {
-			return errors.New("no backups accepted")
-		}
-
-		return nil
-	}, defaultTimeout)
-	if err != nil {
-		t.Fatalf("unable to verify backup task completed: %v", err)
-	}
-
 	// Shutdown Dave to simulate going offline for an extended period of
-	// time. Once he's not watching, Carol will try to breach the channel.
-	restart, err := net.SuspendNode(dave)
-	if err != nil {
-		t.Fatalf("unable to suspend Dave: %v", err)
-	}
-
-	// Now we shutdown Carol, copying over the his temporary database state
-	// which has the *prior* channel state over his current most up to date
-	// state. With this, we essentially force Carol to travel back in time
-	// within the channel's history.
-	if err = net.RestartNode(carol, func() error {
-		return net.RestoreDb(carol)
-	}); err != nil {
-		t.Fatalf("unable to restart node: %v", err)
-	}
-
-	// Now query for Carol's channel state, it should show that he's at a
-	// state number in the past, not the *latest* state.
-	carolChan, err = getChanInfo(carol)
-	if err != nil {
-		t.Fatalf("unable to get carol chan info: %v", err)
-	}
-	if carolChan.NumUpdates != carolStateNumPreCopy {
-		t.Fatalf("db copy failed: %v", carolChan.NumUpdates)
-	}
-
-	// Now force Carol to execute a *force* channel closure by unilaterally
-	// broadcasting his current channel state. This is actually the
-	// commitment transaction of a prior *revoked* state, so he'll soon
-	// feel the wrath of Dave's retribution.
-	closeUpdates, closeTxID, err := net.CloseChannel(carol, chanPoint, true)
-	if err != nil {
-		t.Fatalf("unable to close channel: %v", err)
-	}
-
-	// Query the mempool for the breaching closing transaction, this should
-	// be broadcast by Carol when she force closes the channel above.
-	txid, err := waitForTxInMempool(net.Miner.Client, minerMempoolTimeout)
-	if err != nil {
-		t.Fatalf("unable to find Carol's force close tx in mempool: %v",
-			err)
-	}
-	if *txid != *closeTxID {
-		t.Fatalf("expected closeTx(%v) in mempool, instead found %v",
-			closeTxID, txid)
-	}
-
-	// Finally, generate a single block, wait for the final close status
-	// update, then ensure that the closing transaction was included in the
-	// block.
-	block := mineBlocks(t, net, 1, 1)[0]
-
-	breachTXID, err := net.WaitForChannelClose(closeUpdates)
-	if err != nil {
-		t.Fatalf("error while waiting for channel close: %v", err)
-	}
-	assertTxInBlock(t, block, breachTXID)
-
-	// Query the mempool for Dave's justice transaction, this should be
-	// broadcast as Carol's contract breaching transaction gets confirmed
-	// above.
-	justiceTXID, err := waitForTxInMempool(net.Miner.Client, minerMempoolTimeout)
-	if err != nil {
-		t.Fatalf("unable to find Dave's justice tx in mempool: %v",
-			err)
-	}
-	time.Sleep(100 * time.Millisecond)
-
-	// Query for the mempool transaction found above. Then assert that all
-	// the inputs of this transaction are spending outputs generated by
-	// Carol's breach transaction above.
-	justiceTx, err := net.Miner.Client.GetRawTransaction(justiceTXID)
-	if err != nil {
-		t.Fatalf("unable to query for justice tx: %v", err)
-	}
-	for _, txIn := range justiceTx.MsgTx().TxIn {
-		if !bytes.Equal(txIn.PreviousOutPoint.Hash[:], breachTXID[:]) {
-			t.Fatalf("justice tx not spending commitment utxo "+
-				"instead is: %v", txIn.PreviousOutPoint)
-		}
-	}
-
-	ctxt, _ = context.WithTimeout(ctxb, defaultTimeout)
-	willyBalReq := &lnrpc.WalletBalanceRequest{}
-	willyBalResp, err := willy.WalletBalance(ctxt, willyBalReq)
-	if err != nil {
-		t.Fatalf("unable to get willy's balance: %v", err)
-	}
-
-	if willyBalResp.ConfirmedBalance != 0 {
-		t.Fatalf("willy should have 0 balance before mining "+
-			"justice transaction, instead has %d",
-			willyBalResp.ConfirmedBalance)
-	}
-
-	// Now mine a block, this transaction should include Dave's justice
-	// transaction which was just accepted into the mempool.
-	block = mineBlocks(t, net, 1, 1)[0]
-
-	// The block should have exactly *two* transactions, one of which is
-	// the justice transaction.
-	if len(block.Transactions) != 2 {
-		t.Fatalf("transaction wasn't mined")
-	}
-	justiceSha := block.Transactions[1].TxHash()
-	if !bytes.Equal(justiceTx.Hash()[:], justiceSha[:]) {
-		t.Fatalf("justice tx wasn't mined")
-	}
+	// time. Once he's not watching, Carol will try to breach the channel,
+	// after which Willy should punish her on Dave's behalf.
+	restart := assertTowerRemediedBreach(t, net, dave, willy, chanPoint)
 
 	// Ensure that Willy doesn't get any funds, as he is acting as an
 	// altruist watchtower.
@@ -1290,3 +1156,158 @@ func testRevokedCloseRetributionAltruistWatchtowerCase(
 	// Dave should have no open channels.
 	assertNodeNumChannels(t, dave, 0)
 }
+
+// assertTowerRemediedBreach registers the tower with the victim and asserts
+// that the tower punishes the victim's counterparty for broadcasting a revoked
+// state of the channel while the victim is offline. The caller must have
+// backed up the database of the counterparty before advancing the state of
+// the channel, such that restoring it forces the counterparty to broadcast a
+// revoked commitment. The victim is left offline once the tower's justice
+// transaction has confirmed, and the returned closure brings it back online.
+func assertTowerRemediedBreach(t *harnessTest, net *lntest.NetworkHarness,
+	victim, tower *lntest.HarnessNode,
+	chanPoint *lnrpc.ChannelPoint) func() error {
+
+	ctxb := context.Background()
+
+	// Register the tower with the victim. This is a no-op if the victim
+	// already knows about the tower.
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	towerInfo, err := tower.Watchtower.GetInfo(
+		ctxt, &watchtowerrpc.GetInfoRequest{},
+	)
+	cancel()
+	require.NoError(t.t, err, "unable to get tower info")
+	require.NotEmpty(t.t, towerInfo.Listeners, "tower has no listeners")
+
+	ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+	_, err = victim.WatchtowerClient.AddTower(
+		ctxt, &wtclientrpc.AddTowerRequest{
+			Pubkey:  towerInfo.Pubkey,
+			Address: towerInfo.Listeners[0],
+		},
+	)
+	cancel()
+	require.NoError(t.t, err, "unable to add tower")
+
+	// Look up the counterparty of the victim, who will breach the channel.
+	ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+	chans, err := victim.ListChannels(ctxt, &lnrpc.ListChannelsRequest{})
+	cancel()
+	require.NoError(t.t, err, "unable to list channels")
+
+	var breacher *lntest.HarnessNode
+	for _, c := range chans.Channels {
+		if c.ChannelPoint != txStr(chanPoint) {
+			continue
+		}
+
+		breacher, err = net.LookUpNodeByPub(c.RemotePubkey)
+		require.NoError(t.t, err, "unable to find counterparty")
+	}
+	require.NotNil(t.t, breacher, "channel %v not found", txStr(chanPoint))
+
+	breacherChan, err := getChanInfo(breacher)
+	require.NoError(t.t, err, "unable to get counterparty chan info")
+
+	// Wait until all backups have been accepted by the tower before taking
+	// the victim offline.
+	err = wait.NoError(func() error {
+		ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+		defer cancel()
+
+		stats, err := victim.WatchtowerClient.Stats(
+			ctxt, &wtclientrpc.StatsRequest{},
+		)
+		if err != nil {
+			return err
+		}
+		if stats.NumBackups == 0 {
+			return errors.New("no backups accepted")
+		}
+		if stats.NumPendingBackups != 0 {
+			return fmt.Errorf("%d backups still pending",
+				stats.NumPendingBackups)
+		}
+
+		return nil
+	}, defaultTimeout)
+	require.NoError(t.t, err, "unable to verify backups completed")
+
+	// Shutdown the victim to simulate going offline, so that only the
+	// tower is watching the channel when the breach happens.
+	restart, err := net.SuspendNode(victim)
+	require.NoError(t.t, err, "unable to suspend victim")
+
+	// Restore the prior state of the counterparty, which should now be at
+	// a state number in the past.
+	err = net.RestartNode(breacher, func() error {
+		return net.RestoreDb(breacher)
+	})
+	require.NoError(t.t, err, "unable to restart counterparty")
+
+	revokedChan, err := getChanInfo(breacher)
+	require.NoError(t.t, err, "unable to get counterparty chan info")
+	require.Less(
+		t.t, revokedChan.NumUpdates, breacherChan.NumUpdates,
+		"counterparty not at a revoked state",
+	)
+
+	// Force close the channel from the revoked state and wait for the
+	// breach transaction to confirm.
+	closeUpdates, closeTxID, err := net.CloseChannel(
+		breacher, chanPoint, true,
+	)
+	require.NoError(t.t, err, "unable to close channel")
+
+	txid, err := waitForTxInMempool(net.Miner.Client, minerMempoolTimeout)
+	require.NoError(t.t, err, "breach tx not found in mempool")
+	require.Equal(t.t, closeTxID, txid, "unexpected tx in mempool")
+
+	block := mineBlocks(t, net, 1, 1)[0]
+	breachTXID, err := net.WaitForChannelClose(closeUpdates)
+	require.NoError(t.t, err, "error while waiting for channel close")
+	assertTxInBlock(t, block, breachTXID)
+
+	// The tower should now broadcast the justice transaction, which must
+	// only spend outputs of the breach transaction.
+	justiceTXID, err := waitForTxInMempool(
+		net.Miner.Client, minerMempoolTimeout,
+	)
+	require.NoError(t.t, err, "justice tx not found in mempool")
+	time.Sleep(100 * time.Millisecond)
+
+	justiceTx, err := net.Miner.Client.GetRawTransaction(justiceTXID)
+	require.NoError(t.t, err, "unable to query for justice tx")
+	for _, txIn := range justiceTx.MsgTx().TxIn {
+		require.Equal(
+			t.t, *breachTXID, txIn.PreviousOutPoint.Hash,
+			"justice tx not spending commitment utxo",
+		)
+	}
+
+	// The tower shouldn't have any funds before the justice transaction
+	// confirms.
+	ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+	towerBalResp, err := tower.WalletBalance(
+		ctxt, &lnrpc.WalletBalanceRequest{},
+	)
+	cancel()
+	require.NoError(t.t, err, "unable to get tower balance")
+	require.Zero(
+		t.t, towerBalResp.ConfirmedBalance, "tower should have 0 "+
+			"balance before mining justice transaction",
+	)
+
+	// Now mine a block, which should include the justice transaction that
+	// was just accepted into the mempool. The block should have exactly
+	// *two* transactions, one of which is the justice transaction.
+	block = mineBlocks(t, net, 1, 1)[0]
+	require.Len(t.t, block.Transactions, 2, "transaction wasn't mined")
+	require.Equal(
+		t.t, *justiceTx.Hash(), block.Transactions[1].TxHash(),
+		"justice tx wasn't mined",
+	)
+
+	return restart
+}
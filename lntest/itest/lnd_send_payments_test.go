@@ -0,0 +1,117 @@
+package itest
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/stretchr/testify/require"
+)
+
+// testSendPayments tests that a batch of payments is sent with independent
+// outcomes, so that payments that can't be sent or fail don't affect the
+// others.
+func testSendPayments(net *lntest.NetworkHarness, t *harnessTest) {
+	const (
+		chanAmt    = btcutil.Amount(500000)
+		paymentAmt = btcutil.Amount(10000)
+		numPaid    = 4
+	)
+
+	ctxb := context.Background()
+
+	chanPoint := openChannelAndAssert(
+		t, net, net.Alice, net.Bob, lntest.OpenChannelParams{
+			Amt: chanAmt,
+		},
+	)
+	defer closeChannelAndAssert(t, net, net.Alice, chanPoint, false)
+
+	addInvoice := func(amt btcutil.Amount) string {
+		ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+		defer cancel()
+
+		invoice, err := net.Bob.AddInvoice(ctxt, &lnrpc.Invoice{
+			Value: int64(amt),
+		})
+		require.NoError(t.t, err, "unable to add invoice")
+
+		return invoice.PaymentRequest
+	}
+
+	newRequest := func(payReq string) *routerrpc.SendPaymentRequest {
+		return &routerrpc.SendPaymentRequest{
+			PaymentRequest: payReq,
+			TimeoutSeconds: 60,
+			FeeLimitMsat:   noFeeLimitMsat,
+		}
+	}
+
+	var payments []*routerrpc.SendPaymentRequest
+	for i := 0; i < numPaid; i++ {
+		payReq := addInvoice(paymentAmt)
+		payments = append(payments, newRequest(payReq))
+	}
+
+	// An invoice that was already paid can't be sent again.
+	alreadyPaid := addInvoice(paymentAmt)
+	sendAndAssertSuccess(t, net.Alice, newRequest(alreadyPaid))
+	alreadyPaidIndex := uint32(len(payments))
+	payments = append(payments, newRequest(alreadyPaid))
+
+	// An invoice that exceeds the capacity of the channel fails.
+	exceedingIndex := uint32(len(payments))
+	payments = append(payments, newRequest(addInvoice(chanAmt*2)))
+
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	stream, err := net.Alice.RouterClient.SendPayments(
+		ctxt, &routerrpc.SendPaymentsRequest{
+			Payments:    payments,
+			MaxParallel: 2,
+		},
+	)
+	require.NoError(t.t, err, "unable to send payments")
+
+	results := make(map[uint32]*routerrpc.SendPaymentsResponse)
+	for range payments {
+		result, err := stream.Recv()
+		require.NoError(t.t, err, "unable to receive result")
+
+		require.NotContains(
+			t.t, results, result.Index, "duplicate result",
+		)
+		results[result.Index] = result
+	}
+
+	for i := range payments {
+		result := results[uint32(i)]
+		require.NotNil(t.t, result, "no result for payment %d", i)
+
+		switch uint32(i) {
+		case alreadyPaidIndex:
+			require.Nil(t.t, result.Payment)
+			require.Contains(
+				t.t, result.Error,
+				channeldb.ErrAlreadyPaid.Error(),
+			)
+
+		case exceedingIndex:
+			require.Empty(t.t, result.Error)
+			require.Equal(
+				t.t, lnrpc.Payment_FAILED,
+				result.Payment.Status,
+			)
+
+		default:
+			require.Empty(t.t, result.Error)
+			require.Equal(
+				t.t, lnrpc.Payment_SUCCEEDED,
+				result.Payment.Status,
+			)
+		}
+	}
+}
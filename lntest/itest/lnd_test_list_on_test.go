@@ -230,10 +230,70 @@ var allTestCases = []*testCase{
 		name: "channel backup restore",
 		test: testChannelBackupRestore,
 	},
+	{
+		name: "channel recovery from seed",
+		test: testChannelRecoveryFromSeed,
+	},
 	{
 		name: "hold invoice sender persistence",
 		test: testHoldInvoicePersistence,
 	},
+	{
+		name: "payment restart persistence",
+		test: testPaymentRestartPersistence,
+	},
+	{
+		name: "probe payment",
+		test: testProbePayment,
+	},
+	{
+		name: "expired htlc rejected",
+		test: testExpiredHtlcRejected,
+	},
+	{
+		name: "gossip throttle",
+		test: testGossipThrottle,
+	},
+	{
+		name: "close delivery address",
+		test: testCloseDeliveryAddress,
+	},
+	{
+		name: "reorg channel state",
+		test: testReorgChannelState,
+	},
+	{
+		name: "invalid onion rejected",
+		test: testInvalidOnionRejected,
+	},
+	{
+		name: "wallet locked behavior",
+		test: testWalletLockedBehavior,
+	},
+	{
+		name: "reroutes on disable",
+		test: testReroutesOnDisable,
+	},
+	{
+		name: "pay already settled",
+		test: testPayAlreadySettled,
+	},
+	{
+		name: "send payments batch",
+		test: testSendPayments,
+	},
+	{
+		name: "duplicate htlc",
+		test: testDuplicateHtlc,
+	},
+	{
+		name: "backend outage recovery",
+		test: testBackendOutageRecovery,
+	},
+	{
+		name: "peer error handled",
+		test: testPeerErrorHandled,
+	},
 	{
 		name: "hold invoice force close",
 		test: testHoldInvoiceForceClose,
@@ -246,6 +306,10 @@ var allTestCases = []*testCase{
 		name: "cpfp",
 		test: testCPFP,
 	},
+	{
+		name: "anchor cpfp",
+		test: testAnchorCPFP,
+	},
 	{
 		name: "anchors reserved value",
 		test: testAnchorReservedValue,
@@ -270,6 +334,10 @@ var allTestCases = []*testCase{
 		name: "external channel funding",
 		test: testExternalFundingChanPoint,
 	},
+	{
+		name: "channel funding with key",
+		test: testChannelFundingWithKey,
+	},
 	{
 		name: "psbt channel funding",
 		test: testPsbtChanFunding,
@@ -334,4 +402,8 @@ var allTestCases = []*testCase{
 		name: "max htlc pathfind",
 		test: testMaxHtlcPathfind,
 	},
+	{
+		name: "htlc limit",
+		test: testHtlcLimit,
+	},
 }
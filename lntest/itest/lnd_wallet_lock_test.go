@@ -0,0 +1,180 @@
+package itest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/lightningnetwork/lnd/rpcperms"
+	"github.com/stretchr/testify/require"
+)
+
+// testWalletLockedBehavior tests that a node whose wallet is locked rejects
+// all calls that need the wallet, and that it resumes its channels once the
+// wallet is unlocked again.
+func testWalletLockedBehavior(net *lntest.NetworkHarness, t *harnessTest) {
+	const chanAmt = btcutil.Amount(1000000)
+
+	// Carol must have a wallet password, as her wallet is otherwise
+	// unlocked automatically on restart.
+	password := []byte("El Psy Kongroo")
+	carol, _, _, err := net.NewNodeWithSeed(
+		"Carol", nil, password, false,
+	)
+	require.NoError(t.t, err, "unable to create carol")
+	defer shutdownAndAssert(net, t, carol)
+
+	net.ConnectNodes(t.t, net.Alice, carol)
+
+	chanPoint := openChannelAndAssert(
+		t, net, net.Alice, carol,
+		lntest.OpenChannelParams{
+			Amt: chanAmt,
+		},
+	)
+
+	assertWalletLockedBehavior(t, net, carol)
+
+	closeChannelAndAssert(t, net, net.Alice, chanPoint, false)
+}
+
+// assertWalletLockedBehavior locks the wallet of the node by restarting it
+// without unlocking it. It asserts that funding and signing calls are rejected
+// because the wallet is locked, while the read-only state service still
+// reports the lock state. It then unlocks the wallet and asserts that these
+// calls succeed again and that the channels that were active before are
+// resumed. The node must have been created with a wallet password.
+func assertWalletLockedBehavior(t *harnessTest, net *lntest.NetworkHarness,
+	node *lntest.HarnessNode) {
+
+	t.t.Helper()
+
+	require.NotEmpty(t.t, node.Cfg.Password, "node has no wallet password")
+
+	ctxb := context.Background()
+
+	// numActiveChannels returns the number of active channels of the
+	// node.
+	numActiveChannels := func() (int, error) {
+		ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+		defer cancel()
+
+		resp, err := node.ListChannels(ctxt, &lnrpc.ListChannelsRequest{
+			ActiveOnly: true,
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		return len(resp.Channels), nil
+	}
+
+	numActive, err := numActiveChannels()
+	require.NoError(t.t, err, "unable to list channels")
+
+	ctxt, cancel := context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	addr, err := net.Alice.NewAddress(ctxt, &lnrpc.NewAddressRequest{
+		Type: lnrpc.AddressType_WITNESS_PUBKEY_HASH,
+	})
+	require.NoError(t.t, err, "unable to get address")
+
+	// Restarting the node without unlocking it leaves the wallet locked.
+	err = net.RestartNodeNoUnlock(node, nil)
+	require.NoError(t.t, err, "unable to restart node")
+
+	// The macaroons can't be checked while the wallet is locked, so we
+	// connect without them. The lock state is checked first.
+	conn, err := node.ConnectRPC(false)
+	require.NoError(t.t, err, "unable to connect to node")
+	defer conn.Close()
+
+	client := lnrpc.NewLightningClient(conn)
+	stateClient := lnrpc.NewStateClient(conn)
+
+	ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+
+	state, err := stateClient.GetState(ctxt, &lnrpc.GetStateRequest{})
+	require.NoError(t.t, err, "unable to get state of locked node")
+	require.Equal(t.t, lnrpc.WalletState_LOCKED, state.State)
+
+	// assertLocked asserts that a call failed because the wallet is
+	// locked.
+	assertLocked := func(name string, err error) {
+		require.Error(t.t, err, "%s succeeded with locked wallet", name)
+		require.Contains(
+			t.t, err.Error(), rpcperms.ErrWalletLocked.Error(),
+			"%s failed with unexpected error", name,
+		)
+	}
+
+	_, err = client.NewAddress(ctxt, &lnrpc.NewAddressRequest{
+		Type: lnrpc.AddressType_WITNESS_PUBKEY_HASH,
+	})
+	assertLocked("NewAddress", err)
+
+	_, err = client.SendCoins(ctxt, &lnrpc.SendCoinsRequest{
+		Addr:   addr.Address,
+		Amount: 10000,
+	})
+	assertLocked("SendCoins", err)
+
+	_, err = client.OpenChannelSync(ctxt, &lnrpc.OpenChannelRequest{
+		NodePubkey:         net.Alice.PubKey[:],
+		LocalFundingAmount: int64(btcutil.SatoshiPerBitcoin / 100),
+	})
+	assertLocked("OpenChannelSync", err)
+
+	_, err = client.SignMessage(ctxt, &lnrpc.SignMessageRequest{
+		Msg: []byte("locked"),
+	})
+	assertLocked("SignMessage", err)
+
+	// Unlocking the wallet must restore the full functionality of the
+	// node.
+	err = node.Unlock(ctxb, &lnrpc.UnlockWalletRequest{
+		WalletPassword: node.Cfg.Password,
+	})
+	require.NoError(t.t, err, "unable to unlock wallet")
+
+	ctxt, cancel = context.WithTimeout(ctxb, defaultTimeout)
+	defer cancel()
+	require.NoError(
+		t.t, node.WaitForBlockchainSync(ctxt), "node didn't sync",
+	)
+
+	state, err = stateClient.GetState(ctxt, &lnrpc.GetStateRequest{})
+	require.NoError(t.t, err, "unable to get state of unlocked node")
+	require.Equal(t.t, lnrpc.WalletState_RPC_ACTIVE, state.State)
+
+	_, err = node.NewAddress(ctxt, &lnrpc.NewAddressRequest{
+		Type: lnrpc.AddressType_WITNESS_PUBKEY_HASH,
+	})
+	require.NoError(t.t, err, "unable to get address after unlock")
+
+	_, err = node.SignMessage(ctxt, &lnrpc.SignMessageRequest{
+		Msg: []byte("unlocked"),
+	})
+	require.NoError(t.t, err, "unable to sign message after unlock")
+
+	// The channels are only resumed once the node is unlocked, as they
+	// can't be loaded before.
+	err = wait.NoError(func() error {
+		n, err := numActiveChannels()
+		if err != nil {
+			return err
+		}
+
+		if n != numActive {
+			return fmt.Errorf("expected %d active channels, got %d",
+				numActive, n)
+		}
+
+		return nil
+	}, defaultTimeout)
+	require.NoError(t.t, err, "channels not resumed after unlock")
+}
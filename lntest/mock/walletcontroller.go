@@ -75,11 +75,28 @@ func (w *WalletController) LastUnusedAddress(addrType lnwallet.AddressType,
 	return nil, nil
 }
 
+// DefaultAddressType currently returns a dummy value.
+func (w *WalletController) DefaultAddressType() lnwallet.AddressType {
+	return lnwallet.WitnessPubKey
+}
+
+// SetDefaultAddressType currently does nothing.
+func (w *WalletController) SetDefaultAddressType(lnwallet.AddressType) error {
+	return nil
+}
+
 // IsOurAddress currently returns a dummy value.
 func (w *WalletController) IsOurAddress(a btcutil.Address) bool {
 	return false
 }
 
+// AddressInfo currently returns a dummy value.
+func (w *WalletController) AddressInfo(
+	btcutil.Address) (waddrmgr.ManagedAddress, error) {
+
+	return nil, lnwallet.ErrNotMine
+}
+
 // ListAccounts currently returns a dummy value.
 func (w *WalletController) ListAccounts(_ string,
 	_ *waddrmgr.KeyScope) ([]*waddrmgr.AccountProperties, error) {
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec"
@@ -96,6 +97,11 @@ type BtcWallet struct {
 	chainKeyScope waddrmgr.KeyScope
 
 	blockCache *blockcache.BlockCache
+
+	// defaultAddrType is the lnwallet.AddressType of the addresses that
+	// are generated if no specific type is requested. It must be accessed
+	// atomically.
+	defaultAddrType uint32
 }
 
 // A compile time check to ensure that BtcWallet implements the
@@ -157,7 +163,7 @@ func New(cfg Config, blockCache *blockcache.BlockCache) (*BtcWallet, error) {
 		}
 	}
 
-	return &BtcWallet{
+	b := &BtcWallet{
 		cfg:           &cfg,
 		wallet:        wallet,
 		db:            wallet.Database(),
@@ -165,7 +171,17 @@ func New(cfg Config, blockCache *blockcache.BlockCache) (*BtcWallet, error) {
 		netParams:     cfg.NetParams,
 		chainKeyScope: chainKeyScope,
 		blockCache:    blockCache,
-	}, nil
+	}
+
+	defaultAddrType := cfg.DefaultAddressType
+	if defaultAddrType == lnwallet.UnknownAddressType {
+		defaultAddrType = lnwallet.WitnessPubKey
+	}
+	if err := b.SetDefaultAddressType(defaultAddrType); err != nil {
+		return nil, err
+	}
+
+	return b, nil
 }
 
 // loaderCfg holds optional wallet loader configuration.
@@ -433,6 +449,46 @@ func (b *BtcWallet) NewAddress(t lnwallet.AddressType, change bool,
 	return b.wallet.NewAddress(account, keyScope)
 }
 
+// DefaultAddressType returns the type of the addresses that are generated if
+// no specific type is requested, which includes the change addresses of the
+// transactions crafted by the wallet.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) DefaultAddressType() lnwallet.AddressType {
+	return lnwallet.AddressType(atomic.LoadUint32(&b.defaultAddrType))
+}
+
+// SetDefaultAddressType changes the type of the addresses that are generated
+// if no specific type is requested.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) SetDefaultAddressType(addrType lnwallet.AddressType) error {
+	switch addrType {
+	case lnwallet.WitnessPubKey, lnwallet.NestedWitnessPubKey:
+	default:
+		return fmt.Errorf("unsupported default address type: %v",
+			addrType)
+	}
+
+	atomic.StoreUint32(&b.defaultAddrType, uint32(addrType))
+
+	return nil
+}
+
+// changeKeyScope returns the key scope from which the change addresses of
+// the transactions crafted by the wallet are derived, following the default
+// address type. A nil scope selects the wallet's default p2wkh scope.
+//
+// NOTE: The key scope also restricts the coins that are selected to fund the
+// transaction to those of the same scope.
+func (b *BtcWallet) changeKeyScope() *waddrmgr.KeyScope {
+	if b.DefaultAddressType() == lnwallet.NestedWitnessPubKey {
+		return &waddrmgr.KeyScopeBIP0049Plus
+	}
+
+	return nil
+}
+
 // LastUnusedAddress returns the last *unused* address known by the wallet. An
 // address is unused if it hasn't received any payments. This can be useful in
 // UIs in order to continually show the "freshest" address without having to
@@ -464,6 +520,22 @@ func (b *BtcWallet) IsOurAddress(a btcutil.Address) bool {
 	return result && (err == nil)
 }
 
+// AddressInfo returns the information the wallet has stored about the passed
+// address. If the address doesn't belong to the wallet, lnwallet.ErrNotMine is
+// returned.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) AddressInfo(
+	a btcutil.Address) (waddrmgr.ManagedAddress, error) {
+
+	addr, err := b.wallet.AddressInfo(a)
+	if waddrmgr.IsError(err, waddrmgr.ErrAddressNotFound) {
+		return nil, lnwallet.ErrNotMine
+	}
+
+	return addr, err
+}
+
 // ListAccounts retrieves all accounts belonging to the wallet by default. A
 // name and key scope filter can be provided to filter through all of the wallet
 // accounts and return only those matching.
@@ -655,8 +727,8 @@ func (b *BtcWallet) SendOutputs(outputs []*wire.TxOut,
 	}
 
 	return b.wallet.SendOutputs(
-		outputs, nil, defaultAccount, minConfs, feeSatPerKB,
-		b.cfg.CoinSelectionStrategy, label,
+		outputs, b.changeKeyScope(), defaultAccount, minConfs,
+		feeSatPerKB, b.cfg.CoinSelectionStrategy, label,
 	)
 }
 
@@ -706,7 +778,8 @@ func (b *BtcWallet) CreateSimpleTx(outputs []*wire.TxOut,
 	}
 
 	return b.wallet.CreateSimpleTx(
-		nil, defaultAccount, outputs, minConfs, feeSatPerKB,
+		b.changeKeyScope(), defaultAccount, outputs, minConfs,
+		feeSatPerKB,
 		b.cfg.CoinSelectionStrategy, dryRun,
 	)
 }
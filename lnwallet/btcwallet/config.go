@@ -9,6 +9,7 @@ import (
 
 	"github.com/btcsuite/btcwallet/chain"
 	"github.com/btcsuite/btcwallet/wallet"
+	"github.com/lightningnetwork/lnd/lnwallet"
 )
 
 var (
@@ -72,6 +73,11 @@ type Config struct {
 	// CoinSelectionStrategy is the strategy that is used for selecting
 	// coins when funding a transaction.
 	CoinSelectionStrategy wallet.CoinSelectionStrategy
+
+	// DefaultAddressType is the type of the addresses that are generated
+	// if no specific type is requested, including change addresses. If
+	// not set, p2wkh addresses are generated.
+	DefaultAddressType lnwallet.AddressType
 }
 
 // NetworkDir returns the directory name of a network directory to hold wallet
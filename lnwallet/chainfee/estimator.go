@@ -58,6 +58,69 @@ type Estimator interface {
 	RelayFeePerKW() SatPerKWeight
 }
 
+// EstimateSource describes where a fee estimate came from.
+type EstimateSource uint8
+
+const (
+	// EstimateSourceBackend indicates that the fee estimate was provided
+	// by the fee estimation backend, such as the chain backend or a fee
+	// web API.
+	EstimateSourceBackend EstimateSource = iota
+
+	// EstimateSourceFallback indicates that the fee estimation backend
+	// couldn't provide an estimate, so the configured fallback fee rate
+	// was returned instead.
+	EstimateSourceFallback
+
+	// EstimateSourceStatic indicates that the fee estimate is a static fee
+	// rate that doesn't depend on the current state of the chain.
+	EstimateSourceStatic
+)
+
+// String returns a human readable description of the estimate source.
+func (s EstimateSource) String() string {
+	switch s {
+	case EstimateSourceBackend:
+		return "backend"
+
+	case EstimateSourceFallback:
+		return "fallback"
+
+	case EstimateSourceStatic:
+		return "static"
+
+	default:
+		return "unknown"
+	}
+}
+
+// SourceEstimator is an Estimator that is able to report the source of its fee
+// estimates.
+type SourceEstimator interface {
+	Estimator
+
+	// EstimateFeePerKWWithSource takes in a target for the number of
+	// blocks until an initial confirmation and returns the estimated fee
+	// expressed in sat/kw, together with the source of the estimate.
+	EstimateFeePerKWWithSource(numBlocks uint32) (SatPerKWeight,
+		EstimateSource, error)
+}
+
+// EstimateFeePerKWWithSource returns the fee estimate of the estimator for the
+// given confirmation target, together with the source of the estimate. The
+// estimates of estimators that can't report their source are assumed to be
+// provided by their backend.
+func EstimateFeePerKWWithSource(estimator Estimator,
+	numBlocks uint32) (SatPerKWeight, EstimateSource, error) {
+
+	if e, ok := estimator.(SourceEstimator); ok {
+		return e.EstimateFeePerKWWithSource(numBlocks)
+	}
+
+	feePerKw, err := estimator.EstimateFeePerKW(numBlocks)
+	return feePerKw, EstimateSourceBackend, err
+}
+
 // StaticEstimator will return a static value for all fee calculation requests.
 // It is designed to be replaced by a proper fee calculation implementation.
 // The fees are not accessible directly, because changing them would not be
@@ -88,6 +151,16 @@ func (e StaticEstimator) EstimateFeePerKW(numBlocks uint32) (SatPerKWeight, erro
 	return e.feePerKW, nil
 }
 
+// EstimateFeePerKWWithSource returns the static fee rate, which is always
+// reported as a static estimate.
+//
+// NOTE: This method is part of the SourceEstimator interface.
+func (e StaticEstimator) EstimateFeePerKWWithSource(
+	numBlocks uint32) (SatPerKWeight, EstimateSource, error) {
+
+	return e.feePerKW, EstimateSourceStatic, nil
+}
+
 // RelayFeePerKW returns the minimum fee rate required for transactions to be
 // relayed.
 //
@@ -113,8 +186,8 @@ func (e StaticEstimator) Stop() error {
 }
 
 // A compile-time assertion to ensure that StaticFeeEstimator implements the
-// Estimator interface.
-var _ Estimator = (*StaticEstimator)(nil)
+// SourceEstimator interface.
+var _ SourceEstimator = (*StaticEstimator)(nil)
 
 // BtcdEstimator is an implementation of the Estimator interface backed
 // by the RPC interface of an active btcd node. This implementation will proxy
@@ -209,6 +282,18 @@ func (b *BtcdEstimator) Stop() error {
 //
 // NOTE: This method is part of the Estimator interface.
 func (b *BtcdEstimator) EstimateFeePerKW(numBlocks uint32) (SatPerKWeight, error) {
+	feeEstimate, _, err := b.EstimateFeePerKWWithSource(numBlocks)
+	return feeEstimate, err
+}
+
+// EstimateFeePerKWWithSource takes in a target for the number of blocks until
+// an initial confirmation and returns the estimated fee expressed in sat/kw,
+// together with whether it is btcd's estimate or the fall back fee rate.
+//
+// NOTE: This method is part of the SourceEstimator interface.
+func (b *BtcdEstimator) EstimateFeePerKWWithSource(
+	numBlocks uint32) (SatPerKWeight, EstimateSource, error) {
+
 	feeEstimate, err := b.fetchEstimate(numBlocks)
 	switch {
 	// If the estimator doesn't have enough data, or returns an error, then
@@ -219,10 +304,10 @@ func (b *BtcdEstimator) EstimateFeePerKW(numBlocks uint32) (SatPerKWeight, error
 		fallthrough
 
 	case feeEstimate == 0:
-		return b.fallbackFeePerKW, nil
+		return b.fallbackFeePerKW, EstimateSourceFallback, nil
 	}
 
-	return feeEstimate, nil
+	return feeEstimate, EstimateSourceBackend, nil
 }
 
 // RelayFeePerKW returns the minimum fee rate required for transactions to be
@@ -268,8 +353,8 @@ func (b *BtcdEstimator) fetchEstimate(confTarget uint32) (SatPerKWeight, error)
 }
 
 // A compile-time assertion to ensure that BtcdEstimator implements the
-// Estimator interface.
-var _ Estimator = (*BtcdEstimator)(nil)
+// SourceEstimator interface.
+var _ SourceEstimator = (*BtcdEstimator)(nil)
 
 // BitcoindEstimator is an implementation of the Estimator interface backed by
 // the RPC interface of an active bitcoind node. This implementation will proxy
@@ -378,6 +463,18 @@ func (b *BitcoindEstimator) Stop() error {
 func (b *BitcoindEstimator) EstimateFeePerKW(
 	numBlocks uint32) (SatPerKWeight, error) {
 
+	feeEstimate, _, err := b.EstimateFeePerKWWithSource(numBlocks)
+	return feeEstimate, err
+}
+
+// EstimateFeePerKWWithSource takes in a target for the number of blocks until
+// an initial confirmation and returns the estimated fee expressed in sat/kw,
+// together with whether it is bitcoind's estimate or the fallback fee rate.
+//
+// NOTE: This method is part of the SourceEstimator interface.
+func (b *BitcoindEstimator) EstimateFeePerKWWithSource(
+	numBlocks uint32) (SatPerKWeight, EstimateSource, error) {
+
 	if numBlocks > maxBlockTarget {
 		log.Debugf("conf target %d exceeds the max value, "+
 			"use %d instead.", numBlocks, maxBlockTarget,
@@ -395,10 +492,10 @@ func (b *BitcoindEstimator) EstimateFeePerKW(
 		fallthrough
 
 	case feeEstimate == 0:
-		return b.fallbackFeePerKW, nil
+		return b.fallbackFeePerKW, EstimateSourceFallback, nil
 	}
 
-	return feeEstimate, nil
+	return feeEstimate, EstimateSourceBackend, nil
 }
 
 // RelayFeePerKW returns the minimum fee rate required for transactions to be
@@ -468,8 +565,8 @@ func (b *BitcoindEstimator) fetchEstimate(confTarget uint32) (SatPerKWeight, err
 }
 
 // A compile-time assertion to ensure that BitcoindEstimator implements the
-// Estimator interface.
-var _ Estimator = (*BitcoindEstimator)(nil)
+// SourceEstimator interface.
+var _ SourceEstimator = (*BitcoindEstimator)(nil)
 
 // WebAPIFeeSource is an interface allows the WebAPIEstimator to query an
 // arbitrary HTTP-based fee estimator. Each new set/network will gain an
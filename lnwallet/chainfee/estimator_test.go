@@ -105,6 +105,17 @@ func TestStaticFeeEstimator(t *testing.T) {
 	if feeRate != feePerKw {
 		t.Fatalf("expected fee rate %v, got %v", feePerKw, feeRate)
 	}
+
+	// The static fee rate must be reported as such.
+	feeRate, source, err := EstimateFeePerKWWithSource(feeEstimator, 6)
+	if err != nil {
+		t.Fatalf("unable to get fee rate: %v", err)
+	}
+
+	if feeRate != feePerKw || source != EstimateSourceStatic {
+		t.Fatalf("expected static fee rate %v, got %v from %v",
+			feePerKw, feeRate, source)
+	}
 }
 
 // TestSparseConfFeeSource checks that SparseConfFeeSource generates URLs and
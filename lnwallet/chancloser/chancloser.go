@@ -37,6 +37,35 @@ var (
 		"match upfront shutdown script")
 )
 
+const (
+	// DefaultFeeAcceptanceBand is the default percentage around our last
+	// fee proposal within which we accept the fee proposed by the remote
+	// party.
+	DefaultFeeAcceptanceBand = 30
+
+	// MinFeeAcceptanceBand is the smallest fee acceptance band that may be
+	// configured. It matches the step size of our fee ratchet, which
+	// ensures that the negotiation terminates once the proposals of both
+	// parties have crossed.
+	MinFeeAcceptanceBand = 10
+
+	// MaxFeeAcceptanceBand is the largest fee acceptance band that may be
+	// configured.
+	MaxFeeAcceptanceBand = 100
+)
+
+// ValidateFeeAcceptanceBand returns an error if the given fee acceptance band
+// is outside of the allowed range.
+func ValidateFeeAcceptanceBand(band uint32) error {
+	if band < MinFeeAcceptanceBand || band > MaxFeeAcceptanceBand {
+		return fmt.Errorf("fee acceptance band of %v%% must be within "+
+			"[%v, %v]", band, MinFeeAcceptanceBand,
+			MaxFeeAcceptanceBand)
+	}
+
+	return nil
+}
+
 // closeState represents all the possible states the channel closer state
 // machine can be in. Each message will either advance to the next state, or
 // remain at the current state. Once the state machine reaches a state of
@@ -94,6 +123,12 @@ type ChanCloseCfg struct {
 	// Disconnect will disconnect from the remote peer in this close.
 	Disconnect func() error
 
+	// FeeAcceptanceBand is the percentage around our last fee proposal
+	// within which we accept the fee proposed by the remote party. If the
+	// remote fee is outside of this band, we propose a counter offer
+	// instead. If zero, DefaultFeeAcceptanceBand is used.
+	FeeAcceptanceBand uint32
+
 	// Quit is a channel that should be sent upon in the occasion the state
 	// machine should cease all progress and shutdown.
 	Quit chan struct{}
@@ -189,6 +224,10 @@ func NewChanCloser(cfg ChanCloseCfg, deliveryScript []byte,
 	chancloserLog.Infof("Ideal fee for closure of ChannelPoint(%v) is: %v sat",
 		cfg.Channel.ChannelPoint(), int64(idealFeeSat))
 
+	if cfg.FeeAcceptanceBand == 0 {
+		cfg.FeeAcceptanceBand = DefaultFeeAcceptanceBand
+	}
+
 	cid := lnwire.NewChanIDFromOutPoint(cfg.Channel.ChannelPoint())
 	return &ChanCloser{
 		closeReq:            closeReq,
@@ -494,6 +533,7 @@ func (c *ChanCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message,
 			// proposed fee by both sides.
 			feeProposal := calcCompromiseFee(c.chanPoint, c.idealFeeSat,
 				c.lastFeeProposal, remoteProposedFee,
+				c.cfg.FeeAcceptanceBand,
 			)
 
 			// With our new fee proposal calculated, we'll craft a new close
@@ -629,18 +669,23 @@ func (c *ChanCloser) proposeCloseSigned(fee btcutil.Amount) (*lnwire.ClosingSign
 // feeInAcceptableRange returns true if the passed remote fee is deemed to be
 // in an "acceptable" range to our local fee. This is an attempt at a
 // compromise and to ensure that the fee negotiation has a stopping point. We
-// consider their fee acceptable if it's within 30% of our fee.
-func feeInAcceptableRange(localFee, remoteFee btcutil.Amount) bool {
+// consider their fee acceptable if it's within the given percentage of our
+// fee.
+func feeInAcceptableRange(localFee, remoteFee btcutil.Amount,
+	bandPercent uint32) bool {
+
+	band := (localFee * btcutil.Amount(bandPercent)) / 100
+
 	// If our offer is lower than theirs, then we'll accept their offer if it's
-	// no more than 30% *greater* than our current offer.
+	// no more than the band *greater* than our current offer.
 	if localFee < remoteFee {
-		acceptableRange := localFee + ((localFee * 3) / 10)
+		acceptableRange := localFee + band
 		return remoteFee <= acceptableRange
 	}
 
 	// If our offer is greater than theirs, then we'll accept their offer if
-	// it's no more than 30% *less* than our current offer.
-	acceptableRange := localFee - ((localFee * 3) / 10)
+	// it's no more than the band *less* than our current offer.
+	acceptableRange := localFee - band
 	return remoteFee >= acceptableRange
 }
 
@@ -660,9 +705,10 @@ func ratchetFee(fee btcutil.Amount, up bool) btcutil.Amount {
 
 // calcCompromiseFee performs the current fee negotiation algorithm, taking
 // into consideration our ideal fee based on current fee environment, the fee
-// we last proposed (if any), and the fee proposed by the peer.
+// we last proposed (if any), the fee proposed by the peer and the percentage
+// around our last proposal within which we accept the peer's fee.
 func calcCompromiseFee(chanPoint wire.OutPoint, ourIdealFee, lastSentFee,
-	remoteFee btcutil.Amount) btcutil.Amount {
+	remoteFee btcutil.Amount, feeBand uint32) btcutil.Amount {
 
 	// TODO(roasbeef): take in number of rounds as well?
 
@@ -691,7 +737,7 @@ func calcCompromiseFee(chanPoint wire.OutPoint, ourIdealFee, lastSentFee,
 	case remoteFee < lastSentFee:
 		// If the fee is lower, but still acceptable, then we'll just return
 		// this fee and end the negotiation.
-		if feeInAcceptableRange(lastSentFee, remoteFee) {
+		if feeInAcceptableRange(lastSentFee, remoteFee, feeBand) {
 			chancloserLog.Infof("ChannelPoint(%v): proposed remote fee is "+
 				"close enough, capitulating", chanPoint)
 			return remoteFee
@@ -705,7 +751,7 @@ func calcCompromiseFee(chanPoint wire.OutPoint, ourIdealFee, lastSentFee,
 	case remoteFee > lastSentFee:
 		// If the fee is greater, but still acceptable, then we'll just return
 		// this fee in order to put an end to the negotiation.
-		if feeInAcceptableRange(lastSentFee, remoteFee) {
+		if feeInAcceptableRange(lastSentFee, remoteFee, feeBand) {
 			chancloserLog.Infof("ChannelPoint(%v): proposed remote fee is "+
 				"close enough, capitulating", chanPoint)
 			return remoteFee
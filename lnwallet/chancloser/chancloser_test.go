@@ -4,6 +4,8 @@ import (
 	"crypto/rand"
 	"testing"
 
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
@@ -74,3 +76,62 @@ func TestMaybeMatchScript(t *testing.T) {
 		})
 	}
 }
+
+// TestCalcCompromiseFee tests that the remote fee is only accepted if it's
+// within the configured fee acceptance band, and that a counter offer is made
+// otherwise.
+func TestCalcCompromiseFee(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		lastSentFee btcutil.Amount
+		remoteFee   btcutil.Amount
+		feeBand     uint32
+		expectedFee btcutil.Amount
+	}{
+		{
+			name:        "higher remote fee within band",
+			lastSentFee: 1000,
+			remoteFee:   1200,
+			feeBand:     DefaultFeeAcceptanceBand,
+			expectedFee: 1200,
+		},
+		{
+			name:        "higher remote fee outside band",
+			lastSentFee: 1000,
+			remoteFee:   1200,
+			feeBand:     MinFeeAcceptanceBand,
+			expectedFee: 1100,
+		},
+		{
+			name:        "lower remote fee within band",
+			lastSentFee: 1000,
+			remoteFee:   750,
+			feeBand:     DefaultFeeAcceptanceBand,
+			expectedFee: 750,
+		},
+		{
+			name:        "lower remote fee outside band",
+			lastSentFee: 1000,
+			remoteFee:   750,
+			feeBand:     MinFeeAcceptanceBand,
+			expectedFee: 900,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			fee := calcCompromiseFee(
+				wire.OutPoint{}, 2000, test.lastSentFee,
+				test.remoteFee, test.feeBand,
+			)
+			if fee != test.expectedFee {
+				t.Fatalf("expected fee %v, got %v",
+					test.expectedFee, fee)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package lnwallet
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/btcsuite/btcd/blockchain"
@@ -848,3 +849,181 @@ func addHTLC(commitTx *wire.MsgTx, ourCommit bool,
 
 	return nil
 }
+
+// CommitOutputType denotes the type of an output of a commitment transaction.
+type CommitOutputType uint8
+
+const (
+	// CommitOutputUnknown is an output that couldn't be identified.
+	CommitOutputUnknown CommitOutputType = iota
+
+	// CommitOutputToLocal is the delayed output paying to the owner of the
+	// commitment transaction.
+	CommitOutputToLocal
+
+	// CommitOutputToRemote is the output paying to the non-owner of the
+	// commitment transaction.
+	CommitOutputToRemote
+
+	// CommitOutputLocalAnchor is the anchor output of the owner of the
+	// commitment transaction.
+	CommitOutputLocalAnchor
+
+	// CommitOutputRemoteAnchor is the anchor output of the non-owner of
+	// the commitment transaction.
+	CommitOutputRemoteAnchor
+
+	// CommitOutputIncomingHtlc is the output of an HTLC offered to the
+	// owner of the commitment transaction.
+	CommitOutputIncomingHtlc
+
+	// CommitOutputOutgoingHtlc is the output of an HTLC offered by the
+	// owner of the commitment transaction.
+	CommitOutputOutgoingHtlc
+)
+
+// String returns a human readable representation of the output type.
+func (c CommitOutputType) String() string {
+	switch c {
+	case CommitOutputToLocal:
+		return "ToLocal"
+	case CommitOutputToRemote:
+		return "ToRemote"
+	case CommitOutputLocalAnchor:
+		return "LocalAnchor"
+	case CommitOutputRemoteAnchor:
+		return "RemoteAnchor"
+	case CommitOutputIncomingHtlc:
+		return "IncomingHtlc"
+	case CommitOutputOutgoingHtlc:
+		return "OutgoingHtlc"
+	default:
+		return "Unknown"
+	}
+}
+
+// CommitOutput describes an output of a commitment transaction.
+type CommitOutput struct {
+	// Type is the type of the output.
+	Type CommitOutputType
+
+	// OutputIndex is the index of the output within the commitment
+	// transaction.
+	OutputIndex uint32
+
+	// Amount is the value of the output.
+	Amount btcutil.Amount
+
+	// PkScript is the script the output pays to.
+	PkScript []byte
+
+	// PaymentHash is the payment hash of an HTLC output, and unset for all
+	// other outputs.
+	PaymentHash [32]byte
+}
+
+// LocalCommitOutputs identifies the outputs of our current commitment
+// transaction of the given channel. Outputs that would be dust aren't part of
+// the commitment transaction, so a side without balance has no output.
+func LocalCommitOutputs(chanState *channeldb.OpenChannel) ([]*CommitOutput,
+	error) {
+
+	localCommit := chanState.LocalCommitment
+	if localCommit.CommitTx == nil {
+		return nil, fmt.Errorf("no local commitment for channel %v",
+			chanState.FundingOutpoint)
+	}
+
+	// Re-derive the scripts of the balance outputs, which depend on the
+	// commitment point of our current commitment.
+	revocation, err := chanState.RevocationProducer.AtIndex(
+		localCommit.CommitHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+	commitPoint := input.ComputeCommitmentPoint(revocation[:])
+	keyRing := DeriveCommitmentKeys(
+		commitPoint, true, chanState.ChanType,
+		&chanState.LocalChanCfg, &chanState.RemoteChanCfg,
+	)
+
+	toLocalScript, err := input.CommitScriptToSelf(
+		uint32(chanState.LocalChanCfg.CsvDelay), keyRing.ToLocalKey,
+		keyRing.RevocationKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+	toLocalPkScript, err := input.WitnessScriptHash(toLocalScript)
+	if err != nil {
+		return nil, err
+	}
+
+	toRemote, _, err := CommitScriptToRemote(
+		chanState.ChanType, keyRing.ToRemoteKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var localAnchor, remoteAnchor *ScriptInfo
+	if chanState.ChanType.HasAnchors() {
+		localAnchor, remoteAnchor, err = CommitScriptAnchors(
+			&chanState.LocalChanCfg, &chanState.RemoteChanCfg,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// HTLC outputs are identified by the output index that we stored
+	// along with them.
+	htlcs := make(map[int32]channeldb.HTLC, len(localCommit.Htlcs))
+	for _, htlc := range localCommit.Htlcs {
+		if htlc.OutputIndex < 0 {
+			continue
+		}
+		htlcs[htlc.OutputIndex] = htlc
+	}
+
+	outputs := make([]*CommitOutput, 0, len(localCommit.CommitTx.TxOut))
+	for i, txOut := range localCommit.CommitTx.TxOut {
+		output := &CommitOutput{
+			OutputIndex: uint32(i),
+			Amount:      btcutil.Amount(txOut.Value),
+			PkScript:    txOut.PkScript,
+		}
+
+		htlc, isHtlc := htlcs[int32(i)]
+		switch {
+		case isHtlc && htlc.Incoming:
+			output.Type = CommitOutputIncomingHtlc
+			output.PaymentHash = htlc.RHash
+
+		case isHtlc:
+			output.Type = CommitOutputOutgoingHtlc
+			output.PaymentHash = htlc.RHash
+
+		case bytes.Equal(txOut.PkScript, toLocalPkScript):
+			output.Type = CommitOutputToLocal
+
+		case bytes.Equal(txOut.PkScript, toRemote.PkScript):
+			output.Type = CommitOutputToRemote
+
+		case localAnchor != nil &&
+			bytes.Equal(txOut.PkScript, localAnchor.PkScript):
+
+			output.Type = CommitOutputLocalAnchor
+
+		case remoteAnchor != nil &&
+			bytes.Equal(txOut.PkScript, remoteAnchor.PkScript):
+
+			output.Type = CommitOutputRemoteAnchor
+		}
+
+		outputs = append(outputs, output)
+	}
+
+	return outputs, nil
+}
@@ -0,0 +1,95 @@
+package lnwallet
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLocalCommitOutputs tests that the outputs of our local commitment
+// transaction are identified correctly for the different channel types.
+func TestLocalCommitOutputs(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		chanType channeldb.ChannelType
+	}{
+		{
+			name:     "tweakless",
+			chanType: channeldb.SingleFunderTweaklessBit,
+		},
+		{
+			name: "anchors",
+			chanType: channeldb.SingleFunderTweaklessBit |
+				channeldb.AnchorOutputsBit,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			testLocalCommitOutputs(t, tc.chanType)
+		})
+	}
+}
+
+func testLocalCommitOutputs(t *testing.T, chanType channeldb.ChannelType) {
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels(chanType)
+	require.NoError(t, err)
+	defer cleanUp()
+
+	// Add an HTLC from Alice to Bob and lock it in, so that it shows up as
+	// an outgoing HTLC on Alice's commitment.
+	htlcAmt := lnwire.NewMSatFromSatoshis(btcutil.SatoshiPerBitcoin)
+	htlc, _ := createHTLC(0, htlcAmt)
+	_, err = aliceChannel.AddHTLC(htlc, nil)
+	require.NoError(t, err)
+	_, err = bobChannel.ReceiveHTLC(htlc)
+	require.NoError(t, err)
+	require.NoError(t, ForceStateTransition(aliceChannel, bobChannel))
+
+	chanState := aliceChannel.channelState
+	outputs, err := LocalCommitOutputs(chanState)
+	require.NoError(t, err)
+
+	outputsByType := make(map[CommitOutputType]*CommitOutput)
+	for _, output := range outputs {
+		require.NotContains(t, outputsByType, output.Type)
+		outputsByType[output.Type] = output
+	}
+
+	expectedTypes := []CommitOutputType{
+		CommitOutputToLocal, CommitOutputToRemote,
+		CommitOutputOutgoingHtlc,
+	}
+	if chanType.HasAnchors() {
+		expectedTypes = append(
+			expectedTypes, CommitOutputLocalAnchor,
+			CommitOutputRemoteAnchor,
+		)
+	}
+	require.Len(t, outputsByType, len(expectedTypes))
+	for _, outputType := range expectedTypes {
+		require.Contains(t, outputsByType, outputType)
+	}
+
+	localCommit := chanState.LocalCommitment
+	require.Equal(
+		t, localCommit.LocalBalance.ToSatoshis(),
+		outputsByType[CommitOutputToLocal].Amount,
+	)
+	require.Equal(
+		t, localCommit.RemoteBalance.ToSatoshis(),
+		outputsByType[CommitOutputToRemote].Amount,
+	)
+
+	htlcOutput := outputsByType[CommitOutputOutgoingHtlc]
+	require.Equal(t, htlcAmt.ToSatoshis(), htlcOutput.Amount)
+	require.Equal(t, htlc.PaymentHash, htlcOutput.PaymentHash)
+}
@@ -142,6 +142,17 @@ func ErrChanTooSmall(chanSize, minChanSize btcutil.Amount) ReservationError {
 	}
 }
 
+// ErrInboundChanTooSmall returns an error indicating that an incoming channel
+// request was below the inbound minimum channel size that the node operator
+// has set, either through the config or at runtime.
+func ErrInboundChanTooSmall(chanSize, minChanSize btcutil.Amount) ReservationError {
+	return ReservationError{
+		fmt.Errorf("chan size of %v is below the min inbound chan "+
+			"size of %v accepted by this node", chanSize,
+			minChanSize),
+	}
+}
+
 // ErrChanTooLarge returns an error indicating that an incoming channel request
 // was too large. We'll reject any incoming channels if they're above our
 // configured value for the max channel size we'll accept.
@@ -152,6 +163,17 @@ func ErrChanTooLarge(chanSize, maxChanSize btcutil.Amount) ReservationError {
 	}
 }
 
+// ErrChanAboveSoftMax returns an error indicating that a channel request was
+// above the soft maximum channel size that the node operator has set at
+// runtime.
+func ErrChanAboveSoftMax(chanSize, softMax btcutil.Amount) ReservationError {
+	return ReservationError{
+		fmt.Errorf("chan size of %v exceeds the soft max chan size of "+
+			"%v set by the operator of this node", chanSize,
+			softMax),
+	}
+}
+
 // ErrHtlcIndexAlreadyFailed is returned when the HTLC index has already been
 // failed, but has not been committed by our commitment state.
 type ErrHtlcIndexAlreadyFailed uint64
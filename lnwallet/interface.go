@@ -191,9 +191,24 @@ type WalletController interface {
 	LastUnusedAddress(addrType AddressType,
 		account string) (btcutil.Address, error)
 
+	// DefaultAddressType returns the type of the addresses that are
+	// generated if no specific type is requested, which includes the
+	// change addresses of the transactions crafted by the wallet.
+	DefaultAddressType() AddressType
+
+	// SetDefaultAddressType changes the type of the addresses that are
+	// generated if no specific type is requested. Only WitnessPubKey and
+	// NestedWitnessPubKey are supported.
+	SetDefaultAddressType(addrType AddressType) error
+
 	// IsOurAddress checks if the passed address belongs to this wallet
 	IsOurAddress(a btcutil.Address) bool
 
+	// AddressInfo returns the information the wallet has stored about the
+	// passed address. If the address doesn't belong to the wallet,
+	// ErrNotMine is returned.
+	AddressInfo(a btcutil.Address) (waddrmgr.ManagedAddress, error)
+
 	// ListAccounts retrieves all accounts belonging to the wallet by
 	// default. A name and key scope filter can be provided to filter
 	// through all of the wallet accounts and return only those matching.
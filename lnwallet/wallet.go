@@ -731,7 +731,8 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 			FeeRate:      req.FundingFeePerKw,
 			ChangeAddr: func() (btcutil.Address, error) {
 				return l.NewAddress(
-					WitnessPubKey, true, DefaultAccountName,
+					l.DefaultAddressType(), true,
+					DefaultAccountName,
 				)
 			},
 		}
@@ -940,6 +941,30 @@ func (l *LightningWallet) currentNumAnchorChans() (int, error) {
 	return numAnchors, nil
 }
 
+// anchorReservedValue returns the value we reserve in the wallet for fee
+// bumping the given number of anchor channels.
+func anchorReservedValue(numAnchorChans int) btcutil.Amount {
+	// We reserve a given amount for each anchor channel.
+	reserved := btcutil.Amount(numAnchorChans) * anchorChanReservedValue
+	if reserved > maxAnchorChanReservedValue {
+		reserved = maxAnchorChanReservedValue
+	}
+
+	return reserved
+}
+
+// RequiredReservedValue returns the value the wallet currently has to keep in
+// reserve for fee bumping the anchor channels that are open, pending open or
+// waiting to be closed.
+func (l *LightningWallet) RequiredReservedValue() (btcutil.Amount, error) {
+	numAnchors, err := l.currentNumAnchorChans()
+	if err != nil {
+		return 0, err
+	}
+
+	return anchorReservedValue(numAnchors), nil
+}
+
 // CheckReservedValue checks whether publishing a transaction with the given
 // inputs and outputs would violate the value we reserve in the wallet for
 // bumping the fee of anchor channels. The numAnchorChans argument should be
@@ -1012,12 +1037,7 @@ func (l *LightningWallet) CheckReservedValue(in []wire.OutPoint,
 		}
 	}
 
-	// We reserve a given amount for each anchor channel.
-	reserved := btcutil.Amount(numAnchorChans) * anchorChanReservedValue
-	if reserved > maxAnchorChanReservedValue {
-		reserved = maxAnchorChanReservedValue
-	}
-
+	reserved := anchorReservedValue(numAnchorChans)
 	if walletBalance < reserved {
 		walletLog.Debugf("Reserved value=%v above final "+
 			"walletbalance=%v with %d anchor channels open",
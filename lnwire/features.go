@@ -129,6 +129,16 @@ const (
 	// transactions, which also imply anchor commitments.
 	AnchorsZeroFeeHtlcTxOptional FeatureBit = 23
 
+	// RouteBlindingRequired is a required feature bit that signals that the
+	// node is able to relay payments over blinded paths, i.e. that it can
+	// process HTLCs carrying a blinding point.
+	RouteBlindingRequired FeatureBit = 24
+
+	// RouteBlindingOptional is an optional feature bit that signals that
+	// the node is able to relay payments over blinded paths, i.e. that it
+	// can process HTLCs carrying a blinding point.
+	RouteBlindingOptional FeatureBit = 25
+
 	// AMPRequired is a required feature bit that signals that the receiver
 	// of a payment supports accepts spontaneous payments, i.e.
 	// sender-generated preimages according to BOLT XX.
@@ -202,6 +212,8 @@ var Features = map[FeatureBit]string{
 	AnchorsZeroFeeHtlcTxOptional:  "anchors-zero-fee-htlc-tx",
 	WumboChannelsRequired:         "wumbo-channels",
 	WumboChannelsOptional:         "wumbo-channels",
+	RouteBlindingRequired:         "route-blinding",
+	RouteBlindingOptional:         "route-blinding",
 	AMPRequired:                   "amp",
 	AMPOptional:                   "amp",
 	ExplicitChannelTypeOptional:   "explicit-commitment-type",
@@ -303,6 +303,18 @@ type Config struct {
 	// closure initiated by the remote peer.
 	CoopCloseTargetConfs uint32
 
+	// CoopCloseFeeBand returns the current percentage around our last fee
+	// proposal within which we accept the fee proposed by the remote peer
+	// during a cooperative channel closure. If nil, the default of the
+	// chancloser package is used.
+	CoopCloseFeeBand func() uint32
+
+	// SweepToScript returns the script that our funds are paid out to in
+	// a cooperative channel closure if no upfront shutdown script was
+	// committed to. If nil or if it returns an empty script, a fresh
+	// wallet address is used.
+	SweepToScript func() lnwire.DeliveryAddress
+
 	// ServerPubKey is the serialized, compressed public key of our lnd node.
 	// It is used to determine which policy (channel edge) to pass to the
 	// ChannelLink.
@@ -337,6 +349,10 @@ type Brontide struct {
 	bytesReceived uint64
 	bytesSent     uint64
 
+	// MUST be used atomically.
+	msgsReceived uint64
+	msgsSent     uint64
+
 	// pingTime is a rough estimate of the RTT (round-trip-time) between us
 	// and the connected peer. This time is expressed in microseconds.
 	// To be used atomically.
@@ -822,6 +838,7 @@ func (p *Brontide) addLink(chanPoint *wire.OutPoint,
 		Registry:                p.cfg.Invoices,
 		BestHeight:              p.cfg.Switch.BestHeight,
 		Circuits:                p.cfg.Switch.CircuitModifier(),
+		CheckMaxTotalInFlight:   p.cfg.Switch.CheckMaxTotalInFlight,
 		ForwardPackets:          p.cfg.InterceptSwitch.ForwardPackets,
 		FwrdingPolicy:           *forwardingPolicy,
 		FeeEstimator:            p.cfg.FeeEstimator,
@@ -993,6 +1010,7 @@ func (p *Brontide) readNextMessage() (lnwire.Message, error) {
 	if err != nil {
 		return nil, err
 	}
+	atomic.AddUint64(&p.msgsReceived, 1)
 
 	p.logWireMessage(nextMsg, true)
 
@@ -1801,6 +1819,12 @@ func (p *Brontide) writeMessage(msg lnwire.Message) error {
 			atomic.AddUint64(&p.bytesSent, uint64(n))
 		}
 
+		// A message only counts as sent once it has been flushed
+		// completely, which may take several attempts.
+		if err == nil {
+			atomic.AddUint64(&p.msgsSent, 1)
+		}
+
 		return err
 	}
 
@@ -2125,6 +2149,16 @@ func (p *Brontide) ChannelSnapshots() []*channeldb.ChannelSnapshot {
 // genDeliveryScript returns a new script to be used to send our funds to in
 // the case of a cooperative channel close negotiation.
 func (p *Brontide) genDeliveryScript() ([]byte, error) {
+	// If a node-wide sweep address is set, all funds are delivered to it.
+	if p.cfg.SweepToScript != nil {
+		if script := p.cfg.SweepToScript(); len(script) > 0 {
+			peerLog.Infof("Delivering channel close funds to "+
+				"sweep script %x", script)
+
+			return script, nil
+		}
+	}
+
 	deliveryAddr, err := p.cfg.Wallet.NewAddress(
 		lnwallet.WitnessPubKey, false, lnwallet.DefaultAccountName,
 	)
@@ -2462,7 +2496,8 @@ func (p *Brontide) fetchActiveChanCloser(chanID lnwire.ChannelID) (
 				Disconnect: func() error {
 					return p.cfg.DisconnectPeer(p.IdentityKey())
 				},
-				Quit: p.quit,
+				FeeAcceptanceBand: p.coopCloseFeeBand(),
+				Quit:              p.quit,
 			},
 			deliveryScript,
 			feePerKw,
@@ -2476,6 +2511,17 @@ func (p *Brontide) fetchActiveChanCloser(chanID lnwire.ChannelID) (
 	return chanCloser, nil
 }
 
+// coopCloseFeeBand returns the fee acceptance band to use for a new
+// cooperative close negotiation. A value of zero makes the chancloser fall
+// back to its default.
+func (p *Brontide) coopCloseFeeBand() uint32 {
+	if p.cfg.CoopCloseFeeBand == nil {
+		return 0
+	}
+
+	return p.cfg.CoopCloseFeeBand()
+}
+
 // chooseDeliveryScript takes two optionally set shutdown scripts and returns
 // a suitable script to close out to. This may be nil if neither script is
 // set. If both scripts are set, this function will error if they do not match.
@@ -2580,7 +2626,8 @@ func (p *Brontide) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 				Disconnect: func() error {
 					return p.cfg.DisconnectPeer(p.IdentityKey())
 				},
-				Quit: p.quit,
+				FeeAcceptanceBand: p.coopCloseFeeBand(),
+				Quit:              p.quit,
 			},
 			deliveryScript,
 			req.TargetFeePerKw,
@@ -3191,6 +3238,16 @@ func (p *Brontide) BytesSent() uint64 {
 	return atomic.LoadUint64(&p.bytesSent)
 }
 
+// MessagesReceived returns the number of messages received from the peer.
+func (p *Brontide) MessagesReceived() uint64 {
+	return atomic.LoadUint64(&p.msgsReceived)
+}
+
+// MessagesSent returns the number of messages sent to the peer.
+func (p *Brontide) MessagesSent() uint64 {
+	return atomic.LoadUint64(&p.msgsSent)
+}
+
 // LastRemotePingPayload returns the last payload the remote party sent as part
 // of their ping.
 func (p *Brontide) LastRemotePingPayload() []byte {
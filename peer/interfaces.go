@@ -20,6 +20,11 @@ type messageSwitch interface {
 	// modifying them.
 	CircuitModifier() htlcswitch.CircuitModifier
 
+	// CheckMaxTotalInFlight returns a LinkError if the total value of the
+	// htlcs in flight through the messageSwitch exceeds its maximum.
+	CheckMaxTotalInFlight(
+		outgoing lnwire.ShortChannelID) *htlcswitch.LinkError
+
 	// RemoveLink removes an abstract link given a ChannelID.
 	RemoveLink(cid lnwire.ChannelID)
 
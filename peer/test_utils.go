@@ -387,6 +387,13 @@ func (m *mockMessageSwitch) CircuitModifier() htlcswitch.CircuitModifier {
 	return nil
 }
 
+// CheckMaxTotalInFlight currently returns a dummy value.
+func (m *mockMessageSwitch) CheckMaxTotalInFlight(
+	outgoing lnwire.ShortChannelID) *htlcswitch.LinkError {
+
+	return nil
+}
+
 // RemoveLink currently does nothing.
 func (m *mockMessageSwitch) RemoveLink(cid lnwire.ChannelID) {}
 
@@ -0,0 +1,64 @@
+package lnd
+
+import (
+	"fmt"
+	"time"
+)
+
+// peerBackoffPolicy describes how the delay between attempts to reconnect to a
+// persistent peer evolves. The delay starts at the min backoff, and grows by
+// the multiplier after each failed attempt until it reaches the max backoff.
+// Persistent peers are retried indefinitely at the max backoff.
+type peerBackoffPolicy struct {
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	multiplier float64
+}
+
+// validate returns an error if the policy would make the backoff shrink or
+// exceed its maximum.
+func (p peerBackoffPolicy) validate() error {
+	switch {
+	case p.minBackoff <= 0:
+		return fmt.Errorf("min backoff must be positive")
+
+	case p.minBackoff > p.maxBackoff:
+		return fmt.Errorf("max backoff %v must not be below min "+
+			"backoff %v", p.maxBackoff, p.minBackoff)
+
+	case p.multiplier < 1:
+		return fmt.Errorf("backoff multiplier %v must be at least 1",
+			p.multiplier)
+	}
+
+	return nil
+}
+
+// update returns a copy of the policy with the non-zero fields of the update
+// applied.
+func (p peerBackoffPolicy) update(u peerBackoffPolicy) peerBackoffPolicy {
+	if u.minBackoff != 0 {
+		p.minBackoff = u.minBackoff
+	}
+	if u.maxBackoff != 0 {
+		p.maxBackoff = u.maxBackoff
+	}
+	if u.multiplier != 0 {
+		p.multiplier = u.multiplier
+	}
+
+	return p
+}
+
+// clamp bounds the backoff by the min and max backoff of the policy.
+func (p peerBackoffPolicy) clamp(backoff time.Duration) time.Duration {
+	switch {
+	case backoff < p.minBackoff:
+		return p.minBackoff
+
+	case backoff > p.maxBackoff:
+		return p.maxBackoff
+	}
+
+	return backoff
+}
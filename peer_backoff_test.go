@@ -0,0 +1,151 @@
+// +build !rpctest
+
+package lnd
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// requireBackoffNear asserts that the backoff is within the 1/20 of
+// randomization that computeNextBackoff applies to the expected backoff.
+func requireBackoffNear(t *testing.T, expected, backoff time.Duration) {
+	t.Helper()
+
+	margin := expected / 20
+	require.GreaterOrEqual(t, int64(backoff), int64(expected-margin))
+	require.LessOrEqual(t, int64(backoff), int64(expected+margin))
+}
+
+// TestComputeNextBackoff asserts that the backoff grows by the multiplier of
+// the policy, and that a peer that keeps failing reaches the max backoff and
+// stays there.
+func TestComputeNextBackoff(t *testing.T) {
+	t.Parallel()
+
+	policy := peerBackoffPolicy{
+		minBackoff: time.Second,
+		maxBackoff: time.Minute,
+		multiplier: 3,
+	}
+
+	backoff := computeNextBackoff(policy.minBackoff, policy)
+	requireBackoffNear(t, 3*time.Second, backoff)
+
+	// After enough failures the max backoff is reached, after which the
+	// backoff doesn't grow any further.
+	backoff = policy.minBackoff
+	for i := 0; i < 20; i++ {
+		backoff = computeNextBackoff(backoff, policy)
+	}
+	requireBackoffNear(t, policy.maxBackoff, backoff)
+
+	// A multiplier of one retries at a fixed interval.
+	policy.multiplier = 1
+	backoff = computeNextBackoff(policy.minBackoff, policy)
+	requireBackoffNear(t, policy.minBackoff, backoff)
+
+	// A product that exceeds the range of a duration is truncated to the
+	// max backoff instead of overflowing.
+	policy.multiplier = math.MaxFloat64
+	backoff = computeNextBackoff(policy.maxBackoff, policy)
+	requireBackoffNear(t, policy.maxBackoff, backoff)
+}
+
+// TestPeerBackoffPolicy asserts that policies are validated, updated and used
+// to bound backoffs as expected.
+func TestPeerBackoffPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy := peerBackoffPolicy{
+		minBackoff: time.Second,
+		maxBackoff: time.Hour,
+		multiplier: 2,
+	}
+	require.NoError(t, policy.validate())
+
+	// Zero fields of an update keep the current values.
+	updated := policy.update(peerBackoffPolicy{maxBackoff: time.Minute})
+	require.Equal(t, peerBackoffPolicy{
+		minBackoff: time.Second,
+		maxBackoff: time.Minute,
+		multiplier: 2,
+	}, updated)
+
+	require.Equal(t, time.Second, updated.clamp(0))
+	require.Equal(t, 10*time.Second, updated.clamp(10*time.Second))
+	require.Equal(t, time.Minute, updated.clamp(time.Hour))
+
+	invalid := []peerBackoffPolicy{
+		policy.update(peerBackoffPolicy{minBackoff: -time.Second}),
+		policy.update(peerBackoffPolicy{minBackoff: 2 * time.Hour}),
+		policy.update(peerBackoffPolicy{multiplier: 0.5}),
+	}
+	for _, p := range invalid {
+		require.Error(t, p.validate(), "%+v", p)
+	}
+}
+
+// TestSetPeerBackoff asserts that backoff policies can be set for individual
+// peers and for all peers, and that the current backoffs of peers are bounded
+// by their new policy.
+func TestSetPeerBackoff(t *testing.T) {
+	t.Parallel()
+
+	const (
+		peer1 = "peer1"
+		peer2 = "peer2"
+	)
+
+	defaultPolicy := peerBackoffPolicy{
+		minBackoff: time.Second,
+		maxBackoff: time.Hour,
+		multiplier: 2,
+	}
+	s := &server{
+		persistentPeersBackoff: map[string]time.Duration{
+			peer1: 30 * time.Minute,
+			peer2: 30 * time.Minute,
+		},
+		defaultPeerBackoff:  defaultPolicy,
+		peerBackoffPolicies: make(map[string]peerBackoffPolicy),
+	}
+
+	// Lowering the max backoff of the first peer bounds its backoff, but
+	// not the one of the second peer.
+	policy, err := s.SetPeerBackoff(peer1, peerBackoffPolicy{
+		maxBackoff: time.Minute,
+	})
+	require.NoError(t, err)
+	require.Equal(t, time.Minute, policy.maxBackoff)
+	require.Equal(t, policy, s.PeerBackoff(peer1))
+	require.Equal(t, defaultPolicy, s.PeerBackoff(peer2))
+	require.Equal(t, time.Minute, s.persistentPeersBackoff[peer1])
+	require.Equal(t, 30*time.Minute, s.persistentPeersBackoff[peer2])
+
+	// An invalid update is rejected and leaves the policy untouched.
+	_, err = s.SetPeerBackoff(peer1, peerBackoffPolicy{
+		minBackoff: 2 * time.Minute,
+	})
+	require.Error(t, err)
+	require.Equal(t, policy, s.PeerBackoff(peer1))
+
+	// Raising the min backoff of the default policy applies to the second
+	// peer only, as the first one has a policy of its own.
+	policy, err = s.SetPeerBackoff("", peerBackoffPolicy{
+		minBackoff: 45 * time.Minute,
+	})
+	require.NoError(t, err)
+	require.Equal(t, policy, s.PeerBackoff(peer2))
+	require.Equal(t, time.Minute, s.persistentPeersBackoff[peer1])
+	require.Equal(t, 45*time.Minute, s.persistentPeersBackoff[peer2])
+
+	// Once the policy of the first peer is reset, the default policy
+	// applies to it again.
+	require.Equal(t, policy, s.ResetPeerBackoff(peer1))
+	require.Equal(t, policy, s.PeerBackoff(peer1))
+	require.Equal(t, 45*time.Minute, s.persistentPeersBackoff[peer1])
+}
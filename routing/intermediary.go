@@ -0,0 +1,122 @@
+package routing
+
+import (
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// findPathViaIntermediary returns a path finder that only returns paths to the
+// target that pass through the given intermediary node. Both legs of the path
+// are searched in our own view of the graph.
+//
+// The remainder of the path from the intermediary node to the target is
+// searched first. If no such path exists, errNoIntermediaryRoute is returned so
+// that the sender can distinguish a failure of the remainder from a failure to
+// reach the intermediary node itself. Afterwards a path to the intermediary
+// node is searched that is able to carry the amount, fees and time lock
+// required by the remainder.
+func findPathViaIntermediary(intermediary route.Vertex) pathFinder {
+	return func(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
+		source, target route.Vertex, amt lnwire.MilliSatoshi,
+		finalHtlcExpiry int32) ([]*channeldb.ChannelEdgePolicy,
+		error) {
+
+		// The outgoing channel restriction only applies to the first
+		// hop of the full path, which isn't part of the remainder.
+		remainderRestrictions := *r
+		remainderRestrictions.OutgoingChannelIDs = nil
+
+		remainder, err := findPath(
+			g, &remainderRestrictions, cfg, intermediary, target,
+			amt, finalHtlcExpiry,
+		)
+		switch {
+		case isNoPathError(err):
+			log.Debugf("No path from intermediary node %v to %v: %v",
+				intermediary, target, err)
+
+			return nil, errNoIntermediaryRoute
+
+		case err != nil:
+			return nil, err
+		}
+
+		// Walk the remainder backwards to determine the amount and
+		// time lock that the intermediary node needs to receive. Unlike
+		// the source of a path, the intermediary node charges a fee and
+		// adds its time lock delta for forwarding over its channel.
+		intermediaryAmt := amt
+		intermediaryExpiry := finalHtlcExpiry
+		for i := len(remainder) - 1; i >= 0; i-- {
+			edge := remainder[i]
+
+			intermediaryAmt += edge.ComputeFee(intermediaryAmt)
+			intermediaryExpiry += int32(edge.TimeLockDelta)
+		}
+
+		// The fees and time lock of the remainder count towards the
+		// limits of the full path.
+		remainderFee := intermediaryAmt - amt
+		remainderDelta := uint32(intermediaryExpiry - finalHtlcExpiry)
+
+		feeLimitHit := remainderFee > r.FeeLimit
+		cltvLimitHit := remainderDelta > r.CltvLimit
+		if feeLimitHit || cltvLimitHit {
+			return nil, limitNoRouteError(cltvLimitHit, feeLimitHit)
+		}
+
+		// The hops of the remainder also count towards the hop limit,
+		// which must leave room for at least one hop to the intermediary
+		// node.
+		remainderHops := uint32(len(remainder))
+		if r.MaxHops != 0 && remainderHops >= r.MaxHops {
+			log.Debugf("Path from intermediary node %v to %v "+
+				"exceeds hop limit of %v", intermediary, target,
+				r.MaxHops)
+
+			return nil, errNoPathFound
+		}
+
+		// The destination specific restrictions only apply to the
+		// remainder, so they are cleared for the path to the
+		// intermediary node.
+		intermediaryRestrictions := *r
+		intermediaryRestrictions.FeeLimit = r.FeeLimit - remainderFee
+		intermediaryRestrictions.CltvLimit = r.CltvLimit - remainderDelta
+		if r.MaxHops != 0 {
+			intermediaryRestrictions.MaxHops -= remainderHops
+		}
+		intermediaryRestrictions.LastHop = nil
+		intermediaryRestrictions.DestCustomRecords = nil
+		intermediaryRestrictions.DestFeatures = nil
+		intermediaryRestrictions.PaymentAddr = nil
+
+		path, err := findPath(
+			g, &intermediaryRestrictions, cfg, source, intermediary,
+			intermediaryAmt, intermediaryExpiry,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// Make sure that the full path doesn't visit a node twice,
+		// which could happen if both legs share a node.
+		visited := map[route.Vertex]struct{}{
+			source: {},
+		}
+		fullPath := append(path, remainder...)
+		for _, edge := range fullPath {
+			node := edge.Node.PubKeyBytes
+			if _, ok := visited[node]; ok {
+				log.Debugf("Path via intermediary node %v "+
+					"visits %v twice", intermediary, node)
+
+				return nil, errNoPathFound
+			}
+			visited[node] = struct{}{}
+		}
+
+		return fullPath, nil
+	}
+}
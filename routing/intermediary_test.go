@@ -0,0 +1,91 @@
+package routing
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFindPathViaIntermediary tests that paths found via an intermediary node
+// pass through that node and that an intermediary node without a path to the
+// target results in an intermediary specific error.
+func TestFindPathViaIntermediary(t *testing.T) {
+	t.Parallel()
+
+	policy := &testChannelPolicy{
+		Expiry:      40,
+		FeeBaseMsat: 1000,
+		MinHTLC:     1,
+	}
+
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 100000, policy, 1),
+		symmetricTestChannel("roasbeef", "b", 100000, policy, 2),
+		symmetricTestChannel("a", "target", 100000, policy, 3),
+		symmetricTestChannel("b", "c", 100000, policy, 4),
+		symmetricTestChannel("c", "target", 100000, policy, 5),
+		symmetricTestChannel("e", "f", 100000, policy, 6),
+	}
+
+	ctx := newPathFindingTestContext(t, testChannels, "roasbeef")
+	defer ctx.cleanup()
+
+	target := ctx.keyFromAlias("target")
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+
+	findPathVia := func(intermediary route.Vertex) ([]uint64, error) {
+		routingTx, err := newDbRoutingTx(ctx.graph)
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, routingTx.close())
+		}()
+
+		path, err := findPathViaIntermediary(intermediary)(
+			&graphParams{
+				bandwidthHints: ctx.bandwidthHints,
+				graph:          routingTx,
+			},
+			&ctx.restrictParams, &ctx.pathFindingConfig,
+			ctx.source, target, paymentAmt, 0,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		chanIDs := make([]uint64, 0, len(path))
+		for _, edge := range path {
+			chanIDs = append(chanIDs, edge.ChannelID)
+		}
+
+		return chanIDs, nil
+	}
+
+	// Without an intermediary node, the shortest path via a is taken.
+	path, err := ctx.findPath(target, paymentAmt)
+	require.NoError(t, err)
+	ctx.assertPath(path, []uint64{1, 3})
+
+	// Via intermediary node b, the longer path through b and c is
+	// expected.
+	chanIDs, err := findPathVia(ctx.keyFromAlias("b"))
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 4, 5}, chanIDs)
+
+	// Intermediary node e has no path to the target, which should be
+	// reported with the intermediary specific error.
+	_, err = findPathVia(ctx.keyFromAlias("e"))
+	require.Equal(t, errNoIntermediaryRoute, err)
+	require.Equal(
+		t, channeldb.FailureReasonIntermediaryNoRoute,
+		errNoIntermediaryRoute.FailureReason(),
+	)
+
+	// If the fee limit doesn't cover the fees of the remainder, the fee
+	// limit error is returned.
+	ctx.restrictParams.FeeLimit = 1000
+	_, err = findPathVia(ctx.keyFromAlias("b"))
+	require.Equal(t, errFeeLimitExceeded, err)
+}
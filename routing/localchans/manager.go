@@ -3,6 +3,7 @@ package localchans
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightningnetwork/lnd/channeldb"
@@ -37,6 +38,10 @@ type Manager struct {
 	FetchChannel func(tx kvdb.RTx, chanPoint wire.OutPoint) (
 		*channeldb.OpenChannel, error)
 
+	// RecordPolicyUpdates is used to add the updated policies to the
+	// policy history.
+	RecordPolicyUpdates func(updates []channeldb.PolicyUpdate) error
+
 	// policyUpdateLock ensures that the database and the link do not fall
 	// out of sync if there are concurrent fee update calls. Without it,
 	// there is a chance that policy A updates the database, then policy B
@@ -80,9 +85,17 @@ func (r *Manager) UpdatePolicy(newSchema routing.ChannelPolicy,
 			return nil
 		}
 
-		// Apply the new policy to the edge.
+		// Apply the new policy to the edge. If the channel was
+		// explicitly targeted, an invalid policy fails the whole
+		// update, so that the caller learns about it. Otherwise we skip
+		// the channel and continue with the others.
 		err := r.updateEdge(tx, info.ChannelPoint, edge, newSchema)
-		if err != nil {
+		switch {
+		case err != nil && haveChanFilter:
+			return fmt.Errorf("cannot update policy for %v: %v",
+				info.ChannelPoint, err)
+
+		case err != nil:
 			log.Warnf("Cannot update policy for %v: %v\n",
 				info.ChannelPoint, err,
 			)
@@ -123,6 +136,30 @@ func (r *Manager) UpdatePolicy(newSchema routing.ChannelPolicy,
 	// Update active links.
 	r.UpdateForwardingPolicies(policiesToUpdate)
 
+	// Finally, record the new policies in the policy history. As the
+	// policies are already in effect, a failure to do so doesn't fail the
+	// update.
+	now := time.Now()
+	historyUpdates := make([]channeldb.PolicyUpdate, 0, len(edgesToUpdate))
+	for _, edgeToUpdate := range edgesToUpdate {
+		edge := edgeToUpdate.Edge
+		historyUpdates = append(historyUpdates, channeldb.PolicyUpdate{
+			ChanID: lnwire.NewShortChanIDFromInt(
+				edgeToUpdate.Info.ChannelID,
+			),
+			Timestamp:     now,
+			BaseFee:       edge.FeeBaseMSat,
+			FeeRate:       edge.FeeProportionalMillionths,
+			TimeLockDelta: edge.TimeLockDelta,
+			MinHTLC:       edge.MinHTLC,
+			MaxHTLC:       edge.MaxHTLC,
+		})
+	}
+
+	if err := r.RecordPolicyUpdates(historyUpdates); err != nil {
+		log.Errorf("Unable to record policy updates: %v", err)
+	}
+
 	return nil
 }
 
@@ -186,8 +223,8 @@ func (r *Manager) updateEdge(tx kvdb.RTx, chanPoint wire.OutPoint,
 			edge.MaxHTLC, amtMax, chanPoint)
 
 	case edge.MinHTLC > edge.MaxHTLC:
-		return fmt.Errorf("min_htlc %v greater than max_htlc %v",
-			edge.MinHTLC, edge.MaxHTLC)
+		return fmt.Errorf("max_htlc %v is below min_htlc %v",
+			edge.MaxHTLC, edge.MinHTLC)
 	}
 
 	// Clear signature to help prevent usage of the previous signature.
@@ -118,11 +118,34 @@ func TestManager(t *testing.T) {
 		}, nil
 	}
 
+	recordPolicyUpdates := func(updates []channeldb.PolicyUpdate) error {
+		if len(updates) != 1 {
+			t.Fatal("unexpected number of policy updates to record")
+		}
+
+		update := updates[0]
+		if update.TimeLockDelta != uint16(newPolicy.TimeLockDelta) {
+			t.Fatal("unexpected time lock delta")
+		}
+		if update.BaseFee != newPolicy.BaseFee {
+			t.Fatal("unexpected base fee")
+		}
+		if uint32(update.FeeRate) != newPolicy.FeeRate {
+			t.Fatal("unexpected fee rate")
+		}
+		if update.MaxHTLC != newPolicy.MaxHTLC {
+			t.Fatal("unexpected max htlc")
+		}
+
+		return nil
+	}
+
 	manager := Manager{
 		UpdateForwardingPolicies:  updateForwardingPolicies,
 		PropagateChanPolicyUpdate: propagateChanPolicyUpdate,
 		ForAllOutgoingChannels:    forAllOutgoingChannels,
 		FetchChannel:              fetchChannel,
+		RecordPolicyUpdates:       recordPolicyUpdates,
 	}
 
 	// Test updating a specific channels.
@@ -148,4 +171,14 @@ func TestManager(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+
+	// A max htlc below the channel's min htlc must be rejected if the
+	// channel is targeted explicitly.
+	belowMinPolicy := newPolicy
+	belowMinPolicy.MaxHTLC = minHTLC - 1
+
+	err = manager.UpdatePolicy(belowMinPolicy, chanPoint)
+	if err == nil {
+		t.Fatal("expected max htlc below min htlc to be rejected")
+	}
 }
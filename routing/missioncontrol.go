@@ -42,6 +42,10 @@ const (
 	// restrictive channel level tracking scheme here.
 	minSecondChanceInterval = time.Minute
 
+	// maxPairObservations is the maximum number of raw payment results
+	// that are kept in memory per node pair.
+	maxPairObservations = 10
+
 	// DefaultMaxMcHistory is the default maximum history size.
 	DefaultMaxMcHistory = 1000
 
@@ -196,6 +200,23 @@ type MissionControlPairSnapshot struct {
 	TimedPairResult
 }
 
+// PairObservation is a single raw payment result that mission control learned
+// for a node pair.
+type PairObservation struct {
+	// Timestamp is the time at which the result was reported.
+	Timestamp time.Time
+
+	// Amt is the amount that was attempted to forward across the pair.
+	Amt lnwire.MilliSatoshi
+
+	// Success indicates whether the amount was forwarded successfully.
+	Success bool
+}
+
+// PairObservations maps node pairs to their recent raw payment results,
+// ordered from oldest to newest.
+type PairObservations map[DirectedNodePair][]PairObservation
+
 // paymentResult is the information that becomes available when a payment
 // attempt completes.
 type paymentResult struct {
@@ -381,6 +402,16 @@ func (m *MissionControl) ImportHistory(history *MissionControlSnapshot) error {
 	return nil
 }
 
+// GetPairObservations returns the most recent raw payment results mission
+// control learned from, keyed by node pair and ordered oldest first. Pairs for
+// which no results were observed are omitted.
+func (m *MissionControl) GetPairObservations() PairObservations {
+	m.Lock()
+	defer m.Unlock()
+
+	return m.state.getPairObservations()
+}
+
 // GetPairHistorySnapshot returns the stored history for a given node pair.
 func (m *MissionControl) GetPairHistorySnapshot(
 	fromNode, toNode route.Vertex) TimedPairResult {
@@ -522,6 +553,12 @@ func (m *MissionControl) applyPaymentResult(
 		m.state.setLastPairResult(
 			pair.From, pair.To, result.timeReply, &pairResult,
 		)
+
+		m.state.addPairObservation(pair, PairObservation{
+			Timestamp: result.timeReply,
+			Amt:       pairResult.amt,
+			Success:   pairResult.success,
+		})
 	}
 
 	return i.finalFailureReason
@@ -20,6 +20,11 @@ type missionControlState struct {
 	// a directed node pair.
 	lastSecondChance map[DirectedNodePair]time.Time
 
+	// pairObservations tracks the most recent raw payment results for each
+	// directed node pair, oldest first. At most maxPairObservations
+	// entries are kept per pair.
+	pairObservations PairObservations
+
 	// minFailureRelaxInterval is the minimum time that must have passed
 	// since the previously recorded failure before the failure amount may
 	// be raised.
@@ -33,6 +38,7 @@ func newMissionControlState(
 	return &missionControlState{
 		lastPairResult:          make(map[route.Vertex]NodeResults),
 		lastSecondChance:        make(map[DirectedNodePair]time.Time),
+		pairObservations:        make(PairObservations),
 		minFailureRelaxInterval: minFailureRelaxInterval,
 	}
 }
@@ -50,6 +56,37 @@ func (m *missionControlState) getLastPairResult(node route.Vertex) (NodeResults,
 func (m *missionControlState) resetHistory() {
 	m.lastPairResult = make(map[route.Vertex]NodeResults)
 	m.lastSecondChance = make(map[DirectedNodePair]time.Time)
+	m.pairObservations = make(PairObservations)
+}
+
+// addPairObservation records a raw payment result for a node pair. Once the
+// maximum number of observations for the pair is reached, the oldest one is
+// dropped.
+func (m *missionControlState) addPairObservation(pair DirectedNodePair,
+	observation PairObservation) {
+
+	observations := append(m.pairObservations[pair], observation)
+	if len(observations) > maxPairObservations {
+		observations = observations[len(observations)-
+			maxPairObservations:]
+	}
+
+	m.pairObservations[pair] = observations
+}
+
+// getPairObservations returns a copy of the recorded observations of all
+// pairs. Pairs without any observations are not included.
+func (m *missionControlState) getPairObservations() PairObservations {
+	result := make(PairObservations, len(m.pairObservations))
+	for pair, observations := range m.pairObservations {
+		if len(observations) == 0 {
+			continue
+		}
+
+		result[pair] = append([]PairObservation(nil), observations...)
+	}
+
+	return result
 }
 
 // setLastPairResult stores a result for a node pair.
@@ -223,3 +223,62 @@ func TestMissionControlChannelUpdate(t *testing.T) {
 	)
 	ctx.expectP(100, 0)
 }
+
+// TestMissionControlPairObservations tests that mission control keeps a
+// bounded history of raw results per pair and omits pairs without results.
+func TestMissionControlPairObservations(t *testing.T) {
+	ctx := createMcTestContext(t)
+	defer ctx.cleanup()
+
+	pair := NewDirectedNodePair(mcTestNode1, mcTestNode2)
+
+	// Without any reported results, no observations are expected.
+	require.Empty(t, ctx.mc.GetPairObservations())
+
+	// Importing history for a pair should not produce observations for it,
+	// because no raw results were learned.
+	importedPair := NewDirectedNodePair(mcTestNode2, mcTestNode1)
+	err := ctx.mc.ImportHistory(&MissionControlSnapshot{
+		Pairs: []MissionControlPairSnapshot{{
+			Pair: importedPair,
+			TimedPairResult: TimedPairResult{
+				SuccessTime: mcTestTime,
+				SuccessAmt:  1000,
+			},
+		}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, ctx.mc.GetPairObservations())
+
+	// Report more failures than we keep observations for. Only the most
+	// recent ones should be returned, oldest first.
+	for i := 0; i < maxPairObservations+2; i++ {
+		ctx.now = mcTestTime.Add(time.Duration(i) * time.Hour)
+		ctx.reportFailure(
+			lnwire.MilliSatoshi(1000+i),
+			lnwire.NewTemporaryChannelFailure(nil),
+		)
+	}
+
+	observations := ctx.mc.GetPairObservations()
+	require.NotContains(t, observations, importedPair)
+
+	pairObservations := observations[pair]
+	require.Len(t, pairObservations, maxPairObservations)
+
+	for i, observation := range pairObservations {
+		offset := i + 2
+		require.False(t, observation.Success)
+		require.Equal(
+			t, lnwire.MilliSatoshi(1000+offset), observation.Amt,
+		)
+		require.Equal(
+			t, mcTestTime.Add(time.Duration(offset)*time.Hour),
+			observation.Timestamp,
+		)
+	}
+
+	// Resetting mission control should clear all observations.
+	require.NoError(t, ctx.mc.ResetHistory())
+	require.Empty(t, ctx.mc.GetPairObservations())
+}
@@ -721,6 +721,7 @@ func (m *mockControlTower) FetchPayment(phash lntypes.Hash) (
 	p := args.Get(0).(*channeldb.MPPayment)
 	payment := &channeldb.MPPayment{
 		FailureReason: p.FailureReason,
+		Status:        p.Status,
 	}
 	payment.HTLCs = make([]channeldb.HTLCAttempt, len(p.HTLCs))
 	copy(payment.HTLCs, p.HTLCs)
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/bits"
 	"time"
 
 	sphinx "github.com/lightningnetwork/lightning-onion"
@@ -32,6 +33,12 @@ const (
 	// tweaking and/or be made configurable in the future.
 	RiskFactorBillionths = 15
 
+	// maxWeightTerm caps each of the fee and time lock terms of the weight
+	// of an edge. It is far above any realistic fee, yet small enough that
+	// the weights of all edges of a route can be summed up without
+	// overflowing, even for a custom cost function with large weights.
+	maxWeightTerm = 1 << 53
+
 	// estimatedNodeCount is used to preallocate the path finding structures
 	// to avoid resizing and copies. It should be number on the same order as
 	// the number of active nodes in the network.
@@ -254,22 +261,81 @@ func newRoute(sourceVertex route.Vertex,
 	return newRoute, nil
 }
 
+// CostFunction describes the linear trade-off between fees and time lock that
+// is used to rank routes during path finding. The weight of an edge is the fee
+// multiplied by FeeWeight, plus a time lock penalty that scales with
+// TimeLockWeight.
+type CostFunction struct {
+	// FeeWeight is the factor that the fee charged by a hop is multiplied
+	// with.
+	FeeWeight uint32
+
+	// TimeLockWeight controls the influence of the time lock delta of a
+	// channel. It is expressed as billionths of msat per msat sent through
+	// the channel per time lock delta block.
+	TimeLockWeight uint32
+}
+
+// DefaultCostFunction is the cost function that is used if the caller didn't
+// specify one.
+var DefaultCostFunction = CostFunction{
+	FeeWeight:      1,
+	TimeLockWeight: RiskFactorBillionths,
+}
+
 // edgeWeight computes the weight of an edge. This value is used when searching
 // for the shortest path within the channel graph between two nodes. Weight is
-// is the fee itself plus a time lock penalty added to it. This benefits
-// channels with shorter time lock deltas and shorter (hops) routes in general.
-// RiskFactor controls the influence of time lock on route selection. This is
-// currently a fixed value, but might be configurable in the future.
+// is the weighted fee itself plus a time lock penalty added to it. This
+// benefits channels with shorter time lock deltas and shorter (hops) routes in
+// general. TimeLockWeight controls the influence of time lock on route
+// selection.
+func (c CostFunction) edgeWeight(lockedAmt lnwire.MilliSatoshi,
+	fee lnwire.MilliSatoshi, timeLockDelta uint16) int64 {
+
+	// timeLockPenalty is the penalty for the time lock delta of this channel.
+	// It is controlled by TimeLockWeight and scales proportional to the
+	// amount that will pass through channel. Rationale is that it if a
+	// twice as large amount gets locked up, it is twice as bad.
+	timeLockPenalty := weightTerm(
+		uint64(lockedAmt), uint64(timeLockDelta),
+		uint64(c.TimeLockWeight), 1000000000,
+	)
+
+	return weightTerm(uint64(fee), uint64(c.FeeWeight), 1, 1) +
+		timeLockPenalty
+}
+
+// weightTerm returns a*b*c/div, capped at maxWeightTerm. The product is
+// computed exactly if it fits into 64 bits, which is always the case for the
+// default cost function and realistic amounts. Otherwise it is approximated
+// in floating point, which is sufficient to rank the edge.
+func weightTerm(a, b, c, div uint64) int64 {
+	hi, product := bits.Mul64(a, b)
+	if hi == 0 {
+		hi, product = bits.Mul64(product, c)
+	}
+	if hi == 0 {
+		product /= div
+		if product > maxWeightTerm {
+			return maxWeightTerm
+		}
+
+		return int64(product)
+	}
+
+	term := float64(a) * float64(b) * float64(c) / float64(div)
+	if term > maxWeightTerm {
+		return maxWeightTerm
+	}
+
+	return int64(term)
+}
+
+// edgeWeight computes the weight of an edge using the default cost function.
 func edgeWeight(lockedAmt lnwire.MilliSatoshi, fee lnwire.MilliSatoshi,
 	timeLockDelta uint16) int64 {
-	// timeLockPenalty is the penalty for the time lock delta of this channel.
-	// It is controlled by RiskFactorBillionths and scales proportional
-	// to the amount that will pass through channel. Rationale is that it if
-	// a twice as large amount gets locked up, it is twice as bad.
-	timeLockPenalty := int64(lockedAmt) * int64(timeLockDelta) *
-		RiskFactorBillionths / 1000000000
 
-	return int64(fee) + timeLockPenalty
+	return DefaultCostFunction.edgeWeight(lockedAmt, fee, timeLockDelta)
 }
 
 // graphParams wraps the set of graph parameters passed to findPath.
@@ -330,6 +396,22 @@ type RestrictParams struct {
 	// mitigate probing vectors and payment sniping attacks on overpaid
 	// invoices.
 	PaymentAddr *[32]byte
+
+	// MinProbability is an optional per-payment lower bound on the success
+	// probability of the returned route. If non-zero, it overrides the
+	// global MinProbability of the PathFindingConfig.
+	MinProbability float64
+
+	// CostFunction is an optional custom trade-off between fees and time
+	// lock that is used to rank routes. If nil, DefaultCostFunction is
+	// used. If set, a failure to find a path reports which of the hard
+	// limits FeeLimit and CltvLimit prevented a path from being found.
+	CostFunction *CostFunction
+
+	// MaxHops is an optional upper bound on the number of hops of the
+	// returned path. If zero, the path length is only limited by the
+	// size of the onion packet.
+	MaxHops uint32
 }
 
 // PathFindingConfig defines global parameters that control the trade-off in
@@ -583,6 +665,46 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 	log.Debugf("Pathfinding absolute attempt cost: %v sats",
 		float64(absoluteAttemptCost)/1000)
 
+	// Determine the cost function to rank edges with.
+	costFunc := DefaultCostFunction
+	if r.CostFunction != nil {
+		costFunc = *r.CostFunction
+	}
+
+	// Determine the minimum success probability that the route must
+	// have. A per-payment value takes precedence over the global one.
+	minProbability := cfg.MinProbability
+	if r.MinProbability != 0 {
+		minProbability = r.MinProbability
+	}
+
+	// Keep track of the first edge that was discarded because of the hard
+	// cltv and fee limits or the probability threshold, so that we can
+	// report the reason that pruned the search if no path is found. Only
+	// the reasons the caller asked for are recorded: the probability
+	// threshold if a minimum probability was set, and the hard limits if
+	// a custom cost function was set.
+	pruneReason := errNoPathFound
+	recordPruneReason := func(reason noRouteError) {
+		if pruneReason != errNoPathFound {
+			return
+		}
+
+		switch reason {
+		case errNoRouteAboveProbability:
+			if r.MinProbability == 0 {
+				return
+			}
+
+		default:
+			if r.CostFunction == nil {
+				return
+			}
+		}
+
+		pruneReason = reason
+	}
+
 	// processEdge is a helper closure that will be used to make sure edges
 	// satisfy our specific requirements.
 	processEdge := func(fromVertex route.Vertex,
@@ -632,9 +754,7 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		incomingCltv := toNodeDist.incomingCltv + int32(timeLockDelta)
 
 		// Check that we are within our CLTV limit.
-		if uint64(incomingCltv) > absoluteCltvLimit {
-			return
-		}
+		exceedsCltvLimit := uint64(incomingCltv) > absoluteCltvLimit
 
 		// amountToReceive is the amount that the node that is added to
 		// the distance map needs to receive from a (to be found)
@@ -646,7 +766,14 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		// Check if accumulated fees would exceed fee limit when this
 		// node would be added to the path.
 		totalFee := amountToReceive - amt
-		if totalFee > r.FeeLimit {
+		exceedsFeeLimit := totalFee > r.FeeLimit
+
+		// Record which of the limits were hit before abandoning this
+		// edge, so that they can be reported if no path is found.
+		if exceedsCltvLimit || exceedsFeeLimit {
+			recordPruneReason(limitNoRouteError(
+				exceedsCltvLimit, exceedsFeeLimit,
+			))
 			return
 		}
 
@@ -658,7 +785,8 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		// If the probability is below the specified lower bound, we can
 		// abandon this direction. Adding further nodes can only lower
 		// the probability more.
-		if probability < cfg.MinProbability {
+		if probability < minProbability {
+			recordPruneReason(errNoRouteAboveProbability)
 			return
 		}
 
@@ -666,7 +794,9 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		// weight composed of the fee that this node will charge and
 		// the amount that will be locked for timeLockDelta blocks in
 		// the HTLC that is handed out to fromVertex.
-		weight := edgeWeight(amountToReceive, fee, timeLockDelta)
+		weight := costFunc.edgeWeight(
+			amountToReceive, fee, timeLockDelta,
+		)
 
 		// Compute the tentative weight to this new channel/edge
 		// which is the weight from our toNode to the target node
@@ -736,6 +866,12 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 			return
 		}
 
+		// Skip paths that would exceed the maximum number of hops.
+		hops := toNodeDist.hops + 1
+		if r.MaxHops != 0 && hops > r.MaxHops {
+			return
+		}
+
 		// All conditions are met and this new tentative distance is
 		// better than the current best known distance to this node.
 		// The new better distance is recorded, and also our "next hop"
@@ -749,6 +885,7 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 			probability:     probability,
 			nextHop:         edge,
 			routingInfoSize: routingInfoSize,
+			hops:            hops,
 		}
 		distance[fromVertex] = withDist
 
@@ -820,6 +957,25 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 			u.addPolicy(reverseEdge.sourceNode, reverseEdge.edge, 0)
 		}
 
+		// A route to self must leave and return over different
+		// channels, otherwise it wouldn't rebalance anything. If the
+		// first hop is restricted to a single channel, that channel
+		// is excluded when choosing the last hop. Otherwise, the
+		// source can't reach a pivot over the channel that the path
+		// from the pivot returns over.
+		if routeToSelf {
+			nextHop := partialPath.nextHop
+			switch {
+			case pivot == target && len(r.OutgoingChannelIDs) == 1:
+				u.removeChannel(r.OutgoingChannelIDs[0])
+
+			case nextHop != nil &&
+				nextHop.Node.PubKeyBytes == target:
+
+				u.removeChannel(nextHop.ChannelID)
+			}
+		}
+
 		amtToSend := partialPath.amountToReceive
 
 		// Expand all connections using the optimal policy for each
@@ -890,7 +1046,9 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		currentNodeWithDist, ok := distance[currentNode]
 		if !ok {
 			// If the node doesnt have a next hop it means we didn't find a path.
-			return nil, errNoPathFound
+			// We'll report the first reason that pruned the
+			// search, which is errNoPathFound if there was none.
+			return nil, pruneReason
 		}
 
 		// Add the next hop to the list of path edges.
@@ -2432,6 +2432,246 @@ func TestCltvLimit(t *testing.T) {
 	t.Run("force high cost", func(t *testing.T) { testCltvLimit(t, 80, 3) })
 }
 
+// TestCostFunctionLimitErrors asserts that if a custom cost function is set and
+// no path can be found, the error names the hard limit that was binding.
+func TestCostFunctionLimitErrors(t *testing.T) {
+	t.Parallel()
+
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 100000, &testChannelPolicy{}, 1),
+		symmetricTestChannel("a", "target", 100000, &testChannelPolicy{
+			Expiry:      144,
+			FeeBaseMsat: 10000,
+			MinHTLC:     1,
+		}),
+	}
+
+	testCases := []struct {
+		name        string
+		cltvLimit   uint32
+		feeLimit    lnwire.MilliSatoshi
+		expectedErr error
+	}{{
+		name:        "cltv limit",
+		cltvLimit:   50,
+		feeLimit:    noFeeLimit,
+		expectedErr: errCltvLimitExceeded,
+	}, {
+		name:        "fee limit",
+		cltvLimit:   2016,
+		feeLimit:    1000,
+		expectedErr: errFeeLimitExceeded,
+	}, {
+		name:        "cltv and fee limit",
+		cltvLimit:   50,
+		feeLimit:    1000,
+		expectedErr: errCltvAndFeeLimitExceeded,
+	}}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := newPathFindingTestContext(
+				t, testChannels, "roasbeef",
+			)
+			defer ctx.cleanup()
+
+			ctx.restrictParams.CltvLimit = testCase.cltvLimit
+			ctx.restrictParams.FeeLimit = testCase.feeLimit
+			ctx.restrictParams.CostFunction = &CostFunction{
+				FeeWeight:      1,
+				TimeLockWeight: 100,
+			}
+
+			target := ctx.keyFromAlias("target")
+			_, err := ctx.findPath(
+				target, lnwire.NewMSatFromSatoshis(100),
+			)
+			if err != testCase.expectedErr {
+				t.Fatalf("expected error %v, got %v",
+					testCase.expectedErr, err)
+			}
+		})
+	}
+}
+
+// TestCostFunctionLargeWeights asserts that the weight of an edge saturates
+// instead of overflowing for large custom weights, so that such an edge still
+// ranks worse than a cheaper one.
+func TestCostFunctionLargeWeights(t *testing.T) {
+	t.Parallel()
+
+	costFunc := CostFunction{
+		FeeWeight:      math.MaxUint32,
+		TimeLockWeight: math.MaxUint32,
+	}
+
+	amt := lnwire.NewMSatFromSatoshis(btcutil.SatoshiPerBitcoin)
+	cheap := costFunc.edgeWeight(amt, 1, 1)
+	expensive := costFunc.edgeWeight(
+		amt, lnwire.MilliSatoshi(math.MaxUint32)*1000, math.MaxUint16,
+	)
+
+	if cheap <= 0 || expensive <= cheap {
+		t.Fatalf("expected 0 < %v < %v", cheap, expensive)
+	}
+	if expensive != 2*maxWeightTerm {
+		t.Fatalf("expected saturated weight %v, got %v",
+			int64(2*maxWeightTerm), expensive)
+	}
+
+	// The default cost function must not be affected by the cap.
+	expected := int64(1000 + amt*144*RiskFactorBillionths/1000000000)
+	if weight := edgeWeight(amt, 1000, 144); weight != expected {
+		t.Fatalf("expected default weight %v, got %v", expected,
+			weight)
+	}
+}
+
+// TestCostFunctionFirstLimitError asserts that if edges are discarded because
+// of different hard limits, the error names the limit that pruned the search
+// first.
+func TestCostFunctionFirstLimitError(t *testing.T) {
+	t.Parallel()
+
+	// The edge from a to the target exceeds the fee limit, which is hit
+	// as soon as the target is expanded. The route via b and c only
+	// exceeds the cltv limit once the edge from b is processed.
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 100000, &testChannelPolicy{}, 1),
+		symmetricTestChannel("a", "target", 100000, &testChannelPolicy{
+			Expiry:      10,
+			FeeBaseMsat: 10000,
+			MinHTLC:     1,
+		}, 2),
+		symmetricTestChannel("roasbeef", "b", 100000, &testChannelPolicy{}, 3),
+		symmetricTestChannel("b", "c", 100000, &testChannelPolicy{
+			Expiry:  100,
+			MinHTLC: 1,
+		}, 4),
+		symmetricTestChannel("c", "target", 100000, &testChannelPolicy{
+			Expiry:  20,
+			MinHTLC: 1,
+		}, 5),
+	}
+
+	ctx := newPathFindingTestContext(t, testChannels, "roasbeef")
+	defer ctx.cleanup()
+
+	ctx.restrictParams.CltvLimit = 100
+	ctx.restrictParams.FeeLimit = 1000
+	ctx.restrictParams.CostFunction = &CostFunction{
+		FeeWeight:      1,
+		TimeLockWeight: 100,
+	}
+
+	target := ctx.keyFromAlias("target")
+	_, err := ctx.findPath(target, lnwire.NewMSatFromSatoshis(100))
+	if err != errFeeLimitExceeded {
+		t.Fatalf("expected error %v, got %v", errFeeLimitExceeded, err)
+	}
+}
+
+// TestMinRouteProbability asserts that a per-payment minimum probability
+// overrides the global one and that the path finding fails with a descriptive
+// error if no route meets the threshold.
+func TestMinRouteProbability(t *testing.T) {
+	t.Parallel()
+
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 100000, &testChannelPolicy{}, 1),
+		symmetricTestChannel("a", "target", 100000, &testChannelPolicy{
+			Expiry:      144,
+			FeeBaseMsat: 10000,
+			MinHTLC:     1,
+		}),
+	}
+
+	ctx := newPathFindingTestContext(t, testChannels, "roasbeef")
+	defer ctx.cleanup()
+
+	ctx.restrictParams.ProbabilitySource = func(route.Vertex,
+		route.Vertex, lnwire.MilliSatoshi) float64 {
+
+		return 0.5
+	}
+
+	target := ctx.keyFromAlias("target")
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+
+	// Without a per-payment minimum, the route is found.
+	_, err := ctx.findPath(target, paymentAmt)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+
+	// With a minimum above the route probability, no route qualifies.
+	ctx.restrictParams.MinProbability = 0.9
+	_, err = ctx.findPath(target, paymentAmt)
+	if err != errNoRouteAboveProbability {
+		t.Fatalf("expected error %v, got %v",
+			errNoRouteAboveProbability, err)
+	}
+}
+
+// TestMaxRouteHops asserts that path finding doesn't return paths with more
+// hops than the maximum, even if they are cheaper.
+func TestMaxRouteHops(t *testing.T) {
+	t.Parallel()
+
+	// Set up a test graph with a cheap path to the target through a and b
+	// (three hops) and a more expensive path through c (two hops).
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 100000, &testChannelPolicy{}, 1),
+		symmetricTestChannel("a", "b", 100000, &testChannelPolicy{
+			Expiry:      144,
+			FeeBaseMsat: 1000,
+			MinHTLC:     1,
+		}, 2),
+		symmetricTestChannel("b", "target", 100000, &testChannelPolicy{
+			Expiry:      144,
+			FeeBaseMsat: 1000,
+			MinHTLC:     1,
+		}, 3),
+		symmetricTestChannel("roasbeef", "c", 100000, &testChannelPolicy{}, 4),
+		symmetricTestChannel("c", "target", 100000, &testChannelPolicy{
+			Expiry:      144,
+			FeeBaseMsat: 10000,
+			MinHTLC:     1,
+		}, 5),
+	}
+
+	ctx := newPathFindingTestContext(t, testChannels, "roasbeef")
+	defer ctx.cleanup()
+
+	target := ctx.keyFromAlias("target")
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+
+	// Without a limit, the cheapest path is returned.
+	path, err := ctx.findPath(target, paymentAmt)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	ctx.assertPath(path, []uint64{1, 2, 3})
+
+	// With a limit of two hops, the path through c is returned.
+	ctx.restrictParams.MaxHops = 2
+	path, err = ctx.findPath(target, paymentAmt)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	ctx.assertPath(path, []uint64{4, 5})
+
+	// With a limit of a single hop, there is no path as we don't have a
+	// direct channel to the target.
+	ctx.restrictParams.MaxHops = 1
+	_, err = ctx.findPath(target, paymentAmt)
+	if err != errNoPathFound {
+		t.Fatalf("expected error %v, got %v", errNoPathFound, err)
+	}
+}
+
 func testCltvLimit(t *testing.T, limit uint32, expectedChannel uint64) {
 	t.Parallel()
 
@@ -2853,26 +3093,87 @@ func TestRouteToSelf(t *testing.T) {
 	paymentAmt := lnwire.NewMSatFromSatoshis(100)
 	target := ctx.source
 
-	// Find the best path to self. We expect this to be source->a->source,
-	// because a charges the lowest forwarding fee.
+	// The cheapest path back from both a and b returns directly over the
+	// channel that the source would use to reach them. As such a path
+	// doesn't rebalance anything, no path to self is found.
+	_, err := ctx.findPath(target, paymentAmt)
+	if err != errNoPathFound {
+		t.Fatalf("expected errNoPathFound, got: %v", err)
+	}
+
+	// Find the best path to self given that we want to go out via channel
+	// 1. As channel 1 can't be used as the last hop, we expect this to be
+	// source->a->b->source.
+	outgoingChanID := uint64(1)
+	ctx.restrictParams.OutgoingChannelIDs = []uint64{outgoingChanID}
+
 	path, err := ctx.findPath(target, paymentAmt)
 	if err != nil {
 		t.Fatalf("unable to find path: %v", err)
 	}
-	ctx.assertPath(path, []uint64{1, 1})
+	ctx.assertPath(path, []uint64{1, 3, 2})
 
-	outgoingChanID := uint64(1)
+	// Find the best path to self given that we want to go out via channel 1
+	// and return through node b.
 	lastHop := ctx.keyFromAlias("b")
-	ctx.restrictParams.OutgoingChannelIDs = []uint64{outgoingChanID}
 	ctx.restrictParams.LastHop = &lastHop
 
-	// Find the best path to self given that we want to go out via channel 1
-	// and return through node b.
 	path, err = ctx.findPath(target, paymentAmt)
 	if err != nil {
 		t.Fatalf("unable to find path: %v", err)
 	}
 	ctx.assertPath(path, []uint64{1, 3, 2})
+
+	// With a as the only allowed last hop, no path to self is left when
+	// going out via channel 1.
+	lastHop = ctx.keyFromAlias("a")
+	ctx.restrictParams.LastHop = &lastHop
+
+	_, err = ctx.findPath(target, paymentAmt)
+	if err != errNoPathFound {
+		t.Fatalf("expected errNoPathFound, got: %v", err)
+	}
+}
+
+// TestRouteToSelfParallelChannels asserts that a path to self can leave and
+// return via the same peer, as long as it uses different channels.
+func TestRouteToSelfParallelChannels(t *testing.T) {
+	t.Parallel()
+
+	testChannels := []*testChannel{
+		symmetricTestChannel("source", "a", 100000, &testChannelPolicy{
+			Expiry:      144,
+			FeeBaseMsat: 500,
+		}, 1),
+		symmetricTestChannel("source", "a", 100000, &testChannelPolicy{
+			Expiry:      144,
+			FeeBaseMsat: 500,
+		}, 2),
+	}
+
+	ctx := newPathFindingTestContext(t, testChannels, "source")
+	defer ctx.cleanup()
+
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+	target := ctx.source
+
+	// Without restrictions, the path leaves over one of the channels with
+	// a and returns over the other one.
+	path, err := ctx.findPath(target, paymentAmt)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	if len(path) != 2 || path[0].ChannelID == path[1].ChannelID {
+		t.Fatalf("expected path over both channels, got: %v", path)
+	}
+
+	// Going out via channel 1, the path returns over channel 2.
+	ctx.restrictParams.OutgoingChannelIDs = []uint64{1}
+	path, err = ctx.findPath(target, paymentAmt)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	ctx.assertPath(path, []uint64{1, 2})
 }
 
 type pathFindingTestContext struct {
@@ -1001,6 +1001,8 @@ func marshallError(sendError error, time time.Time) *channeldb.HTLCFailInfo {
 	fErr, ok := rtErr.(*htlcswitch.ForwardingError)
 	if ok {
 		response.FailureSourceIndex = uint32(fErr.FailureSourceIdx)
+		response.RawMessage = fErr.RawMessage
+		response.EncryptedReason = fErr.EncryptedReason
 	}
 
 	return response
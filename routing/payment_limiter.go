@@ -0,0 +1,95 @@
+package routing
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrMaxConcurrentPayments is returned when a new payment is rejected because
+// the maximum number of concurrent payment lifecycles has been reached.
+var ErrMaxConcurrentPayments = errors.New("maximum number of concurrent " +
+	"payments reached")
+
+// paymentLimiter bounds the number of payment lifecycles that are executed
+// concurrently by the router. A maximum of zero means that the number of
+// concurrent payments is unbounded.
+type paymentLimiter struct {
+	// max is the maximum number of concurrent payments.
+	max uint32
+
+	// active is the number of payments that are currently in flight.
+	active uint32
+
+	sync.Mutex
+}
+
+// newPaymentLimiter returns a payment limiter that allows at most max
+// concurrent payments.
+func newPaymentLimiter(max uint32) *paymentLimiter {
+	return &paymentLimiter{
+		max: max,
+	}
+}
+
+// tryAcquire reserves a slot for a new payment. If the maximum number of
+// concurrent payments has been reached, ErrMaxConcurrentPayments is returned.
+// Every successful call must be followed by a call to release once the
+// payment lifecycle has completed.
+func (p *paymentLimiter) tryAcquire() error {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.max != 0 && p.active >= p.max {
+		return ErrMaxConcurrentPayments
+	}
+
+	p.active++
+
+	return nil
+}
+
+// acquire unconditionally reserves a slot for a payment. This is used for
+// payments that are resumed after a restart, which can't be rejected but still
+// count towards the limit for new payments.
+func (p *paymentLimiter) acquire() {
+	p.Lock()
+	defer p.Unlock()
+
+	p.active++
+}
+
+// release frees the slot of a payment whose lifecycle has completed.
+func (p *paymentLimiter) release() {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.active == 0 {
+		log.Errorf("Payment limiter released more payments than " +
+			"were acquired")
+		return
+	}
+
+	p.active--
+}
+
+// setMax updates the maximum number of concurrent payments and returns the
+// previous maximum. Lowering the maximum below the number of active payments
+// doesn't affect those payments, but new payments are rejected until enough
+// of them have completed.
+func (p *paymentLimiter) setMax(max uint32) uint32 {
+	p.Lock()
+	defer p.Unlock()
+
+	prevMax := p.max
+	p.max = max
+
+	return prevMax
+}
+
+// stats returns the number of active payments and the current maximum.
+func (p *paymentLimiter) stats() (uint32, uint32) {
+	p.Lock()
+	defer p.Unlock()
+
+	return p.active, p.max
+}
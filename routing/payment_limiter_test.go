@@ -0,0 +1,62 @@
+package routing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestPaymentLimiter asserts that the payment limiter bounds the number of
+// concurrent payments and correctly accounts for completed payments.
+func TestPaymentLimiter(t *testing.T) {
+	t.Parallel()
+
+	limiter := newPaymentLimiter(2)
+
+	// The first two payments should be accepted, while the third one is
+	// rejected.
+	require.NoError(t, limiter.tryAcquire())
+	require.NoError(t, limiter.tryAcquire())
+	require.Equal(t, ErrMaxConcurrentPayments, limiter.tryAcquire())
+
+	active, max := limiter.stats()
+	require.EqualValues(t, 2, active)
+	require.EqualValues(t, 2, max)
+
+	// Once a payment completes, a new one can be started.
+	limiter.release()
+	require.NoError(t, limiter.tryAcquire())
+
+	// Resumed payments are always accepted, but count towards the limit.
+	limiter.acquire()
+	active, _ = limiter.stats()
+	require.EqualValues(t, 3, active)
+
+	// Raising the limit allows new payments again, while lowering it
+	// doesn't affect the active ones.
+	require.EqualValues(t, 2, limiter.setMax(4))
+	require.NoError(t, limiter.tryAcquire())
+	require.Equal(t, ErrMaxConcurrentPayments, limiter.tryAcquire())
+
+	require.EqualValues(t, 4, limiter.setMax(1))
+	active, _ = limiter.stats()
+	require.EqualValues(t, 4, active)
+
+	for i := 0; i < 4; i++ {
+		limiter.release()
+	}
+	require.NoError(t, limiter.tryAcquire())
+
+	// Releasing more payments than were acquired must not underflow the
+	// counter.
+	limiter.release()
+	limiter.release()
+	active, _ = limiter.stats()
+	require.EqualValues(t, 0, active)
+
+	// A maximum of zero doesn't limit the number of payments.
+	limiter.setMax(0)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, limiter.tryAcquire())
+	}
+}
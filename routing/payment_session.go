@@ -63,6 +63,28 @@ const (
 	// errMissingDependentFeature is returned when the destination node
 	// misses a feature that a feature that we require depends on.
 	errMissingDependentFeature
+
+	// errCltvLimitExceeded is returned when no path to the destination
+	// could be found because the cltv limit of the payment was binding.
+	errCltvLimitExceeded
+
+	// errFeeLimitExceeded is returned when no path to the destination
+	// could be found because the fee limit of the payment was binding.
+	errFeeLimitExceeded
+
+	// errCltvAndFeeLimitExceeded is returned when no path to the
+	// destination could be found because both the cltv and fee limit of
+	// the payment were binding.
+	errCltvAndFeeLimitExceeded
+
+	// errNoRouteAboveProbability is returned when all routes to the
+	// destination have a success probability below the minimum requested
+	// for the payment.
+	errNoRouteAboveProbability
+
+	// errNoIntermediaryRoute is returned when the intermediary node
+	// specified for the payment has no path to the destination.
+	errNoIntermediaryRoute
 )
 
 var (
@@ -96,11 +118,63 @@ func (e noRouteError) Error() string {
 	case errMissingDependentFeature:
 		return "missing dependent feature"
 
+	case errCltvLimitExceeded:
+		return "unable to find a path to destination within the cltv " +
+			"limit"
+
+	case errFeeLimitExceeded:
+		return "unable to find a path to destination within the fee " +
+			"limit"
+
+	case errCltvAndFeeLimitExceeded:
+		return "unable to find a path to destination within the cltv " +
+			"and fee limits"
+
+	case errNoRouteAboveProbability:
+		return "no route above probability threshold"
+
+	case errNoIntermediaryRoute:
+		return "intermediary node unable to find a path to destination"
+
 	default:
 		return "unknown no-route error"
 	}
 }
 
+// limitNoRouteError returns the error that describes which of the hard limits
+// of a payment prevented a path from being found. If neither limit discarded
+// any edges, the generic errNoPathFound is returned.
+func limitNoRouteError(cltvLimitHit, feeLimitHit bool) noRouteError {
+	switch {
+	case cltvLimitHit && feeLimitHit:
+		return errCltvAndFeeLimitExceeded
+
+	case cltvLimitHit:
+		return errCltvLimitExceeded
+
+	case feeLimitHit:
+		return errFeeLimitExceeded
+
+	default:
+		return errNoPathFound
+	}
+}
+
+// isNoPathError returns true if the error indicates that no path to the
+// destination could be found for the current amount, in which case splitting
+// the payment may still succeed.
+func isNoPathError(err error) bool {
+	switch err {
+	case errNoPathFound, errCltvLimitExceeded, errFeeLimitExceeded,
+		errCltvAndFeeLimitExceeded:
+
+		return true
+
+	default:
+		return false
+	}
+}
+
 // FailureReason converts a path finding error into a payment-level failure.
 func (e noRouteError) FailureReason() channeldb.FailureReason {
 	switch e {
@@ -110,13 +184,20 @@ func (e noRouteError) FailureReason() channeldb.FailureReason {
 		errNoPathFound,
 		errEmptyPaySession,
 		errUnknownRequiredFeature,
-		errMissingDependentFeature:
+		errMissingDependentFeature,
+		errCltvLimitExceeded,
+		errFeeLimitExceeded,
+		errCltvAndFeeLimitExceeded,
+		errNoRouteAboveProbability:
 
 		return channeldb.FailureReasonNoRoute
 
 	case errInsufficientBalance:
 		return channeldb.FailureReasonInsufficientBalance
 
+	case errNoIntermediaryRoute:
+		return channeldb.FailureReasonIntermediaryNoRoute
+
 	default:
 		return channeldb.FailureReasonError
 	}
@@ -204,11 +285,18 @@ func newPaymentSession(p *LightningPayment,
 
 	logPrefix := fmt.Sprintf("PaymentSession(%x):", p.Identifier())
 
+	// If the payment is sent via an intermediary node, only paths through
+	// that node are considered.
+	finder := findPath
+	if p.Intermediary != nil {
+		finder = findPathViaIntermediary(*p.Intermediary)
+	}
+
 	return &paymentSession{
 		additionalEdges:   edges,
 		getBandwidthHints: getBandwidthHints,
 		payment:           p,
-		pathFinder:        findPath,
+		pathFinder:        finder,
 		getRoutingGraph:   getRoutingGraph,
 		pathFindingConfig: pathFindingConfig,
 		missionControl:    missionControl,
@@ -240,8 +328,10 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 
 	// We need to subtract the final delta before passing it into path
 	// finding. The optimal path is independent of the final cltv delta and
-	// the path finding algorithm is unaware of this value.
-	cltvLimit := p.payment.CltvLimit - uint32(finalCltvDelta)
+	// the path finding algorithm is unaware of this value. The same holds
+	// for the buffer on the time lock of the first hop.
+	cltvLimit := p.payment.CltvLimit - uint32(finalCltvDelta) -
+		uint32(p.payment.FirstHopCltvDelta)
 
 	// TODO(roasbeef): sync logic amongst dist sys
 
@@ -257,6 +347,9 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 		DestCustomRecords:  p.payment.DestCustomRecords,
 		DestFeatures:       p.payment.DestFeatures,
 		PaymentAddr:        p.payment.PaymentAddr,
+		MinProbability:     p.payment.MinProbability,
+		CostFunction:       p.payment.CostFunction,
+		MaxHops:            p.payment.MaxHops,
 	}
 
 	finalHtlcExpiry := int32(height) + int32(finalCltvDelta)
@@ -284,6 +377,9 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 		if err != nil {
 			return nil, err
 		}
+		applyCapacityHints(
+			bandwidthHints, p.payment.OutgoingChanCapacityHints,
+		)
 
 		p.log.Debugf("pathfinding for amt=%v", maxAmt)
 
@@ -311,20 +407,20 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 		cleanup()
 
 		switch {
-		case err == errNoPathFound:
+		case isNoPathError(err):
 			// Don't split if this is a legacy payment without mpp
 			// record.
 			if p.payment.PaymentAddr == nil {
 				p.log.Debugf("not splitting because payment " +
 					"address is unspecified")
 
-				return nil, errNoPathFound
+				return nil, err
 			}
 
 			if p.payment.DestFeatures == nil {
 				p.log.Debug("Not splitting because " +
 					"destination DestFeatures is nil")
-				return nil, errNoPathFound
+				return nil, err
 			}
 
 			destFeatures := p.payment.DestFeatures
@@ -334,7 +430,7 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 				p.log.Debug("not splitting because " +
 					"destination doesn't declare MPP or AMP")
 
-				return nil, errNoPathFound
+				return nil, err
 			}
 
 			// No splitting if this is the last shard.
@@ -344,7 +440,7 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 					"limit %v has been reached",
 					p.payment.MaxParts)
 
-				return nil, errNoPathFound
+				return nil, err
 			}
 
 			// This is where the magic happens. If we can't find a
@@ -357,7 +453,7 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 					"shard amount %v has been reached",
 					p.minShardAmt)
 
-				return nil, errNoPathFound
+				return nil, err
 			}
 
 			// Go pathfinding.
@@ -394,10 +490,31 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 			return nil, err
 		}
 
+		// Add the buffer to the time lock of the HTLC that we extend
+		// to the first hop.
+		route.TotalTimeLock += uint32(p.payment.FirstHopCltvDelta)
+
 		return route, err
 	}
 }
 
+// applyCapacityHints lowers the bandwidth of our channels to the balances that
+// are known to be available for the payment. A hint never raises the bandwidth
+// of a channel, as we can't send more than the channel itself allows. Hints
+// for channels we have no bandwidth for are ignored.
+func applyCapacityHints(bandwidthHints,
+	capacityHints map[uint64]lnwire.MilliSatoshi) {
+
+	for chanID, hint := range capacityHints {
+		bandwidth, ok := bandwidthHints[chanID]
+		if !ok || hint >= bandwidth {
+			continue
+		}
+
+		bandwidthHints[chanID] = hint
+	}
+}
+
 // UpdateAdditionalEdge updates the channel edge policy for a private edge. It
 // validates the message signature and checks it's up to date, then applies the
 // updates to the supplied policy. It returns a boolean to indicate whether
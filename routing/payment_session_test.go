@@ -253,6 +253,153 @@ func TestRequestRoute(t *testing.T) {
 	}
 }
 
+// TestRequestRouteFirstHopCltvDelta tests that the buffer on the time lock of
+// the first hop is added to the route and counts towards the cltv limit.
+func TestRequestRouteFirstHopCltvDelta(t *testing.T) {
+	const (
+		height            = 10
+		firstHopCltvDelta = 5
+	)
+
+	payment := &LightningPayment{
+		CltvLimit:         30,
+		FinalCLTVDelta:    8,
+		FirstHopCltvDelta: firstHopCltvDelta,
+		Amount:            1000,
+		FeeLimit:          1000,
+	}
+
+	var paymentHash [32]byte
+	require.NoError(t, payment.SetPaymentHash(paymentHash))
+
+	session, err := newPaymentSession(
+		payment,
+		func() (map[uint64]lnwire.MilliSatoshi,
+			error) {
+
+			return nil, nil
+		},
+		func() (routingGraph, func(), error) {
+			return &sessionGraph{}, func() {}, nil
+		},
+		&MissionControl{},
+		PathFindingConfig{},
+	)
+	require.NoError(t, err)
+
+	// Override pathfinder with a mock.
+	session.pathFinder = func(
+		g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
+		source, target route.Vertex, amt lnwire.MilliSatoshi,
+		finalHtlcExpiry int32) ([]*channeldb.ChannelEdgePolicy, error) {
+
+		// We expect find path to receive a cltv limit excluding the
+		// final cltv delta (including the block padding) as well as
+		// the first hop buffer.
+		expectedLimit := 22 - uint32(BlockPadding) - firstHopCltvDelta
+		require.Equal(t, expectedLimit, r.CltvLimit)
+
+		path := []*channeldb.ChannelEdgePolicy{
+			{
+				Node: &channeldb.LightningNode{
+					Features: lnwire.NewFeatureVector(
+						nil, nil,
+					),
+				},
+			},
+		}
+
+		return path, nil
+	}
+
+	route, err := session.RequestRoute(
+		payment.Amount, payment.FeeLimit, 0, height,
+	)
+	require.NoError(t, err)
+
+	// We expect an absolute route lock value of height + finalCltvDelta
+	// + BlockPadding + firstHopCltvDelta, while the final hop's time lock
+	// isn't affected by the buffer.
+	require.EqualValues(
+		t, 18+BlockPadding+firstHopCltvDelta, route.TotalTimeLock,
+	)
+	require.EqualValues(
+		t, 18+BlockPadding, route.Hops[0].OutgoingTimeLock,
+	)
+}
+
+// TestRequestRouteCapacityHints tests that the capacity hints of a payment
+// lower the bandwidth of the first hop channels used for path finding, while
+// hints exceeding the bandwidth of a channel are clamped to it.
+func TestRequestRouteCapacityHints(t *testing.T) {
+	const height = 10
+
+	payment := &LightningPayment{
+		CltvLimit:      30,
+		FinalCLTVDelta: 8,
+		Amount:         1000,
+		FeeLimit:       1000,
+		OutgoingChanCapacityHints: map[uint64]lnwire.MilliSatoshi{
+			1: 500,
+			2: 5000,
+			3: 100,
+		},
+	}
+
+	var paymentHash [32]byte
+	require.NoError(t, payment.SetPaymentHash(paymentHash))
+
+	session, err := newPaymentSession(
+		payment,
+		func() (map[uint64]lnwire.MilliSatoshi,
+			error) {
+
+			return map[uint64]lnwire.MilliSatoshi{
+				1: 2000,
+				2: 2000,
+			}, nil
+		},
+		func() (routingGraph, func(), error) {
+			return &sessionGraph{}, func() {}, nil
+		},
+		&MissionControl{},
+		PathFindingConfig{},
+	)
+	require.NoError(t, err)
+
+	// Override pathfinder with a mock.
+	session.pathFinder = func(
+		g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
+		source, target route.Vertex, amt lnwire.MilliSatoshi,
+		finalHtlcExpiry int32) ([]*channeldb.ChannelEdgePolicy, error) {
+
+		// The first channel is restricted to its hint, while the hint
+		// of the second channel exceeds its bandwidth. There is no
+		// bandwidth for the third channel, so its hint is ignored.
+		require.Equal(t, map[uint64]lnwire.MilliSatoshi{
+			1: 500,
+			2: 2000,
+		}, g.bandwidthHints)
+
+		path := []*channeldb.ChannelEdgePolicy{
+			{
+				Node: &channeldb.LightningNode{
+					Features: lnwire.NewFeatureVector(
+						nil, nil,
+					),
+				},
+			},
+		}
+
+		return path, nil
+	}
+
+	_, err = session.RequestRoute(
+		payment.Amount, payment.FeeLimit, 0, height,
+	)
+	require.NoError(t, err)
+}
+
 type sessionGraph struct {
 	routingGraph
 }
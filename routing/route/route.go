@@ -358,6 +358,14 @@ func (r *Route) FinalHop() *Hop {
 	return r.Hops[len(r.Hops)-1]
 }
 
+// IsCircular returns true if the route ends at the node it originates from,
+// as is the case for rebalancing payments.
+func (r *Route) IsCircular() bool {
+	finalHop := r.FinalHop()
+
+	return finalHop != nil && finalHop.PubKeyBytes == r.SourcePubKey
+}
+
 // NewRouteFromHops creates a new Route structure from the minimally required
 // information to perform the payment. It infers fee amounts and populates the
 // node, chan and prev/next hop maps.
@@ -85,6 +85,15 @@ var (
 	// ErrRouterShuttingDown is returned if the router is in the process of
 	// shutting down.
 	ErrRouterShuttingDown = fmt.Errorf("router shutting down")
+
+	// ErrPaymentNotInFlight is returned when attempting to clear a payment
+	// that isn't in flight.
+	ErrPaymentNotInFlight = fmt.Errorf("payment not in flight")
+
+	// ErrPaymentHasInFlightHTLCs is returned when attempting to clear an
+	// in flight payment that still has HTLCs in flight, which may still
+	// be settled.
+	ErrPaymentHasInFlightHTLCs = fmt.Errorf("payment has in-flight HTLCs")
 )
 
 // ChannelGraphSource represents the source of information about the topology
@@ -359,6 +368,12 @@ type Config struct {
 	// Otherwise, we'll only prune the channel when both edges have a very
 	// dated last update.
 	StrictZombiePruning bool
+
+	// MaxConcurrentPayments is the maximum number of payment lifecycles
+	// that may be executed concurrently. New payments are rejected with
+	// ErrMaxConcurrentPayments once this limit is reached. A value of zero
+	// means that the number of concurrent payments is unbounded.
+	MaxConcurrentPayments uint32
 }
 
 // EdgeLocator is a struct used to identify a specific edge.
@@ -439,6 +454,10 @@ type ChannelRouter struct {
 	// announcements over a window of defaultStatInterval.
 	stats *routerStats
 
+	// paymentLimiter bounds the number of payment lifecycles that are
+	// executed concurrently.
+	paymentLimiter *paymentLimiter
+
 	sync.RWMutex
 
 	quit chan struct{}
@@ -470,6 +489,7 @@ func New(cfg Config) (*ChannelRouter, error) {
 		selfNode:          selfNode,
 		statTicker:        ticker.New(defaultStatInterval),
 		stats:             new(routerStats),
+		paymentLimiter:    newPaymentLimiter(cfg.MaxConcurrentPayments),
 		quit:              make(chan struct{}),
 	}
 
@@ -613,9 +633,15 @@ func (r *ChannelRouter) Start() error {
 
 	for _, payment := range payments {
 		log.Infof("Resuming payment %v", payment.Info.PaymentIdentifier)
+
+		// Resumed payments can't be rejected, but they still count
+		// towards the limit of concurrent payments.
+		r.paymentLimiter.acquire()
+
 		r.wg.Add(1)
 		go func(payment *channeldb.MPPayment) {
 			defer r.wg.Done()
+			defer r.paymentLimiter.release()
 
 			// Get the hashes used for the outstanding HTLCs.
 			htlcs := make(map[uint64]lntypes.Hash)
@@ -1798,6 +1824,28 @@ type LightningPayment struct {
 	// complete this payment.
 	CltvLimit uint32
 
+	// MinProbability is an optional lower bound on the success probability
+	// of the routes that are attempted for this payment. If zero, the
+	// global minimum probability is used. Payments for which no route
+	// meets the threshold fail right away without splitting.
+	MinProbability float64
+
+	// CostFunction is an optional linear trade-off between fees and time
+	// lock that path finding uses to rank routes. If nil, the default
+	// cost function is used.
+	CostFunction *CostFunction
+
+	// MaxHops is an optional upper bound on the number of hops of the
+	// routes that are attempted for this payment. If zero, the number of
+	// hops is only limited by the size of the onion packet.
+	MaxHops uint32
+
+	// Intermediary is an optional intermediary node that all routes of the
+	// payment must pass through. If no path from the intermediary node to
+	// the target exists, the payment fails with
+	// FailureReasonIntermediaryNoRoute.
+	Intermediary *route.Vertex
+
 	// paymentHash is the r-hash value to use within the HTLC extended to
 	// the first hop. This won't be set for AMP payments.
 	paymentHash *lntypes.Hash
@@ -1811,6 +1859,11 @@ type LightningPayment struct {
 	// of at least: currentHeight + FinalCLTVDelta.
 	FinalCLTVDelta uint16
 
+	// FirstHopCltvDelta is an optional buffer that is added to the time
+	// lock of the HTLC extended to the first hop, on top of the minimum
+	// required by the route. The buffer counts towards the CltvLimit.
+	FirstHopCltvDelta uint16
+
 	// PayAttemptTimeout is a timeout value that we'll use to determine
 	// when we should should abandon the payment attempt after consecutive
 	// payment failure. This prevents us from attempting to send a payment
@@ -1833,6 +1886,12 @@ type LightningPayment struct {
 	// first hop. If nil, any channel may be used.
 	OutgoingChannelIDs []uint64
 
+	// OutgoingChanCapacityHints optionally overrides the balance that is
+	// available for the payment on our channels, keyed by channel ID.
+	// Hints that exceed the bandwidth of the channel itself are clamped
+	// to it, so they can only restrict the amount sent over a channel.
+	OutgoingChanCapacityHints map[uint64]lnwire.MilliSatoshi
+
 	// LastHop is the pubkey of the last node before the final destination
 	// is reached. If nil, any node may be used.
 	LastHop *route.Vertex
@@ -1923,6 +1982,13 @@ func (l *LightningPayment) Identifier() [32]byte {
 func (r *ChannelRouter) SendPayment(payment *LightningPayment) ([32]byte,
 	*route.Route, error) {
 
+	// Make sure we don't exceed the maximum number of concurrent payments.
+	// The slot is freed again once the payment lifecycle has completed.
+	if err := r.paymentLimiter.tryAcquire(); err != nil {
+		return [32]byte{}, nil, err
+	}
+	defer r.paymentLimiter.release()
+
 	paySession, shardTracker, err := r.preparePayment(payment)
 	if err != nil {
 		return [32]byte{}, nil, err
@@ -1942,8 +2008,15 @@ func (r *ChannelRouter) SendPayment(payment *LightningPayment) ([32]byte,
 // SendPaymentAsync is the non-blocking version of SendPayment. The payment
 // result needs to be retrieved via the control tower.
 func (r *ChannelRouter) SendPaymentAsync(payment *LightningPayment) error {
+	// Make sure we don't exceed the maximum number of concurrent payments.
+	// The slot is freed again once the payment lifecycle has completed.
+	if err := r.paymentLimiter.tryAcquire(); err != nil {
+		return err
+	}
+
 	paySession, shardTracker, err := r.preparePayment(payment)
 	if err != nil {
+		r.paymentLimiter.release()
 		return err
 	}
 
@@ -1952,6 +2025,7 @@ func (r *ChannelRouter) SendPaymentAsync(payment *LightningPayment) error {
 	r.wg.Add(1)
 	go func() {
 		defer r.wg.Done()
+		defer r.paymentLimiter.release()
 
 		log.Tracef("Dispatching SendPayment for lightning payment: %v",
 			spewPayment(payment))
@@ -1969,6 +2043,50 @@ func (r *ChannelRouter) SendPaymentAsync(payment *LightningPayment) error {
 	return nil
 }
 
+// SetMaxConcurrentPayments updates the maximum number of payment lifecycles
+// that may be executed concurrently and returns the previous maximum. A value
+// of zero means that the number of concurrent payments is unbounded. Payments
+// that are already in flight aren't affected by a lower maximum.
+func (r *ChannelRouter) SetMaxConcurrentPayments(max uint32) uint32 {
+	return r.paymentLimiter.setMax(max)
+}
+
+// PaymentConcurrency returns the number of payment lifecycles that are
+// currently in flight and the maximum number of concurrent payments.
+func (r *ChannelRouter) PaymentConcurrency() (uint32, uint32) {
+	return r.paymentLimiter.stats()
+}
+
+// InFlightPayments returns all payments that are tracked as in flight. New
+// payments to the hash of any of these payments are refused.
+func (r *ChannelRouter) InFlightPayments() ([]*channeldb.MPPayment, error) {
+	return r.cfg.Control.FetchInFlightPayments()
+}
+
+// ClearInFlightPayment fails an in flight payment that has no HTLCs in
+// flight, allowing a new payment to its hash to be made. This allows clearing
+// payments that got stuck in flight, for example after a crash. Payments that
+// still have HTLCs in flight can't be cleared, since these HTLCs may still be
+// settled.
+func (r *ChannelRouter) ClearInFlightPayment(hash lntypes.Hash) error {
+	payment, err := r.cfg.Control.FetchPayment(hash)
+	if err != nil {
+		return err
+	}
+
+	if payment.Status != channeldb.StatusInFlight {
+		return ErrPaymentNotInFlight
+	}
+
+	if len(payment.InFlightHTLCs()) > 0 {
+		return ErrPaymentHasInFlightHTLCs
+	}
+
+	log.Infof("Clearing in flight payment %v", hash)
+
+	return r.cfg.Control.Fail(hash, channeldb.FailureReasonError)
+}
+
 // spewPayment returns a log closures that provides a spewed string
 // representation of the passed payment.
 func spewPayment(payment *LightningPayment) logClosure {
@@ -2559,6 +2677,90 @@ func (r *ChannelRouter) MarkEdgeLive(chanID lnwire.ShortChannelID) error {
 	return r.cfg.Graph.MarkEdgeLive(chanID.ToUint64())
 }
 
+// RestoreZombieEdge clears a channel from our zombie index, as long as its
+// funding output is still unspent, and adds it back to the graph along with
+// the policies we knew of when it was marked as a zombie. The returned boolean
+// is false if no data was kept for the channel, for example because it was
+// never part of our graph. Such a channel is only added back once we receive
+// its announcement again.
+func (r *ChannelRouter) RestoreZombieEdge(
+	chanID lnwire.ShortChannelID) (bool, error) {
+
+	isZombie, _, _ := r.cfg.Graph.IsZombieEdge(chanID.ToUint64())
+	if !isZombie {
+		return false, fmt.Errorf("channel %v is not a zombie", chanID)
+	}
+
+	fundingTx, err := r.fetchFundingTx(&chanID)
+	if err != nil {
+		return false, newErrf(ErrNoFundingTransaction, "unable to locate "+
+			"funding tx: %v", err)
+	}
+
+	numOutputs := uint32(len(fundingTx.TxOut))
+	if uint32(chanID.TxPosition) >= numOutputs {
+		return false, newErrf(ErrInvalidFundingOutput, "output_index=%v is "+
+			"out of range (num_outputs=%v), chan_id=%v",
+			chanID.TxPosition, numOutputs, chanID)
+	}
+
+	// A channel whose funding output was spent has been closed, so there's
+	// no point in bringing it back.
+	fundingPoint := &wire.OutPoint{
+		Hash:  fundingTx.TxHash(),
+		Index: uint32(chanID.TxPosition),
+	}
+	_, err = r.cfg.Chain.GetUtxo(
+		fundingPoint, fundingTx.TxOut[chanID.TxPosition].PkScript,
+		chanID.BlockHeight, r.quit,
+	)
+	if err != nil {
+		return false, newErrf(ErrChannelSpent, "unable to fetch utxo for "+
+			"chan_id=%v, chan_point=%v: %v", chanID, fundingPoint,
+			err)
+	}
+
+	info, policy1, policy2, err := r.cfg.Graph.FetchZombieEdgeData(
+		chanID.ToUint64(),
+	)
+	switch {
+	case err == channeldb.ErrEdgeNotFound:
+		return false, r.cfg.Graph.MarkEdgeLive(chanID.ToUint64())
+
+	case err != nil:
+		return false, err
+	}
+
+	// The channel must no longer be a zombie before we add it back, as
+	// updates for zombie channels are ignored.
+	if err := r.cfg.Graph.MarkEdgeLive(chanID.ToUint64()); err != nil {
+		return false, err
+	}
+
+	// We might have received the channel's announcement or a newer update
+	// in the meantime, in which case there's nothing left to restore.
+	err = r.AddEdge(info)
+	if err != nil && !IsError(err, ErrIgnored, ErrOutdated) {
+		return false, fmt.Errorf("unable to add edge: %v", err)
+	}
+	for _, policy := range []*channeldb.ChannelEdgePolicy{
+		policy1, policy2,
+	} {
+
+		if policy == nil {
+			continue
+		}
+
+		err := r.UpdateEdge(policy)
+		if err != nil && !IsError(err, ErrIgnored, ErrOutdated) {
+			return false, fmt.Errorf("unable to update edge "+
+				"policy: %v", err)
+		}
+	}
+
+	return true, nil
+}
+
 // generateBandwidthHints is a helper function that's utilized the main
 // findPath function in order to obtain hints from the lower layer w.r.t to the
 // available bandwidth of edges on the network. Currently, we'll only obtain
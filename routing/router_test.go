@@ -3459,6 +3459,104 @@ func TestChannelOnChainRejectionZombie(t *testing.T) {
 	assertChanChainRejection(t, ctx, edge, ErrInvalidFundingOutput)
 }
 
+// TestRestoreZombieEdge tests that a zombie channel is only restored if its
+// funding output is still unspent, and that it's added back to the graph along
+// with its known policies.
+func TestRestoreZombieEdge(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp := createTestCtxSingleNode(t, startingBlockHeight)
+	defer cleanUp()
+
+	var (
+		pub1 [33]byte
+		pub2 [33]byte
+	)
+	copy(pub1[:], priv1.PubKey().SerializeCompressed())
+	copy(pub2[:], priv2.PubKey().SerializeCompressed())
+
+	fundingTx, chanUtxo, chanID, err := createChannelEdge(ctx,
+		bitcoinKey1.SerializeCompressed(),
+		bitcoinKey2.SerializeCompressed(),
+		10000, 500)
+	require.NoError(t, err, "unable to create channel edge")
+	fundingBlock := &wire.MsgBlock{
+		Transactions: []*wire.MsgTx{fundingTx},
+	}
+	ctx.chain.addBlock(fundingBlock, chanID.BlockHeight, chanID.BlockHeight)
+
+	// A channel that isn't a zombie can't be restored.
+	_, err = ctx.router.RestoreZombieEdge(*chanID)
+	require.Error(t, err, "restored unknown channel")
+
+	// A channel that was never part of our graph is only cleared from the
+	// zombie index.
+	err = ctx.graph.MarkEdgeZombie(chanID.ToUint64(), pub1, pub2)
+	require.NoError(t, err, "unable to mark edge as zombie")
+
+	added, err := ctx.router.RestoreZombieEdge(*chanID)
+	require.NoError(t, err, "unable to restore zombie")
+	require.False(t, added, "unknown channel added to graph")
+
+	isZombie, _, _ := ctx.graph.IsZombieEdge(chanID.ToUint64())
+	require.False(t, isZombie, "channel not restored")
+
+	// Add the channel along with the policy of its first node to the graph,
+	// and then delete it as a zombie.
+	edge := &channeldb.ChannelEdgeInfo{
+		ChannelID:        chanID.ToUint64(),
+		NodeKey1Bytes:    pub1,
+		NodeKey2Bytes:    pub2,
+		BitcoinKey1Bytes: pub1,
+		BitcoinKey2Bytes: pub2,
+	}
+	require.NoError(t, ctx.router.AddEdge(edge), "unable to add edge")
+
+	edgePolicy := &channeldb.ChannelEdgePolicy{
+		SigBytes:                  testSig.Serialize(),
+		ChannelID:                 edge.ChannelID,
+		LastUpdate:                testTime,
+		TimeLockDelta:             10,
+		MinHTLC:                   1,
+		FeeBaseMSat:               10,
+		FeeProportionalMillionths: 10000,
+	}
+	err = ctx.router.UpdateEdge(edgePolicy)
+	require.NoError(t, err, "unable to update edge policy")
+
+	err = ctx.graph.DeleteChannelEdges(false, chanID.ToUint64())
+	require.NoError(t, err, "unable to delete edge as zombie")
+
+	// Once the funding output is spent, the channel must remain a zombie.
+	ctx.chain.delUtxo(*chanUtxo)
+	_, err = ctx.router.RestoreZombieEdge(*chanID)
+	require.True(t, IsError(err, ErrChannelSpent), "unexpected error: %v",
+		err)
+
+	isZombie, _, _ = ctx.graph.IsZombieEdge(chanID.ToUint64())
+	require.True(t, isZombie, "spent channel restored")
+
+	// With the funding output unspent, the channel is restored and added
+	// back to the graph along with its known policy.
+	ctx.chain.addUtxo(*chanUtxo, fundingTx.TxOut[0])
+	added, err = ctx.router.RestoreZombieEdge(*chanID)
+	require.NoError(t, err, "unable to restore zombie")
+	require.True(t, added, "channel not added to graph")
+
+	isZombie, _, _ = ctx.graph.IsZombieEdge(chanID.ToUint64())
+	require.False(t, isZombie, "channel not restored")
+
+	_, policy1, policy2, err := ctx.graph.FetchChannelEdgesByID(
+		chanID.ToUint64(),
+	)
+	require.NoError(t, err, "channel not added to graph")
+	require.NotNil(t, policy1, "policy not restored")
+	require.Equal(t, edgePolicy.FeeProportionalMillionths,
+		policy1.FeeProportionalMillionths)
+	require.Nil(t, policy2, "unknown policy restored")
+}
+
 func createDummyTestGraph(t *testing.T) *testGraphInstance {
 	// Setup two simple channels such that we can mock sending along this
 	// route.
@@ -4249,3 +4347,69 @@ func TestSendMPPaymentFailedWithShardsInFlight(t *testing.T) {
 	session.AssertExpectations(t)
 	missionControl.AssertExpectations(t)
 }
+
+// TestClearInFlightPayment tests that only in flight payments without any
+// HTLCs in flight can be cleared.
+func TestClearInFlightPayment(t *testing.T) {
+	t.Parallel()
+
+	var hash lntypes.Hash
+
+	testCases := []struct {
+		name        string
+		payment     *channeldb.MPPayment
+		expectedErr error
+	}{
+		{
+			name: "no htlcs in flight",
+			payment: &channeldb.MPPayment{
+				Status: channeldb.StatusInFlight,
+				HTLCs: []channeldb.HTLCAttempt{
+					makeFailedAttempt(1000, 10),
+				},
+			},
+		},
+		{
+			name: "htlcs in flight",
+			payment: &channeldb.MPPayment{
+				Status: channeldb.StatusInFlight,
+				HTLCs: []channeldb.HTLCAttempt{
+					makeFailedAttempt(1000, 10),
+					makeActiveAttempt(1000, 10),
+				},
+			},
+			expectedErr: ErrPaymentHasInFlightHTLCs,
+		},
+		{
+			name: "payment not in flight",
+			payment: &channeldb.MPPayment{
+				Status: channeldb.StatusFailed,
+			},
+			expectedErr: ErrPaymentNotInFlight,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ct := &mockControlTower{}
+			router := &ChannelRouter{cfg: &Config{Control: ct}}
+
+			ct.On("FetchPayment", hash).Return(tc.payment, nil)
+
+			// The payment should only be failed if it can be
+			// cleared.
+			if tc.expectedErr == nil {
+				reason := channeldb.FailureReasonError
+				ct.On("Fail", hash, reason).Return(nil)
+			}
+
+			err := router.ClearInFlightPayment(hash)
+			require.Equal(t, tc.expectedErr, err)
+
+			ct.AssertExpectations(t)
+		})
+	}
+}
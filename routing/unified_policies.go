@@ -66,6 +66,19 @@ func (u *unifiedPolicies) addPolicy(fromNode route.Vertex,
 	})
 }
 
+// removeChannel removes the policies of the given channel, if present.
+func (u *unifiedPolicies) removeChannel(chanID uint64) {
+	for _, policy := range u.policies {
+		edges := policy.edges[:0]
+		for _, edge := range policy.edges {
+			if edge.policy.ChannelID != chanID {
+				edges = append(edges, edge)
+			}
+		}
+		policy.edges = edges
+	}
+}
+
 // addGraphPolicies adds all policies that are known for the toNode in the
 // graph.
 func (u *unifiedPolicies) addGraphPolicies(g routingGraph) error {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"net"
 	"net/http"
 	"runtime"
 	"sort"
@@ -31,6 +32,7 @@ import (
 	proxy "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/lightningnetwork/lnd/autopilot"
 	"github.com/lightningnetwork/lnd/build"
+	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/chainreg"
 	"github.com/lightningnetwork/lnd/chanacceptor"
 	"github.com/lightningnetwork/lnd/chanbackup"
@@ -293,6 +295,10 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "peers",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/SetPeerBackoff": {{
+			Entity: "peers",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/OpenChannel": {{
 			Entity: "onchain",
 			Action: "write",
@@ -326,10 +332,30 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "info",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/GetSyncProgress": {{
+			Entity: "info",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/GetReorgHistory": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/GetAdvertisedAddresses": {{
+			Entity: "info",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/ListPeers": {{
 			Entity: "peers",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/ResetFlapCount": {{
+			Entity: "peers",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/GetPeerStats": {{
+			Entity: "peers",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/WalletBalance": {{
 			Entity: "onchain",
 			Action: "read",
@@ -338,10 +364,21 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "onchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/GetFeeExposure": {{
+			Entity: "onchain",
+			Action: "read",
+		}, {
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/ChannelBalance": {{
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/InboundLiquidity": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/PendingChannels": {{
 			Entity: "offchain",
 			Action: "read",
@@ -350,6 +387,26 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/ChannelUptimeHistory": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/GetCommitmentOutputs": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/SetTargetLocalRatio": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/SetMaxHtlcHold": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ListRoutableChannels": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/SubscribeChannelEvents": {{
 			Entity: "offchain",
 			Action: "read",
@@ -358,6 +415,14 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/PreviewForceCloseSweep": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/ChannelOpenHistory": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/SendPayment": {{
 			Entity: "offchain",
 			Action: "write",
@@ -378,10 +443,18 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "invoices",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/SetDefaultInvoiceExpiry": {{
+			Entity: "invoices",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/LookupInvoice": {{
 			Entity: "invoices",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/LookupInvoices": {{
+			Entity: "invoices",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/ListInvoices": {{
 			Entity: "invoices",
 			Action: "read",
@@ -410,6 +483,10 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "info",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/GetChanPolicies": {{
+			Entity: "info",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/GetNodeInfo": {{
 			Entity: "info",
 			Action: "read",
@@ -422,6 +499,14 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "info",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/ListZombieChannels": {{
+			Entity: "info",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/RestoreZombieChannel": {{
+			Entity: "info",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/StopDaemon": {{
 			Entity: "info",
 			Action: "write",
@@ -442,6 +527,14 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "info",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/GetGossipBatchParams": {{
+			Entity: "info",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/SetGossipBatchParams": {{
+			Entity: "info",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/DecodePayReq": {{
 			Entity: "offchain",
 			Action: "read",
@@ -450,6 +543,10 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/OnChainFeeReport": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/UpdateChannelPolicy": {{
 			Entity: "offchain",
 			Action: "write",
@@ -458,6 +555,26 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/ChannelPolicyHistory": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/GetSwitchInfo": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/ForwardingFailureStats": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/ResetForwardingFailureStats": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/LookupHtlcCircuit": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/RestoreChannelBackups": {{
 			Entity: "offchain",
 			Action: "write",
@@ -485,6 +602,34 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/SetMinInboundChanSize": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/SetCoopCloseFeeBand": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/SetSweepToAddress": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/GetMaxChanSize": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/SetSoftMaxChanSize": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/GetMaxTotalInFlight": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/SetMaxTotalInFlight": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/BakeMacaroon": {{
 			Entity: "macaroon",
 			Action: "generate",
@@ -568,6 +713,11 @@ type rpcServer struct {
 
 	// interceptor is used to be able to request a shutdown
 	interceptor signal.Interceptor
+
+	// blockSyncProgress and graphSyncProgress are used to estimate the
+	// remaining duration of the chain and the graph sync respectively.
+	blockSyncProgress *syncProgressTracker
+	graphSyncProgress *syncProgressTracker
 }
 
 // A compile time check to ensure that rpcServer fully implements the
@@ -595,13 +745,15 @@ func newRPCServer(cfg *Config, interceptorChain *rpcperms.InterceptorChain,
 	}
 
 	return &rpcServer{
-		cfg:              cfg,
-		subGrpcHandlers:  subServerHandlers,
-		interceptorChain: interceptorChain,
-		extSubserverCfg:  extSubserverCfg,
-		extRestRegistrar: extRestRegistrar,
-		quit:             make(chan struct{}, 1),
-		interceptor:      interceptor,
+		cfg:               cfg,
+		subGrpcHandlers:   subServerHandlers,
+		interceptorChain:  interceptorChain,
+		extSubserverCfg:   extSubserverCfg,
+		extRestRegistrar:  extRestRegistrar,
+		quit:              make(chan struct{}, 1),
+		interceptor:       interceptor,
+		blockSyncProgress: newSyncProgressTracker(time.Now),
+		graphSyncProgress: newSyncProgressTracker(time.Now),
 	}
 }
 
@@ -1070,15 +1222,18 @@ func (r *rpcServer) EstimateFee(ctx context.Context,
 	}
 
 	// Query the fee estimator for the fee rate for the given confirmation
-	// target.
-	target := in.TargetConf
-	feePerKw, err := sweep.DetermineFeePerKw(
-		r.server.cc.FeeEstimator, sweep.FeePreference{
-			ConfTarget: uint32(target),
-		},
+	// target, falling back to the default target of the sweeper if none
+	// is set.
+	target := uint32(in.TargetConf)
+	if target == 0 {
+		target = sweep.DefaultNumBlocksEstimate
+	}
+	feePerKw, source, err := chainfee.EstimateFeePerKWWithSource(
+		r.server.cc.FeeEstimator, target,
 	)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to query fee estimator: %v",
+			err)
 	}
 
 	// Then, we'll extract the minimum number of confirmations that each
@@ -1112,6 +1267,8 @@ func (r *rpcServer) EstimateFee(ctx context.Context,
 	resp := &lnrpc.EstimateFeeResponse{
 		FeeSat:      totalFee,
 		SatPerVbyte: uint64(feePerKw.FeePerKVByte() / 1000),
+		ConfTarget:  target,
+		Source:      lnrpc.MarshalFeeEstimateSource(source),
 
 		// Deprecated field.
 		FeerateSatPerByte: int64(feePerKw.FeePerKVByte() / 1000),
@@ -1123,6 +1280,82 @@ func (r *rpcServer) EstimateFee(ctx context.Context,
 	return resp, nil
 }
 
+// commitFeeUpdateConfTarget is the confirmation target the channel link uses
+// to determine the fee rate of commitment transactions.
+const commitFeeUpdateConfTarget = 3
+
+// GetFeeExposure returns the aggregate on-chain fees the node has committed to
+// across its open channels, the wallet reserve kept for fee bumping anchor
+// channels and the worst-case fee exposure at the current fee estimate.
+func (r *rpcServer) GetFeeExposure(ctx context.Context,
+	in *lnrpc.GetFeeExposureRequest) (*lnrpc.GetFeeExposureResponse,
+	error) {
+
+	target := uint32(commitFeeUpdateConfTarget)
+	if in.TargetConf != 0 {
+		target = uint32(in.TargetConf)
+	}
+
+	// The worst-case exposure is based on the current fee estimate, so it
+	// tracks the estimate as it changes.
+	feePerKw, err := sweep.DetermineFeePerKw(
+		r.server.cc.FeeEstimator, sweep.FeePreference{
+			ConfTarget: target,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	anchorReserve, err := r.server.cc.Wallet.RequiredReservedValue()
+	if err != nil {
+		return nil, err
+	}
+
+	dbChannels, err := r.server.chanStateDB.FetchAllOpenChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.GetFeeExposureResponse{
+		AnchorReserveSat: int64(anchorReserve),
+		FeeRateSatPerKw:  int64(feePerKw),
+	}
+	for _, dbChannel := range dbChannels {
+		localCommit := dbChannel.LocalCommitment
+		utx := btcutil.NewTx(localCommit.CommitTx)
+		commitWeight := blockchain.GetTransactionWeight(utx) +
+			input.WitnessCommitmentTxWeight
+
+		resp.TotalCapacitySat += int64(dbChannel.Capacity)
+
+		// Only the initiator of a channel pays the commitment fee.
+		var exposure btcutil.Amount
+		if dbChannel.IsInitiator {
+			exposure = localCommit.CommitFee
+			resp.CommittedCommitFeeSat += int64(exposure)
+		}
+
+		// If the commitment fee falls short of the fee estimate, the
+		// difference is paid through a fee update if we're the
+		// initiator, or by fee bumping our anchor. Without anchors,
+		// the responder has no way of raising the fee.
+		requiredFee := feePerKw.FeeForWeight(commitWeight)
+		canBump := dbChannel.IsInitiator ||
+			dbChannel.ChanType.HasAnchors()
+		if canBump && requiredFee > localCommit.CommitFee {
+			exposure += requiredFee - localCommit.CommitFee
+		}
+
+		resp.WorstCaseFeeExposureSat += int64(exposure)
+	}
+
+	rpcsLog.Debugf("[feeexposure] fee exposure for conf target %d: %v",
+		target, resp)
+
+	return resp, nil
+}
+
 // SendCoins executes a request to send coins to a particular address. Unlike
 // SendMany, this RPC call only allows creating a single output at a time.
 func (r *rpcServer) SendCoins(ctx context.Context,
@@ -1243,7 +1476,7 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 			// ensures this is an address the wallet knows about,
 			// allowing us to pass the reserved value check.
 			changeAddr, err := r.server.cc.Wallet.NewAddress(
-				lnwallet.WitnessPubKey, true,
+				r.server.cc.Wallet.DefaultAddressType(), true,
 				lnwallet.DefaultAccountName,
 			)
 			if err != nil {
@@ -1399,13 +1632,23 @@ func (r *rpcServer) NewAddress(ctx context.Context,
 		account = in.Account
 	}
 
+	// The zero value of the address type is used if no type was
+	// requested, in which case the wallet's default address type is used.
+	addrType := in.Type
+	if addrType == lnrpc.AddressType_WITNESS_PUBKEY_HASH &&
+		r.server.cc.Wallet.DefaultAddressType() ==
+			lnwallet.NestedWitnessPubKey {
+
+		addrType = lnrpc.AddressType_NESTED_PUBKEY_HASH
+	}
+
 	// Translate the gRPC proto address type to the wallet controller's
 	// available address types.
 	var (
 		addr btcutil.Address
 		err  error
 	)
-	switch in.Type {
+	switch addrType {
 	case lnrpc.AddressType_WITNESS_PUBKEY_HASH:
 		addr, err = r.server.cc.Wallet.NewAddress(
 			lnwallet.WitnessPubKey, false, account,
@@ -1440,10 +1683,46 @@ func (r *rpcServer) NewAddress(ctx context.Context,
 	}
 
 	rpcsLog.Debugf("[newaddress] account=%v type=%v addr=%v", account,
-		in.Type, addr.String())
+		addrType, addr.String())
 	return &lnrpc.NewAddressResponse{Address: addr.String()}, nil
 }
 
+// newDeliveryScript generates a fresh address of the given type from the
+// default wallet account and returns the script paying to it, for use as the
+// delivery script of a cooperative close.
+func (r *rpcServer) newDeliveryScript(
+	addrType lnrpc.AddressType) (lnwire.DeliveryAddress, error) {
+
+	var walletAddrType lnwallet.AddressType
+	switch addrType {
+	case lnrpc.AddressType_WITNESS_PUBKEY_HASH,
+		lnrpc.AddressType_UNUSED_WITNESS_PUBKEY_HASH:
+
+		walletAddrType = lnwallet.WitnessPubKey
+
+	case lnrpc.AddressType_NESTED_PUBKEY_HASH,
+		lnrpc.AddressType_UNUSED_NESTED_PUBKEY_HASH:
+
+		walletAddrType = lnwallet.NestedWitnessPubKey
+
+	default:
+		return nil, fmt.Errorf("unknown delivery address type: %v",
+			addrType)
+	}
+
+	addr, err := r.server.cc.Wallet.NewAddress(
+		walletAddrType, false, lnwallet.DefaultAccountName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("[closechannel] delivery addr=%v type=%v", addr,
+		addrType)
+
+	return txscript.PayToAddrScript(addr)
+}
+
 var (
 	// signedMsgPrefix is a special prefix that we'll prepend to any
 	// messages we sign/verify. We do this to ensure that we don't
@@ -1637,6 +1916,70 @@ func (r *rpcServer) DisconnectPeer(ctx context.Context,
 	return &lnrpc.DisconnectPeerResponse{}, nil
 }
 
+// SetPeerBackoff updates the backoff between attempts to reconnect to a
+// persistent peer, or the default backoff of all peers if no peer is given.
+func (r *rpcServer) SetPeerBackoff(ctx context.Context,
+	in *lnrpc.SetPeerBackoffRequest) (*lnrpc.SetPeerBackoffResponse,
+	error) {
+
+	rpcsLog.Debugf("[setpeerbackoff] peer=%v, min_backoff_ms=%v, "+
+		"max_backoff_ms=%v, multiplier=%v, reset=%v", in.PubKey,
+		in.MinBackoffMs, in.MaxBackoffMs, in.Multiplier, in.Reset_)
+
+	var pubStr string
+	if in.PubKey != "" {
+		pubKeyBytes, err := hex.DecodeString(in.PubKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode pubkey "+
+				"bytes: %v", err)
+		}
+		peerPubKey, err := btcec.ParsePubKey(pubKeyBytes, btcec.S256())
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse pubkey: %v",
+				err)
+		}
+
+		pubStr = string(peerPubKey.SerializeCompressed())
+	}
+
+	update := peerBackoffPolicy{
+		minBackoff: time.Duration(in.MinBackoffMs) * time.Millisecond,
+		maxBackoff: time.Duration(in.MaxBackoffMs) * time.Millisecond,
+		multiplier: in.Multiplier,
+	}
+
+	var policy peerBackoffPolicy
+	switch {
+	case in.Reset_ && pubStr == "":
+		return nil, fmt.Errorf("reset requires a peer")
+
+	case in.Reset_ && update != peerBackoffPolicy{}:
+		return nil, fmt.Errorf("reset can't be combined with other " +
+			"backoff fields")
+
+	case in.Reset_:
+		policy = r.server.ResetPeerBackoff(pubStr)
+
+	// Without any fields set, we only return the current backoff, so that
+	// querying a peer doesn't give it a backoff of its own.
+	case update == peerBackoffPolicy{}:
+		policy = r.server.PeerBackoff(pubStr)
+
+	default:
+		var err error
+		policy, err = r.server.SetPeerBackoff(pubStr, update)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &lnrpc.SetPeerBackoffResponse{
+		MinBackoffMs: uint64(policy.minBackoff / time.Millisecond),
+		MaxBackoffMs: uint64(policy.maxBackoff / time.Millisecond),
+		Multiplier:   policy.multiplier,
+	}, nil
+}
+
 // newFundingShimAssembler returns a new fully populated
 // chanfunding.CannedAssembler using a FundingShim obtained from an RPC caller.
 func newFundingShimAssembler(chanPointShim *lnrpc.ChanPointShim, initiator bool,
@@ -2285,8 +2628,9 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 
 		var deliveryScript lnwire.DeliveryAddress
 
+		switch {
 		// If a delivery address to close out to was specified, decode it.
-		if len(in.DeliveryAddress) > 0 {
+		case len(in.DeliveryAddress) > 0:
 			// Decode the address provided.
 			addr, err := btcutil.DecodeAddress(
 				in.DeliveryAddress, r.cfg.ActiveNetParams.Params,
@@ -2300,6 +2644,21 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 			if err != nil {
 				return err
 			}
+
+		// If a non-default address type was requested, we'll generate a
+		// fresh address of that type to close out to. The type is
+		// ignored if an upfront shutdown script was committed to at
+		// channel open, since the funds must pay out to that script.
+		case in.DeliveryAddressType !=
+			lnrpc.AddressType_WITNESS_PUBKEY_HASH &&
+			len(channel.LocalShutdownScript) == 0:
+
+			deliveryScript, err = r.newDeliveryScript(
+				in.DeliveryAddressType,
+			)
+			if err != nil {
+				return err
+			}
 		}
 
 		updateChan, errChan = r.server.htlcSwitch.CloseLink(
@@ -2400,18 +2759,16 @@ func abandonChanFromGraph(chanGraph *channeldb.ChannelGraph,
 func (r *rpcServer) AbandonChannel(_ context.Context,
 	in *lnrpc.AbandonChannelRequest) (*lnrpc.AbandonChannelResponse, error) {
 
-	// If this isn't the dev build, then we won't allow the RPC to be
-	// executed, as it's an advanced feature and won't be activated in
-	// regular production/release builds except for the explicit case of
-	// externally funded channels that are still pending. Due to repeated
-	// requests, we also allow this requirement to be overwritten by a new
-	// flag that attests to the user knowing what they're doing and the risk
-	// associated with the command/RPC.
-	if !in.IKnowWhatIAmDoing && !in.PendingFundingShimOnly &&
-		!build.IsDevBuild() {
-
-		return nil, fmt.Errorf("AbandonChannel RPC call only " +
-			"available in dev builds")
+	// We won't allow the RPC to be executed, as it's an advanced feature,
+	// except for the explicit case of externally funded channels that are
+	// still pending. Due to repeated requests, we also allow this
+	// requirement to be overwritten by a flag that attests to the user
+	// knowing what they're doing and the risk associated with the
+	// command/RPC.
+	if !in.IKnowWhatIAmDoing && !in.PendingFundingShimOnly {
+		return nil, fmt.Errorf("AbandonChannel RPC call requires " +
+			"pending_funding_shim_only or i_know_what_i_am_doing " +
+			"to be set")
 	}
 
 	// We'll parse out the arguments to we can obtain the chanPoint of the
@@ -2647,53 +3004,223 @@ func (r *rpcServer) GetRecoveryInfo(ctx context.Context,
 	}, nil
 }
 
-// ListPeers returns a verbose listing of all currently active peers.
-func (r *rpcServer) ListPeers(ctx context.Context,
-	in *lnrpc.ListPeersRequest) (*lnrpc.ListPeersResponse, error) {
+// GetSyncProgress returns the progress of the chain and the graph sync, and
+// the estimated time remaining until both are complete.
+func (r *rpcServer) GetSyncProgress(_ context.Context,
+	_ *lnrpc.GetSyncProgressRequest) (*lnrpc.GetSyncProgressResponse,
+	error) {
 
-	rpcsLog.Tracef("[listpeers] request")
+	_, bestHeight, err := r.server.cc.ChainIO.GetBestBlock()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get best block info: %v", err)
+	}
+	targetHeight := uint32(bestHeight)
 
-	serverPeers := r.server.Peers()
-	resp := &lnrpc.ListPeersResponse{
-		Peers: make([]*lnrpc.Peer, 0, len(serverPeers)),
+	isSynced, _, err := r.server.cc.Wallet.IsSynced()
+	if err != nil {
+		return nil, fmt.Errorf("unable to sync PoV of the wallet "+
+			"with current best block in the main chain: %v", err)
 	}
 
-	for _, serverPeer := range serverPeers {
-		var (
-			satSent int64
-			satRecv int64
-		)
+	// Just like in GetInfo, we only consider ourselves synced once the
+	// router has caught up as well, and we report its height as ours, as
+	// it is the last subsystem to process new blocks.
+	currentHeight := r.server.chanRouter.SyncedHeight()
+	if !r.cfg.Routing.AssumeChannelValid {
+		isSynced = isSynced && currentHeight == targetHeight
+	}
 
-		// In order to display the total number of satoshis of outbound
-		// (sent) and inbound (recv'd) satoshis that have been
-		// transported through this peer, we'll sum up the sent/recv'd
-		// values for each of the active channels we have with the
-		// peer.
-		chans := serverPeer.ChannelSnapshots()
-		for _, c := range chans {
-			satSent += int64(c.TotalMSatSent.ToSatoshis())
-			satRecv += int64(c.TotalMSatReceived.ToSatoshis())
-		}
+	// A fully synced node reports the tip as its height, even if the
+	// router lags behind by a block as it skips validation.
+	if isSynced {
+		currentHeight = targetHeight
+	}
 
-		nodePub := serverPeer.PubKey()
+	blockPercent := blockSyncPercent(currentHeight, targetHeight, isSynced)
 
-		// Retrieve the peer's sync type. If we don't currently have a
-		// syncer for the peer, then we'll default to a passive sync.
-		// This can happen if the RPC is called while a peer is
-		// initializing.
-		syncer, ok := r.server.authGossiper.SyncManager().GossipSyncer(
-			nodePub,
-		)
+	syncMgr := r.server.authGossiper.SyncManager()
+	isGraphSynced := syncMgr.IsGraphSynced()
+	graphProgress := syncMgr.GraphSyncProgress()
 
-		var lnrpcSyncType lnrpc.Peer_SyncType
-		if !ok {
-			rpcsLog.Warnf("Gossip syncer for peer=%x not found",
-				nodePub)
-			lnrpcSyncType = lnrpc.Peer_UNKNOWN_SYNC
-		} else {
-			syncType := syncer.SyncType()
-			switch syncType {
-			case discovery.ActiveSync:
+	// The remaining duration of both syncs is the one of the sync that
+	// takes longer.
+	etaSeconds := int64(-1)
+	blockRemaining, blockOk := r.blockSyncProgress.remaining(
+		blockPercent / 100,
+	)
+	graphRemaining, graphOk := r.graphSyncProgress.remaining(
+		graphProgress,
+	)
+	if blockOk && graphOk {
+		remaining := blockRemaining
+		if graphRemaining > remaining {
+			remaining = graphRemaining
+		}
+		etaSeconds = int64(remaining.Seconds())
+	}
+
+	rpcsLog.Debugf("[getsyncprogress] block=%.2f%%, graph=%.2f%%, "+
+		"height=%v/%v, eta=%vs", blockPercent, graphProgress*100,
+		currentHeight, targetHeight, etaSeconds)
+
+	return &lnrpc.GetSyncProgressResponse{
+		BlockSyncPercent: blockPercent,
+		GraphSyncPercent: graphProgress * 100,
+		CurrentHeight:    currentHeight,
+		TargetHeight:     targetHeight,
+		EtaSeconds:       etaSeconds,
+		SyncedToChain:    isSynced,
+		SyncedToGraph:    isGraphSynced,
+	}, nil
+}
+
+// GetReorgHistory returns the chain reorganizations that the chain notifier
+// observed since it was started.
+func (r *rpcServer) GetReorgHistory(_ context.Context,
+	_ *lnrpc.GetReorgHistoryRequest) (*lnrpc.GetReorgHistoryResponse,
+	error) {
+
+	historian, ok := r.server.cc.ChainNotifier.(chainntnfs.ReorgHistorian)
+	if !ok {
+		return nil, fmt.Errorf("chain notifier doesn't keep a reorg " +
+			"history")
+	}
+
+	reorgs := historian.ReorgHistory()
+
+	rpcsLog.Debugf("[getreorghistory] num_reorgs=%v", len(reorgs))
+
+	resp := &lnrpc.GetReorgHistoryResponse{
+		Reorgs: make([]*lnrpc.ReorgEvent, 0, len(reorgs)),
+	}
+	for _, reorg := range reorgs {
+		var oldTipHash string
+		if reorg.OldTipHash != (chainhash.Hash{}) {
+			oldTipHash = reorg.OldTipHash.String()
+		}
+
+		resp.Reorgs = append(resp.Reorgs, &lnrpc.ReorgEvent{
+			OldTipHash:   oldTipHash,
+			OldTipHeight: reorg.OldTipHeight,
+			NewTipHash:   reorg.NewTipHash.String(),
+			NewTipHeight: reorg.NewTipHeight,
+			Depth:        reorg.Depth,
+			Timestamp:    reorg.Timestamp.Unix(),
+		})
+	}
+
+	return resp, nil
+}
+
+// GetAdvertisedAddresses returns the addresses of the node's latest node
+// announcement, as well as the ones of the node announcement that has been
+// processed by the gossiper.
+func (r *rpcServer) GetAdvertisedAddresses(_ context.Context,
+	_ *lnrpc.GetAdvertisedAddressesRequest) (
+	*lnrpc.GetAdvertisedAddressesResponse, error) {
+
+	rpcsLog.Tracef("[getadvertisedaddrs] request")
+
+	nodeAnn, err := r.server.genNodeAnnouncement(false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve current node "+
+			"announcement: %v", err)
+	}
+
+	// The gossiper writes the node announcements it processes for our
+	// own node to the graph, so our source node reflects the announcement
+	// that is served to the network.
+	selfNode, err := r.server.graphDB.SourceNode()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch source node: %v", err)
+	}
+
+	public, err := r.server.graphDB.IsPublicNode(selfNode.PubKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	marshalAddrs := func(addrs []net.Addr) []*lnrpc.NodeAddress {
+		nodeAddrs := make([]*lnrpc.NodeAddress, 0, len(addrs))
+		for _, addr := range addrs {
+			nodeAddrs = append(nodeAddrs, &lnrpc.NodeAddress{
+				Network: addr.Network(),
+				Addr:    addr.String(),
+			})
+		}
+
+		return nodeAddrs
+	}
+
+	// An announcement with a newer timestamp than the gossiped one hasn't
+	// been processed by the gossiper yet.
+	gossipedTimestamp := uint32(selfNode.LastUpdate.Unix())
+
+	return &lnrpc.GetAdvertisedAddressesResponse{
+		CurrentAddresses:  marshalAddrs(nodeAnn.Addresses),
+		CurrentTimestamp:  nodeAnn.Timestamp,
+		GossipedAddresses: marshalAddrs(selfNode.Addresses),
+		GossipedTimestamp: gossipedTimestamp,
+		UpdatePending:     nodeAnn.Timestamp > gossipedTimestamp,
+		Public:            public,
+	}, nil
+}
+
+// ListPeers returns a verbose listing of all currently active peers.
+func (r *rpcServer) ListPeers(ctx context.Context,
+	in *lnrpc.ListPeersRequest) (*lnrpc.ListPeersResponse, error) {
+
+	rpcsLog.Tracef("[listpeers] request")
+
+	serverPeers := r.server.Peers()
+	resp := &lnrpc.ListPeersResponse{
+		Peers: make([]*lnrpc.Peer, 0, len(serverPeers)),
+	}
+
+	for _, serverPeer := range serverPeers {
+		var (
+			satSent int64
+			satRecv int64
+		)
+
+		// In order to display the total number of satoshis of outbound
+		// (sent) and inbound (recv'd) satoshis that have been
+		// transported through this peer, we'll sum up the sent/recv'd
+		// values for each of the active channels we have with the
+		// peer.
+		chans := serverPeer.ChannelSnapshots()
+		for _, c := range chans {
+			satSent += int64(c.TotalMSatSent.ToSatoshis())
+			satRecv += int64(c.TotalMSatReceived.ToSatoshis())
+		}
+
+		nodePub := serverPeer.PubKey()
+
+		// Retrieve the peer's sync type. If we don't currently have a
+		// syncer for the peer, then we'll default to a passive sync.
+		// This can happen if the RPC is called while a peer is
+		// initializing.
+		syncer, ok := r.server.authGossiper.SyncManager().GossipSyncer(
+			nodePub,
+		)
+
+		var (
+			lnrpcSyncType   lnrpc.Peer_SyncType
+			gossipFilter    = &lnrpc.GossipTimestampFilter{}
+			receivingGossip bool
+		)
+		if !ok {
+			rpcsLog.Warnf("Gossip syncer for peer=%x not found",
+				nodePub)
+			lnrpcSyncType = lnrpc.Peer_UNKNOWN_SYNC
+		} else {
+			gossipFilter, receivingGossip = marshallGossipFilter(
+				syncer.RemoteUpdateHorizon(),
+			)
+
+			syncType := syncer.SyncType()
+			switch syncType {
+			case discovery.ActiveSync:
 				lnrpcSyncType = lnrpc.Peer_ACTIVE_SYNC
 			case discovery.PassiveSync:
 				lnrpcSyncType = lnrpc.Peer_PASSIVE_SYNC
@@ -2705,8 +3232,25 @@ func (r *rpcServer) ListPeers(ctx context.Context,
 			}
 		}
 
-		features := invoicesrpc.CreateRPCFeatures(
-			serverPeer.RemoteFeatures(),
+		remoteFeatures := serverPeer.RemoteFeatures()
+		features := invoicesrpc.CreateRPCFeatures(remoteFeatures)
+
+		// Whether the peer can be used as a hop of a blinded path
+		// depends on it signaling route blinding to the network, so
+		// we'll also consult its node announcement if it has one.
+		var announcedFeatures *lnwire.FeatureVector
+		node, err := r.server.graphDB.FetchLightningNode(
+			nil, route.Vertex(nodePub),
+		)
+		switch {
+		case err == nil:
+			announcedFeatures = node.Features
+
+		case err != channeldb.ErrGraphNodeNotFound:
+			return nil, err
+		}
+		blindingSupport := feature.RouteBlindingSupport(
+			remoteFeatures, announcedFeatures,
 		)
 
 		rpcPeer := &lnrpc.Peer{
@@ -2721,6 +3265,11 @@ func (r *rpcServer) ListPeers(ctx context.Context,
 			SyncType:        lnrpcSyncType,
 			Features:        features,
 			LastPingPayload: serverPeer.LastRemotePingPayload(),
+			RouteBlindingSupport: marshallBlindingSupport(
+				blindingSupport,
+			),
+			GossipFilter:    gossipFilter,
+			ReceivingGossip: receivingGossip,
 		}
 
 		var peerErrors []interface{}
@@ -2782,6 +3331,137 @@ func (r *rpcServer) ListPeers(ctx context.Context,
 	return resp, nil
 }
 
+// marshallBlindingSupport converts a peer's support for blinded paths into its
+// RPC representation.
+func marshallBlindingSupport(
+	support feature.BlindingSupport) lnrpc.RouteBlindingSupport {
+
+	switch support {
+	case feature.BlindingRelay:
+		return lnrpc.RouteBlindingSupport_BLINDING_RELAY
+
+	case feature.BlindingReceiveOnly:
+		return lnrpc.RouteBlindingSupport_BLINDING_RECEIVE_ONLY
+
+	default:
+		return lnrpc.RouteBlindingSupport_BLINDING_UNSUPPORTED
+	}
+}
+
+// marshallGossipFilter converts the gossip filter a peer has requested into
+// its RPC representation, and reports whether new gossip is forwarded to the
+// peer under that filter. A nil filter means the peer hasn't sent one yet.
+func marshallGossipFilter(
+	filter *lnwire.GossipTimestampRange) (*lnrpc.GossipTimestampFilter,
+	bool) {
+
+	// Without a filter, we don't forward any gossip to the peer.
+	if filter == nil {
+		return &lnrpc.GossipTimestampFilter{}, false
+	}
+
+	// New gossip messages are timestamped with the current time, so they
+	// are only forwarded if the filter's range includes the present.
+	now := uint64(time.Now().Unix())
+	start := uint64(filter.FirstTimestamp)
+	end := start + uint64(filter.TimestampRange)
+
+	return &lnrpc.GossipTimestampFilter{
+		IsSet:          true,
+		FirstTimestamp: filter.FirstTimestamp,
+		TimestampRange: filter.TimestampRange,
+	}, start <= now && now < end
+}
+
+// ResetFlapCount resets the flap count of a peer to zero, lifting any flap
+// based rate limiting of its online events immediately rather than waiting for
+// the count to cool down.
+func (r *rpcServer) ResetFlapCount(ctx context.Context,
+	in *lnrpc.ResetFlapCountRequest) (*lnrpc.ResetFlapCountResponse, error) {
+
+	rpcsLog.Debugf("[resetflapcount] peer=%v", in.PubKey)
+
+	// The event store only serves requests once the server has started,
+	// so we bail out early to prevent the request from blocking.
+	if !r.server.Started() {
+		return nil, ErrServerNotActive
+	}
+
+	vertex, err := route.NewVertexFromStr(in.PubKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse pubkey: %v", err)
+	}
+
+	count, lastFlap, err := r.server.chanEventStore.ResetFlapCount(vertex)
+	switch {
+	case err == chanfitness.ErrPeerNotFound:
+		return nil, fmt.Errorf("no flap count recorded for peer %v",
+			in.PubKey)
+
+	case err != nil:
+		return nil, err
+	}
+
+	resp := &lnrpc.ResetFlapCountResponse{
+		PreviousFlapCount: int32(count),
+	}
+	if lastFlap != nil {
+		resp.LastFlapNs = lastFlap.UnixNano()
+	}
+
+	return resp, nil
+}
+
+// GetPeerStats returns the number of bytes and messages exchanged with the
+// connected peers since their connection was established. A peer that has
+// just connected reports zero for all of its counters.
+func (r *rpcServer) GetPeerStats(ctx context.Context,
+	in *lnrpc.GetPeerStatsRequest) (*lnrpc.GetPeerStatsResponse, error) {
+
+	rpcsLog.Tracef("[getpeerstats] peer=%v", in.PubKey)
+
+	serverPeers := r.server.Peers()
+	if in.PubKey != "" {
+		vertex, err := route.NewVertexFromStr(in.PubKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse pubkey: %v",
+				err)
+		}
+
+		serverPeer, err := r.server.FindPeerByPubStr(string(vertex[:]))
+		if err != nil {
+			return nil, fmt.Errorf("unable to find peer %v: %v",
+				in.PubKey, err)
+		}
+
+		serverPeers = []*peer.Brontide{serverPeer}
+	}
+
+	resp := &lnrpc.GetPeerStatsResponse{
+		Peers: make([]*lnrpc.PeerStats, 0, len(serverPeers)),
+	}
+	for _, serverPeer := range serverPeers {
+		// The start time is only set once the peer has been started,
+		// so we leave it at zero for a peer that is still starting up.
+		var connectedSince int64
+		if startTime := serverPeer.StartTime(); !startTime.IsZero() {
+			connectedSince = startTime.Unix()
+		}
+
+		nodePub := serverPeer.PubKey()
+		resp.Peers = append(resp.Peers, &lnrpc.PeerStats{
+			PubKey:         hex.EncodeToString(nodePub[:]),
+			ConnectedSince: connectedSince,
+			BytesSent:      serverPeer.BytesSent(),
+			BytesRecv:      serverPeer.BytesReceived(),
+			MsgsSent:       serverPeer.MessagesSent(),
+			MsgsRecv:       serverPeer.MessagesReceived(),
+		})
+	}
+
+	return resp, nil
+}
+
 // SubscribePeerEvents returns a uni-directional stream (server -> client)
 // for notifying the client of peer online and offline events.
 func (r *rpcServer) SubscribePeerEvents(req *lnrpc.PeerEventSubscription,
@@ -3001,6 +3681,86 @@ func (r *rpcServer) ChannelBalance(ctx context.Context,
 	}, nil
 }
 
+// InboundLiquidity returns the total amount that can be received across all
+// open channels, broken down by whether the channels are public or private.
+// Channels whose peer is offline are reported separately.
+func (r *rpcServer) InboundLiquidity(ctx context.Context,
+	in *lnrpc.InboundLiquidityRequest) (
+	*lnrpc.InboundLiquidityResponse, error) {
+
+	var (
+		publicInbound  lnwire.MilliSatoshi
+		privateInbound lnwire.MilliSatoshi
+		offlineInbound lnwire.MilliSatoshi
+
+		numActive  uint32
+		numOffline uint32
+	)
+
+	openChannels, err := r.server.chanStateDB.FetchAllOpenChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, channel := range openChannels {
+		// The remote party can only send us the part of its balance
+		// that exceeds the reserve it is required to keep.
+		reserve := lnwire.NewMSatFromSatoshis(
+			channel.RemoteChanCfg.ChanReserve,
+		)
+
+		var inbound lnwire.MilliSatoshi
+		remoteBalance := channel.LocalCommitment.RemoteBalance
+		if remoteBalance > reserve {
+			inbound = remoteBalance - reserve
+		}
+
+		chanID := lnwire.NewChanIDFromOutPoint(&channel.FundingOutpoint)
+		isPublic := channel.ChannelFlags&lnwire.FFAnnounceChannel != 0
+
+		switch {
+		case !r.server.htlcSwitch.HasActiveLink(chanID):
+			offlineInbound += inbound
+			numOffline++
+
+		case isPublic:
+			publicInbound += inbound
+			numActive++
+
+		default:
+			privateInbound += inbound
+			numActive++
+		}
+	}
+
+	totalInbound := publicInbound + privateInbound
+
+	rpcsLog.Debugf("[inboundliquidity] total=%v public=%v private=%v "+
+		"offline=%v", totalInbound, publicInbound, privateInbound,
+		offlineInbound)
+
+	return &lnrpc.InboundLiquidityResponse{
+		Total: &lnrpc.Amount{
+			Sat:  uint64(totalInbound.ToSatoshis()),
+			Msat: uint64(totalInbound),
+		},
+		Public: &lnrpc.Amount{
+			Sat:  uint64(publicInbound.ToSatoshis()),
+			Msat: uint64(publicInbound),
+		},
+		Private: &lnrpc.Amount{
+			Sat:  uint64(privateInbound.ToSatoshis()),
+			Msat: uint64(privateInbound),
+		},
+		Offline: &lnrpc.Amount{
+			Sat:  uint64(offlineInbound.ToSatoshis()),
+			Msat: uint64(offlineInbound),
+		},
+		NumActiveChannels:  numActive,
+		NumOfflineChannels: numOffline,
+	}, nil
+}
+
 // PendingChannels returns a list of all the channels that are currently
 // considered "pending". A channel is pending if it has finished the funding
 // workflow and is waiting for confirmations for the funding txn, or is in the
@@ -3047,21 +3807,46 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 		commitBaseWeight := blockchain.GetTransactionWeight(utx)
 		commitWeight := commitBaseWeight + input.WitnessCommitmentTxWeight
 
+		// Only channels initiated by the remote party that wait for
+		// a confirmation are abandoned if the funding transaction
+		// doesn't confirm in time. For all others we report a zero
+		// expiry height.
+		fundingExpiryHeight, _ := funding.FundingExpiryHeight(
+			pendingChan,
+		)
+
+		// Until we start waiting for the funding transaction to
+		// confirm, it needs all of the required confirmations.
+		confsRemaining := uint32(pendingChan.NumConfsRequired)
+		numConfs, ok := r.server.fundingMgr.ConfirmationsRemaining(
+			pendingChan.FundingOutpoint,
+		)
+		if ok {
+			confsRemaining = numConfs
+		}
+
 		resp.PendingOpenChannels[i] = &lnrpc.PendingChannelsResponse_PendingOpenChannel{
 			Channel: &lnrpc.PendingChannelsResponse_PendingChannel{
-				RemoteNodePub:        hex.EncodeToString(pub),
-				ChannelPoint:         pendingChan.FundingOutpoint.String(),
-				Capacity:             int64(pendingChan.Capacity),
-				LocalBalance:         int64(localCommitment.LocalBalance.ToSatoshis()),
-				RemoteBalance:        int64(localCommitment.RemoteBalance.ToSatoshis()),
-				LocalChanReserveSat:  int64(pendingChan.LocalChanCfg.ChanReserve),
-				RemoteChanReserveSat: int64(pendingChan.RemoteChanCfg.ChanReserve),
-				Initiator:            rpcInitiator(pendingChan.IsInitiator),
-				CommitmentType:       rpcCommitmentType(pendingChan.ChanType),
+				RemoteNodePub:          hex.EncodeToString(pub),
+				ChannelPoint:           pendingChan.FundingOutpoint.String(),
+				Capacity:               int64(pendingChan.Capacity),
+				LocalBalance:           int64(localCommitment.LocalBalance.ToSatoshis()),
+				RemoteBalance:          int64(localCommitment.RemoteBalance.ToSatoshis()),
+				LocalChanReserveSat:    int64(pendingChan.LocalChanCfg.ChanReserve),
+				RemoteChanReserveSat:   int64(pendingChan.RemoteChanCfg.ChanReserve),
+				Initiator:              rpcInitiator(pendingChan.IsInitiator),
+				CommitmentType:         rpcCommitmentType(pendingChan.ChanType),
+				ConfirmationsRemaining: confsRemaining,
 			},
-			CommitWeight: commitWeight,
-			CommitFee:    int64(localCommitment.CommitFee),
-			FeePerKw:     int64(localCommitment.FeePerKw),
+			CommitWeight:        commitWeight,
+			CommitFee:           int64(localCommitment.CommitFee),
+			FeePerKw:            int64(localCommitment.FeePerKw),
+			FundingExpiryHeight: fundingExpiryHeight,
+			FundingState: rpcFundingState(
+				r.server.fundingMgr.FundingState(
+					pendingChan.FundingOutpoint,
+				),
+			),
 			// TODO(roasbeef): need to track confirmation height
 		}
 	}
@@ -3165,6 +3950,15 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 				return nil, err
 			}
 
+			// Our funds can be spent once our commitment output
+			// matured. A channel that already matured reports
+			// zero.
+			if forceClose.BlocksTilMaturity > 0 {
+				channel.ConfirmationsRemaining = uint32(
+					forceClose.BlocksTilMaturity,
+				)
+			}
+
 			resp.TotalLimboBalance += forceClose.LimboBalance
 
 			resp.PendingForceClosingChannels = append(
@@ -3467,52 +4261,212 @@ func (r *rpcServer) ClosedChannels(ctx context.Context,
 	return resp, nil
 }
 
-// ListChannels returns a description of all the open channels that this node
-// is a participant in.
-func (r *rpcServer) ListChannels(ctx context.Context,
-	in *lnrpc.ListChannelsRequest) (*lnrpc.ListChannelsResponse, error) {
+// PreviewForceCloseSweep estimates the transaction that sweeps the outputs of
+// a pending force closed channel back to the wallet at the current fee
+// estimate. Nothing is broadcast. Outputs that can't be swept yet are returned
+// as pending.
+func (r *rpcServer) PreviewForceCloseSweep(ctx context.Context,
+	in *lnrpc.PreviewForceCloseSweepRequest) (
+	*lnrpc.PreviewForceCloseSweepResponse, error) {
 
-	if in.ActiveOnly && in.InactiveOnly {
-		return nil, fmt.Errorf("either `active_only` or " +
-			"`inactive_only` can be set, but not both")
+	if in.ChannelPoint == nil {
+		return nil, fmt.Errorf("channel_point must be set")
 	}
-
-	if in.PublicOnly && in.PrivateOnly {
-		return nil, fmt.Errorf("either `public_only` or " +
-			"`private_only` can be set, but not both")
+	txid, err := lnrpc.GetChanPointFundingTxid(in.ChannelPoint)
+	if err != nil {
+		return nil, err
 	}
+	chanPoint := wire.NewOutPoint(txid, in.ChannelPoint.OutputIndex)
 
-	if len(in.Peer) > 0 && len(in.Peer) != 33 {
-		_, err := route.NewVertexFromBytes(in.Peer)
-		return nil, fmt.Errorf("invalid `peer` key: %v", err)
+	rpcsLog.Debugf("[previewforceclosesweep] chan_point=%v", chanPoint)
+
+	// The close summary is only written once the closing transaction
+	// confirmed, which is when the outputs to sweep are known.
+	summary, err := r.server.chanStateDB.FetchClosedChannel(chanPoint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find force close of "+
+			"channel %v: %v", chanPoint, err)
+	}
+	if !summary.IsPending {
+		return nil, fmt.Errorf("channel %v is fully resolved",
+			chanPoint)
 	}
 
-	resp := &lnrpc.ListChannelsResponse{}
+	var localForceClose bool
+	switch summary.CloseType {
+	case channeldb.LocalForceClose:
+		localForceClose = true
 
-	graph := r.server.graphDB
+	case channeldb.RemoteForceClose:
 
-	dbChannels, err := r.server.chanStateDB.FetchAllOpenChannels()
+	default:
+		return nil, fmt.Errorf("channel %v wasn't force closed",
+			chanPoint)
+	}
+
+	_, currentHeight, err := r.server.cc.ChainIO.GetBestBlock()
 	if err != nil {
 		return nil, err
 	}
 
-	rpcsLog.Debugf("[listchannels] fetched %v channels from DB",
-		len(dbChannels))
+	feeRate, err := r.server.cc.FeeEstimator.EstimateFeePerKW(
+		sweep.DefaultNumBlocksEstimate,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, dbChannel := range dbChannels {
-		nodePub := dbChannel.IdentityPub
-		nodePubBytes := nodePub.SerializeCompressed()
-		chanPoint := dbChannel.FundingOutpoint
+	arbitrator, err := r.server.chainArb.GetChannelArbitrator(*chanPoint)
+	if err != nil {
+		return nil, err
+	}
 
-		// If the caller requested channels for a target node, skip any
-		// that don't match the provided pubkey.
-		if len(in.Peer) > 0 && !bytes.Equal(nodePubBytes, in.Peer) {
+	var inputs []*sweepPreviewInput
+	for _, report := range arbitrator.Report() {
+		in, ok := newSweepPreviewInput(report, localForceClose)
+		if !ok {
 			continue
 		}
 
-		var peerOnline bool
-		if _, err := r.server.FindPeer(nodePub); err == nil {
-			peerOnline = true
+		inputs = append(inputs, in)
+	}
+
+	preview, err := newSweepPreview(
+		inputs, currentHeight, feeRate, r.server.SweepToScript(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	marshallInput := func(in *sweepPreviewInput,
+		pending bool) *lnrpc.SweepPreviewInput {
+
+		rpcInput := &lnrpc.SweepPreviewInput{
+			Outpoint:       in.outpoint.String(),
+			ResolutionType: in.resolutionType,
+			AmountSat:      int64(in.amount),
+			MaturityHeight: in.maturityHeight,
+			Pending:        pending,
+		}
+		if in.maturityHeight != 0 {
+			rpcInput.BlocksTilMaturity =
+				int32(in.maturityHeight) - currentHeight
+		}
+
+		return rpcInput
+	}
+
+	resp := &lnrpc.PreviewForceCloseSweepResponse{
+		OutputAmountSat: int64(preview.outputAmount),
+		FeeSat:          int64(preview.fee),
+		SatPerVbyte:     uint64(feeRate.FeePerKVByte() / 1000),
+		Weight:          preview.weight,
+	}
+	for _, in := range preview.inputs {
+		resp.Inputs = append(resp.Inputs, marshallInput(in, false))
+	}
+	for _, in := range preview.pending {
+		resp.Inputs = append(resp.Inputs, marshallInput(in, true))
+	}
+
+	return resp, nil
+}
+
+// ChannelOpenHistory returns the most recent channel opens that we initiated,
+// together with their outcome.
+func (r *rpcServer) ChannelOpenHistory(ctx context.Context,
+	in *lnrpc.ChannelOpenHistoryRequest) (*lnrpc.ChannelOpenHistoryResponse,
+	error) {
+
+	var peer route.Vertex
+	if len(in.Peer) > 0 {
+		var err error
+		peer, err = route.NewVertexFromBytes(in.Peer)
+		if err != nil {
+			return nil, fmt.Errorf("invalid `peer` key: %v", err)
+		}
+	}
+
+	resp := &lnrpc.ChannelOpenHistoryResponse{}
+	for _, attempt := range r.server.chanOpenHistory.list() {
+		if len(in.Peer) > 0 && attempt.peer != peer {
+			continue
+		}
+
+		rpcAttempt := &lnrpc.ChannelOpenAttempt{
+			RemoteNodePub:      attempt.peer.String(),
+			LocalFundingAmount: int64(attempt.localAmt),
+			StartTime:          attempt.startTime.Unix(),
+		}
+
+		switch attempt.outcome {
+		case chanOpenPending:
+			rpcAttempt.Outcome = lnrpc.ChannelOpenAttempt_PENDING
+
+		case chanOpenSucceeded:
+			rpcAttempt.Outcome = lnrpc.ChannelOpenAttempt_SUCCEEDED
+
+		case chanOpenFailed:
+			rpcAttempt.Outcome = lnrpc.ChannelOpenAttempt_FAILED
+			rpcAttempt.FailureReason = attempt.failure.Error()
+		}
+
+		if attempt.chanPoint != nil {
+			rpcAttempt.ChannelPoint = attempt.chanPoint.String()
+		}
+
+		resp.Attempts = append(resp.Attempts, rpcAttempt)
+	}
+
+	return resp, nil
+}
+
+// ListChannels returns a description of all the open channels that this node
+// is a participant in.
+func (r *rpcServer) ListChannels(ctx context.Context,
+	in *lnrpc.ListChannelsRequest) (*lnrpc.ListChannelsResponse, error) {
+
+	if in.ActiveOnly && in.InactiveOnly {
+		return nil, fmt.Errorf("either `active_only` or " +
+			"`inactive_only` can be set, but not both")
+	}
+
+	if in.PublicOnly && in.PrivateOnly {
+		return nil, fmt.Errorf("either `public_only` or " +
+			"`private_only` can be set, but not both")
+	}
+
+	if len(in.Peer) > 0 && len(in.Peer) != 33 {
+		_, err := route.NewVertexFromBytes(in.Peer)
+		return nil, fmt.Errorf("invalid `peer` key: %v", err)
+	}
+
+	resp := &lnrpc.ListChannelsResponse{}
+
+	graph := r.server.graphDB
+
+	dbChannels, err := r.server.chanStateDB.FetchAllOpenChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("[listchannels] fetched %v channels from DB",
+		len(dbChannels))
+
+	for _, dbChannel := range dbChannels {
+		nodePub := dbChannel.IdentityPub
+		nodePubBytes := nodePub.SerializeCompressed()
+		chanPoint := dbChannel.FundingOutpoint
+
+		// If the caller requested channels for a target node, skip any
+		// that don't match the provided pubkey.
+		if len(in.Peer) > 0 && !bytes.Equal(nodePubBytes, in.Peer) {
+			continue
+		}
+
+		var peerOnline bool
+		if _, err := r.server.FindPeer(nodePub); err == nil {
+			peerOnline = true
 		}
 
 		channelID := lnwire.NewChanIDFromOutPoint(&chanPoint)
@@ -3524,31 +4478,582 @@ func (r *rpcServer) ListChannels(ctx context.Context,
 			linkActive = link.EligibleToForward()
 		}
 
-		// Next, we'll determine whether we should add this channel to
-		// our list depending on the type of channels requested to us.
-		isActive := peerOnline && linkActive
-		channel, err := createRPCOpenChannel(r, graph, dbChannel, isActive)
+		// Next, we'll determine whether we should add this channel to
+		// our list depending on the type of channels requested to us.
+		isActive := peerOnline && linkActive
+		channel, err := createRPCOpenChannel(r, graph, dbChannel, isActive)
+		if err != nil {
+			return nil, err
+		}
+
+		// We'll only skip returning this channel if we were requested
+		// for a specific kind and this channel doesn't satisfy it.
+		switch {
+		case in.ActiveOnly && !isActive:
+			continue
+		case in.InactiveOnly && isActive:
+			continue
+		case in.PublicOnly && channel.Private:
+			continue
+		case in.PrivateOnly && !channel.Private:
+			continue
+		}
+
+		resp.Channels = append(resp.Channels, channel)
+	}
+
+	if in.SortBy == lnrpc.ListChannelsRequest_UNSORTED {
+		return resp, nil
+	}
+
+	window := defaultActivityWindow
+	if in.ActivityWindowSeconds != 0 {
+		window = time.Duration(in.ActivityWindowSeconds) * time.Second
+	}
+
+	activity, err := r.fetchChannelActivity(window)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sortChannelsByActivity(
+		resp.Channels, activity, in.SortBy,
+	); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// defaultActivityWindow is the window over which the routing activity of
+// channels is computed if none is requested.
+const defaultActivityWindow = 30 * 24 * time.Hour
+
+// channelActivity describes the routing activity of a channel.
+type channelActivity struct {
+	// forwards is the number of forwards the channel took part in, either
+	// as incoming or outgoing channel.
+	forwards uint64
+
+	// feeRevenue is the total fee earned by forwards that left through
+	// the channel.
+	feeRevenue lnwire.MilliSatoshi
+}
+
+// fetchChannelActivity returns the routing activity over the given window,
+// ending at the present, keyed by short channel id. Channels without any
+// forwards within the window are absent from the returned map.
+func (r *rpcServer) fetchChannelActivity(window time.Duration) (
+	map[uint64]*channelActivity, error) {
+
+	// Flush any pending events to disk to get a complete snapshot.
+	if err := r.server.htlcSwitch.FlushForwardingEvents(); err != nil {
+		return nil, fmt.Errorf("unable to flush forwarding "+
+			"events: %v", err)
+	}
+
+	now := time.Now()
+	query := channeldb.ForwardingEventQuery{
+		StartTime:    now.Add(-window),
+		EndTime:      now,
+		NumMaxEvents: 1000,
+	}
+
+	activity := make(map[uint64]*channelActivity)
+	activityOf := func(chanID lnwire.ShortChannelID) *channelActivity {
+		a, ok := activity[chanID.ToUint64()]
+		if !ok {
+			a = &channelActivity{}
+			activity[chanID.ToUint64()] = a
+		}
+
+		return a
+	}
+
+	fwdEventLog := r.server.chanStateDB.ForwardingLog()
+	for {
+		timeSlice, err := fwdEventLog.Query(query)
+		if err != nil {
+			return nil, err
+		}
+
+		// We've retrieved all events in the window once the time
+		// slice comes back empty.
+		if len(timeSlice.ForwardingEvents) == 0 {
+			break
+		}
+
+		for _, event := range timeSlice.ForwardingEvents {
+			activityOf(event.IncomingChanID).forwards++
+
+			outgoing := activityOf(event.OutgoingChanID)
+			outgoing.forwards++
+			outgoing.feeRevenue += event.AmtIn - event.AmtOut
+		}
+
+		query.IndexOffset = timeSlice.LastIndexOffset
+	}
+
+	return activity, nil
+}
+
+// sortChannelsByActivity sorts the channels by the given measure of routing
+// activity, most active first. Channels without activity are moved to the
+// end, and ties are broken by channel id so that the order is stable across
+// calls.
+func sortChannelsByActivity(channels []*lnrpc.Channel,
+	activity map[uint64]*channelActivity,
+	sortBy lnrpc.ListChannelsRequest_SortOrder) error {
+
+	var measure func(a *channelActivity) uint64
+	switch sortBy {
+	case lnrpc.ListChannelsRequest_FORWARD_COUNT:
+		measure = func(a *channelActivity) uint64 {
+			return a.forwards
+		}
+
+	case lnrpc.ListChannelsRequest_FEE_REVENUE:
+		measure = func(a *channelActivity) uint64 {
+			return uint64(a.feeRevenue)
+		}
+
+	default:
+		return fmt.Errorf("unknown sort order %v", sortBy)
+	}
+
+	activityOf := func(channel *lnrpc.Channel) (uint64, bool) {
+		a, ok := activity[channel.ChanId]
+		if !ok {
+			return 0, false
+		}
+
+		return measure(a), true
+	}
+
+	sort.SliceStable(channels, func(i, j int) bool {
+		measureI, activeI := activityOf(channels[i])
+		measureJ, activeJ := activityOf(channels[j])
+
+		switch {
+		case activeI != activeJ:
+			return activeI
+
+		case measureI != measureJ:
+			return measureI > measureJ
+
+		default:
+			return channels[i].ChanId < channels[j].ChanId
+		}
+	})
+
+	return nil
+}
+
+// ListRoutableChannels returns the set of active channels that currently have
+// enough spendable balance to originate a payment of the given amount. The
+// spendable balance is taken from the link's bandwidth, which accounts for the
+// channel reserve and any in-flight HTLCs.
+func (r *rpcServer) ListRoutableChannels(ctx context.Context,
+	in *lnrpc.ListRoutableChannelsRequest) (
+	*lnrpc.ListRoutableChannelsResponse, error) {
+
+	amt, err := lnrpc.UnmarshallAmt(in.AmtSat, in.AmtMsat)
+	if err != nil {
+		return nil, err
+	}
+
+	dbChannels, err := r.server.chanStateDB.FetchAllOpenChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("[listroutablechannels] checking %v channels for "+
+		"amount %v", len(dbChannels), amt)
+
+	resp := &lnrpc.ListRoutableChannelsResponse{}
+	for _, dbChannel := range dbChannels {
+		chanPoint := dbChannel.FundingOutpoint
+		chanID := lnwire.NewChanIDFromOutPoint(&chanPoint)
+
+		// Channels that aren't known to the switch or aren't able to
+		// forward can't be used to originate a payment.
+		link, err := r.server.htlcSwitch.GetLink(chanID)
+		if err != nil || !link.EligibleToForward() {
+			continue
+		}
+
+		// A channel that already has the maximum number of HTLCs in
+		// flight can't take another one, regardless of its balance.
+		if link.MayAddOutgoingHtlc() != nil {
+			continue
+		}
+
+		// The bandwidth already has the reserve and the value of any
+		// in-flight HTLCs subtracted.
+		bandwidth := link.Bandwidth()
+		if bandwidth < amt {
+			continue
+		}
+
+		nodePub := dbChannel.IdentityPub.SerializeCompressed()
+		resp.Channels = append(resp.Channels, &lnrpc.RoutableChannel{
+			ChanId:          dbChannel.ShortChanID().ToUint64(),
+			ChannelPoint:    chanPoint.String(),
+			RemotePubkey:    hex.EncodeToString(nodePub),
+			SpendableMsat:   uint64(bandwidth),
+			NumPendingHtlcs: uint32(len(dbChannel.ActiveHtlcs())),
+		})
+	}
+
+	return resp, nil
+}
+
+// ChannelUptimeHistory returns the uptime of the peers of our open channels
+// over the requested window, split into buckets of the requested size.
+// Channels that are younger than the window only report their uptime since
+// they were opened.
+func (r *rpcServer) ChannelUptimeHistory(ctx context.Context,
+	in *lnrpc.ChannelUptimeHistoryRequest) (
+	*lnrpc.ChannelUptimeHistoryResponse, error) {
+
+	if in.WindowSeconds == 0 {
+		return nil, fmt.Errorf("window_seconds must be set")
+	}
+	if in.BucketSeconds == 0 {
+		return nil, fmt.Errorf("bucket_seconds must be set")
+	}
+	if in.BucketSeconds > in.WindowSeconds {
+		return nil, fmt.Errorf("bucket_seconds (%v) must not exceed "+
+			"window_seconds (%v)", in.BucketSeconds,
+			in.WindowSeconds)
+	}
+
+	// The event store only serves requests once the server has started,
+	// so we bail out early to prevent the request from blocking.
+	if !r.server.Started() {
+		return nil, ErrServerNotActive
+	}
+
+	window := time.Duration(in.WindowSeconds) * time.Second
+	bucketSize := time.Duration(in.BucketSeconds) * time.Second
+
+	dbChannels, err := r.server.chanStateDB.FetchAllOpenChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("[chanuptimehistory] chan_id=%v, window=%v, "+
+		"bucket_size=%v", in.ChanId, window, bucketSize)
+
+	resp := &lnrpc.ChannelUptimeHistoryResponse{}
+	for _, dbChannel := range dbChannels {
+		chanID := dbChannel.ShortChanID().ToUint64()
+		if in.ChanId != 0 && chanID != in.ChanId {
+			continue
+		}
+
+		nodePub := dbChannel.IdentityPub.SerializeCompressed()
+		peer, err := route.NewVertexFromBytes(nodePub)
+		if err != nil {
+			return nil, err
+		}
+
+		// Do not fail if the event store does not know about the
+		// channel yet, unless it was explicitly requested, because
+		// there is a potential race between a channel being added to
+		// our node and the event store being notified of it.
+		chanPoint := dbChannel.FundingOutpoint
+		buckets, err := r.server.chanEventStore.GetUptimeHistory(
+			chanPoint, peer, window, bucketSize,
+		)
+		switch {
+		case err == chanfitness.ErrChannelNotFound && in.ChanId == 0:
+			rpcsLog.Infof("channel: %v not found by channel event "+
+				"store", chanPoint)
+			continue
+
+		case err != nil:
+			return nil, err
+		}
+
+		history := &lnrpc.ChannelUptimeHistory{
+			ChanId:       chanID,
+			ChannelPoint: chanPoint.String(),
+			RemotePubkey: hex.EncodeToString(nodePub),
+			SinceOpen:    true,
+		}
+
+		// The buckets only cover the full window if the channel has
+		// been monitored for at least as long.
+		if len(buckets) > 0 {
+			first, last := buckets[0], buckets[len(buckets)-1]
+			history.SinceOpen = last.End.Sub(first.Start) < window
+		}
+
+		for _, bucket := range buckets {
+			size := bucket.End.Sub(bucket.Start)
+			uptime := bucket.Uptime
+
+			history.Buckets = append(
+				history.Buckets, &lnrpc.UptimeBucket{
+					StartTime: bucket.Start.Unix(),
+					EndTime:   bucket.End.Unix(),
+					Uptime:    int64(uptime.Seconds()),
+					UptimePercentage: float64(uptime) /
+						float64(size) * 100,
+				},
+			)
+		}
+
+		resp.Channels = append(resp.Channels, history)
+	}
+
+	if in.ChanId != 0 && len(resp.Channels) == 0 {
+		return nil, fmt.Errorf("open channel %v not found", in.ChanId)
+	}
+
+	return resp, nil
+}
+
+// GetCommitmentOutputs returns the outputs of our current commitment
+// transaction of an open channel along with their amounts and script types.
+func (r *rpcServer) GetCommitmentOutputs(ctx context.Context,
+	in *lnrpc.GetCommitmentOutputsRequest) (
+	*lnrpc.GetCommitmentOutputsResponse, error) {
+
+	if in.ChannelPoint == nil {
+		return nil, fmt.Errorf("channel_point must be set")
+	}
+	txid, err := lnrpc.GetChanPointFundingTxid(in.ChannelPoint)
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.NewOutPoint(txid, in.ChannelPoint.OutputIndex)
+
+	rpcsLog.Debugf("[getcommitmentoutputs] chan_point=%v", chanPoint)
+
+	dbChannel, err := r.server.chanStateDB.FetchChannel(nil, *chanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	commitOutputs, err := lnwallet.LocalCommitOutputs(dbChannel)
+	if err != nil {
+		return nil, err
+	}
+
+	localCommit := dbChannel.LocalCommitment
+	resp := &lnrpc.GetCommitmentOutputsResponse{
+		CommitTxid:   localCommit.CommitTx.TxHash().String(),
+		CommitHeight: localCommit.CommitHeight,
+	}
+	for _, output := range commitOutputs {
+		scriptClass := txscript.GetScriptClass(output.PkScript)
+		rpcOutput := &lnrpc.CommitmentOutput{
+			Type:        marshallCommitOutputType(output.Type),
+			OutputIndex: output.OutputIndex,
+			AmountSat:   int64(output.Amount),
+			ScriptType:  scriptClass.String(),
+			PkScript:    hex.EncodeToString(output.PkScript),
+		}
+
+		switch output.Type {
+		case lnwallet.CommitOutputIncomingHtlc,
+			lnwallet.CommitOutputOutgoingHtlc:
+
+			rpcOutput.PaymentHash = output.PaymentHash[:]
+		}
+
+		resp.Outputs = append(resp.Outputs, rpcOutput)
+	}
+
+	return resp, nil
+}
+
+// SetTargetLocalRatio stores the share of the capacity of an open channel that
+// the user would like to be on the local side of the channel.
+func (r *rpcServer) SetTargetLocalRatio(ctx context.Context,
+	in *lnrpc.SetTargetLocalRatioRequest) (
+	*lnrpc.SetTargetLocalRatioResponse, error) {
+
+	if in.ChannelPoint == nil {
+		return nil, fmt.Errorf("channel_point must be set")
+	}
+	txid, err := lnrpc.GetChanPointFundingTxid(in.ChannelPoint)
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.NewOutPoint(txid, in.ChannelPoint.OutputIndex)
+
+	rpcsLog.Debugf("[settargetlocalratio] chan_point=%v, ratio=%v",
+		chanPoint, in.TargetLocalRatio)
+
+	dbChannel, err := r.server.chanStateDB.FetchChannel(nil, *chanPoint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find channel %v: %v",
+			chanPoint, err)
+	}
+
+	err = dbChannel.SetTargetLocalRatio(in.TargetLocalRatio)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.SetTargetLocalRatioResponse{}, nil
+}
+
+// SetMaxHtlcHold sets the maximum duration that an HTLC forwarded over an open
+// channel may wait to be added to the channel before it is failed back.
+func (r *rpcServer) SetMaxHtlcHold(ctx context.Context,
+	in *lnrpc.SetMaxHtlcHoldRequest) (*lnrpc.SetMaxHtlcHoldResponse,
+	error) {
+
+	if in.ChannelPoint == nil {
+		return nil, fmt.Errorf("channel_point must be set")
+	}
+	txid, err := lnrpc.GetChanPointFundingTxid(in.ChannelPoint)
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.NewOutPoint(txid, in.ChannelPoint.OutputIndex)
+
+	hold := time.Duration(in.MaxHtlcHoldSec) * time.Second
+
+	rpcsLog.Debugf("[setmaxhtlchold] chan_point=%v, hold=%v", chanPoint,
+		hold)
+
+	dbChannel, err := r.server.chanStateDB.FetchChannel(nil, *chanPoint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find channel %v: %v",
+			chanPoint, err)
+	}
+
+	// The hold must pass before the incoming htlcs are resolved on chain,
+	// which is bounded by the time lock delta of the channel.
+	if hold != 0 {
+		timeLockDelta, err := r.localTimeLockDelta(*chanPoint)
+		if err != nil {
+			return nil, err
+		}
+
+		err = htlcswitch.ValidateMaxHtlcHold(
+			hold, r.cfg.ActiveNetParams.TargetTimePerBlock,
+			timeLockDelta, lncfg.DefaultIncomingBroadcastDelta,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := dbChannel.SetMaxHtlcHold(hold); err != nil {
+		return nil, err
+	}
+
+	chanID := lnwire.NewChanIDFromOutPoint(chanPoint)
+	r.server.htlcSwitch.SetMaxHtlcHold(chanID, hold)
+
+	return &lnrpc.SetMaxHtlcHoldResponse{}, nil
+}
+
+// localTimeLockDelta returns the time lock delta of our policy for the given
+// channel. Channels that aren't part of the graph yet have no policy, in which
+// case the smallest time lock delta we allow for our channels is returned, as
+// the policy can't be set below it.
+func (r *rpcServer) localTimeLockDelta(chanPoint wire.OutPoint) (uint32,
+	error) {
+
+	graph := r.server.graphDB
+	edgeInfo, edge1, edge2, err := graph.FetchChannelEdgesByOutpoint(
+		&chanPoint,
+	)
+	switch {
+	case err == channeldb.ErrGraphNotFound,
+		err == channeldb.ErrGraphNoEdgesFound,
+		err == channeldb.ErrEdgeNotFound:
+
+		return r.policyMinTimeLockDelta(), nil
+
+	case err != nil:
+		return 0, err
+	}
+
+	localPolicy := edge1
+	if edgeInfo.NodeKey2Bytes == r.selfNode {
+		localPolicy = edge2
+	}
+	if localPolicy == nil {
+		return r.policyMinTimeLockDelta(), nil
+	}
+
+	return uint32(localPolicy.TimeLockDelta), nil
+}
+
+// checkMaxHtlcHolds returns an error if the given time lock delta is too small
+// for the max htlc hold of any of the given channels. All open channels are
+// checked if none are given.
+func (r *rpcServer) checkMaxHtlcHolds(timeLockDelta uint32,
+	chanPoints []wire.OutPoint) error {
+
+	var channels []*channeldb.OpenChannel
+	if len(chanPoints) == 0 {
+		var err error
+		channels, err = r.server.chanStateDB.FetchAllOpenChannels()
 		if err != nil {
-			return nil, err
+			return err
+		}
+	}
+	for _, chanPoint := range chanPoints {
+		channel, err := r.server.chanStateDB.FetchChannel(nil, chanPoint)
+		if err != nil {
+			return err
 		}
+		channels = append(channels, channel)
+	}
 
-		// We'll only skip returning this channel if we were requested
-		// for a specific kind and this channel doesn't satisfy it.
+	for _, channel := range channels {
+		hold, err := channel.MaxHtlcHold()
 		switch {
-		case in.ActiveOnly && !isActive:
-			continue
-		case in.InactiveOnly && isActive:
-			continue
-		case in.PublicOnly && channel.Private:
-			continue
-		case in.PrivateOnly && !channel.Private:
+		case err == channeldb.ErrNoMaxHtlcHold:
 			continue
+
+		case err != nil:
+			return err
 		}
 
-		resp.Channels = append(resp.Channels, channel)
+		err = htlcswitch.ValidateMaxHtlcHold(
+			hold, r.cfg.ActiveNetParams.TargetTimePerBlock,
+			timeLockDelta, lncfg.DefaultIncomingBroadcastDelta,
+		)
+		if err != nil {
+			return fmt.Errorf("channel %v: %v",
+				channel.FundingOutpoint, err)
+		}
 	}
 
-	return resp, nil
+	return nil
+}
+
+// marshallCommitOutputType converts a commitment output type to its rpc
+// counterpart.
+func marshallCommitOutputType(
+	outputType lnwallet.CommitOutputType) lnrpc.CommitmentOutputType {
+
+	switch outputType {
+	case lnwallet.CommitOutputToLocal:
+		return lnrpc.CommitmentOutputType_TO_LOCAL
+	case lnwallet.CommitOutputToRemote:
+		return lnrpc.CommitmentOutputType_TO_REMOTE
+	case lnwallet.CommitOutputLocalAnchor:
+		return lnrpc.CommitmentOutputType_LOCAL_ANCHOR
+	case lnwallet.CommitOutputRemoteAnchor:
+		return lnrpc.CommitmentOutputType_REMOTE_ANCHOR
+	case lnwallet.CommitOutputIncomingHtlc:
+		return lnrpc.CommitmentOutputType_INCOMING_HTLC_OUTPUT
+	case lnwallet.CommitOutputOutgoingHtlc:
+		return lnrpc.CommitmentOutputType_OUTGOING_HTLC_OUTPUT
+	default:
+		return lnrpc.CommitmentOutputType_UNKNOWN_COMMITMENT_OUTPUT
+	}
 }
 
 // rpcCommitmentType takes the channel type and converts it to an rpc commitment
@@ -3568,6 +5073,68 @@ func rpcCommitmentType(chanType channeldb.ChannelType) lnrpc.CommitmentType {
 	return lnrpc.CommitmentType_LEGACY
 }
 
+// currentMaxForwardable returns the largest HTLC that can currently be sent
+// over the channel of the given link. It is limited by the bandwidth of the
+// link, the value of outgoing HTLCs that the remote peer still accepts in
+// flight, and the max HTLC of our channel policy, if non-zero. If no further
+// HTLC can be added to the channel, zero is returned.
+func currentMaxForwardable(link htlcswitch.ChannelUpdateHandler,
+	dbChannel *channeldb.OpenChannel,
+	maxHtlc lnwire.MilliSatoshi) lnwire.MilliSatoshi {
+
+	// This covers channels that reached the maximum number of HTLCs
+	// accepted by the remote peer.
+	if err := link.MayAddOutgoingHtlc(); err != nil {
+		return 0
+	}
+
+	maxForwardable := link.Bandwidth()
+
+	var inFlight lnwire.MilliSatoshi
+	for _, htlc := range dbChannel.LocalCommitment.Htlcs {
+		if !htlc.Incoming {
+			inFlight += htlc.Amt
+		}
+	}
+
+	maxInFlight := dbChannel.RemoteChanCfg.MaxPendingAmount
+	if inFlight >= maxInFlight {
+		return 0
+	}
+	if maxForwardable > maxInFlight-inFlight {
+		maxForwardable = maxInFlight - inFlight
+	}
+
+	if maxHtlc != 0 && maxForwardable > maxHtlc {
+		maxForwardable = maxHtlc
+	}
+
+	return maxForwardable
+}
+
+// rpcFundingState converts the funding state of a pending channel to its rpc
+// representation.
+func rpcFundingState(
+	state funding.FundingState) lnrpc.PendingChannelsResponse_PendingOpenChannel_FundingState {
+
+	switch state {
+	case funding.FundingStateAwaitingPublication:
+		return lnrpc.PendingChannelsResponse_PendingOpenChannel_AWAITING_PUBLICATION
+
+	case funding.FundingStateAwaitingConfirmation:
+		return lnrpc.PendingChannelsResponse_PendingOpenChannel_AWAITING_CONFIRMATION
+
+	case funding.FundingStateConfirmed:
+		return lnrpc.PendingChannelsResponse_PendingOpenChannel_CONFIRMED
+
+	case funding.FundingStateFailed:
+		return lnrpc.PendingChannelsResponse_PendingOpenChannel_FAILED
+
+	default:
+		return lnrpc.PendingChannelsResponse_PendingOpenChannel_UNKNOWN_FUNDING_STATE
+	}
+}
+
 // createChannelConstraint creates a *lnrpc.ChannelConstraints using the
 // *Channeldb.ChannelConfig.
 func createChannelConstraint(
@@ -3749,6 +5316,77 @@ func createRPCOpenChannel(r *rpcServer, graph *channeldb.ChannelGraph,
 		channel.CloseAddress = addresses[0].String()
 	}
 
+	// Report the target local ratio of the channel, if one was set.
+	targetRatio, err := dbChannel.TargetLocalRatio()
+	switch {
+	case err == channeldb.ErrNoTargetLocalRatio:
+
+	case err != nil:
+		return nil, err
+
+	default:
+		channel.TargetLocalRatio = targetRatio
+		channel.TargetLocalRatioSet = true
+	}
+
+	// Report the max htlc hold duration of the channel, if one was set.
+	maxHtlcHold, err := dbChannel.MaxHtlcHold()
+	switch {
+	case err == channeldb.ErrNoMaxHtlcHold:
+
+	case err != nil:
+		return nil, err
+
+	default:
+		channel.MaxHtlcHoldSec = uint32(maxHtlcHold / time.Second)
+	}
+
+	// Flag the channel if the time lock delta we advertise for it is below
+	// the configured minimum, which happens if the minimum was raised after
+	// the policy was last updated. Channels that aren't part of the graph
+	// yet have no advertised policy.
+	var maxHtlc lnwire.MilliSatoshi
+	edgeInfo, edge1, edge2, err := graph.FetchChannelEdgesByOutpoint(
+		&chanPoint,
+	)
+	switch {
+	case err == channeldb.ErrGraphNotFound,
+		err == channeldb.ErrGraphNoEdgesFound,
+		err == channeldb.ErrEdgeNotFound:
+
+	case err != nil:
+		return nil, err
+
+	default:
+		localPolicy := edge1
+		if edgeInfo.NodeKey2Bytes == r.selfNode {
+			localPolicy = edge2
+		}
+
+		minDelta := r.policyMinTimeLockDelta()
+		if localPolicy != nil &&
+			uint32(localPolicy.TimeLockDelta) < minDelta {
+
+			channel.TimeLockDeltaBelowMin = true
+		}
+
+		if localPolicy != nil && localPolicy.MessageFlags.HasMaxHtlc() {
+			maxHtlc = localPolicy.MaxHTLC
+		}
+	}
+
+	// Only active channels have a link that can forward an HTLC right
+	// now.
+	if isActive {
+		chanID := lnwire.NewChanIDFromOutPoint(&chanPoint)
+		link, err := r.server.htlcSwitch.GetLink(chanID)
+		if err == nil {
+			channel.CurrentMaxForwardableMsat = uint64(
+				currentMaxForwardable(link, dbChannel, maxHtlc),
+			)
+		}
+	}
+
 	// If the server hasn't fully started yet, it's possible that the
 	// channel event store hasn't either, so it won't be able to consume any
 	// requests until then. To prevent blocking, we'll just omit the uptime
@@ -4878,6 +6516,7 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 		GenAmpInvoiceFeatures: func() *lnwire.FeatureVector {
 			return r.server.featureMgr.Get(feature.SetInvoiceAmp)
 		},
+		DefaultExpiry: r.server.invoices.DefaultInvoiceExpiry(),
 	}
 
 	value, err := lnrpc.UnmarshallAmt(invoice.Value, invoice.ValueMsat)
@@ -4900,6 +6539,7 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 		Private:         invoice.Private,
 		RouteHints:      routeHints,
 		Amp:             invoice.IsAmp,
+		MaxHints:        int(invoice.MaxHints),
 	}
 
 	if invoice.RPreimage != nil {
@@ -4917,17 +6557,56 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 		return nil, err
 	}
 
+	// Report the channels the route hints of the invoice were created
+	// for, so that callers can tell where inbound payments are steered.
+	hintChans, err := invoicesrpc.HopHintChannels(
+		addInvoiceCfg, string(dbInvoice.PaymentRequest),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	hintChanPoints := make([]string, 0, len(hintChans))
+	for _, chanPoint := range hintChans {
+		hintChanPoints = append(hintChanPoints, chanPoint.String())
+	}
+
 	return &lnrpc.AddInvoiceResponse{
-		AddIndex:       dbInvoice.AddIndex,
-		PaymentRequest: string(dbInvoice.PaymentRequest),
-		RHash:          hash[:],
-		PaymentAddr:    dbInvoice.Terms.PaymentAddr[:],
+		AddIndex:          dbInvoice.AddIndex,
+		PaymentRequest:    string(dbInvoice.PaymentRequest),
+		RHash:             hash[:],
+		PaymentAddr:       dbInvoice.Terms.PaymentAddr[:],
+		HintChannelPoints: hintChanPoints,
+	}, nil
+}
+
+// SetDefaultInvoiceExpiry updates the expiry of new invoices that don't specify
+// one and returns the previous default expiry.
+func (r *rpcServer) SetDefaultInvoiceExpiry(ctx context.Context,
+	req *lnrpc.SetDefaultInvoiceExpiryRequest) (
+	*lnrpc.SetDefaultInvoiceExpiryResponse, error) {
+
+	maxExpiry := invoicesrpc.MaxInvoiceExpiry
+	expiry := time.Duration(req.Expiry) * time.Second
+	if expiry <= 0 || expiry > maxExpiry {
+		return nil, fmt.Errorf("expiry must be positive and at most "+
+			"%v seconds, got %v", maxExpiry.Seconds(), req.Expiry)
+	}
+
+	prevExpiry := r.server.invoices.SetDefaultInvoiceExpiry(expiry)
+
+	rpcsLog.Infof("Updated default invoice expiry from %v to %v",
+		prevExpiry, expiry)
+
+	return &lnrpc.SetDefaultInvoiceExpiryResponse{
+		PreviousExpiry: int64(prevExpiry.Seconds()),
 	}, nil
 }
 
 // LookupInvoice attempts to look up an invoice according to its payment hash.
 // The passed payment hash *must* be exactly 32 bytes, if not an error is
-// returned.
+// returned. If a set id is provided instead, the AMP invoice that the set paid
+// to is looked up, and only the HTLCs of that set are returned.
 func (r *rpcServer) LookupInvoice(ctx context.Context,
 	req *lnrpc.PaymentHash) (*lnrpc.Invoice, error) {
 
@@ -4955,11 +6634,43 @@ func (r *rpcServer) LookupInvoice(ctx context.Context,
 	}
 	copy(payHash[:], rHash)
 
-	rpcsLog.Tracef("[lookupinvoice] searching for invoice %x", payHash[:])
+	var invoice channeldb.Invoice
+	if len(req.SetId) != 0 {
+		if len(req.SetId) != 32 {
+			return nil, fmt.Errorf("set id must be exactly 32 "+
+				"bytes, is instead %v", len(req.SetId))
+		}
 
-	invoice, err := r.server.invoices.LookupInvoice(payHash)
-	if err != nil {
-		return nil, err
+		var setID [32]byte
+		copy(setID[:], req.SetId)
+
+		rpcsLog.Tracef("[lookupinvoice] searching for invoice with "+
+			"set id %x", setID[:])
+
+		invoice, err = r.server.invoices.LookupInvoiceByRef(
+			channeldb.InvoiceRefBySetID(setID),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// We only report the HTLCs of the requested set, so that the
+		// state of the invoice reflects that set alone.
+		htlcs := make(map[channeldb.CircuitKey]*channeldb.InvoiceHTLC)
+		for key, htlc := range invoice.Htlcs {
+			if htlc.IsInHTLCSet(&setID) {
+				htlcs[key] = htlc
+			}
+		}
+		invoice.Htlcs = htlcs
+	} else {
+		rpcsLog.Tracef("[lookupinvoice] searching for invoice %x",
+			payHash[:])
+
+		invoice, err = r.server.invoices.LookupInvoice(payHash)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	rpcsLog.Tracef("[lookupinvoice] located invoice %v",
@@ -4974,9 +6685,85 @@ func (r *rpcServer) LookupInvoice(ctx context.Context,
 		return nil, err
 	}
 
+	// If a payment hash was provided along with the set id, it must match
+	// the invoice the set paid to.
+	if len(req.SetId) != 0 && len(rHash) != 0 &&
+		!bytes.Equal(rpcInvoice.RHash, rHash) {
+
+		return nil, fmt.Errorf("set id %x does not belong to invoice "+
+			"with payment hash %x", req.SetId, rHash)
+	}
+
 	return rpcInvoice, nil
 }
 
+// maxLookupInvoices is the maximum number of invoices that can be looked up
+// with a single LookupInvoices call.
+const maxLookupInvoices = 1000
+
+// LookupInvoices looks up several invoices at once according to their payment
+// hashes. A result is returned for every requested hash, indicating whether a
+// matching invoice was found, so that a mix of known and unknown hashes
+// results in a partial result rather than an error.
+func (r *rpcServer) LookupInvoices(ctx context.Context,
+	req *lnrpc.LookupInvoicesRequest) (*lnrpc.LookupInvoicesResponse,
+	error) {
+
+	if len(req.RHashes) > maxLookupInvoices {
+		return nil, fmt.Errorf("at most %v invoices can be looked up "+
+			"at once, got %v", maxLookupInvoices, len(req.RHashes))
+	}
+
+	// Validate all hashes up front, so we don't do any work for a request
+	// that is going to fail anyway.
+	for i, rHash := range req.RHashes {
+		if len(rHash) != 32 {
+			return nil, fmt.Errorf("payment hash %d must be "+
+				"exactly 32 bytes, is instead %v", i,
+				len(rHash))
+		}
+	}
+
+	rpcsLog.Tracef("[lookupinvoices] searching for %v invoices",
+		len(req.RHashes))
+
+	results := make([]*lnrpc.InvoiceLookupResult, 0, len(req.RHashes))
+	for _, rHash := range req.RHashes {
+		var payHash lntypes.Hash
+		copy(payHash[:], rHash)
+
+		result := &lnrpc.InvoiceLookupResult{
+			RHash: rHash,
+		}
+		results = append(results, result)
+
+		invoice, err := r.server.invoices.LookupInvoice(payHash)
+		switch {
+		case err == channeldb.ErrInvoiceNotFound ||
+			err == channeldb.ErrNoInvoicesCreated:
+
+			continue
+
+		case err != nil:
+			return nil, err
+		}
+
+		rpcInvoice, err := invoicesrpc.CreateRPCInvoice(
+			&invoice, r.cfg.ActiveNetParams.Params,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Found = true
+		result.Invoice = rpcInvoice
+	}
+
+	return &lnrpc.LookupInvoicesResponse{
+		Results: results,
+	}, nil
+}
+
 // ListInvoices returns a list of all the invoices currently stored within the
 // database. Any active debug invoices are ignored.
 func (r *rpcServer) ListInvoices(ctx context.Context,
@@ -5259,32 +7046,34 @@ func marshalDbEdge(edgeInfo *channeldb.ChannelEdgeInfo,
 	}
 
 	if c1 != nil {
-		edge.Node1Policy = &lnrpc.RoutingPolicy{
-			TimeLockDelta:    uint32(c1.TimeLockDelta),
-			MinHtlc:          int64(c1.MinHTLC),
-			MaxHtlcMsat:      uint64(c1.MaxHTLC),
-			FeeBaseMsat:      int64(c1.FeeBaseMSat),
-			FeeRateMilliMsat: int64(c1.FeeProportionalMillionths),
-			Disabled:         c1.ChannelFlags&lnwire.ChanUpdateDisabled != 0,
-			LastUpdate:       uint32(c1.LastUpdate.Unix()),
-		}
+		edge.Node1Policy = marshalDBRoutingPolicy(c1)
 	}
 
 	if c2 != nil {
-		edge.Node2Policy = &lnrpc.RoutingPolicy{
-			TimeLockDelta:    uint32(c2.TimeLockDelta),
-			MinHtlc:          int64(c2.MinHTLC),
-			MaxHtlcMsat:      uint64(c2.MaxHTLC),
-			FeeBaseMsat:      int64(c2.FeeBaseMSat),
-			FeeRateMilliMsat: int64(c2.FeeProportionalMillionths),
-			Disabled:         c2.ChannelFlags&lnwire.ChanUpdateDisabled != 0,
-			LastUpdate:       uint32(c2.LastUpdate.Unix()),
-		}
+		edge.Node2Policy = marshalDBRoutingPolicy(c2)
 	}
 
 	return edge
 }
 
+// marshalDBRoutingPolicy converts a channel edge policy from the database into
+// its RPC representation.
+func marshalDBRoutingPolicy(
+	policy *channeldb.ChannelEdgePolicy) *lnrpc.RoutingPolicy {
+
+	disabled := policy.ChannelFlags&lnwire.ChanUpdateDisabled != 0
+
+	return &lnrpc.RoutingPolicy{
+		TimeLockDelta:    uint32(policy.TimeLockDelta),
+		MinHtlc:          int64(policy.MinHTLC),
+		MaxHtlcMsat:      uint64(policy.MaxHTLC),
+		FeeBaseMsat:      int64(policy.FeeBaseMSat),
+		FeeRateMilliMsat: int64(policy.FeeProportionalMillionths),
+		Disabled:         disabled,
+		LastUpdate:       uint32(policy.LastUpdate.Unix()),
+	}
+}
+
 // GetNodeMetrics returns all available node metrics calculated from the
 // current channel graph.
 func (r *rpcServer) GetNodeMetrics(ctx context.Context,
@@ -5364,6 +7153,75 @@ func (r *rpcServer) GetChanInfo(ctx context.Context,
 	return channelEdge, nil
 }
 
+// GetChanPolicies returns the routing policies of one of our own channels,
+// separated into the policy advertised by our node and the policy advertised by
+// the remote peer.
+func (r *rpcServer) GetChanPolicies(ctx context.Context,
+	in *lnrpc.ChanInfoRequest) (*lnrpc.ChanPoliciesResponse, error) {
+
+	graph := r.server.graphDB
+
+	edgeInfo, edge1, edge2, err := graph.FetchChannelEdgesByID(in.ChanId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure the policies match the node they belong to. edge1 should
+	// point to the policy for NodeKey1, and edge2 for NodeKey2.
+	const direction = lnwire.ChanUpdateDirection
+	if edge1 != nil && edge1.ChannelFlags&direction == 1 ||
+		edge2 != nil && edge2.ChannelFlags&direction == 0 {
+
+		edge2, edge1 = edge1, edge2
+	}
+
+	// Determine which side of the channel we are.
+	selfPub := r.server.identityECDH.PubKey().SerializeCompressed()
+	node1, node2 := edgeInfo.NodeKey1Bytes, edgeInfo.NodeKey2Bytes
+	var (
+		localPub, remotePub       [33]byte
+		localPolicy, remotePolicy *channeldb.ChannelEdgePolicy
+	)
+	switch {
+	case bytes.Equal(node1[:], selfPub):
+		localPub, remotePub = node1, node2
+		localPolicy, remotePolicy = edge1, edge2
+
+	case bytes.Equal(node2[:], selfPub):
+		localPub, remotePub = node2, node1
+		localPolicy, remotePolicy = edge2, edge1
+
+	default:
+		return nil, fmt.Errorf("channel %v is not one of our own "+
+			"channels", in.ChanId)
+	}
+
+	// marshallPolicy is a helper closure that converts a policy into its
+	// RPC representation. A nil policy means the node hasn't announced a
+	// policy for its direction of the channel yet.
+	marshallPolicy := func(pub [33]byte, isOurs bool,
+		policy *channeldb.ChannelEdgePolicy) *lnrpc.ChanPolicy {
+
+		rpcPolicy := &lnrpc.ChanPolicy{
+			PubKey: hex.EncodeToString(pub[:]),
+			IsOurs: isOurs,
+		}
+		if policy != nil {
+			rpcPolicy.Announced = true
+			rpcPolicy.Policy = marshalDBRoutingPolicy(policy)
+		}
+
+		return rpcPolicy
+	}
+
+	return &lnrpc.ChanPoliciesResponse{
+		ChanId:       edgeInfo.ChannelID,
+		ChanPoint:    edgeInfo.ChannelPoint.String(),
+		LocalPolicy:  marshallPolicy(localPub, true, localPolicy),
+		RemotePolicy: marshallPolicy(remotePub, false, remotePolicy),
+	}, nil
+}
+
 // GetNodeInfo returns the latest advertised and aggregate authenticated
 // channel information for the specified node identified by its public key.
 func (r *rpcServer) GetNodeInfo(ctx context.Context,
@@ -5596,6 +7454,59 @@ func (r *rpcServer) GetNetworkInfo(ctx context.Context,
 	return netInfo, nil
 }
 
+// ListZombieChannels returns the channels of the graph that are marked as
+// zombies.
+func (r *rpcServer) ListZombieChannels(_ context.Context,
+	_ *lnrpc.ListZombieChannelsRequest) (*lnrpc.ListZombieChannelsResponse,
+	error) {
+
+	zombies, err := r.server.graphDB.FetchZombieEdges()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch zombie channels: %v",
+			err)
+	}
+
+	resp := &lnrpc.ListZombieChannelsResponse{
+		ZombieChannels: make([]*lnrpc.ZombieChannel, 0, len(zombies)),
+	}
+	for _, zombie := range zombies {
+		resp.ZombieChannels = append(
+			resp.ZombieChannels, &lnrpc.ZombieChannel{
+				ChanId: zombie.ChannelID,
+				Node1Pub: hex.EncodeToString(
+					zombie.NodeKey1Bytes[:],
+				),
+				Node2Pub: hex.EncodeToString(
+					zombie.NodeKey2Bytes[:],
+				),
+			},
+		)
+	}
+
+	return resp, nil
+}
+
+// RestoreZombieChannel removes a channel from the set of zombies, as long as
+// its funding output is still unspent, and adds it back to the graph.
+func (r *rpcServer) RestoreZombieChannel(_ context.Context,
+	in *lnrpc.RestoreZombieChannelRequest) (
+	*lnrpc.RestoreZombieChannelResponse, error) {
+
+	chanID := lnwire.NewShortChanIDFromInt(in.ChanId)
+	added, err := r.server.chanRouter.RestoreZombieEdge(chanID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to restore zombie channel %v: "+
+			"%v", chanID, err)
+	}
+
+	rpcsLog.Infof("Restored zombie channel %v (added_to_graph=%v)",
+		chanID, added)
+
+	return &lnrpc.RestoreZombieChannelResponse{
+		AddedToGraph: added,
+	}, nil
+}
+
 // StopDaemon will send a shutdown request to the interrupt handler, triggering
 // a graceful shutdown of the daemon.
 func (r *rpcServer) StopDaemon(_ context.Context,
@@ -5800,6 +7711,10 @@ func (r *rpcServer) ListPayments(ctx context.Context,
 			return nil, err
 		}
 
+		if req.IncludeRawFailures {
+			routerrpc.MarshalRawFailures(payment, rpcPayment)
+		}
+
 		paymentsResp.Payments = append(
 			paymentsResp.Payments, rpcPayment,
 		)
@@ -5844,16 +7759,64 @@ func (r *rpcServer) DebugLevel(ctx context.Context,
 		}, nil
 	}
 
-	rpcsLog.Infof("[debuglevel] changing debug level to: %v", req.LevelSpec)
+	rpcsLog.Infof("[debuglevel] changing debug level to: %v", req.LevelSpec)
+
+	// Otherwise, we'll attempt to set the logging level using the
+	// specified level spec.
+	err := build.ParseAndSetDebugLevels(req.LevelSpec, r.cfg.LogWriter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.DebugLevelResponse{}, nil
+}
+
+// GetGossipBatchParams returns the parameters that control how a batch of
+// gossip messages is split into sub batches when it's broadcast.
+func (r *rpcServer) GetGossipBatchParams(ctx context.Context,
+	_ *lnrpc.GetGossipBatchParamsRequest) (
+	*lnrpc.GetGossipBatchParamsResponse, error) {
+
+	subBatchDelay, minimumBatchSize := r.server.authGossiper.BatchParams()
+
+	return &lnrpc.GetGossipBatchParamsResponse{
+		SubBatchDelayMs: uint64(subBatchDelay / time.Millisecond),
+		MinBatchSize:    uint32(minimumBatchSize),
+		TrickleDelayMs:  uint64(r.cfg.TrickleDelay),
+	}, nil
+}
+
+// SetGossipBatchParams adjusts the parameters that control how a batch of
+// gossip messages is split into sub batches when it's broadcast. Parameters
+// that aren't set in the request are left unchanged.
+func (r *rpcServer) SetGossipBatchParams(ctx context.Context,
+	req *lnrpc.SetGossipBatchParamsRequest) (
+	*lnrpc.SetGossipBatchParamsResponse, error) {
+
+	if req.SubBatchDelayMs == 0 && req.MinBatchSize == 0 {
+		return nil, fmt.Errorf("no gossip batch parameters to set")
+	}
+
+	gossiper := r.server.authGossiper
+
+	subBatchDelay, minimumBatchSize := gossiper.BatchParams()
+	if req.SubBatchDelayMs != 0 {
+		subBatchDelay = time.Duration(req.SubBatchDelayMs) *
+			time.Millisecond
+	}
+	if req.MinBatchSize != 0 {
+		minimumBatchSize = int(req.MinBatchSize)
+	}
+
+	rpcsLog.Infof("[setgossipbatch] changing sub batch delay to %v and "+
+		"minimum batch size to %v", subBatchDelay, minimumBatchSize)
 
-	// Otherwise, we'll attempt to set the logging level using the
-	// specified level spec.
-	err := build.ParseAndSetDebugLevels(req.LevelSpec, r.cfg.LogWriter)
+	err := gossiper.SetBatchParams(subBatchDelay, minimumBatchSize)
 	if err != nil {
 		return nil, err
 	}
 
-	return &lnrpc.DebugLevelResponse{}, nil
+	return &lnrpc.SetGossipBatchParamsResponse{}, nil
 }
 
 // DecodePayReq takes an encoded payment request string and attempts to decode
@@ -6070,6 +8033,206 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 	}, nil
 }
 
+// OnChainFeeReport returns the total on-chain fees paid by the node for
+// transactions confirmed within a time range, broken down by category.
+func (r *rpcServer) OnChainFeeReport(ctx context.Context,
+	req *lnrpc.OnChainFeeReportRequest) (*lnrpc.OnChainFeeReportResponse,
+	error) {
+
+	startTime := time.Unix(int64(req.StartTime), 0)
+	endTime := time.Now()
+	if req.EndTime != 0 {
+		endTime = time.Unix(int64(req.EndTime), 0)
+	}
+	if !endTime.After(startTime) {
+		return nil, fmt.Errorf("end time %v must be after start time "+
+			"%v", endTime, startTime)
+	}
+
+	// Transactions are attributed to the range by the time of the block
+	// they confirmed in.
+	inRange := func(blockTime time.Time) bool {
+		return !blockTime.Before(startTime) && blockTime.Before(endTime)
+	}
+
+	resp := &lnrpc.OnChainFeeReportResponse{}
+	addFee := func(category *int64, fee int64) {
+		*category += fee
+		resp.TotalFeesSat += fee
+		resp.NumTransactions++
+	}
+
+	_, bestHeight, err := r.server.cc.ChainIO.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+	txns, err := r.server.cc.Wallet.ListTransactionDetails(
+		0, bestHeight, "",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	walletTxns := make(map[chainhash.Hash]*lnwallet.TransactionDetail)
+	for _, tx := range txns {
+		walletTxns[tx.Hash] = tx
+	}
+
+	// The wallet doesn't know the fees of closing transactions as they
+	// spend the funding output, so we'll compute them from the closed
+	// channels. Their closing transactions are skipped below.
+	closeSummaries, err := r.server.chanStateDB.FetchClosedChannels(false)
+	if err != nil {
+		return nil, err
+	}
+
+	closingTxids := make(map[chainhash.Hash]struct{})
+	for _, summary := range closeSummaries {
+		switch summary.CloseType {
+		case channeldb.CooperativeClose, channeldb.LocalForceClose,
+			channeldb.RemoteForceClose, channeldb.BreachClose:
+
+		default:
+			continue
+		}
+
+		if summary.IsPending || summary.CloseHeight == 0 {
+			continue
+		}
+
+		closingTxids[summary.ClosingTXID] = struct{}{}
+
+		// A close we can't resolve, for example because its block was
+		// pruned, is only flagged so that it doesn't fail the whole
+		// report.
+		fee, err := r.closingFee(
+			summary, walletTxns[summary.ClosingTXID], inRange,
+		)
+		if err != nil {
+			rpcsLog.Warnf("Unable to determine closing fee of "+
+				"channel %v: %v", summary.ChanPoint, err)
+
+			resp.NumUnresolvedCloses++
+			continue
+		}
+
+		if fee > 0 {
+			addFee(&resp.ChannelCloseFeesSat, int64(fee))
+		}
+	}
+
+	for _, tx := range txns {
+		// The wallet only knows the fees of transactions that spend
+		// its own outputs, which are the ones paid by us.
+		if tx.NumConfirmations <= 0 || tx.TotalFees <= 0 {
+			continue
+		}
+		if _, ok := closingTxids[tx.Hash]; ok {
+			continue
+		}
+		if !inRange(time.Unix(tx.Timestamp, 0)) {
+			continue
+		}
+
+		labelType, _ := labels.ParseType(tx.Label)
+		switch labelType {
+		case labels.LabelTypeChannelOpen:
+			addFee(&resp.ChannelOpenFeesSat, tx.TotalFees)
+
+		case labels.LabelTypeChannelClose:
+			addFee(&resp.ChannelCloseFeesSat, tx.TotalFees)
+
+		case labels.LabelTypeSweepTransaction,
+			labels.LabelTypeJusticeTransaction:
+
+			addFee(&resp.SweepFeesSat, tx.TotalFees)
+
+		default:
+			addFee(&resp.WalletSendFeesSat, tx.TotalFees)
+		}
+	}
+
+	return resp, nil
+}
+
+// closingFee returns the fee we paid for the confirmed closing transaction of
+// a closed channel if it confirmed within the range of the report. The fee is
+// paid from the funds of the channel initiator, so it is zero for channels
+// opened by the remote party, and no closing transaction is fetched for them.
+// If the wallet knows the closing transaction, the fee is computed from it
+// without any access to the chain backend. Otherwise, the block of the closing
+// transaction is fetched.
+func (r *rpcServer) closingFee(summary *channeldb.ChannelCloseSummary,
+	walletTx *lnwallet.TransactionDetail,
+	inRange func(time.Time) bool) (btcutil.Amount, error) {
+
+	// Channels closed before the historical channel bucket was introduced
+	// can't be looked up, in which case we can't tell who paid the fee.
+	histChan, err := r.server.chanStateDB.FetchHistoricalChannel(
+		&summary.ChanPoint,
+	)
+	switch {
+	case err == channeldb.ErrNoHistoricalBucket ||
+		err == channeldb.ErrChannelNotFound:
+
+		return 0, nil
+
+	case err != nil:
+		return 0, err
+	}
+
+	if !histChan.IsInitiator {
+		return 0, nil
+	}
+
+	feeOf := func(tx *wire.MsgTx) btcutil.Amount {
+		var outputs btcutil.Amount
+		for _, txOut := range tx.TxOut {
+			outputs += btcutil.Amount(txOut.Value)
+		}
+
+		return summary.Capacity - outputs
+	}
+
+	if walletTx != nil && walletTx.NumConfirmations > 0 {
+		if !inRange(time.Unix(walletTx.Timestamp, 0)) {
+			return 0, nil
+		}
+
+		var tx wire.MsgTx
+		err := tx.Deserialize(bytes.NewReader(walletTx.RawTx))
+		if err != nil {
+			return 0, err
+		}
+
+		return feeOf(&tx), nil
+	}
+
+	blockHash, err := r.server.cc.ChainIO.GetBlockHash(
+		int64(summary.CloseHeight),
+	)
+	if err != nil {
+		return 0, err
+	}
+	block, err := r.server.cc.ChainIO.GetBlock(blockHash)
+	if err != nil {
+		return 0, err
+	}
+	if !inRange(block.Header.Timestamp) {
+		return 0, nil
+	}
+
+	for _, tx := range block.Transactions {
+		if tx.TxHash() == summary.ClosingTXID {
+			return feeOf(tx), nil
+		}
+	}
+
+	// This can happen if the closing transaction was reorged out.
+	return 0, fmt.Errorf("closing transaction %v not found in block %v",
+		summary.ClosingTXID, blockHash)
+}
+
 // minFeeRate is the smallest permitted fee rate within the network. This is
 // derived by the fact that fee rates are computed using a fixed point of
 // 1,000,000. As a result, the smallest representable fee rate is 1e-6, or
@@ -6112,10 +8275,18 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 
 	// We'll also ensure that the user isn't setting a CLTV delta that
 	// won't give outgoing HTLCs enough time to fully resolve if needed.
-	case req.TimeLockDelta < minTimeLockDelta:
+	case req.TimeLockDelta < r.policyMinTimeLockDelta():
 		return nil, fmt.Errorf("time lock delta of %v is too small, "+
 			"minimum supported is %v", req.TimeLockDelta,
-			minTimeLockDelta)
+			r.policyMinTimeLockDelta())
+	}
+
+	// The max htlc hold of a channel must remain below its time lock
+	// delta, so that held htlcs are still failed back before they are
+	// resolved on chain.
+	err := r.checkMaxHtlcHolds(req.TimeLockDelta, targetChans)
+	if err != nil {
+		return nil, err
 	}
 
 	// We'll also need to convert the floating point fee rate we accept
@@ -6130,6 +8301,9 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 		FeeRate: feeRateFixed,
 	}
 
+	// The min and max htlc values only restrict the htlcs that we forward
+	// out through the target channels. Htlcs that the peer sends to us
+	// are governed by the peer's policy for its direction of the channel.
 	maxHtlc := lnwire.MilliSatoshi(req.MaxHtlcMsat)
 	var minHtlc *lnwire.MilliSatoshi
 	if req.MinHtlcMsatSpecified {
@@ -6137,6 +8311,14 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 		minHtlc = &min
 	}
 
+	// If both values are given, we can reject an inconsistent range right
+	// away. A max htlc below a min htlc that is left unchanged is caught
+	// when the new policy is validated against each channel.
+	if minHtlc != nil && maxHtlc != 0 && maxHtlc < *minHtlc {
+		return nil, fmt.Errorf("max htlc of %v is below min htlc of %v",
+			maxHtlc, *minHtlc)
+	}
+
 	chanPolicy := routing.ChannelPolicy{
 		FeeSchema:     feeSchema,
 		TimeLockDelta: req.TimeLockDelta,
@@ -6153,7 +8335,7 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 
 	// With the scope resolved, we'll now send this to the local channel
 	// manager so it can propagate the new policy for our target channel(s).
-	err := r.server.localChanMgr.UpdatePolicy(chanPolicy, targetChans...)
+	err = r.server.localChanMgr.UpdatePolicy(chanPolicy, targetChans...)
 	if err != nil {
 		return nil, err
 	}
@@ -6161,6 +8343,16 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 	return &lnrpc.PolicyUpdateResponse{}, nil
 }
 
+// policyMinTimeLockDelta returns the smallest time lock delta that our
+// channel policies are allowed to advertise on the primary chain.
+func (r *rpcServer) policyMinTimeLockDelta() uint32 {
+	if r.cfg.registeredChains.PrimaryChain() == chainreg.LitecoinChain {
+		return r.cfg.Litecoin.MinTimeLockDelta
+	}
+
+	return r.cfg.Bitcoin.MinTimeLockDelta
+}
+
 // ForwardingHistory allows the caller to query the htlcswitch for a record of
 // all HTLC's forwarded within the target time range, and integer offset within
 // that time range. If no time-range is specified, then the first chunk of the
@@ -6255,6 +8447,204 @@ func (r *rpcServer) ForwardingHistory(ctx context.Context,
 	return resp, nil
 }
 
+// ChannelPolicyHistory returns the forwarding policies that were set on our own
+// channels within the requested time slice, grouped by channel.
+func (r *rpcServer) ChannelPolicyHistory(ctx context.Context,
+	req *lnrpc.ChannelPolicyHistoryRequest) (
+	*lnrpc.ChannelPolicyHistoryResponse, error) {
+
+	rpcsLog.Debugf("[channelpolicyhistory] chan_id=%v", req.ChanId)
+
+	// The start time defaults to the unix epoch, and the end time to now.
+	startTime := time.Unix(int64(req.StartTime), 0)
+	endTime := time.Now()
+	if req.EndTime != 0 {
+		endTime = time.Unix(int64(req.EndTime), 0)
+	}
+
+	if endTime.Before(startTime) {
+		return nil, fmt.Errorf("end time %v is before start time %v",
+			req.EndTime, req.StartTime)
+	}
+
+	updates, err := r.server.chanStateDB.PolicyHistory().Query(
+		channeldb.PolicyHistoryQuery{
+			ChanID:    lnwire.NewShortChanIDFromInt(req.ChanId),
+			StartTime: startTime,
+			EndTime:   endTime,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query policy history: %v",
+			err)
+	}
+
+	// The updates are ordered by channel, so that we can group them by
+	// starting a new entry whenever the channel changes.
+	resp := &lnrpc.ChannelPolicyHistoryResponse{}
+	var channel *lnrpc.ChannelPolicyChanges
+	for _, update := range updates {
+		chanID := update.ChanID.ToUint64()
+		if channel == nil || channel.ChanId != chanID {
+			channel = &lnrpc.ChannelPolicyChanges{
+				ChanId: chanID,
+			}
+			resp.Channels = append(resp.Channels, channel)
+		}
+
+		channel.Changes = append(channel.Changes, &lnrpc.PolicyChange{
+			Timestamp:        uint64(update.Timestamp.Unix()),
+			TimestampNs:      uint64(update.Timestamp.UnixNano()),
+			BaseFeeMsat:      int64(update.BaseFee),
+			FeeRateMilliMsat: int64(update.FeeRate),
+			TimeLockDelta:    uint32(update.TimeLockDelta),
+			MinHtlcMsat:      uint64(update.MinHTLC),
+			MaxHtlcMsat:      uint64(update.MaxHTLC),
+		})
+	}
+
+	return resp, nil
+}
+
+// GetSwitchInfo returns the number of HTLC circuits currently held by the
+// htlcswitch.
+func (r *rpcServer) GetSwitchInfo(ctx context.Context,
+	_ *lnrpc.GetSwitchInfoRequest) (*lnrpc.GetSwitchInfoResponse, error) {
+
+	stats, err := r.server.htlcSwitch.CircuitStats()
+	if err != nil {
+		return nil, fmt.Errorf("unable to query circuit map: %v", err)
+	}
+
+	return &lnrpc.GetSwitchInfoResponse{
+		NumOpenCircuits:    uint64(stats.NumOpen),
+		NumPendingCircuits: uint64(stats.NumPending),
+		NumKeystones:       uint64(stats.NumKeystones),
+	}, nil
+}
+
+// ForwardingFailureStats returns the number of forwards that were rejected by
+// our node within the requested window, grouped by reason.
+func (r *rpcServer) ForwardingFailureStats(ctx context.Context,
+	req *lnrpc.ForwardingFailureStatsRequest) (
+	*lnrpc.ForwardingFailureStatsResponse, error) {
+
+	maxWindow := uint64(htlcswitch.ForwardFailureStatsRetention.Seconds())
+	if req.WindowSeconds > maxWindow {
+		return nil, fmt.Errorf("window of %v seconds exceeds the "+
+			"maximum of %v seconds", req.WindowSeconds, maxWindow)
+	}
+
+	stats := r.server.htlcNotifier.ForwardFailureStats()
+	counts, since, err := stats.Query(
+		time.Duration(req.WindowSeconds) * time.Second,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.ForwardingFailureStatsResponse{
+		StartTime: uint64(since.Unix()),
+	}
+	for reason, count := range counts {
+		switch reason {
+		case htlcswitch.ForwardFailureInsufficientBalance:
+			resp.InsufficientBalance = count
+
+		case htlcswitch.ForwardFailureFeeInsufficient:
+			resp.FeeInsufficient = count
+
+		case htlcswitch.ForwardFailureExpiryTooSoon:
+			resp.ExpiryTooSoon = count
+
+		case htlcswitch.ForwardFailureChannelDisabled:
+			resp.ChannelDisabled = count
+
+		default:
+			resp.Other += count
+		}
+	}
+
+	return resp, nil
+}
+
+// ResetForwardingFailureStats clears the counts of rejected forwards and
+// restarts counting.
+func (r *rpcServer) ResetForwardingFailureStats(ctx context.Context,
+	_ *lnrpc.ResetForwardingFailureStatsRequest) (
+	*lnrpc.ResetForwardingFailureStatsResponse, error) {
+
+	rpcsLog.Infof("[resetforwardingfailurestats]")
+
+	r.server.htlcNotifier.ForwardFailureStats().Reset()
+
+	return &lnrpc.ResetForwardingFailureStatsResponse{}, nil
+}
+
+// LookupHtlcCircuit returns the circuit of the HTLC identified by a channel
+// point and HTLC index, together with the state of the circuit.
+func (r *rpcServer) LookupHtlcCircuit(ctx context.Context,
+	in *lnrpc.LookupHtlcCircuitRequest) (*lnrpc.LookupHtlcCircuitResponse,
+	error) {
+
+	if in.ChanPoint == nil {
+		return nil, fmt.Errorf("chan_point must be set")
+	}
+	txid, err := lnrpc.GetChanPointFundingTxid(in.ChanPoint)
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.NewOutPoint(txid, in.ChanPoint.OutputIndex)
+
+	dbChannel, err := r.server.chanStateDB.FetchChannel(nil, *chanPoint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find channel %v: %v",
+			chanPoint, err)
+	}
+
+	key := htlcswitch.CircuitKey{
+		ChanID: dbChannel.ShortChanID(),
+		HtlcID: in.HtlcIndex,
+	}
+	circuit, state := r.server.htlcSwitch.LookupCircuit(key, in.Outgoing)
+
+	rpcsLog.Debugf("[lookuphtlccircuit] htlc %v (outgoing=%v) has "+
+		"circuit state %v", key, in.Outgoing, state)
+
+	resp := &lnrpc.LookupHtlcCircuitResponse{}
+	switch state {
+	case htlcswitch.CircuitNotTracked:
+		resp.State = lnrpc.LookupHtlcCircuitResponse_NOT_TRACKED
+		return resp, nil
+
+	case htlcswitch.CircuitPending:
+		resp.State = lnrpc.LookupHtlcCircuitResponse_PENDING
+		resp.WaitingOnOutgoing = true
+
+	case htlcswitch.CircuitOpen:
+		resp.State = lnrpc.LookupHtlcCircuitResponse_OPEN
+		resp.WaitingOnOutgoing = true
+
+	case htlcswitch.CircuitClosing:
+		resp.State = lnrpc.LookupHtlcCircuitResponse_CLOSING
+
+	default:
+		return nil, fmt.Errorf("unknown circuit state %v", state)
+	}
+
+	resp.IncomingChanId = circuit.Incoming.ChanID.ToUint64()
+	resp.IncomingHtlcIndex = circuit.Incoming.HtlcID
+	if circuit.Outgoing != nil {
+		resp.OutgoingChanId = circuit.Outgoing.ChanID.ToUint64()
+		resp.OutgoingHtlcIndex = circuit.Outgoing.HtlcID
+	}
+	resp.IncomingAmtMsat = uint64(circuit.IncomingAmount)
+	resp.OutgoingAmtMsat = uint64(circuit.OutgoingAmount)
+	resp.PaymentHash = circuit.PaymentHash[:]
+
+	return resp, nil
+}
+
 // ExportChannelBackup attempts to return an encrypted static channel backup
 // for the target channel identified by it channel point. The backup is
 // encrypted with a key generated from the aezeed seed of the user. The
@@ -6626,6 +9016,186 @@ func (r *rpcServer) ChannelAcceptor(stream lnrpc.Lightning_ChannelAcceptorServer
 	return rpcAcceptor.Run()
 }
 
+// SetMinInboundChanSize updates the smallest channel size that the funding
+// manager will accept from a remote peer. Channels opened by the local node
+// are not subject to this limit.
+func (r *rpcServer) SetMinInboundChanSize(ctx context.Context,
+	req *lnrpc.SetMinInboundChanSizeRequest) (
+	*lnrpc.SetMinInboundChanSizeResponse, error) {
+
+	// The inbound minimum must not exceed the max channel size that the
+	// funding manager currently enforces, which includes the soft cap set
+	// at runtime, as no inbound channel could be accepted otherwise.
+	minSize := btcutil.Amount(req.MinChanSizeInboundSat)
+	maxSize := r.server.fundingMgr.EffectiveMaxChanSize()
+	switch {
+	case minSize < 0:
+		return nil, fmt.Errorf("min inbound channel size must be " +
+			"non-negative")
+
+	case minSize > maxSize:
+		return nil, fmt.Errorf("min inbound channel size %v must not "+
+			"exceed max channel size %v", minSize, maxSize)
+	}
+
+	rpcsLog.Infof("[setmininboundchansize] min_chan_size_inbound=%v",
+		minSize)
+
+	prevSize := r.server.fundingMgr.SetMinChanSizeInbound(minSize)
+
+	return &lnrpc.SetMinInboundChanSizeResponse{
+		PreviousMinChanSizeInboundSat: int64(prevSize),
+	}, nil
+}
+
+// SetCoopCloseFeeBand queries and updates the percentage around our last fee
+// proposal within which the fee proposed by the remote peer is accepted during
+// cooperative close fee negotiation. A band of zero leaves the current band
+// unchanged.
+func (r *rpcServer) SetCoopCloseFeeBand(ctx context.Context,
+	req *lnrpc.SetCoopCloseFeeBandRequest) (
+	*lnrpc.SetCoopCloseFeeBandResponse, error) {
+
+	if req.BandPercent == 0 {
+		band := r.server.CoopCloseFeeBand()
+		return &lnrpc.SetCoopCloseFeeBandResponse{
+			PreviousBandPercent: band,
+			BandPercent:         band,
+		}, nil
+	}
+
+	rpcsLog.Infof("[setcoopclosefeeband] band_percent=%v",
+		req.BandPercent)
+
+	prevBand, err := r.server.SetCoopCloseFeeBand(req.BandPercent)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.SetCoopCloseFeeBandResponse{
+		PreviousBandPercent: prevBand,
+		BandPercent:         req.BandPercent,
+	}, nil
+}
+
+// SetSweepToAddress updates the address that the funds of closed channels are
+// swept to. An empty address makes us sweep to fresh wallet addresses again.
+func (r *rpcServer) SetSweepToAddress(ctx context.Context,
+	req *lnrpc.SetSweepToAddressRequest) (*lnrpc.SetSweepToAddressResponse,
+	error) {
+
+	rpcsLog.Infof("[setsweeptoaddress] address=%v", req.Address)
+
+	prevAddr, err := r.server.SetSweepToAddress(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.SetSweepToAddressResponse{
+		PreviousAddress: prevAddr,
+	}, nil
+}
+
+// wumboEnabled returns true if the node signals support for wumbo channels.
+func (r *rpcServer) wumboEnabled() bool {
+	return r.server.featureMgr.Get(feature.SetNodeAnn).HasFeature(
+		lnwire.WumboChannelsOptional,
+	)
+}
+
+// GetMaxChanSize returns the limits on the size of channels that the node
+// opens or accepts, including the soft cap set at runtime, if any.
+func (r *rpcServer) GetMaxChanSize(ctx context.Context,
+	_ *lnrpc.GetMaxChanSizeRequest) (*lnrpc.GetMaxChanSizeResponse,
+	error) {
+
+	wumbo := r.wumboEnabled()
+	maxFundingAmt := MaxFundingAmount
+	if wumbo {
+		maxFundingAmt = funding.MaxBtcFundingAmountWumbo
+	}
+
+	softMax := r.server.fundingMgr.SoftMaxChanSize()
+
+	return &lnrpc.GetMaxChanSizeResponse{
+		WumboEnabled:        wumbo,
+		MaxFundingAmountSat: int64(maxFundingAmt),
+		MaxChanSizeSat:      r.cfg.MaxChanSize,
+		SoftMaxChanSizeSat:  int64(softMax),
+	}, nil
+}
+
+// SetSoftMaxChanSize sets a soft cap on the size of channels that the funding
+// manager opens or accepts. As the cap is meant to limit the size of wumbo
+// channels at runtime, it can only be set if wumbo channels are enabled.
+func (r *rpcServer) SetSoftMaxChanSize(ctx context.Context,
+	req *lnrpc.SetSoftMaxChanSizeRequest) (
+	*lnrpc.SetSoftMaxChanSizeResponse, error) {
+
+	softMax := btcutil.Amount(req.SoftMaxChanSizeSat)
+	switch {
+	case softMax < 0:
+		return nil, fmt.Errorf("soft max channel size must be " +
+			"non-negative")
+
+	case !r.wumboEnabled():
+		return nil, fmt.Errorf("soft max channel size can only be set "+
+			"if wumbo channels are enabled, the max channel size "+
+			"is: %v", MaxFundingAmount)
+
+	case softMax > funding.MaxBtcFundingAmountWumbo:
+		return nil, fmt.Errorf("soft max channel size %v must not "+
+			"exceed the max wumbo channel size %v", softMax,
+			funding.MaxBtcFundingAmountWumbo)
+
+	case softMax != 0 && softMax < funding.MinChanFundingSize:
+		return nil, fmt.Errorf("soft max channel size %v must not "+
+			"be below the min channel size %v", softMax,
+			funding.MinChanFundingSize)
+	}
+
+	rpcsLog.Infof("[setsoftmaxchansize] soft_max_chan_size=%v", softMax)
+
+	prevSoftMax := r.server.fundingMgr.SetSoftMaxChanSize(softMax)
+
+	return &lnrpc.SetSoftMaxChanSizeResponse{
+		PreviousSoftMaxChanSizeSat: int64(prevSoftMax),
+	}, nil
+}
+
+// GetMaxTotalInFlight returns the maximum total value of htlcs that may be in
+// flight through the switch, along with the value currently in flight.
+func (r *rpcServer) GetMaxTotalInFlight(ctx context.Context,
+	_ *lnrpc.GetMaxTotalInFlightRequest) (
+	*lnrpc.GetMaxTotalInFlightResponse, error) {
+
+	htlcSwitch := r.server.htlcSwitch
+
+	return &lnrpc.GetMaxTotalInFlightResponse{
+		MaxTotalInflightMsat: uint64(htlcSwitch.MaxTotalInFlight()),
+		TotalInflightMsat:    uint64(htlcSwitch.TotalInFlight()),
+	}, nil
+}
+
+// SetMaxTotalInFlight sets the maximum total value of htlcs that may be in
+// flight through the switch. New outgoing and forwarded htlcs are rejected
+// while the total exceeds the maximum.
+func (r *rpcServer) SetMaxTotalInFlight(ctx context.Context,
+	req *lnrpc.SetMaxTotalInFlightRequest) (
+	*lnrpc.SetMaxTotalInFlightResponse, error) {
+
+	maxInFlight := lnwire.MilliSatoshi(req.MaxTotalInflightMsat)
+
+	rpcsLog.Infof("[setmaxtotalinflight] max_total_inflight=%v",
+		maxInFlight)
+
+	prevMax := r.server.htlcSwitch.SetMaxTotalInFlight(maxInFlight)
+
+	return &lnrpc.SetMaxTotalInFlightResponse{
+		PreviousMaxTotalInflightMsat: uint64(prevMax),
+	}, nil
+}
+
 // BakeMacaroon allows the creation of a new macaroon with custom read and write
 // permissions. No first-party caveats are added since this can be done offline.
 func (r *rpcServer) BakeMacaroon(ctx context.Context,
@@ -52,6 +52,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwallet/chancloser"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/nat"
 	"github.com/lightningnetwork/lnd/netann"
@@ -148,6 +149,18 @@ type server struct {
 	active   int32 // atomic
 	stopping int32 // atomic
 
+	// coopCloseFeeBand is the percentage around our last fee proposal
+	// within which we accept the fee proposed by the remote peer during a
+	// cooperative channel closure.
+	coopCloseFeeBand uint32 // atomic
+
+	// sweepToAddress is the address that the funds of closed channels are
+	// swept to instead of a fresh wallet address, if set. sweepToScript is
+	// the script paying to it.
+	sweepToAddress string
+	sweepToScript  lnwire.DeliveryAddress
+	sweepToMtx     sync.RWMutex
+
 	start sync.Once
 	stop  sync.Once
 
@@ -197,6 +210,14 @@ type server struct {
 	persistentConnReqs     map[string][]*connmgr.ConnReq
 	persistentRetryCancels map[string]chan struct{}
 
+	// defaultPeerBackoff is the backoff policy used to reconnect to
+	// persistent peers that don't have a policy in peerBackoffPolicies.
+	defaultPeerBackoff peerBackoffPolicy
+
+	// peerBackoffPolicies holds the backoff policies that were set for
+	// individual peers, keyed by the string of their compressed pubkey.
+	peerBackoffPolicies map[string]peerBackoffPolicy
+
 	// peerErrors keeps a set of peer error buffers for peers that have
 	// disconnected from us. This allows us to track historic peer errors
 	// over connections. The string of the peer's compressed pubkey is used
@@ -292,6 +313,10 @@ type server struct {
 	// provide insights into their health and performance.
 	chanEventStore *chanfitness.ChannelEventStore
 
+	// chanOpenHistory tracks the outcome of the most recent channel opens
+	// that we initiated.
+	chanOpenHistory *chanOpenHistory
+
 	hostAnn *netann.HostAnnouncer
 
 	// livelinessMonitor monitors that lnd has access to critical resources.
@@ -427,6 +452,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		GcCanceledInvoicesOnStartup: cfg.GcCanceledInvoicesOnStartup,
 		GcCanceledInvoicesOnTheFly:  cfg.GcCanceledInvoicesOnTheFly,
 		KeysendHoldTime:             cfg.KeysendHoldTime,
+		DefaultInvoiceExpiry:        cfg.DefaultInvoiceExpiry,
 	}
 
 	s := &server{
@@ -439,6 +465,8 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		readPool:       readPool,
 		chansToRestore: chansToRestore,
 
+		coopCloseFeeBand: cfg.CoopCloseFeeBand,
+
 		channelNotifier: channelnotifier.New(dbs.chanStateDB),
 
 		identityECDH: nodeKeyECDH,
@@ -466,6 +494,13 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		peerConnectedListeners:    make(map[string][]chan<- lnpeer.Peer),
 		peerDisconnectedListeners: make(map[string][]chan<- struct{}),
 
+		defaultPeerBackoff: peerBackoffPolicy{
+			minBackoff: cfg.MinBackoff,
+			maxBackoff: cfg.MaxBackoff,
+			multiplier: cfg.BackoffMultiplier,
+		},
+		peerBackoffPolicies: make(map[string]peerBackoffPolicy),
+
 		featureMgr: featureMgr,
 		quit:       make(chan struct{}),
 	}
@@ -475,6 +510,13 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		subscribers: make(map[uint64]*preimageSubscriber),
 	}
 
+	if cfg.SweepToAddress != "" {
+		_, err := s.SetSweepToAddress(cfg.SweepToAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	currentHash, currentHeight, err := s.cc.ChainIO.GetBestBlock()
 	if err != nil {
 		return nil, err
@@ -519,6 +561,9 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		RejectHTLC:             cfg.RejectHTLC,
 		Clock:                  clock.NewDefaultClock(),
 		HTLCExpiry:             htlcswitch.DefaultHTLCExpiry,
+		MaxTotalInFlight: lnwire.MilliSatoshi(
+			cfg.MaxTotalInFlightMsat,
+		),
 	}, uint32(currentHeight))
 	if err != nil {
 		return nil, err
@@ -794,6 +839,8 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		PathFindingConfig:   pathFindingConfig,
 		Clock:               clock.NewDefaultClock(),
 		StrictZombiePruning: strictPruning,
+
+		MaxConcurrentPayments: cfg.MaxConcurrentPayments,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("can't create router: %v", err)
@@ -830,8 +877,8 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		RotateTicker:            ticker.New(discovery.DefaultSyncerRotationInterval),
 		HistoricalSyncTicker:    ticker.New(cfg.HistoricalSyncInterval),
 		NumActiveSyncers:        cfg.NumGraphSyncPeers,
-		MinimumBatchSize:        10,
-		SubBatchDelay:           time.Second * 5,
+		MinimumBatchSize:        cfg.Gossip.MinimumBatchSize,
+		SubBatchDelay:           cfg.Gossip.SubBatchDelay,
 		IgnoreHistoricalFilters: cfg.IgnoreHistoricalGossipFilters,
 		PinnedSyncers:           cfg.Gossip.PinnedSyncers,
 		MaxChannelUpdateBurst:   cfg.Gossip.MaxChannelUpdateBurst,
@@ -845,6 +892,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		PropagateChanPolicyUpdate: s.authGossiper.PropagateChanPolicyUpdate,
 		UpdateForwardingPolicies:  s.htlcSwitch.UpdateForwardingPolicies,
 		FetchChannel:              s.chanStateDB.FetchChannel,
+		RecordPolicyUpdates:       s.chanStateDB.PolicyHistory().AddPolicyUpdates,
 	}
 
 	utxnStore, err := newNurseryStore(
@@ -881,6 +929,9 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		NextAttemptDeltaFunc: sweep.DefaultNextAttemptDeltaFunc,
 		MaxFeeRate:           sweep.DefaultMaxFeeRate,
 		FeeRateBucketSize:    sweep.DefaultFeeRateBucketSize,
+		SweepToScript: func() []byte {
+			return s.SweepToScript()
+		},
 	})
 
 	s.utxoNursery = newUtxoNursery(&NurseryConfig{
@@ -914,7 +965,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		ChainHash:              *s.cfg.ActiveNetParams.GenesisHash,
 		IncomingBroadcastDelta: lncfg.DefaultIncomingBroadcastDelta,
 		OutgoingBroadcastDelta: lncfg.DefaultOutgoingBroadcastDelta,
-		NewSweepAddr:           newSweepPkScriptGen(cc.Wallet),
+		NewSweepAddr:           s.sweepPkScriptGen(cc.Wallet),
 		PublishTx:              cc.Wallet.PublishTransaction,
 		DeliverResolutionMsg: func(msgs ...contractcourt.ResolutionMsg) error {
 			for _, msg := range msgs {
@@ -1015,7 +1066,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		CloseLink:          closeLink,
 		DB:                 dbs.chanStateDB,
 		Estimator:          s.cc.FeeEstimator,
-		GenSweepScript:     newSweepPkScriptGen(cc.Wallet),
+		GenSweepScript:     s.sweepPkScriptGen(cc.Wallet),
 		Notifier:           cc.ChainNotifier,
 		PublishTransaction: cc.Wallet.PublishTransaction,
 		ContractBreaches:   contractBreaches,
@@ -1221,6 +1272,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		ReservationTimeout:            10 * time.Minute,
 		MinChanSize:                   btcutil.Amount(cfg.MinChanSize),
 		MaxChanSize:                   btcutil.Amount(cfg.MaxChanSize),
+		MinChanSizeInbound:            btcutil.Amount(cfg.MinChanSizeInbound),
 		MaxPendingChannels:            cfg.MaxPendingChannels,
 		RejectPush:                    cfg.RejectPush,
 		MaxLocalCSVDelay:              chainCfg.MaxLocalDelay,
@@ -1273,6 +1325,11 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		FlapCountTicker: ticker.New(chanfitness.FlapCountFlushRate),
 	})
 
+	s.chanOpenHistory, err = newChanOpenHistory(chanOpenHistorySize)
+	if err != nil {
+		return nil, err
+	}
+
 	if cfg.WtClient.Active {
 		policy := wtpolicy.DefaultPolicy()
 
@@ -1463,6 +1520,95 @@ func (s *server) Started() bool {
 	return atomic.LoadInt32(&s.active) != 0
 }
 
+// CoopCloseFeeBand returns the percentage around our last fee proposal within
+// which we accept the fee proposed by the remote peer during a cooperative
+// channel closure.
+//
+// NOTE: This function is safe for concurrent access.
+func (s *server) CoopCloseFeeBand() uint32 {
+	return atomic.LoadUint32(&s.coopCloseFeeBand)
+}
+
+// SetCoopCloseFeeBand updates the cooperative close fee acceptance band and
+// returns the previous one. Negotiations that are already in progress keep
+// using the band they were started with.
+//
+// NOTE: This function is safe for concurrent access.
+func (s *server) SetCoopCloseFeeBand(band uint32) (uint32, error) {
+	if err := chancloser.ValidateFeeAcceptanceBand(band); err != nil {
+		return 0, err
+	}
+
+	return atomic.SwapUint32(&s.coopCloseFeeBand, band), nil
+}
+
+// SweepToAddress returns the address that the funds of closed channels are
+// swept to, or an empty string if fresh wallet addresses are used.
+//
+// NOTE: This function is safe for concurrent access.
+func (s *server) SweepToAddress() string {
+	s.sweepToMtx.RLock()
+	defer s.sweepToMtx.RUnlock()
+
+	return s.sweepToAddress
+}
+
+// SweepToScript returns the script paying to the address that the funds of
+// closed channels are swept to, or nil if fresh wallet addresses are used.
+//
+// NOTE: This function is safe for concurrent access.
+func (s *server) SweepToScript() lnwire.DeliveryAddress {
+	s.sweepToMtx.RLock()
+	defer s.sweepToMtx.RUnlock()
+
+	return s.sweepToScript
+}
+
+// SetSweepToAddress updates the address that the funds of closed channels are
+// swept to and returns the previous one. An empty address makes us sweep to
+// fresh wallet addresses again. Closes that already have a delivery script
+// keep using it.
+//
+// NOTE: This function is safe for concurrent access.
+func (s *server) SetSweepToAddress(address string) (string, error) {
+	var script lnwire.DeliveryAddress
+	if address != "" {
+		var err error
+		script, err = parseSweepToAddress(
+			address, s.cfg.ActiveNetParams.Params,
+		)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	s.sweepToMtx.Lock()
+	defer s.sweepToMtx.Unlock()
+
+	prev := s.sweepToAddress
+	s.sweepToAddress = address
+	s.sweepToScript = script
+
+	return prev, nil
+}
+
+// sweepPkScriptGen returns a closure that generates the script that swept
+// funds are sent to. If a sweep address is set, its script is returned,
+// otherwise a new address of the wallet is generated.
+func (s *server) sweepPkScriptGen(
+	wallet lnwallet.WalletController) func() ([]byte, error) {
+
+	newScript := newSweepPkScriptGen(wallet)
+
+	return func() ([]byte, error) {
+		if script := s.SweepToScript(); len(script) > 0 {
+			return script, nil
+		}
+
+		return newScript()
+	}
+}
+
 // cleaner is used to aggregate "cleanup" functions during an operation that
 // starts several subsystems. In case one of the subsystem fails to start
 // and a proper resource cleanup is required, the "run" method achieves this
@@ -1600,6 +1746,11 @@ func (s *server) Start() error {
 		}
 		cleanup = cleanup.add(s.htlcSwitch.Stop)
 
+		if err := s.restoreMaxHtlcHolds(); err != nil {
+			startErr = err
+			return
+		}
+
 		if err := s.sweeper.Start(); err != nil {
 			startErr = err
 			return
@@ -1813,6 +1964,31 @@ func (s *server) Start() error {
 	return startErr
 }
 
+// restoreMaxHtlcHolds applies the max htlc hold durations stored for our open
+// channels to the switch.
+func (s *server) restoreMaxHtlcHolds() error {
+	channels, err := s.chanStateDB.FetchAllOpenChannels()
+	if err != nil {
+		return err
+	}
+
+	for _, channel := range channels {
+		hold, err := channel.MaxHtlcHold()
+		switch {
+		case err == channeldb.ErrNoMaxHtlcHold:
+			continue
+
+		case err != nil:
+			return err
+		}
+
+		chanID := lnwire.NewChanIDFromOutPoint(&channel.FundingOutpoint)
+		s.htlcSwitch.SetMaxHtlcHold(chanID, hold)
+	}
+
+	return nil
+}
+
 // Stop gracefully shutsdown the main daemon server. This function will signal
 // any active goroutines, or helper objects to exit, then blocks until they've
 // all successfully exited. Additionally, any/all listeners are closed.
@@ -2642,7 +2818,8 @@ func (s *server) establishPersistentConnections() error {
 		// been requested as perm by the user.
 		s.persistentPeers[pubStr] = false
 		if _, ok := s.persistentPeersBackoff[pubStr]; !ok {
-			s.persistentPeersBackoff[pubStr] = s.cfg.MinBackoff
+			s.persistentPeersBackoff[pubStr] =
+				s.peerBackoff(pubStr).minBackoff
 		}
 
 		for _, address := range nodeAddr.addresses {
@@ -2881,17 +3058,18 @@ func (s *server) nextPeerBackoff(pubStr string,
 	startTime time.Time) time.Duration {
 
 	// Now, determine the appropriate backoff to use for the retry.
+	policy := s.peerBackoff(pubStr)
 	backoff, ok := s.persistentPeersBackoff[pubStr]
 	if !ok {
 		// If an existing backoff was unknown, use the default.
-		return s.cfg.MinBackoff
+		return policy.minBackoff
 	}
 
 	// If the peer failed to start properly, we'll just use the previous
 	// backoff to compute the subsequent randomized exponential backoff
-	// duration. This will roughly double on average.
+	// duration. This will grow by the multiplier on average.
 	if startTime.IsZero() {
-		return computeNextBackoff(backoff, s.cfg.MaxBackoff)
+		return computeNextBackoff(backoff, policy)
 	}
 
 	// The peer succeeded in starting. If the connection didn't last long
@@ -2899,24 +3077,105 @@ func (s *server) nextPeerBackoff(pubStr string,
 	// with this peer.
 	connDuration := time.Since(startTime)
 	if connDuration < defaultStableConnDuration {
-		return computeNextBackoff(backoff, s.cfg.MaxBackoff)
+		return computeNextBackoff(backoff, policy)
 	}
 
 	// The peer succeed in starting and this was stable peer, so we'll
 	// reduce the timeout duration by the length of the connection after
 	// applying randomized exponential backoff. We'll only apply this in the
 	// case that:
-	//   reb(curBackoff) - connDuration > cfg.MinBackoff
-	relaxedBackoff := computeNextBackoff(backoff, s.cfg.MaxBackoff) - connDuration
-	if relaxedBackoff > s.cfg.MinBackoff {
+	//   reb(curBackoff) - connDuration > minBackoff
+	relaxedBackoff := computeNextBackoff(backoff, policy) - connDuration
+	if relaxedBackoff > policy.minBackoff {
 		return relaxedBackoff
 	}
 
-	// Lastly, if reb(currBackoff) - connDuration <= cfg.MinBackoff, meaning
+	// Lastly, if reb(currBackoff) - connDuration <= minBackoff, meaning
 	// the stable connection lasted much longer than our previous backoff.
 	// To reward such good behavior, we'll reconnect after the default
 	// timeout.
-	return s.cfg.MinBackoff
+	return policy.minBackoff
+}
+
+// peerBackoff returns the backoff policy used to reconnect to the peer with
+// the given public key. This is the policy set for the peer, or the default
+// policy if none was set.
+//
+// NOTE: This MUST be called with the server's mutex held.
+func (s *server) peerBackoff(pubStr string) peerBackoffPolicy {
+	if policy, ok := s.peerBackoffPolicies[pubStr]; ok {
+		return policy
+	}
+
+	return s.defaultPeerBackoff
+}
+
+// SetPeerBackoff applies the non-zero fields of the update to the backoff
+// policy used to reconnect to the persistent peer with the given public key,
+// and returns the resulting policy. If no public key is given, the default
+// policy of all peers without a policy of their own is updated instead. The
+// current backoffs of the affected peers are bounded by the new policy, which
+// takes effect once their next reconnection attempt is scheduled.
+//
+// NOTE: This function is safe for concurrent access.
+func (s *server) SetPeerBackoff(pubStr string,
+	update peerBackoffPolicy) (peerBackoffPolicy, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policy := s.peerBackoff(pubStr).update(update)
+	if err := policy.validate(); err != nil {
+		return peerBackoffPolicy{}, err
+	}
+
+	if pubStr == "" {
+		s.defaultPeerBackoff = policy
+	} else {
+		s.peerBackoffPolicies[pubStr] = policy
+	}
+
+	s.clampPeerBackoffs()
+
+	return policy, nil
+}
+
+// ResetPeerBackoff removes the backoff policy of the peer with the given
+// public key, so that the default policy applies to it again, and returns the
+// default policy.
+//
+// NOTE: This function is safe for concurrent access.
+func (s *server) ResetPeerBackoff(pubStr string) peerBackoffPolicy {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.peerBackoffPolicies, pubStr)
+	s.clampPeerBackoffs()
+
+	return s.defaultPeerBackoff
+}
+
+// PeerBackoff returns the backoff policy used to reconnect to the peer with the
+// given public key, or the default policy if no public key is given.
+//
+// NOTE: This function is safe for concurrent access.
+func (s *server) PeerBackoff(pubStr string) peerBackoffPolicy {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.peerBackoff(pubStr)
+}
+
+// clampPeerBackoffs bounds the current backoff of each persistent peer by its
+// backoff policy.
+//
+// NOTE: This MUST be called with the server's mutex held.
+func (s *server) clampPeerBackoffs() {
+	for pubStr, backoff := range s.persistentPeersBackoff {
+		s.persistentPeersBackoff[pubStr] = s.peerBackoff(pubStr).clamp(
+			backoff,
+		)
+	}
 }
 
 // shouldDropConnection determines if our local connection to a remote peer
@@ -3275,6 +3534,8 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 		MaxOutgoingCltvExpiry:   s.cfg.MaxOutgoingCltvExpiry,
 		MaxChannelFeeAllocation: s.cfg.MaxChannelFeeAllocation,
 		CoopCloseTargetConfs:    s.cfg.CoopCloseTargetConfs,
+		CoopCloseFeeBand:        s.CoopCloseFeeBand,
+		SweepToScript:           s.SweepToScript,
 		MaxAnchorsCommitFeeRate: chainfee.SatPerKVByte(
 			s.cfg.MaxCommitFeeRateAnchors * 1000).FeePerKWeight(),
 		ChannelCommitInterval:  s.cfg.ChannelCommitInterval,
@@ -3678,7 +3939,8 @@ func (s *server) ConnectToPeer(addr *lnwire.NetAddress,
 		// zero.
 		s.persistentPeers[targetPub] = true
 		if _, ok := s.persistentPeersBackoff[targetPub]; !ok {
-			s.persistentPeersBackoff[targetPub] = s.cfg.MinBackoff
+			s.persistentPeersBackoff[targetPub] =
+				s.peerBackoff(targetPub).minBackoff
 		}
 		s.persistentConnReqs[targetPub] = append(
 			s.persistentConnReqs[targetPub], connReq,
@@ -3780,6 +4042,10 @@ func (s *server) OpenChannel(
 	req.Updates = make(chan *lnrpc.OpenStatusUpdate, 2)
 	req.Err = make(chan error, 1)
 
+	// The updates and the error of the funding flow are relayed to the
+	// caller, so that we can record the outcome of the channel open.
+	updates, errChan := s.trackChannelOpen(req)
+
 	// First attempt to locate the target peer to open a channel with, if
 	// we're unable to locate the peer then this request will fail.
 	pubKeyBytes := req.TargetPubkey.SerializeCompressed()
@@ -3789,7 +4055,7 @@ func (s *server) OpenChannel(
 		s.mu.RUnlock()
 
 		req.Err <- fmt.Errorf("peer %x is not online", pubKeyBytes)
-		return req.Updates, req.Err
+		return updates, errChan
 	}
 	req.Peer = peer
 	s.mu.RUnlock()
@@ -3800,10 +4066,10 @@ func (s *server) OpenChannel(
 	case <-peer.ActiveSignal():
 	case <-peer.QuitSignal():
 		req.Err <- fmt.Errorf("peer %x disconnected", pubKeyBytes)
-		return req.Updates, req.Err
+		return updates, errChan
 	case <-s.quit:
 		req.Err <- ErrServerShuttingDown
-		return req.Updates, req.Err
+		return updates, errChan
 	}
 
 	// If the fee rate wasn't specified, then we'll use a default
@@ -3813,7 +4079,7 @@ func (s *server) OpenChannel(
 		feeRate, err := estimator.EstimateFeePerKW(6)
 		if err != nil {
 			req.Err <- err
-			return req.Updates, req.Err
+			return updates, errChan
 		}
 		req.FundingFeePerKw = feeRate
 	}
@@ -3824,7 +4090,87 @@ func (s *server) OpenChannel(
 	// request to the outside world.
 	go s.fundingMgr.InitFundingWorkflow(req)
 
-	return req.Updates, req.Err
+	return updates, errChan
+}
+
+// trackChannelOpen records the channel open request in the channel open
+// history, and returns the channels the updates and the error of its funding
+// flow are relayed to.
+func (s *server) trackChannelOpen(req *funding.InitFundingMsg) (
+	chan *lnrpc.OpenStatusUpdate, chan error) {
+
+	attempt := s.chanOpenHistory.add(
+		route.NewVertex(req.TargetPubkey), req.LocalFundingAmt,
+	)
+
+	// The channels have the same buffer sizes as the ones of the funding
+	// flow, so relaying doesn't change when the funding flow blocks.
+	updates := make(chan *lnrpc.OpenStatusUpdate, cap(req.Updates))
+	errChan := make(chan error, cap(req.Err))
+
+	s.wg.Add(1)
+	go s.relayChannelOpen(attempt, req.Updates, req.Err, updates, errChan)
+
+	return updates, errChan
+}
+
+// relayChannelOpen relays the updates and the error of a funding flow, until
+// the channel is open or the funding flow failed. The outcome of the channel
+// open is recorded in the channel open history.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *server) relayChannelOpen(attempt *chanOpenAttempt,
+	fundingUpdates <-chan *lnrpc.OpenStatusUpdate, fundingErr <-chan error,
+	updates chan<- *lnrpc.OpenStatusUpdate, errChan chan<- error) {
+
+	defer s.wg.Done()
+
+	for {
+		select {
+		case update := <-fundingUpdates:
+			var opened bool
+			switch u := update.Update.(type) {
+			case *lnrpc.OpenStatusUpdate_ChanPending:
+				pending := u.ChanPending
+				txid, err := chainhash.NewHash(pending.Txid)
+				if err == nil {
+					chanPoint := wire.NewOutPoint(
+						txid, pending.OutputIndex,
+					)
+					s.chanOpenHistory.setChanPoint(
+						attempt, chanPoint,
+					)
+				}
+
+			case *lnrpc.OpenStatusUpdate_ChanOpen:
+				s.chanOpenHistory.resolve(attempt, nil)
+				opened = true
+			}
+
+			select {
+			case updates <- update:
+			case <-s.quit:
+				return
+			}
+
+			if opened {
+				return
+			}
+
+		case err := <-fundingErr:
+			s.chanOpenHistory.resolve(attempt, err)
+
+			select {
+			case errChan <- err:
+			case <-s.quit:
+			}
+
+			return
+
+		case <-s.quit:
+			return
+		}
+	}
 }
 
 // Peers returns a slice of all active peers.
@@ -3863,14 +4209,18 @@ func parseHexColor(colorStr string) (color.RGBA, error) {
 }
 
 // computeNextBackoff uses a truncated exponential backoff to compute the next
-// backoff using the value of the exiting backoff. The returned duration is
-// randomized in either direction by 1/20 to prevent tight loops from
-// stabilizing.
-func computeNextBackoff(currBackoff, maxBackoff time.Duration) time.Duration {
-	// Double the current backoff, truncating if it exceeds our maximum.
-	nextBackoff := 2 * currBackoff
-	if nextBackoff > maxBackoff {
-		nextBackoff = maxBackoff
+// backoff using the value of the exiting backoff and the given policy. The
+// returned duration is randomized in either direction by 1/20 to prevent tight
+// loops from stabilizing.
+func computeNextBackoff(currBackoff time.Duration,
+	policy peerBackoffPolicy) time.Duration {
+
+	// Grow the current backoff, truncating if it exceeds our maximum. The
+	// product is computed as a float, so that it can't overflow.
+	nextBackoff := policy.maxBackoff
+	grown := float64(currBackoff) * policy.multiplier
+	if grown < float64(policy.maxBackoff) {
+		nextBackoff = time.Duration(grown)
 	}
 
 	// Using 1/10 of our duration as a margin, compute a random offset to
@@ -1,6 +1,7 @@
 package shachain
 
 import (
+	"crypto/sha256"
 	"encoding/binary"
 	"io"
 
@@ -34,6 +35,17 @@ type Store interface {
 	Encode(io.Writer) error
 }
 
+// ElementDigest describes one of the secrets held by a RevocationStore without
+// revealing it.
+type ElementDigest struct {
+	// Height is the number of secrets that were added to the store before
+	// this one, which is the index the secret was produced at.
+	Height uint64
+
+	// Digest is the SHA256 digest of the secret.
+	Digest [sha256.Size]byte
+}
+
 // RevocationStore is a concrete implementation of the Store interface. The
 // revocation store is able to efficiently store N derived shachain elements in
 // a space efficient manner with a space complexity of O(log N). The original
@@ -182,3 +194,26 @@ func (store *RevocationStore) Encode(w io.Writer) error {
 
 	return binary.Write(w, binary.BigEndian, store.index)
 }
+
+// NumEntries returns the number of secrets that have been added to the store.
+func (store *RevocationStore) NumEntries() uint64 {
+	return uint64(startIndex - store.index)
+}
+
+// ElementDigests returns a digest of each of the secrets that the store holds
+// in its buckets, from which all previously added secrets can be derived. The
+// digests allow comparing the state of two stores without revealing the
+// secrets themselves.
+func (store *RevocationStore) ElementDigests() []ElementDigest {
+	digests := make([]ElementDigest, 0, store.lenBuckets)
+	for i := uint8(0); i < store.lenBuckets; i++ {
+		element := store.buckets[i]
+
+		digests = append(digests, ElementDigest{
+			Height: uint64(startIndex - element.index),
+			Digest: sha256.Sum256(element.hash[:]),
+		})
+	}
+
+	return digests
+}
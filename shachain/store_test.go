@@ -2,6 +2,7 @@ package shachain
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"testing"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -471,3 +472,60 @@ func TestShaChainStore(t *testing.T) {
 		}
 	}
 }
+
+// TestShaChainStoreDigests checks that the digests of a shachain store match
+// the secrets it was given, without containing them.
+func TestShaChainStoreDigests(t *testing.T) {
+	t.Parallel()
+
+	seed := chainhash.DoubleHashH([]byte("shachaintest"))
+
+	sender := NewRevocationProducer(seed)
+	receiver := NewRevocationStore()
+
+	if receiver.NumEntries() != 0 {
+		t.Fatalf("expected empty store, got %v entries",
+			receiver.NumEntries())
+	}
+	if len(receiver.ElementDigests()) != 0 {
+		t.Fatalf("expected no digests for empty store")
+	}
+
+	const numEntries = 100
+	for n := uint64(0); n < numEntries; n++ {
+		sha, err := sender.AtIndex(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err = receiver.AddNextEntry(sha); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if receiver.NumEntries() != numEntries {
+		t.Fatalf("expected %v entries, got %v", numEntries,
+			receiver.NumEntries())
+	}
+
+	digests := receiver.ElementDigests()
+	if len(digests) != int(receiver.lenBuckets) {
+		t.Fatalf("expected %v digests, got %v", receiver.lenBuckets,
+			len(digests))
+	}
+
+	for _, digest := range digests {
+		sha, err := sender.AtIndex(digest.Height)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if digest.Digest != sha256.Sum256(sha[:]) {
+			t.Fatalf("wrong digest for height %v", digest.Height)
+		}
+		if bytes.Equal(digest.Digest[:], sha[:]) {
+			t.Fatalf("digest reveals secret at height %v",
+				digest.Height)
+		}
+	}
+}
@@ -81,6 +81,11 @@ type Params struct {
 	// ExclusiveGroup is an identifier that, if set, prevents other inputs
 	// with the same identifier from being batched together.
 	ExclusiveGroup *uint64
+
+	// DeadlineHeight is the height by which the input needs to be
+	// confirmed, if the fee preference was derived from a deadline. It is
+	// only informational and zero if the sweep isn't deadline-driven.
+	DeadlineHeight uint32
 }
 
 // ParamsUpdate contains a new set of parameters to update a pending sweep with.
@@ -242,6 +247,14 @@ type UtxoSweeperConfig struct {
 	// funds can be swept.
 	GenSweepScript func() ([]byte, error)
 
+	// SweepToScript returns the script that the outputs of resolved
+	// contracts are swept to instead of a script of GenSweepScript. Inputs
+	// of the wallet, such as the ones offered to bump the fee of a wallet
+	// transaction through CPFP, are never swept to it, and neither is a
+	// sweep that needs a wallet input to pay for its fees. If it is nil or
+	// returns an empty script, GenSweepScript is used for all sweeps.
+	SweepToScript func() []byte
+
 	// FeeEstimator is used when crafting sweep transactions to estimate
 	// the necessary fee relative to the expected size of the sweep
 	// transaction.
@@ -780,12 +793,46 @@ func (s *UtxoSweeper) bucketForFeeRate(
 }
 
 // createInputClusters creates a list of input clusters from the set of pending
-// inputs known by the UtxoSweeper. It clusters inputs by
-// 1) Required tx locktime
-// 2) Similar fee rates
+// inputs known by the UtxoSweeper. Inputs of the wallet are only clustered
+// with the outputs of resolved contracts if both are swept to the same kind of
+// script. See clusterInputs for the clustering criteria.
 func (s *UtxoSweeper) createInputClusters() []inputCluster {
-	inputs := s.pendingInputs
+	// If the outputs of resolved contracts are swept to a different script
+	// than the inputs of the wallet, we can't batch them together.
+	if len(s.sweepToScript()) > 0 {
+		walletInputs := make(pendingInputs)
+		contractInputs := make(pendingInputs)
+		for op, input := range s.pendingInputs {
+			if isWalletInput(input) {
+				walletInputs[op] = input
+			} else {
+				contractInputs[op] = input
+			}
+		}
 
+		return append(
+			s.clusterInputs(contractInputs),
+			s.clusterInputs(walletInputs)...,
+		)
+	}
+
+	return s.clusterInputs(s.pendingInputs)
+}
+
+// sweepToScript returns the script that the outputs of resolved contracts are
+// swept to, or nil if they are swept to a script of GenSweepScript.
+func (s *UtxoSweeper) sweepToScript() []byte {
+	if s.cfg.SweepToScript == nil {
+		return nil
+	}
+
+	return s.cfg.SweepToScript()
+}
+
+// clusterInputs clusters the given inputs by
+// 1) Required tx locktime
+// 2) Similar fee rates
+func (s *UtxoSweeper) clusterInputs(inputs pendingInputs) []inputCluster {
 	// We start by getting the inputs clusters by locktime. Since the
 	// inputs commit to the locktime, they can only be clustered together
 	// if the locktime is equal.
@@ -1181,18 +1228,31 @@ func (s *UtxoSweeper) getInputLists(cluster inputCluster,
 func (s *UtxoSweeper) sweep(inputs inputSet, feeRate chainfee.SatPerKWeight,
 	currentHeight int32) error {
 
+	// Only sweeps that exclusively spend the outputs of resolved contracts
+	// are swept to SweepToScript. All other sweeps, including the ones that
+	// required a wallet input to pay for fees, are swept back into the
+	// wallet.
+	var sweepToScript []byte
+	if !hasWalletInput(inputs) {
+		sweepToScript = s.sweepToScript()
+	}
+
 	// Generate an output script if there isn't an unused script available.
-	if s.currentOutputScript == nil {
-		pkScript, err := s.cfg.GenSweepScript()
-		if err != nil {
-			return fmt.Errorf("gen sweep script: %v", err)
+	outputScript := sweepToScript
+	if len(outputScript) == 0 {
+		if s.currentOutputScript == nil {
+			pkScript, err := s.cfg.GenSweepScript()
+			if err != nil {
+				return fmt.Errorf("gen sweep script: %v", err)
+			}
+			s.currentOutputScript = pkScript
 		}
-		s.currentOutputScript = pkScript
+		outputScript = s.currentOutputScript
 	}
 
 	// Create sweep tx.
 	tx, err := createSweepTx(
-		inputs, nil, s.currentOutputScript, uint32(currentHeight),
+		inputs, nil, outputScript, uint32(currentHeight),
 		feeRate, dustLimit(s.relayFeeRate), s.cfg.Signer,
 	)
 	if err != nil {
@@ -1228,7 +1288,7 @@ func (s *UtxoSweeper) sweep(inputs inputSet, feeRate chainfee.SatPerKWeight,
 
 	// Keep the output script in case of an error, so that it can be reused
 	// for the next transaction and causes no address inflation.
-	if err == nil {
+	if err == nil && len(sweepToScript) == 0 {
 		s.currentOutputScript = nil
 	}
 
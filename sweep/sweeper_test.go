@@ -1381,6 +1381,59 @@ func TestCpfp(t *testing.T) {
 	ctx.finish(1)
 }
 
+// TestSweepToScript asserts that only the outputs of resolved contracts are
+// swept to SweepToScript, while wallet utxos are still swept back into the
+// wallet.
+func TestSweepToScript(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	sweepToScript := []byte{0xff}
+	ctx.sweeper.cfg.SweepToScript = func() []byte {
+		return sweepToScript
+	}
+
+	// Offer a contract output and a wallet utxo, like one that is offered
+	// to bump the fee of a wallet tx, with the same fee preference.
+	contractInput := spendableInputs[0]
+	contractResult, err := ctx.sweeper.SweepInput(
+		contractInput, defaultFeePref,
+	)
+	require.NoError(t, err)
+
+	walletInput := createTestInput(100000, input.WitnessKeyHash)
+	walletResult, err := ctx.sweeper.SweepInput(
+		&walletInput, defaultFeePref,
+	)
+	require.NoError(t, err)
+
+	// We expect the inputs to be swept in separate txes, because they are
+	// swept to different scripts.
+	ctx.tick()
+
+	sweepTxes := make(map[wire.OutPoint]wire.MsgTx)
+	for i := 0; i < 2; i++ {
+		tx := ctx.receiveTx()
+		require.Len(t, tx.TxIn, 1)
+		require.Len(t, tx.TxOut, 1)
+
+		sweepTxes[tx.TxIn[0].PreviousOutPoint] = tx
+	}
+
+	contractTx, ok := sweepTxes[*contractInput.OutPoint()]
+	require.True(t, ok)
+	require.Equal(t, sweepToScript, contractTx.TxOut[0].PkScript)
+
+	walletTx, ok := sweepTxes[*walletInput.OutPoint()]
+	require.True(t, ok)
+	require.NotEqual(t, sweepToScript, walletTx.TxOut[0].PkScript)
+
+	ctx.backend.mine()
+	ctx.expectResult(contractResult, nil)
+	ctx.expectResult(walletResult, nil)
+
+	ctx.finish(1)
+}
+
 var (
 	testInputsA = pendingInputs{
 		wire.OutPoint{Hash: chainhash.Hash{}, Index: 0}: &pendingInput{},
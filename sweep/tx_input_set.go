@@ -393,3 +393,28 @@ func createWalletTxInput(utxo *lnwallet.Utxo) (input.Input, error) {
 		&utxo.OutPoint, witnessType, signDesc, heightHint,
 	), nil
 }
+
+// isWalletInput returns true if the given input spends an output of the
+// wallet. Those inputs use the witness types of the wallet's addresses, which
+// differ from the ones of any contract output.
+func isWalletInput(inp input.Input) bool {
+	switch inp.WitnessType() {
+	case input.WitnessKeyHash, input.NestedWitnessKeyHash:
+		return true
+
+	default:
+		return false
+	}
+}
+
+// hasWalletInput returns true if any of the given inputs spends an output of
+// the wallet.
+func hasWalletInput(inputs []input.Input) bool {
+	for _, inp := range inputs {
+		if isWalletInput(inp) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -13,10 +13,15 @@ import (
 )
 
 const (
-	// defaultNumBlocksEstimate is the number of blocks that we fall back
+	// DefaultNumBlocksEstimate is the number of blocks that we fall back
 	// to issuing an estimate for if a fee pre fence doesn't specify an
 	// explicit conf target or fee rate.
-	defaultNumBlocksEstimate = 6
+	DefaultNumBlocksEstimate = 6
+
+	// maxEstimateConfTarget is the highest confirmation target that is
+	// considered when estimating the confirmation target of a fee rate.
+	// It matches the highest target supported by the fee estimators.
+	maxEstimateConfTarget = 1008
 )
 
 // FeePreference allows callers to express their time value for inclusion of a
@@ -85,7 +90,7 @@ func DetermineFeePerKw(feeEstimator chainfee.Estimator,
 	// transaction
 	default:
 		feePerKw, err := feeEstimator.EstimateFeePerKW(
-			defaultNumBlocksEstimate,
+			DefaultNumBlocksEstimate,
 		)
 		if err != nil {
 			return 0, fmt.Errorf("unable to query fee estimator: "+
@@ -96,6 +101,46 @@ func DetermineFeePerKw(feeEstimator chainfee.Estimator,
 	}
 }
 
+// EstimateConfTarget returns the lowest confirmation target for which the fee
+// estimator expects a transaction paying the given fee rate to confirm. This
+// relies on the estimates being non-increasing with the confirmation target.
+// If the fee rate is below the estimate for the highest target that is
+// considered, false is returned.
+func EstimateConfTarget(feeEstimator chainfee.Estimator,
+	feeRate chainfee.SatPerKWeight) (uint32, bool, error) {
+
+	low, high := uint32(1), uint32(maxEstimateConfTarget)
+
+	estimate, err := feeEstimator.EstimateFeePerKW(high)
+	if err != nil {
+		return 0, false, fmt.Errorf("unable to query fee estimator: "+
+			"%v", err)
+	}
+	if feeRate < estimate {
+		return 0, false, nil
+	}
+
+	// Search for the lowest target whose estimate doesn't exceed the fee
+	// rate. The fee rate is known to suffice for the high target.
+	for low < high {
+		mid := low + (high-low)/2
+
+		estimate, err := feeEstimator.EstimateFeePerKW(mid)
+		if err != nil {
+			return 0, false, fmt.Errorf("unable to query fee "+
+				"estimator: %v", err)
+		}
+
+		if feeRate >= estimate {
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+
+	return low, true, nil
+}
+
 // UtxoSource is an interface that allows a caller to access a source of UTXOs
 // to use when crafting sweep transactions.
 type UtxoSource interface {
@@ -225,52 +270,13 @@ func CraftSweepAllTx(feeRate chainfee.SatPerKWeight, dustLimit btcutil.Amount,
 	// sweeper to generate and sign a transaction for us.
 	var inputsToSweep []input.Input
 	for _, output := range allOutputs {
-		// As we'll be signing for outputs under control of the wallet,
-		// we only need to populate the output value and output script.
-		// The rest of the items will be populated internally within
-		// the sweeper via the witness generation function.
-		signDesc := &input.SignDescriptor{
-			Output: &wire.TxOut{
-				PkScript: output.PkScript,
-				Value:    int64(output.Value),
-			},
-			HashType: txscript.SigHashAll,
-		}
-
-		pkScript := output.PkScript
-
-		// Based on the output type, we'll map it to the proper witness
-		// type so we can generate the set of input scripts needed to
-		// sweep the output.
-		var witnessType input.WitnessType
-		switch output.AddressType {
-
-		// If this is a p2wkh output, then we'll assume it's a witness
-		// key hash witness type.
-		case lnwallet.WitnessPubKey:
-			witnessType = input.WitnessKeyHash
-
-		// If this is a p2sh output, then as since it's under control
-		// of the wallet, we'll assume it's a nested p2sh output.
-		case lnwallet.NestedWitnessPubKey:
-			witnessType = input.NestedWitnessKeyHash
-
-		// All other output types we count as unknown and will fail to
-		// sweep.
-		default:
+		input, err := makeWalletInput(output)
+		if err != nil {
 			unlockOutputs()
 
-			return nil, fmt.Errorf("unable to sweep coins, "+
-				"unknown script: %x", pkScript[:])
+			return nil, err
 		}
-
-		// Now that we've constructed the items required, we'll make an
-		// input which can be passed to the sweeper for ultimate
-		// sweeping.
-		input := input.MakeBaseInput(
-			&output.OutPoint, witnessType, signDesc, 0, nil,
-		)
-		inputsToSweep = append(inputsToSweep, &input)
+		inputsToSweep = append(inputsToSweep, input)
 	}
 
 	// Create a list of TxOuts from the given delivery addresses.
@@ -315,3 +321,110 @@ func CraftSweepAllTx(feeRate chainfee.SatPerKWeight, dustLimit btcutil.Amount,
 		CancelSweepAttempt: unlockOutputs,
 	}, nil
 }
+
+// CraftSweepOutputTx attempts to craft a WalletSweepPackage which will allow
+// the caller to sweep a single output of the wallet to the given delivery
+// address. The full value of the output, minus the transaction fee at the
+// target fee rate, is sent to the delivery address. The output is locked using
+// the outpointLocker while the sweep transaction is crafted.
+func CraftSweepOutputTx(feeRate chainfee.SatPerKWeight,
+	dustLimit btcutil.Amount, blockHeight uint32, utxo *lnwallet.Utxo,
+	deliveryAddr btcutil.Address, coinSelectLocker CoinSelectionLocker,
+	outpointLocker OutpointLocker,
+	signer input.Signer) (*WalletSweepPackage, error) {
+
+	unlockOutput := func() {
+		outpointLocker.UnlockOutpoint(utxo.OutPoint)
+	}
+
+	// Lock the output under the coin selection lock, to make sure no
+	// concurrent coin selection attempt picks it up while we're crafting
+	// the sweep transaction.
+	err := coinSelectLocker.WithCoinSelectLock(func() error {
+		outpointLocker.LockOutpoint(utxo.OutPoint)
+		return nil
+	})
+	if err != nil {
+		unlockOutput()
+
+		return nil, fmt.Errorf("unable to lock wallet utxo: %v", err)
+	}
+
+	inputToSweep, err := makeWalletInput(utxo)
+	if err != nil {
+		unlockOutput()
+
+		return nil, err
+	}
+
+	deliveryPkScript, err := txscript.PayToAddrScript(deliveryAddr)
+	if err != nil {
+		unlockOutput()
+
+		return nil, err
+	}
+
+	// As there are no other outputs, the full value of the output after
+	// fees is sent to the delivery address as change.
+	sweepTx, err := createSweepTx(
+		[]input.Input{inputToSweep}, nil, deliveryPkScript, blockHeight,
+		feeRate, dustLimit, signer,
+	)
+	if err != nil {
+		unlockOutput()
+
+		return nil, err
+	}
+
+	return &WalletSweepPackage{
+		SweepTx:            sweepTx,
+		CancelSweepAttempt: unlockOutput,
+	}, nil
+}
+
+// makeWalletInput creates an input that spends the given output under control
+// of the wallet.
+func makeWalletInput(output *lnwallet.Utxo) (input.Input, error) {
+	// As we'll be signing for outputs under control of the wallet, we only
+	// need to populate the output value and output script. The rest of the
+	// items will be populated internally within the sweeper via the
+	// witness generation function.
+	signDesc := &input.SignDescriptor{
+		Output: &wire.TxOut{
+			PkScript: output.PkScript,
+			Value:    int64(output.Value),
+		},
+		HashType: txscript.SigHashAll,
+	}
+
+	pkScript := output.PkScript
+
+	// Based on the output type, we'll map it to the proper witness type so
+	// we can generate the set of input scripts needed to sweep the output.
+	var witnessType input.WitnessType
+	switch output.AddressType {
+
+	// If this is a p2wkh output, then we'll assume it's a witness key hash
+	// witness type.
+	case lnwallet.WitnessPubKey:
+		witnessType = input.WitnessKeyHash
+
+	// If this is a p2sh output, then as since it's under control of the
+	// wallet, we'll assume it's a nested p2sh output.
+	case lnwallet.NestedWitnessPubKey:
+		witnessType = input.NestedWitnessKeyHash
+
+	// All other output types we count as unknown and will fail to sweep.
+	default:
+		return nil, fmt.Errorf("unable to sweep coins, "+
+			"unknown script: %x", pkScript[:])
+	}
+
+	// Now that we've constructed the items required, we'll make an input
+	// which can be passed to the sweeper for ultimate sweeping.
+	input := input.MakeBaseInput(
+		&output.OutPoint, witnessType, signDesc, 0, nil,
+	)
+
+	return &input, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/lightningnetwork/lnd/lntest/mock"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
 )
 
 // TestDetermineFeePerKw tests that given a fee preference, the
@@ -29,7 +30,7 @@ func TestDetermineFeePerKw(t *testing.T) {
 	// an arbitrary conf target. We'll ensure below that both of these are
 	// properly
 	feeEstimator.blocksToFee[50] = 300
-	feeEstimator.blocksToFee[defaultNumBlocksEstimate] = 1000
+	feeEstimator.blocksToFee[DefaultNumBlocksEstimate] = 1000
 
 	testCases := []struct {
 		// feePref is the target fee preference for this case.
@@ -109,6 +110,50 @@ func TestDetermineFeePerKw(t *testing.T) {
 	}
 }
 
+// TestEstimateConfTarget tests that the lowest confirmation target for which
+// a fee rate suffices is found.
+func TestEstimateConfTarget(t *testing.T) {
+	t.Parallel()
+
+	// The estimates halve from 8000 sat/kw for the next block down to 250
+	// sat/kw for 32 blocks and more.
+	feeEstimator := newMockFeeEstimator(0, 0)
+	feeEstimator.estimateFeePerKW = func(numBlocks uint32) (
+		chainfee.SatPerKWeight, error) {
+
+		fee := chainfee.SatPerKWeight(8000)
+		for target := uint32(2); target <= numBlocks && fee > 250; {
+			fee /= 2
+			target *= 2
+		}
+
+		return fee, nil
+	}
+
+	testCases := []struct {
+		feeRate    chainfee.SatPerKWeight
+		confTarget uint32
+		ok         bool
+	}{
+		{feeRate: 10000, confTarget: 1, ok: true},
+		{feeRate: 8000, confTarget: 1, ok: true},
+		{feeRate: 7999, confTarget: 2, ok: true},
+		{feeRate: 1000, confTarget: 8, ok: true},
+		{feeRate: 250, confTarget: 32, ok: true},
+		{feeRate: 249, ok: false},
+	}
+	for _, testCase := range testCases {
+		confTarget, ok, err := EstimateConfTarget(
+			feeEstimator, testCase.feeRate,
+		)
+		require.NoError(t, err)
+		require.Equal(t, testCase.ok, ok, "fee rate %v",
+			testCase.feeRate)
+		require.Equal(t, testCase.confTarget, confTarget,
+			"fee rate %v", testCase.feeRate)
+	}
+}
+
 type mockUtxoSource struct {
 	outputs []*lnwallet.Utxo
 }
@@ -393,3 +438,73 @@ func TestCraftSweepAllTx(t *testing.T) {
 	sweepPkg.CancelSweepAttempt()
 	assertUtxosUnlocked(t, utxoLocker, testUtxos[:2])
 }
+
+// TestCraftSweepOutputTx tests that we'll properly lock the target output and
+// craft a sweep transaction that spends only that output to the delivery
+// address.
+func TestCraftSweepOutputTx(t *testing.T) {
+	t.Parallel()
+
+	// We'll use zero fees to we can assert a precise output value.
+	signer := &mock.DummySigner{}
+	targetUTXO := testUtxos[1]
+	coinSelectLocker := &mockCoinSelectionLocker{}
+	utxoLocker := newMockOutpointLocker()
+
+	sweepPkg, err := CraftSweepOutputTx(
+		0, 100, 10, targetUTXO, deliveryAddr, coinSelectLocker,
+		utxoLocker, signer,
+	)
+	if err != nil {
+		t.Fatalf("unable to make sweep tx: %v", err)
+	}
+
+	// The target output should now be locked, but not yet unlocked.
+	assertUtxosLocked(t, utxoLocker, []*lnwallet.Utxo{targetUTXO})
+	assertNoUtxosUnlocked(t, utxoLocker, []*lnwallet.Utxo{targetUTXO})
+
+	// The sweep transaction should only spend the target output and pay
+	// its full value to the delivery address.
+	sweepTx := sweepPkg.SweepTx
+	if len(sweepTx.TxIn) != 1 {
+		t.Fatalf("expected 1 input, got %v", len(sweepTx.TxIn))
+	}
+	if sweepTx.TxIn[0].PreviousOutPoint != targetUTXO.OutPoint {
+		t.Fatalf("expected input %v, got %v", targetUTXO.OutPoint,
+			sweepTx.TxIn[0].PreviousOutPoint)
+	}
+
+	if len(sweepTx.TxOut) != 1 {
+		t.Fatalf("should have %v outputs, instead have %v", 1,
+			len(sweepTx.TxOut))
+	}
+	output := sweepTx.TxOut[0]
+	switch {
+	case output.Value != int64(targetUTXO.Value):
+		t.Fatalf("expected %v sweep value, instead got %v",
+			targetUTXO.Value, output.Value)
+
+	case !bytes.Equal(sweepScript, output.PkScript):
+		t.Fatalf("expected %x sweep script, instead got %x",
+			sweepScript, output.PkScript)
+	}
+
+	// If we cancel the sweep attempt, then we should find that the output
+	// is now unlocked.
+	sweepPkg.CancelSweepAttempt()
+	assertUtxosUnlocked(t, utxoLocker, []*lnwallet.Utxo{targetUTXO})
+
+	// Sweeping an output of an unknown witness type should fail and leave
+	// the output unlocked.
+	utxoLocker = newMockOutpointLocker()
+	_, err = CraftSweepOutputTx(
+		0, 100, 10, testUtxos[2], deliveryAddr, coinSelectLocker,
+		utxoLocker, signer,
+	)
+	if err == nil {
+		t.Fatalf("sweep tx should have failed")
+	}
+	assertUtxosLockedAndUnlocked(
+		t, utxoLocker, []*lnwallet.Utxo{testUtxos[2]},
+	)
+}
@@ -0,0 +1,153 @@
+package lnd
+
+import (
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/contractcourt"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// sweepPreviewInput is an output of a force closed channel that is swept back
+// to the wallet.
+type sweepPreviewInput struct {
+	outpoint       wire.OutPoint
+	resolutionType lnrpc.ResolutionType
+	amount         btcutil.Amount
+	maturityHeight uint32
+
+	// witnessType is the witness type the output is swept with. It is nil
+	// if the output can't be swept by a timelock alone, because it must be
+	// spent by a second level transaction first, or requires a preimage.
+	witnessType input.WitnessType
+}
+
+// isMature returns true if a sweep of the output can confirm in the block
+// after the given height.
+func (s *sweepPreviewInput) isMature(height int32) bool {
+	return s.witnessType != nil && s.maturityHeight != 0 &&
+		s.maturityHeight <= uint32(height)+1
+}
+
+// newSweepPreviewInput converts the contract report of an output of a force
+// closed channel to a preview input. The witness type is an upper bound, as the
+// report doesn't tell the exact type of the commitment. False is returned for
+// outputs that aren't swept to the wallet, which are anchors and outputs that
+// are no longer in limbo.
+func newSweepPreviewInput(report *contractcourt.ContractReport,
+	localForceClose bool) (*sweepPreviewInput, bool) {
+
+	if report.LimboBalance == 0 {
+		return nil, false
+	}
+
+	in := &sweepPreviewInput{
+		outpoint:       report.Outpoint,
+		amount:         report.Amount,
+		maturityHeight: report.MaturityHeight,
+	}
+
+	switch report.Type {
+	case contractcourt.ReportOutputUnencumbered:
+		in.resolutionType = lnrpc.ResolutionType_COMMIT
+		in.witnessType = input.CommitmentToRemoteConfirmed
+		if localForceClose {
+			in.witnessType = input.CommitmentTimeLock
+		}
+
+	// Incoming htlcs can only be swept with their preimage, unless they
+	// reached the output of the second level success transaction.
+	case contractcourt.ReportOutputIncomingHtlc:
+		in.resolutionType = lnrpc.ResolutionType_INCOMING_HTLC
+		if localForceClose && report.Stage == 2 {
+			in.witnessType = input.HtlcAcceptedSuccessSecondLevel
+		}
+
+	// Outgoing htlcs on our commitment are spent by the second level
+	// timeout transaction first, while those on the remote commitment are
+	// swept directly.
+	case contractcourt.ReportOutputOutgoingHtlc:
+		in.resolutionType = lnrpc.ResolutionType_OUTGOING_HTLC
+		switch {
+		case localForceClose && report.Stage == 2:
+			in.witnessType = input.HtlcOfferedTimeoutSecondLevel
+
+		case !localForceClose:
+			in.witnessType = input.HtlcOfferedRemoteTimeout
+		}
+
+	// Anchors are only swept to bump the fee of the commitment.
+	default:
+		return nil, false
+	}
+
+	return in, true
+}
+
+// sweepPreview is the transaction that sweeps the mature outputs of a force
+// closed channel at a given fee rate.
+type sweepPreview struct {
+	// inputs are the mature outputs that are swept.
+	inputs []*sweepPreviewInput
+
+	// pending are the outputs that can't be swept yet.
+	pending []*sweepPreviewInput
+
+	weight       int64
+	fee          btcutil.Amount
+	outputAmount btcutil.Amount
+}
+
+// newSweepPreview estimates the transaction that sweeps the outputs that are
+// mature at the given height into a single output paying to the sweep script,
+// or to a p2wkh output of the wallet if no sweep script is given. The output
+// amount is zero if the swept outputs don't cover the fee.
+func newSweepPreview(inputs []*sweepPreviewInput, height int32,
+	feeRate chainfee.SatPerKWeight, sweepScript []byte) (*sweepPreview,
+	error) {
+
+	preview := &sweepPreview{}
+
+	var (
+		estimator   input.TxWeightEstimator
+		totalAmount btcutil.Amount
+	)
+	for _, in := range inputs {
+		if !in.isMature(height) {
+			preview.pending = append(preview.pending, in)
+			continue
+		}
+
+		size, isNested, err := in.witnessType.SizeUpperBound()
+		if err != nil {
+			return nil, err
+		}
+		if isNested {
+			estimator.AddNestedP2WSHInput(size)
+		} else {
+			estimator.AddWitnessInput(size)
+		}
+
+		preview.inputs = append(preview.inputs, in)
+		totalAmount += in.amount
+	}
+
+	if len(preview.inputs) == 0 {
+		return preview, nil
+	}
+
+	if len(sweepScript) > 0 {
+		estimator.AddTxOutput(&wire.TxOut{PkScript: sweepScript})
+	} else {
+		estimator.AddP2WKHOutput()
+	}
+
+	preview.weight = int64(estimator.Weight())
+	preview.fee = feeRate.FeeForWeight(preview.weight)
+	if totalAmount > preview.fee {
+		preview.outputAmount = totalAmount - preview.fee
+	}
+
+	return preview, nil
+}
@@ -0,0 +1,205 @@
+// +build !rpctest
+
+package lnd
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/contractcourt"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewSweepPreviewInput asserts that the outputs of a force closed channel
+// are swept with the expected witness type, and that outputs that aren't swept
+// to the wallet are skipped.
+func TestNewSweepPreviewInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		reportType  contractcourt.ReportOutputType
+		stage       uint32
+		local       bool
+		limbo       bool
+		skipped     bool
+		witnessType input.WitnessType
+	}{
+		{
+			name:        "local commitment output",
+			reportType:  contractcourt.ReportOutputUnencumbered,
+			local:       true,
+			limbo:       true,
+			witnessType: input.CommitmentTimeLock,
+		},
+		{
+			name:        "remote commitment output",
+			reportType:  contractcourt.ReportOutputUnencumbered,
+			limbo:       true,
+			witnessType: input.CommitmentToRemoteConfirmed,
+		},
+		{
+			name:       "local outgoing htlc first stage",
+			reportType: contractcourt.ReportOutputOutgoingHtlc,
+			stage:      1,
+			local:      true,
+			limbo:      true,
+		},
+		{
+			name:        "local outgoing htlc second stage",
+			reportType:  contractcourt.ReportOutputOutgoingHtlc,
+			stage:       2,
+			local:       true,
+			limbo:       true,
+			witnessType: input.HtlcOfferedTimeoutSecondLevel,
+		},
+		{
+			name:        "remote outgoing htlc",
+			reportType:  contractcourt.ReportOutputOutgoingHtlc,
+			stage:       1,
+			limbo:       true,
+			witnessType: input.HtlcOfferedRemoteTimeout,
+		},
+		{
+			name:       "remote incoming htlc",
+			reportType: contractcourt.ReportOutputIncomingHtlc,
+			stage:      1,
+			limbo:      true,
+		},
+		{
+			name:        "local incoming htlc second stage",
+			reportType:  contractcourt.ReportOutputIncomingHtlc,
+			stage:       2,
+			local:       true,
+			limbo:       true,
+			witnessType: input.HtlcAcceptedSuccessSecondLevel,
+		},
+		{
+			name:       "anchor",
+			reportType: contractcourt.ReportOutputAnchor,
+			limbo:      true,
+			skipped:    true,
+		},
+		{
+			name:       "swept output",
+			reportType: contractcourt.ReportOutputUnencumbered,
+			local:      true,
+			skipped:    true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			report := &contractcourt.ContractReport{
+				Type:           test.reportType,
+				Amount:         1000,
+				MaturityHeight: 100,
+				Stage:          test.stage,
+			}
+			if test.limbo {
+				report.LimboBalance = report.Amount
+			} else {
+				report.RecoveredBalance = report.Amount
+			}
+
+			in, ok := newSweepPreviewInput(report, test.local)
+			require.Equal(t, !test.skipped, ok)
+			if test.skipped {
+				return
+			}
+
+			require.Equal(t, test.witnessType, in.witnessType)
+			require.Equal(t, report.Amount, in.amount)
+			require.Equal(t, report.MaturityHeight, in.maturityHeight)
+		})
+	}
+}
+
+// TestNewSweepPreview asserts that only mature outputs are swept, and that the
+// fee is derived from the weight of the sweep transaction.
+func TestNewSweepPreview(t *testing.T) {
+	t.Parallel()
+
+	const (
+		height  = 100
+		feeRate = chainfee.SatPerKWeight(2500)
+	)
+
+	commit := &sweepPreviewInput{
+		outpoint:       wire.OutPoint{Index: 0},
+		resolutionType: lnrpc.ResolutionType_COMMIT,
+		amount:         100000,
+		maturityHeight: height + 1,
+		witnessType:    input.CommitmentTimeLock,
+	}
+	htlc := &sweepPreviewInput{
+		outpoint:       wire.OutPoint{Index: 1},
+		resolutionType: lnrpc.ResolutionType_OUTGOING_HTLC,
+		amount:         20000,
+		maturityHeight: height + 10,
+		witnessType:    input.HtlcOfferedTimeoutSecondLevel,
+	}
+	unconfirmed := &sweepPreviewInput{
+		outpoint:       wire.OutPoint{Index: 2},
+		resolutionType: lnrpc.ResolutionType_OUTGOING_HTLC,
+		amount:         30000,
+		witnessType:    input.HtlcOfferedTimeoutSecondLevel,
+	}
+	secondLevel := &sweepPreviewInput{
+		outpoint:       wire.OutPoint{Index: 3},
+		resolutionType: lnrpc.ResolutionType_INCOMING_HTLC,
+		amount:         40000,
+		maturityHeight: height,
+	}
+
+	inputs := []*sweepPreviewInput{commit, htlc, unconfirmed, secondLevel}
+	preview, err := newSweepPreview(inputs, height, feeRate, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, []*sweepPreviewInput{commit}, preview.inputs)
+	require.Equal(
+		t, []*sweepPreviewInput{htlc, unconfirmed, secondLevel},
+		preview.pending,
+	)
+
+	var estimator input.TxWeightEstimator
+	estimator.AddWitnessInput(input.ToLocalTimeoutWitnessSize)
+	estimator.AddP2WKHOutput()
+	expectedWeight := int64(estimator.Weight())
+
+	require.Equal(t, expectedWeight, preview.weight)
+	require.Equal(t, feeRate.FeeForWeight(expectedWeight), preview.fee)
+	require.Equal(t, commit.amount-preview.fee, preview.outputAmount)
+
+	// Once the htlc matures, it is swept as well, which raises the fee.
+	preview, err = newSweepPreview(inputs, height+9, feeRate, nil)
+	require.NoError(t, err)
+	require.Equal(t, []*sweepPreviewInput{commit, htlc}, preview.inputs)
+	require.Greater(t, preview.weight, expectedWeight)
+
+	// Without mature outputs, there is nothing to sweep.
+	preview, err = newSweepPreview(inputs, height-10, feeRate, nil)
+	require.NoError(t, err)
+	require.Empty(t, preview.inputs)
+	require.Zero(t, preview.fee)
+	require.Zero(t, preview.outputAmount)
+
+	// An output that doesn't cover the fee results in an empty output.
+	dust := &sweepPreviewInput{
+		resolutionType: lnrpc.ResolutionType_COMMIT,
+		amount:         100,
+		maturityHeight: height,
+		witnessType:    input.CommitmentTimeLock,
+	}
+	preview, err = newSweepPreview(
+		[]*sweepPreviewInput{dust}, height, feeRate, nil,
+	)
+	require.NoError(t, err)
+	require.NotZero(t, preview.fee)
+	require.Zero(t, preview.outputAmount)
+}
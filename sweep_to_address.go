@@ -0,0 +1,42 @@
+package lnd
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// parseSweepToAddress decodes the address that all funds of closed channels
+// are swept to and returns the script paying to it. The address must belong
+// to the given chain. Only the address types that are valid as a shutdown
+// script in a cooperative close are accepted, so that the same address can
+// be used for force close sweeps and cooperative closes alike. None of them
+// encumbers the swept funds with a relative time lock of our own.
+func parseSweepToAddress(address string,
+	params *chaincfg.Params) (lnwire.DeliveryAddress, error) {
+
+	addr, err := btcutil.DecodeAddress(address, params)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sweep address: %v", err)
+	}
+
+	if !addr.IsForNet(params) {
+		return nil, fmt.Errorf("sweep address %v is not valid for "+
+			"network %v", address, params.Name)
+	}
+
+	switch addr.(type) {
+	case *btcutil.AddressPubKeyHash, *btcutil.AddressScriptHash,
+		*btcutil.AddressWitnessPubKeyHash,
+		*btcutil.AddressWitnessScriptHash:
+
+	default:
+		return nil, fmt.Errorf("unsupported sweep address type %T, "+
+			"must be p2pkh, p2sh, p2wkh or p2wsh", addr)
+	}
+
+	return txscript.PayToAddrScript(addr)
+}
@@ -0,0 +1,97 @@
+// +build !rpctest
+
+package lnd
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseSweepToAddress asserts that only addresses of the active network
+// that are valid as a shutdown script are accepted as sweep address.
+func TestParseSweepToAddress(t *testing.T) {
+	t.Parallel()
+
+	params := &chaincfg.MainNetParams
+	hash20 := make([]byte, 20)
+	hash32 := make([]byte, 32)
+
+	p2pkh, err := btcutil.NewAddressPubKeyHash(hash20, params)
+	require.NoError(t, err)
+	p2sh, err := btcutil.NewAddressScriptHashFromHash(hash20, params)
+	require.NoError(t, err)
+	p2wkh, err := btcutil.NewAddressWitnessPubKeyHash(hash20, params)
+	require.NoError(t, err)
+	p2wsh, err := btcutil.NewAddressWitnessScriptHash(hash32, params)
+	require.NoError(t, err)
+
+	testnetP2wkh, err := btcutil.NewAddressWitnessPubKeyHash(
+		hash20, &chaincfg.TestNet3Params,
+	)
+	require.NoError(t, err)
+
+	// A bare public key isn't a valid shutdown script.
+	p2pk := "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"
+
+	tests := []struct {
+		name    string
+		address string
+		valid   bool
+	}{
+		{
+			name:    "p2pkh",
+			address: p2pkh.String(),
+			valid:   true,
+		},
+		{
+			name:    "p2sh",
+			address: p2sh.String(),
+			valid:   true,
+		},
+		{
+			name:    "p2wkh",
+			address: p2wkh.String(),
+			valid:   true,
+		},
+		{
+			name:    "p2wsh",
+			address: p2wsh.String(),
+			valid:   true,
+		},
+		{
+			name:    "wrong network",
+			address: testnetP2wkh.String(),
+		},
+		{
+			name:    "p2pk",
+			address: p2pk,
+		},
+		{
+			name:    "garbage",
+			address: "not an address",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			script, err := parseSweepToAddress(test.address, params)
+			if !test.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			addr, err := btcutil.DecodeAddress(test.address, params)
+			require.NoError(t, err)
+			expected, err := txscript.PayToAddrScript(addr)
+			require.NoError(t, err)
+			require.Equal(t, expected, []byte(script))
+		})
+	}
+}
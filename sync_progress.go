@@ -0,0 +1,92 @@
+package lnd
+
+import (
+	"sync"
+	"time"
+)
+
+// maxUnsyncedPercent is the highest sync percentage we report as long as we
+// don't consider ourselves synced, so that a completed sync is never reported
+// prematurely.
+const maxUnsyncedPercent = 99.99
+
+// blockSyncPercent returns the percentage of the chain that we've synced,
+// given our current height and the height of the chain tip.
+func blockSyncPercent(currentHeight, targetHeight uint32, synced bool) float64 {
+	if synced {
+		return 100
+	}
+
+	if targetHeight == 0 {
+		return 0
+	}
+
+	percent := float64(currentHeight) / float64(targetHeight) * 100
+	if percent > maxUnsyncedPercent {
+		return maxUnsyncedPercent
+	}
+
+	return percent
+}
+
+// syncProgressTracker estimates the remaining duration of a sync from the
+// progress made since it was first queried.
+type syncProgressTracker struct {
+	// now returns the current time, it is set to allow for timestamp
+	// mocking in tests.
+	now func() time.Time
+
+	// startTime is the time at which we first observed the progress of
+	// the sync.
+	startTime time.Time
+
+	// startProgress is the progress we first observed, ranging from 0 to
+	// 1.
+	startProgress float64
+
+	mu sync.Mutex
+}
+
+// newSyncProgressTracker creates a new tracker that hasn't observed any
+// progress yet.
+func newSyncProgressTracker(now func() time.Time) *syncProgressTracker {
+	return &syncProgressTracker{
+		now: now,
+	}
+}
+
+// remaining returns the estimated duration until the sync completes, given
+// its current progress ranging from 0 to 1. The returned boolean is false if
+// no estimate is possible yet, which is the case until the sync has made
+// progress since we first observed it. If the progress is lower than the one
+// we first observed, the sync has started over and we start observing it
+// anew.
+func (t *syncProgressTracker) remaining(progress float64) (time.Duration,
+	bool) {
+
+	if progress >= 1 {
+		return 0, true
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	if t.startTime.IsZero() || progress < t.startProgress {
+		t.startTime = now
+		t.startProgress = progress
+
+		return 0, false
+	}
+
+	elapsed := now.Sub(t.startTime)
+	done := progress - t.startProgress
+	if elapsed <= 0 || done <= 0 {
+		return 0, false
+	}
+
+	rate := done / elapsed.Seconds()
+	remaining := (1 - progress) / rate
+
+	return time.Duration(remaining * float64(time.Second)), true
+}
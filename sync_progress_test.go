@@ -0,0 +1,96 @@
+package lnd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestBlockSyncPercent tests that the block sync percentage only reaches 100
+// once we consider ourselves synced.
+func TestBlockSyncPercent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		current uint32
+		target  uint32
+		synced  bool
+		percent float64
+	}{
+		{
+			name:    "synced",
+			current: 500,
+			target:  500,
+			synced:  true,
+			percent: 100,
+		},
+		{
+			name:    "halfway",
+			current: 250,
+			target:  500,
+			percent: 50,
+		},
+		{
+			name:    "at tip but not synced",
+			current: 500,
+			target:  500,
+			percent: maxUnsyncedPercent,
+		},
+		{
+			name:    "unknown target",
+			percent: 0,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			percent := blockSyncPercent(
+				test.current, test.target, test.synced,
+			)
+			require.Equal(t, test.percent, percent)
+		})
+	}
+}
+
+// TestSyncProgressTrackerRemaining tests the estimation of the remaining sync
+// duration.
+func TestSyncProgressTrackerRemaining(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	tracker := newSyncProgressTracker(func() time.Time {
+		return now
+	})
+
+	// Without a previous observation, no estimate is possible.
+	_, ok := tracker.remaining(0.2)
+	require.False(t, ok)
+
+	// Neither is it if no progress has been made.
+	now = now.Add(time.Minute)
+	_, ok = tracker.remaining(0.2)
+	require.False(t, ok)
+
+	// Having made 20% progress in two minutes, the remaining 60% take six
+	// more minutes.
+	now = now.Add(time.Minute)
+	remaining, ok := tracker.remaining(0.4)
+	require.True(t, ok)
+	require.InDelta(
+		t, float64(6*time.Minute), float64(remaining),
+		float64(time.Second),
+	)
+
+	// A completed sync has nothing remaining.
+	remaining, ok = tracker.remaining(1)
+	require.True(t, ok)
+	require.Zero(t, remaining)
+
+	// If the sync started over, we start observing it anew.
+	_, ok = tracker.remaining(0.1)
+	require.False(t, ok)
+}
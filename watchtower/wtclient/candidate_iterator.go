@@ -21,6 +21,10 @@ type TowerCandidateIterator interface {
 	// completely removed from the iterator.
 	RemoveCandidate(wtdb.TowerID, net.Addr) error
 
+	// PrioritizeCandidate moves an existing candidate tower to the front
+	// of the iterator, making it the next candidate to be returned.
+	PrioritizeCandidate(wtdb.TowerID)
+
 	// IsActive determines whether a given tower is exists within the
 	// iterator.
 	IsActive(wtdb.TowerID) bool
@@ -153,6 +157,29 @@ func (t *towerListIterator) RemoveCandidate(candidate wtdb.TowerID,
 	return nil
 }
 
+// PrioritizeCandidate moves an existing candidate tower to the front of the
+// iterator, making it the next candidate to be returned. Unknown towers are
+// ignored.
+func (t *towerListIterator) PrioritizeCandidate(candidate wtdb.TowerID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.candidates[candidate]; !ok {
+		return
+	}
+
+	for e := t.queue.Front(); e != nil; e = e.Next() {
+		if e.Value.(wtdb.TowerID) != candidate {
+			continue
+		}
+
+		t.queue.MoveToFront(e)
+		t.nextCandidate = t.queue.Front()
+
+		return
+	}
+}
+
 // IsActive determines whether a given tower is exists within the iterator.
 func (t *towerListIterator) IsActive(tower wtdb.TowerID) bool {
 	t.mu.Lock()
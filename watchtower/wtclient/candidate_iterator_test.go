@@ -154,4 +154,10 @@ func TestTowerCandidateIterator(t *testing.T) {
 	towerIterator.AddCandidate(secondTower)
 	assertActiveCandidate(t, towerIterator, secondTower, true)
 	assertNextCandidate(t, towerIterator, secondTower)
+
+	// Prioritizing the third tower should make it the next candidate, even
+	// though the iterator has already moved past it.
+	towerIterator.PrioritizeCandidate(thirdTower.ID)
+	assertNextCandidate(t, towerIterator, thirdTower)
+	assertNextCandidate(t, towerIterator, firstTower)
 }
@@ -81,6 +81,13 @@ type Client interface {
 	// instead.
 	RemoveTower(*btcec.PublicKey, net.Addr) error
 
+	// MigrateTower replaces the watchtower with the given public key by
+	// the watchtower reachable at the given address. The old watchtower is
+	// no longer considered for new sessions or backups, while the new one
+	// is preferred over any other candidates. States that were already
+	// backed up to the old watchtower remain with it.
+	MigrateTower(*btcec.PublicKey, *lnwire.NetAddress) error
+
 	// RegisteredTowers retrieves the list of watchtowers registered with
 	// the client.
 	RegisteredTowers() ([]*RegisteredTower, error)
@@ -223,6 +230,24 @@ type staleTowerMsg struct {
 	errChan chan error
 }
 
+// migrateTowerMsg is an internal message we'll use within the TowerClient to
+// signal that a tower should be replaced by another one.
+type migrateTowerMsg struct {
+	// oldPubKey is the identifying public key of the watchtower that
+	// should no longer be considered.
+	oldPubKey *btcec.PublicKey
+
+	// newAddr is the reachable address of the watchtower that should be
+	// considered in favor of the old one.
+	newAddr *lnwire.NetAddress
+
+	// errChan is the channel through which we'll send a response back to
+	// the caller when handling their request.
+	//
+	// NOTE: This channel must be buffered.
+	errChan chan error
+}
+
 // TowerClient is a concrete implementation of the Client interface, offering a
 // non-blocking, reliable subsystem for backing up revoked states to a specified
 // private tower.
@@ -245,6 +270,11 @@ type TowerClient struct {
 	sessionQueue *sessionQueue
 	prevTask     *backupTask
 
+	// priorityTower is the tower whose candidate sessions are preferred
+	// when loading the next session queue. It is set after a tower has
+	// been migrated.
+	priorityTower *wtdb.TowerID
+
 	backupMu          sync.Mutex
 	summaries         wtdb.ChannelSummaries
 	chanCommitHeights map[lnwire.ChannelID]uint64
@@ -252,8 +282,9 @@ type TowerClient struct {
 	statTicker *time.Ticker
 	stats      *ClientStats
 
-	newTowers   chan *newTowerMsg
-	staleTowers chan *staleTowerMsg
+	newTowers     chan *newTowerMsg
+	staleTowers   chan *staleTowerMsg
+	migrateTowers chan *migrateTowerMsg
 
 	wg        sync.WaitGroup
 	forceQuit chan struct{}
@@ -326,6 +357,7 @@ func New(config *Config) (*TowerClient, error) {
 		stats:             new(ClientStats),
 		newTowers:         make(chan *newTowerMsg),
 		staleTowers:       make(chan *staleTowerMsg),
+		migrateTowers:     make(chan *migrateTowerMsg),
 		forceQuit:         make(chan struct{}),
 	}
 	c.negotiator = newSessionNegotiator(&NegotiatorConfig{
@@ -648,6 +680,11 @@ func (c *TowerClient) BackupState(chanID *lnwire.ChannelID,
 // client is restarted with a matching policy. If no candidates were found, nil
 // is returned to signal that we need to request a new policy.
 func (c *TowerClient) nextSessionQueue() *sessionQueue {
+	// If a tower has been prioritized, we'll try its sessions first.
+	if prioritySession := c.nextPrioritySession(); prioritySession != nil {
+		return c.getOrInitActiveQueue(prioritySession)
+	}
+
 	// Select any candidate session at random, and remove it from the set of
 	// candidate sessions.
 	var candidateSession *wtdb.ClientSession
@@ -678,6 +715,30 @@ func (c *TowerClient) nextSessionQueue() *sessionQueue {
 	return c.getOrInitActiveQueue(candidateSession)
 }
 
+// nextPrioritySession removes and returns a candidate session of the
+// prioritized tower that matches the active client's TxPolicy. If there is no
+// prioritized tower or it has no such session, nil is returned.
+func (c *TowerClient) nextPrioritySession() *wtdb.ClientSession {
+	if c.priorityTower == nil {
+		return nil
+	}
+
+	for id, sessionInfo := range c.candidateSessions {
+		if sessionInfo.TowerID != *c.priorityTower {
+			continue
+		}
+		if sessionInfo.Policy.TxPolicy != c.cfg.Policy.TxPolicy {
+			continue
+		}
+
+		delete(c.candidateSessions, id)
+
+		return sessionInfo
+	}
+
+	return nil
+}
+
 // backupDispatcher processes events coming from the taskPipeline and is
 // responsible for detecting when the client needs to renegotiate a session to
 // fulfill continuing demand. The event loop exits after all tasks have been
@@ -734,6 +795,14 @@ func (c *TowerClient) backupDispatcher() {
 					"is disallowed while a new session " +
 					"negotiation is in progress")
 
+			// A tower has been requested to be migrated. For the
+			// same reason as above, we'll immediately return an
+			// error.
+			case msg := <-c.migrateTowers:
+				msg.errChan <- errors.New("migrating towers " +
+					"is disallowed while a new session " +
+					"negotiation is in progress")
+
 			case <-c.forceQuit:
 				return
 			}
@@ -812,6 +881,12 @@ func (c *TowerClient) backupDispatcher() {
 			// of its corresponding candidate sessions as inactive.
 			case msg := <-c.staleTowers:
 				msg.errChan <- c.handleStaleTower(msg)
+
+			// A tower has been requested to be replaced by
+			// another one. We'll add the new tower, remove the old
+			// one and prefer the new tower's sessions from now on.
+			case msg := <-c.migrateTowers:
+				msg.errChan <- c.handleMigrateTower(msg)
 			}
 		}
 	}
@@ -1181,6 +1256,90 @@ func (c *TowerClient) handleStaleTower(msg *staleTowerMsg) error {
 	return nil
 }
 
+// MigrateTower replaces the watchtower with the given public key by the
+// watchtower reachable at the given address. The old watchtower is no longer
+// considered for new sessions or backups, while the new one is preferred over
+// any other candidates. States that were already backed up to the old
+// watchtower remain with it.
+func (c *TowerClient) MigrateTower(oldPubKey *btcec.PublicKey,
+	newAddr *lnwire.NetAddress) error {
+
+	errChan := make(chan error, 1)
+
+	select {
+	case c.migrateTowers <- &migrateTowerMsg{
+		oldPubKey: oldPubKey,
+		newAddr:   newAddr,
+		errChan:   errChan,
+	}:
+	case <-c.pipeline.quit:
+		return ErrClientExiting
+	case <-c.pipeline.forceQuit:
+		return ErrClientExiting
+	}
+
+	select {
+	case err := <-errChan:
+		return err
+	case <-c.pipeline.quit:
+		return ErrClientExiting
+	case <-c.pipeline.forceQuit:
+		return ErrClientExiting
+	}
+}
+
+// handleMigrateTower handles a request for an existing tower to be replaced by
+// a new one. The old tower can only be replaced if none of its sessions have
+// pending updates, as these would otherwise be left without a tower. If
+// successful, the new tower becomes the first candidate for new sessions and
+// its sessions are preferred over those of any other tower.
+func (c *TowerClient) handleMigrateTower(msg *migrateTowerMsg) error {
+	oldPubKey := msg.oldPubKey.SerializeCompressed()
+	newPubKey := msg.newAddr.IdentityKey.SerializeCompressed()
+	if bytes.Equal(oldPubKey, newPubKey) {
+		return errors.New("old and new tower must differ")
+	}
+
+	// We'll make sure the old tower can be removed before making any
+	// changes, so that a failed migration leaves the client untouched.
+	oldTower, err := c.cfg.DB.LoadTower(msg.oldPubKey)
+	if err != nil {
+		return err
+	}
+	sessions, err := c.cfg.DB.ListClientSessions(&oldTower.ID)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve sessions for tower %x: "+
+			"%v", oldPubKey, err)
+	}
+	for _, session := range sessions {
+		if len(session.CommittedUpdates) > 0 {
+			return wtdb.ErrTowerUnackedUpdates
+		}
+	}
+
+	err = c.handleNewTower(&newTowerMsg{addr: msg.newAddr})
+	if err != nil {
+		return err
+	}
+
+	err = c.handleStaleTower(&staleTowerMsg{pubKey: msg.oldPubKey})
+	if err != nil {
+		return err
+	}
+
+	newTower, err := c.cfg.DB.LoadTower(msg.newAddr.IdentityKey)
+	if err != nil {
+		return err
+	}
+	c.candidateTowers.PrioritizeCandidate(newTower.ID)
+	c.priorityTower = &newTower.ID
+
+	c.log.Infof("Migrated tower %x to %x@%v", oldPubKey, newPubKey,
+		msg.newAddr.Address)
+
+	return nil
+}
+
 // RegisteredTowers retrieves the list of watchtowers registered with the
 // client.
 func (c *TowerClient) RegisteredTowers() ([]*RegisteredTower, error) {